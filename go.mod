@@ -1,13 +1,17 @@
 module github.com/mithrandie/csvq
 
 require (
+	github.com/dsnet/compress v0.0.1
+	github.com/klauspost/compress v1.11.13
 	github.com/mitchellh/go-homedir v1.0.0
 	github.com/mithrandie/go-file v1.1.0
 	github.com/mithrandie/go-text v1.1.0
 	github.com/mithrandie/readline-csvq v1.0.2
 	github.com/mithrandie/ternary v1.1.0
+	github.com/ulikunitz/xz v0.5.10
 	github.com/urfave/cli v1.20.0
 	golang.org/x/crypto v0.0.0-20181112202954-3d3f9f413869
 	golang.org/x/sys v0.0.0-20181107165924-66b7b1311ac8
+	golang.org/x/text v0.3.0
 	golang.org/x/tools v0.0.0-20181207222222-4c874b978acb // indirect
 )
@@ -20,7 +20,7 @@ func Calc(expr string) error {
 
 	q := "select " + expr + " from stdin"
 
-	program, err := parser.Parse(q, "")
+	program, err := parser.Parse(q, "", cmd.GetFlags().DatetimeFormat, cmd.GetFlags().GetLocation())
 	if err != nil {
 		return errors.New("syntax error")
 	}
@@ -1,13 +1,17 @@
 package action
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 )
 
+const TestWorkerEnv = "CSVQ_ACTION_TEST_WORKER"
+
 var TestDir = filepath.Join(os.TempDir(), "csvq_action_test")
 var TestDataDir string
 
@@ -16,6 +20,13 @@ func GetTestFilePath(filename string) string {
 }
 
 func TestMain(m *testing.M) {
+	if os.Getenv(TestWorkerEnv) == "1" {
+		if err := ServeWorker(); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 	os.Exit(run(m))
 }
 
@@ -42,6 +53,12 @@ func setup() {
 	os.Stdin = r
 
 	cmd.GetFlags().SetColor(false)
+
+	workerCommand = func() (*exec.Cmd, error) {
+		c := exec.Command(os.Args[0])
+		c.Env = append(os.Environ(), TestWorkerEnv+"=1")
+		return c, nil
+	}
 }
 
 func teardown() {
@@ -29,10 +29,10 @@ func Run(proc *query.Procedure, input string, sourceFile string, outfile string)
 		if err := query.ReleaseResourcesWithErrors(); err != nil {
 			query.LogError(err.Error())
 		}
-		showStats(start)
+		showStats(start, proc.Filter)
 	}()
 
-	statements, err := parser.Parse(input, sourceFile)
+	statements, err := parser.Parse(input, sourceFile, cmd.GetFlags().DatetimeFormat, cmd.GetFlags().GetLocation())
 	if err != nil {
 		return query.NewSyntaxError(err.(*parser.SyntaxError))
 	}
@@ -143,7 +143,7 @@ func LaunchInteractiveShell(proc *query.Procedure) error {
 		}
 		query.Terminal.SaveHistory(saveQuery)
 
-		statements, e := parser.Parse(strings.Join(lines, "\n"), "")
+		statements, e := parser.Parse(strings.Join(lines, "\n"), "", cmd.GetFlags().DatetimeFormat, cmd.GetFlags().GetLocation())
 		if e != nil {
 			e = query.NewSyntaxError(e.(*parser.SyntaxError))
 			query.LogError(e.Error())
@@ -176,7 +176,7 @@ func LaunchInteractiveShell(proc *query.Procedure) error {
 	return err
 }
 
-func showStats(start time.Time) {
+func showStats(start time.Time, filter *query.Filter) {
 	flags := cmd.GetFlags()
 	if !flags.Stats {
 		return
@@ -199,7 +199,7 @@ func showStats(start time.Time) {
 	}
 	width = width + 1
 
-	w := query.NewObjectWriter()
+	w := query.NewObjectWriter(filter)
 	w.WriteColor(" TotalTime:", cmd.LableEffect)
 	w.WriteSpaces(width - len(exectime))
 	w.WriteWithoutLineBreak(exectime + " seconds")
@@ -0,0 +1,448 @@
+package action
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/mithrandie/go-text"
+)
+
+const (
+	SessionHeader = "X-Csvq-Session"
+	LogHeader     = "X-Csvq-Log"
+)
+
+const (
+	queryPath    = "/query"
+	sessionsPath = "/sessions"
+)
+
+const DefaultMaxRequestSize = 10 << 20
+
+const XlsxMimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+var formatMimeTypes = []struct {
+	MimeType string
+	Format   cmd.Format
+}{
+	{MimeType: "text/csv", Format: cmd.CSV},
+	{MimeType: "text/tab-separated-values", Format: cmd.TSV},
+	{MimeType: "application/json", Format: cmd.JSON},
+	{MimeType: "text/markdown", Format: cmd.GFM},
+	{MimeType: XlsxMimeType, Format: cmd.XLSX},
+	{MimeType: "text/plain", Format: cmd.TEXT},
+}
+
+var activeServer *Server
+
+func Serve(listen string, sessionTimeout float64, maxRequestSize int64) error {
+	server := NewServer(time.Duration(sessionTimeout * float64(time.Second)))
+	server.MaxRequestSize = maxRequestSize
+	activeServer = server
+	defer func() {
+		server.Close()
+		activeServer = nil
+	}()
+
+	go server.expireSessions()
+
+	query.Log(fmt.Sprintf("csvq server is listening on %s", listen), cmd.GetFlags().Quiet)
+	return http.ListenAndServe(listen, server)
+}
+
+func FormatMimeType(format cmd.Format) string {
+	switch format {
+	case cmd.CSV:
+		return "text/csv"
+	case cmd.TSV:
+		return "text/tab-separated-values"
+	case cmd.JSON:
+		return "application/json"
+	case cmd.GFM:
+		return "text/markdown"
+	case cmd.XLSX:
+		return XlsxMimeType
+	}
+	return "text/plain"
+}
+
+// ParseAcceptHeader returns the format of the supported media type with the highest quality value in the header.
+// Media types with the same quality value are preferred in the order of the header.
+func ParseAcceptHeader(accept string) (cmd.Format, bool) {
+	format := cmd.TEXT
+	quality := 0.0
+	ok := false
+
+	for _, item := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if s, exists := params["q"]; exists {
+			if q, err = strconv.ParseFloat(s, 64); err != nil || q <= 0 {
+				continue
+			}
+		}
+		if ok && q <= quality {
+			continue
+		}
+
+		for _, v := range formatMimeTypes {
+			if mediaType == v.MimeType {
+				format = v.Format
+				quality = q
+				ok = true
+				break
+			}
+		}
+	}
+	return format, ok
+}
+
+type serverSession struct {
+	worker     *worker
+	lastAccess time.Time
+}
+
+// Server runs the queries of each session in a dedicated worker process so that requests are executed concurrently.
+// Requests without a session are executed by idle workers, and each of them is executed in a new session.
+type Server struct {
+	SessionTimeout time.Duration
+	MaxIdleWorkers int
+	MaxRequestSize int64
+
+	flags    *cmd.Flags
+	sessions map[string]*serverSession
+	idle     []*worker
+
+	mtx *sync.Mutex
+}
+
+func NewServer(sessionTimeout time.Duration) *Server {
+	return &Server{
+		SessionTimeout: sessionTimeout,
+		MaxIdleWorkers: runtime.NumCPU(),
+		MaxRequestSize: DefaultMaxRequestSize,
+		flags:          cmd.GetFlags().Copy(),
+		sessions:       make(map[string]*serverSession),
+		mtx:            &sync.Mutex{},
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == queryPath:
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeErrorResponse(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		s.handleQuery(w, r)
+	case r.URL.Path == sessionsPath:
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeErrorResponse(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		s.handleCreateSession(w)
+	case strings.HasPrefix(r.URL.Path, sessionsPath+"/"):
+		if r.Method != http.MethodDelete {
+			w.Header().Set("Allow", http.MethodDelete)
+			writeErrorResponse(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		s.handleDeleteSession(w, r, strings.TrimPrefix(r.URL.Path, sessionsPath+"/"))
+	default:
+		writeErrorResponse(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) handleCreateSession(w http.ResponseWriter) {
+	token, err := generateSessionToken()
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	wk, err := startWorker(s.flags)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	s.mtx.Lock()
+	s.sessions[token] = &serverSession{
+		worker:     wk,
+		lastAccess: time.Now(),
+	}
+	s.mtx.Unlock()
+
+	w.Header().Set(SessionHeader, token)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(token + "\n"))
+}
+
+func (s *Server) handleDeleteSession(w http.ResponseWriter, r *http.Request, token string) {
+	s.mtx.Lock()
+	sess, ok := s.sessions[token]
+	delete(s.sessions, token)
+	s.mtx.Unlock()
+
+	if !ok {
+		writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("session %s does not exist", token))
+		return
+	}
+
+	res, err := sess.worker.close(r.Context())
+	writeLogHeaders(w, res.Logs)
+	if err == nil && 0 < len(res.Error) {
+		err = errors.New(res.Error)
+	}
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request) {
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, s.MaxRequestSize))
+	if err != nil {
+		status := http.StatusBadRequest
+		if _, ok := err.(*http.MaxBytesError); ok {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeErrorResponse(w, status, err.Error())
+		return
+	}
+	input := string(b)
+
+	params := r.URL.Query()
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/x-www-form-urlencoded" {
+		if form, err := url.ParseQuery(input); err == nil && 0 < len(form.Get("query")) {
+			input = form.Get("query")
+			for k, v := range form {
+				params[k] = v
+			}
+		}
+	}
+
+	format := params.Get("format")
+	if 0 < len(format) {
+		if _, _, err := cmd.ParseFormat(format, s.flags.JsonEscape); err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	} else if fm, ok := ParseAcceptHeader(r.Header.Get("Accept")); ok {
+		format = fm.String()
+	}
+
+	req := workerRequest{
+		Query:  input,
+		Format: format,
+	}
+
+	var wk *worker
+	if token := r.Header.Get(SessionHeader); 0 < len(token) {
+		s.mtx.Lock()
+		sess := s.sessions[token]
+		if sess != nil {
+			sess.lastAccess = time.Now()
+		}
+		s.mtx.Unlock()
+		if sess == nil {
+			writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("session %s does not exist", token))
+			return
+		}
+		wk = sess.worker
+		req.Persistent = true
+	} else if wk, err = s.getIdleWorker(); err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	res, err := wk.request(r.Context(), req)
+	if !req.Persistent {
+		s.putIdleWorker(wk, err)
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == query.ErrSessionClosed {
+			status = http.StatusNotFound
+		}
+		writeErrorResponse(w, status, err.Error())
+		return
+	}
+
+	writeLogHeaders(w, res.Logs)
+	if 0 < len(res.Error) {
+		status := http.StatusBadRequest
+		if res.SessionClosed {
+			status = http.StatusNotFound
+		}
+		writeErrorResponse(w, status, res.Error)
+		return
+	}
+
+	w.Header().Set("Content-Type", res.ContentType)
+	if 0 < len(res.ContentEncoding) {
+		w.Header().Set("Content-Encoding", res.ContentEncoding)
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(res.Body)
+}
+
+func (s *Server) getIdleWorker() (*worker, error) {
+	s.mtx.Lock()
+	if 0 < len(s.idle) {
+		wk := s.idle[len(s.idle)-1]
+		s.idle = s.idle[:len(s.idle)-1]
+		s.mtx.Unlock()
+		return wk, nil
+	}
+	s.mtx.Unlock()
+
+	return startWorker(s.flags)
+}
+
+func (s *Server) putIdleWorker(wk *worker, err error) {
+	if err == nil {
+		s.mtx.Lock()
+		if len(s.idle) < s.MaxIdleWorkers {
+			s.idle = append(s.idle, wk)
+			s.mtx.Unlock()
+			return
+		}
+		s.mtx.Unlock()
+	}
+
+	if _, err := wk.close(context.Background()); err != nil && err != query.ErrSessionClosed {
+		query.LogError(err.Error())
+	}
+}
+
+func (s *Server) expireSessions() {
+	if s.SessionTimeout <= 0 {
+		return
+	}
+
+	interval := s.SessionTimeout / 2
+	if time.Minute < interval {
+		interval = time.Minute
+	}
+
+	for range time.Tick(interval) {
+		expired := make([]*serverSession, 0)
+
+		s.mtx.Lock()
+		for token, sess := range s.sessions {
+			if s.SessionTimeout < time.Since(sess.lastAccess) {
+				expired = append(expired, sess)
+				delete(s.sessions, token)
+			}
+		}
+		s.mtx.Unlock()
+
+		for _, sess := range expired {
+			sess.worker.close(context.Background())
+		}
+	}
+}
+
+func (s *Server) Close() {
+	s.mtx.Lock()
+	workers := make([]*worker, 0, len(s.sessions)+len(s.idle))
+	for token, sess := range s.sessions {
+		workers = append(workers, sess.worker)
+		delete(s.sessions, token)
+	}
+	workers = append(workers, s.idle...)
+	s.idle = nil
+	s.mtx.Unlock()
+
+	for _, wk := range workers {
+		res, err := wk.close(context.Background())
+		if err == nil && 0 < len(res.Error) {
+			err = errors.New(res.Error)
+		}
+		if err != nil && err != query.ErrSessionClosed {
+			query.LogError(err.Error())
+		}
+		if 0 < len(res.Logs) {
+			query.Log(strings.Join(res.Logs, "\n"), false)
+		}
+	}
+}
+
+func responseContentType(format cmd.Format, encoding text.Encoding) string {
+	contentType := FormatMimeType(format)
+	if format == cmd.XLSX {
+		return contentType
+	}
+
+	switch encoding {
+	case text.SJIS:
+		return contentType + "; charset=Shift_JIS"
+	case cmd.UTF16BE, cmd.UTF16BEM:
+		return contentType + "; charset=UTF-16BE"
+	case cmd.UTF16LE, cmd.UTF16LEM:
+		return contentType + "; charset=UTF-16LE"
+	case cmd.EUCJP:
+		return contentType + "; charset=EUC-JP"
+	case cmd.LATIN1:
+		return contentType + "; charset=ISO-8859-1"
+	case cmd.CP1252:
+		return contentType + "; charset=windows-1252"
+	case cmd.GBK:
+		return contentType + "; charset=GBK"
+	case cmd.BIG5:
+		return contentType + "; charset=Big5"
+	}
+	return contentType + "; charset=utf-8"
+}
+
+func splitLogLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if len(s) < 1 {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+func writeLogHeaders(w http.ResponseWriter, logs []string) {
+	for _, log := range logs {
+		w.Header().Add(LogHeader, log)
+	}
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write([]byte(message + "\n"))
+}
+
+func generateSessionToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
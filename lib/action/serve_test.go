@@ -0,0 +1,328 @@
+package action
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+type serverTestRequest struct {
+	Name            string
+	Method          string
+	Path            string
+	Session         int
+	Header          map[string]string
+	Body            string
+	Status          int
+	ContentType     string
+	Logs            []string
+	ResponseBody    string
+	ResponsePrefix  string
+	NewSessionIndex int
+}
+
+var serverTests = []serverTestRequest{
+	{
+		Name:         "Query with Format Parameter",
+		Method:       "POST",
+		Path:         "/query?format=csv",
+		Body:         "select 1 as a, 'str' as b",
+		Status:       http.StatusOK,
+		ContentType:  "text/csv; charset=utf-8",
+		ResponseBody: "a,b\n1,str\n",
+	},
+	{
+		Name:         "Query with Accept Header",
+		Method:       "POST",
+		Path:         "/query",
+		Header:       map[string]string{"Accept": "text/html, application/json;q=0.9"},
+		Body:         "select 1 as a",
+		Status:       http.StatusOK,
+		ContentType:  "application/json; charset=utf-8",
+		ResponseBody: "[{\"a\":1}]\n",
+	},
+	{
+		Name:         "Query Form Value",
+		Method:       "POST",
+		Path:         "/query",
+		Header:       map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+		Body:         url.Values{"query": {"print 'abc'; select 1 as a;"}, "format": {"tsv"}}.Encode(),
+		Status:       http.StatusOK,
+		ContentType:  "text/tab-separated-values; charset=utf-8",
+		Logs:         []string{"\"abc\""},
+		ResponseBody: "a\n1\n",
+	},
+	{
+		Name:         "Query Syntax Error",
+		Method:       "POST",
+		Path:         "/query",
+		Body:         "select from",
+		Status:       http.StatusBadRequest,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "[L:1 C:8] syntax error: unexpected token \"from\"\n",
+	},
+	{
+		Name:         "Invalid Format Error",
+		Method:       "POST",
+		Path:         "/query?format=html",
+		Body:         "select 1",
+		Status:       http.StatusBadRequest,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XLSX\n",
+	},
+	{
+		Name:         "Request Too Large",
+		Method:       "POST",
+		Path:         "/query",
+		Body:         "select '" + strings.Repeat("a", 1024) + "'",
+		Status:       http.StatusRequestEntityTooLarge,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "http: request body too large\n",
+	},
+	{
+		Name:         "Method Not Allowed",
+		Method:       "GET",
+		Path:         "/query",
+		Status:       http.StatusMethodNotAllowed,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "method not allowed\n",
+	},
+	{
+		Name:         "Not Found",
+		Method:       "POST",
+		Path:         "/notexist",
+		Status:       http.StatusNotFound,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "not found\n",
+	},
+	{
+		Name:            "Create Session",
+		Method:          "POST",
+		Path:            "/sessions",
+		Status:          http.StatusCreated,
+		ContentType:     "text/plain; charset=utf-8",
+		NewSessionIndex: 1,
+	},
+	{
+		Name:        "Declare Variable in Session",
+		Method:      "POST",
+		Path:        "/query",
+		Session:     1,
+		Body:        "var @a := 'session value'; set @@format to csv;",
+		Status:      http.StatusOK,
+		ContentType: "text/csv; charset=utf-8",
+	},
+	{
+		Name:         "Use Variable in Session",
+		Method:       "POST",
+		Path:         "/query",
+		Session:      1,
+		Body:         "select @a as a",
+		Status:       http.StatusOK,
+		ContentType:  "text/csv; charset=utf-8",
+		ResponseBody: "a\nsession value\n",
+	},
+	{
+		Name:         "Variable Not Shared with Other Requests",
+		Method:       "POST",
+		Path:         "/query",
+		Body:         "select @a as a",
+		Status:       http.StatusBadRequest,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "[L:1 C:8] variable @a is undeclared\n",
+	},
+	{
+		Name:        "Create Table in Session",
+		Method:      "POST",
+		Path:        "/query",
+		Session:     1,
+		Body:        "create table `serve_test.csv` (c1, c2)",
+		Status:      http.StatusOK,
+		ContentType: "text/csv; charset=utf-8",
+		Logs:        []string{"file \"" + GetTestFilePath("serve_test.csv") + "\" is created."},
+	},
+	{
+		Name:         "Table Locked by Other Session",
+		Method:       "POST",
+		Path:         "/query",
+		Body:         "select * from serve_test",
+		Status:       http.StatusBadRequest,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "[L:1 C:15] file " + GetTestFilePath("serve_test.csv") + ": lock wait timeout period exceeded\n",
+	},
+	{
+		Name:        "Delete Session",
+		Method:      "DELETE",
+		Path:        "/sessions/%s",
+		Session:     1,
+		Status:      http.StatusNoContent,
+		ContentType: "",
+		Logs:        []string{"Rollback: file \"" + GetTestFilePath("serve_test.csv") + "\" is deleted."},
+	},
+	{
+		Name:           "Query in Deleted Session",
+		Method:         "POST",
+		Path:           "/query",
+		Session:        1,
+		Body:           "select 1",
+		Status:         http.StatusNotFound,
+		ContentType:    "text/plain; charset=utf-8",
+		ResponsePrefix: "session ",
+	},
+	{
+		Name:         "Delete Not Existing Session",
+		Method:       "DELETE",
+		Path:         "/sessions/notexist",
+		Status:       http.StatusNotFound,
+		ContentType:  "text/plain; charset=utf-8",
+		ResponseBody: "session notexist does not exist\n",
+	},
+	{
+		Name:        "Create Table and Commit Automatically",
+		Method:      "POST",
+		Path:        "/query",
+		Body:        "create table `serve_test.csv` (c1, c2); insert into serve_test values (1, 'a');",
+		Status:      http.StatusOK,
+		ContentType: "text/plain; charset=utf-8",
+		Logs:        []string{"file \"" + GetTestFilePath("serve_test.csv") + "\" is created.", "1 record inserted on \"" + GetTestFilePath("serve_test.csv") + "\".", "Commit: file \"" + GetTestFilePath("serve_test.csv") + "\" is created."},
+	},
+	{
+		Name:         "Select Committed Table",
+		Method:       "POST",
+		Path:         "/query?format=CSV",
+		Body:         "select * from serve_test",
+		Status:       http.StatusOK,
+		ContentType:  "text/csv; charset=utf-8",
+		ResponseBody: "c1,c2\n1,a\n",
+	},
+}
+
+func TestServer(t *testing.T) {
+	initFlags()
+	tf := cmd.GetFlags()
+	tf.NoHeader = false
+
+	server := NewServer(0)
+	server.flags.WaitTimeout = 0.1
+	server.MaxRequestSize = 1024
+	defer server.Close()
+
+	sessions := map[int]string{}
+
+	for _, v := range serverTests {
+		path := v.Path
+		if v.Method == "DELETE" && 0 < v.Session {
+			path = strings.Replace(path, "%s", sessions[v.Session], 1)
+		}
+
+		req := httptest.NewRequest(v.Method, path, strings.NewReader(v.Body))
+		for k, h := range v.Header {
+			req.Header.Set(k, h)
+		}
+		if v.Method == "POST" && 0 < v.Session {
+			req.Header.Set(SessionHeader, sessions[v.Session])
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		res := rec.Result()
+
+		if res.StatusCode != v.Status {
+			t.Errorf("%s: status = %d, want %d (body: %q)", v.Name, res.StatusCode, v.Status, rec.Body.String())
+			continue
+		}
+		if ct := res.Header.Get("Content-Type"); ct != v.ContentType {
+			t.Errorf("%s: content type = %q, want %q", v.Name, ct, v.ContentType)
+		}
+		if logs := res.Header[LogHeader]; !reflect.DeepEqual(logs, v.Logs) {
+			t.Errorf("%s: logs = %q, want %q", v.Name, logs, v.Logs)
+		}
+
+		if 0 < v.NewSessionIndex {
+			token := res.Header.Get(SessionHeader)
+			if len(token) != 32 || rec.Body.String() != token+"\n" {
+				t.Errorf("%s: session token = %q, body = %q", v.Name, token, rec.Body.String())
+			}
+			sessions[v.NewSessionIndex] = token
+			continue
+		}
+
+		if 0 < len(v.ResponsePrefix) {
+			if !strings.HasPrefix(rec.Body.String(), v.ResponsePrefix) {
+				t.Errorf("%s: body = %q, want prefix %q", v.Name, rec.Body.String(), v.ResponsePrefix)
+			}
+		} else if rec.Body.String() != v.ResponseBody {
+			t.Errorf("%s: body = %q, want %q", v.Name, rec.Body.String(), v.ResponseBody)
+		}
+	}
+}
+
+func TestServer_Concurrency(t *testing.T) {
+	initFlags()
+
+	server := NewServer(0)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	long := httptest.NewRequest("POST", "/query", strings.NewReader("var @i := 0; while true do @i := @i + 1; end while;")).WithContext(ctx)
+	longRec := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		server.ServeHTTP(longRec, long)
+		close(done)
+	}()
+
+	quickCtx, quickCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer quickCancel()
+	quick := httptest.NewRequest("POST", "/query?format=csv", strings.NewReader("select 1 as a")).WithContext(quickCtx)
+	quickRec := httptest.NewRecorder()
+	server.ServeHTTP(quickRec, quick)
+
+	if quickRec.Code != http.StatusOK || quickRec.Body.String() != "a\n1\n" {
+		t.Errorf("quick query: status = %d, body = %q, want %d, %q", quickRec.Code, quickRec.Body.String(), http.StatusOK, "a\n1\n")
+	}
+
+	select {
+	case <-done:
+		t.Fatalf("long query finished before cancellation: status = %d, body = %q", longRec.Code, longRec.Body.String())
+	default:
+	}
+
+	cancel()
+	<-done
+	if longRec.Code != http.StatusBadRequest || longRec.Body.String() != context.Canceled.Error()+"\n" {
+		t.Errorf("long query: status = %d, body = %q, want %d, %q", longRec.Code, longRec.Body.String(), http.StatusBadRequest, context.Canceled.Error()+"\n")
+	}
+}
+
+var parseAcceptHeaderTests = []struct {
+	Accept string
+	Format cmd.Format
+	OK     bool
+}{
+	{Accept: "text/csv", Format: cmd.CSV, OK: true},
+	{Accept: "text/html, text/tab-separated-values; charset=utf-8", Format: cmd.TSV, OK: true},
+	{Accept: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", Format: cmd.XLSX, OK: true},
+	{Accept: "text/csv;q=0.1, application/json", Format: cmd.JSON, OK: true},
+	{Accept: "text/csv;q=0.5, application/json;q=0.5", Format: cmd.CSV, OK: true},
+	{Accept: "text/csv;q=0, text/html", Format: cmd.TEXT, OK: false},
+	{Accept: "text/csv;q=x, text/markdown;q=0.2", Format: cmd.GFM, OK: true},
+	{Accept: "*/*", Format: cmd.TEXT, OK: false},
+	{Accept: "", Format: cmd.TEXT, OK: false},
+}
+
+func TestParseAcceptHeader(t *testing.T) {
+	for _, v := range parseAcceptHeaderTests {
+		format, ok := ParseAcceptHeader(v.Accept)
+		if format != v.Format || ok != v.OK {
+			t.Errorf("format = %s, %t, want %s, %t for %q", format, ok, v.Format, v.OK, v.Accept)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+const WorkerCommandName = "serve-worker"
+
+var workerCommand = func() (*exec.Cmd, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	return exec.Command(path, WorkerCommandName), nil
+}
+
+type workerRequest struct {
+	Query      string
+	Format     string
+	Persistent bool
+	Close      bool
+	Cancel     bool
+}
+
+type workerResponse struct {
+	Body            []byte
+	Logs            []string
+	ContentType     string
+	ContentEncoding string
+	Error           string
+	SessionClosed   bool
+}
+
+// worker is a process that executes queries in a session.
+// The server sends the flags and then requests to the standard input of the process, and receives responses from the standard output as JSON values.
+type worker struct {
+	process *exec.Cmd
+	stdin   io.WriteCloser
+	enc     *json.Encoder
+	dec     *json.Decoder
+	closed  bool
+
+	mtx *sync.Mutex
+}
+
+func startWorker(flags *cmd.Flags) (*worker, error) {
+	process, err := workerCommand()
+	if err != nil {
+		return nil, err
+	}
+	process.Stderr = os.Stderr
+
+	stdin, err := process.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := process.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := process.Start(); err != nil {
+		return nil, err
+	}
+
+	wk := &worker{
+		process: process,
+		stdin:   stdin,
+		enc:     json.NewEncoder(stdin),
+		dec:     json.NewDecoder(stdout),
+		mtx:     &sync.Mutex{},
+	}
+	if err := wk.enc.Encode(flags); err != nil {
+		wk.terminate(true)
+		return nil, err
+	}
+	return wk, nil
+}
+
+func (wk *worker) request(ctx context.Context, req workerRequest) (workerResponse, error) {
+	wk.mtx.Lock()
+	defer wk.mtx.Unlock()
+
+	if wk.closed {
+		return workerResponse{}, query.ErrSessionClosed
+	}
+	return wk.send(ctx, req)
+}
+
+func (wk *worker) close(ctx context.Context) (workerResponse, error) {
+	wk.mtx.Lock()
+	defer wk.mtx.Unlock()
+
+	if wk.closed {
+		return workerResponse{}, query.ErrSessionClosed
+	}
+
+	res, err := wk.send(ctx, workerRequest{Close: true})
+	if err == nil {
+		wk.terminate(false)
+	}
+	return res, err
+}
+
+func (wk *worker) send(ctx context.Context, req workerRequest) (workerResponse, error) {
+	var res workerResponse
+
+	if err := wk.enc.Encode(req); err != nil {
+		wk.terminate(true)
+		return res, err
+	}
+
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			wk.enc.Encode(workerRequest{Cancel: true})
+		case <-done:
+		}
+	}()
+
+	err := wk.dec.Decode(&res)
+	close(done)
+	<-exited
+
+	if err != nil {
+		wk.terminate(true)
+	}
+	return res, err
+}
+
+func (wk *worker) terminate(kill bool) {
+	wk.closed = true
+	wk.stdin.Close()
+	if kill {
+		wk.process.Process.Kill()
+	}
+	wk.process.Wait()
+}
+
+// ServeWorker executes the requests received from the standard input in a worker process of the server.
+func ServeWorker() error {
+	in, out := os.Stdin, os.Stdout
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
+	}
+	defer devNull.Close()
+
+	os.Stdin = devNull
+	os.Stdout = os.Stderr
+	query.Stdin = devNull
+	query.Stdout = os.Stderr
+
+	dec := json.NewDecoder(in)
+	enc := json.NewEncoder(out)
+
+	flags := &cmd.Flags{}
+	if err := dec.Decode(flags); err != nil {
+		return err
+	}
+	if err := flags.SetLocation(flags.Location); err != nil {
+		return err
+	}
+	session := query.NewSession(flags)
+
+	requests := make(chan workerRequest)
+	go func() {
+		defer close(requests)
+		for {
+			var req workerRequest
+			if err := dec.Decode(&req); err != nil {
+				return
+			}
+			requests <- req
+		}
+	}()
+
+	results := make(chan workerResponse)
+	var cancel context.CancelFunc
+	for {
+		select {
+		case req, ok := <-requests:
+			if !ok {
+				if cancel != nil {
+					cancel()
+					<-results
+				}
+				return session.Close(context.Background(), nil)
+			}
+
+			if req.Cancel {
+				if cancel != nil {
+					cancel()
+				}
+				continue
+			}
+
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			go func(req workerRequest) {
+				results <- handleWorkerRequest(ctx, session, flags, req)
+			}(req)
+		case res := <-results:
+			cancel()
+			cancel = nil
+			if err := enc.Encode(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func handleWorkerRequest(ctx context.Context, session *query.Session, flags *cmd.Flags, req workerRequest) workerResponse {
+	if req.Close {
+		logs := &bytes.Buffer{}
+		res := workerResponse{}
+		if err := session.Close(ctx, logs); err != nil {
+			res.Error = err.Error()
+		}
+		res.Logs = splitLogLines(logs.String())
+		return res
+	}
+
+	if !req.Persistent {
+		session = query.NewSession(flags)
+	}
+
+	res, err := execute(ctx, session, req.Query, req.Format, req.Persistent)
+	if err != nil {
+		res.Error = err.Error()
+		res.SessionClosed = err == query.ErrSessionClosed
+	}
+	return res
+}
+
+func execute(ctx context.Context, session *query.Session, input string, format string, persistent bool) (workerResponse, error) {
+	body := &bytes.Buffer{}
+	logs := &bytes.Buffer{}
+	if err := session.Enter(ctx, body, logs); err != nil {
+		return workerResponse{}, err
+	}
+	defer session.Leave()
+
+	proc := session.Procedure
+	flags := session.Flags()
+
+	var fileInfo *query.FileInfo
+	if 0 < len(format) {
+		fileInfo = query.NewFileInfoForOutput(flags)
+		if err := fileInfo.SetFormat(format); err != nil {
+			if _, ok := err.(*query.TableAttributeUnchangedError); !ok {
+				return workerResponse{}, err
+			}
+		}
+		proc.Output = fileInfo
+		defer func() {
+			proc.Output = nil
+		}()
+	}
+
+	statements, err := parser.Parse(input, "", flags.DatetimeFormat, flags.GetLocation())
+	if err != nil {
+		err = query.NewSyntaxError(err.(*parser.SyntaxError))
+	} else {
+		var flow query.StatementFlow
+		flow, err = proc.Execute(statements)
+
+		if !persistent {
+			if err == nil && flow == query.Terminate {
+				if e := query.Commit(nil, proc.Filter); e != nil {
+					fmt.Fprintln(logs, e.Error())
+				}
+			}
+			if e := query.Rollback(nil, proc.Filter); e != nil {
+				fmt.Fprintln(logs, e.Error())
+			}
+			if e := session.ReleaseResources(); e != nil {
+				fmt.Fprintln(logs, e.Error())
+			}
+		}
+	}
+
+	if fileInfo == nil {
+		fileInfo = query.NewFileInfoForOutput(flags)
+	}
+	res := workerResponse{
+		Body:        body.Bytes(),
+		Logs:        splitLogLines(logs.String()),
+		ContentType: responseContentType(fileInfo.Format, fileInfo.Encoding),
+	}
+	if fileInfo.Compression == cmd.GZIP {
+		res.ContentEncoding = "gzip"
+	}
+	return res, err
+}
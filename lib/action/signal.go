@@ -13,6 +13,9 @@ func SetSignalHandler() {
 
 	go func() {
 		<-ch
+		if activeServer != nil {
+			activeServer.Close()
+		}
 		if err := query.Rollback(nil, nil); err != nil {
 			query.LogError(err.Error())
 		}
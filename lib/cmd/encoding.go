@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/go-text"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+const (
+	AUTO     text.Encoding = "AUTO"
+	UTF8M    text.Encoding = "UTF8M"
+	UTF16    text.Encoding = "UTF16"
+	UTF16BE  text.Encoding = "UTF16BE"
+	UTF16LE  text.Encoding = "UTF16LE"
+	UTF16BEM text.Encoding = "UTF16BEM"
+	UTF16LEM text.Encoding = "UTF16LEM"
+	EUCJP    text.Encoding = "EUCJP"
+	LATIN1   text.Encoding = "LATIN1"
+	CP1252   text.Encoding = "CP1252"
+	GBK      text.Encoding = "GBK"
+	BIG5     text.Encoding = "BIG5"
+)
+
+const EncodingDetectionSize = 64 * 1024
+
+var readEncodings = []text.Encoding{
+	AUTO,
+	text.UTF8,
+	UTF8M,
+	UTF16,
+	UTF16BE,
+	UTF16LE,
+	UTF16BEM,
+	UTF16LEM,
+	text.SJIS,
+	EUCJP,
+	LATIN1,
+	CP1252,
+	GBK,
+	BIG5,
+}
+
+var encodingAliases = map[string]text.Encoding{
+	"SHIFTJIS":    text.SJIS,
+	"ISO88591":    LATIN1,
+	"WINDOWS1252": CP1252,
+}
+
+var byteOrderMarks = map[text.Encoding][]byte{
+	UTF8M:    {0xEF, 0xBB, 0xBF},
+	UTF16BEM: {0xFE, 0xFF},
+	UTF16LEM: {0xFF, 0xFE},
+}
+
+var EncodingLiteral = map[text.Encoding]string{
+	AUTO:      "AUTO",
+	text.UTF8: "UTF8",
+	UTF8M:     "UTF8M",
+	UTF16:     "UTF16",
+	UTF16BE:   "UTF16BE",
+	UTF16LE:   "UTF16LE",
+	UTF16BEM:  "UTF16BEM",
+	UTF16LEM:  "UTF16LEM",
+	text.SJIS: "SJIS",
+	EUCJP:     "EUCJP",
+	LATIN1:    "LATIN1",
+	CP1252:    "CP1252",
+	GBK:       "GBK",
+	BIG5:      "BIG5",
+}
+
+func EncodingString(enc text.Encoding) string {
+	return EncodingLiteral[enc]
+}
+
+func encodingList(encodings []text.Encoding) string {
+	list := make([]string, 0, len(encodings))
+	for _, enc := range encodings {
+		list = append(list, EncodingString(enc))
+	}
+	return strings.Join(list, "|")
+}
+
+func ParseEncoding(s string) (text.Encoding, error) {
+	name := strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(s))
+	if enc, ok := encodingAliases[name]; ok {
+		return enc, nil
+	}
+	for _, enc := range readEncodings {
+		if name == EncodingString(enc) {
+			return enc, nil
+		}
+	}
+	return text.UTF8, errors.New("encoding must be one of " + encodingList(readEncodings))
+}
+
+func ParseWriteEncoding(s string) (text.Encoding, error) {
+	enc, err := ParseEncoding(s)
+	if err != nil || enc == AUTO || enc == UTF16 {
+		encodings := make([]text.Encoding, 0, len(readEncodings))
+		for _, e := range readEncodings {
+			if e != AUTO && e != UTF16 {
+				encodings = append(encodings, e)
+			}
+		}
+		return text.UTF8, errors.New("encoding must be one of " + encodingList(encodings))
+	}
+	return enc, nil
+}
+
+// TextEncoding returns the encoding to be passed to the readers and writers of go-text.
+// Shift_JIS is left to them so that fixed-length byte positions are counted correctly.
+func TextEncoding(enc text.Encoding) text.Encoding {
+	if enc == text.SJIS {
+		return text.SJIS
+	}
+	return text.UTF8
+}
+
+func IsFixedLengthEncoding(enc text.Encoding) bool {
+	return enc == text.UTF8 || enc == UTF8M || enc == text.SJIS
+}
+
+func getEncoding(enc text.Encoding) encoding.Encoding {
+	switch enc {
+	case UTF16, UTF16BE, UTF16BEM:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	case UTF16LE, UTF16LEM:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case EUCJP:
+		return japanese.EUCJP
+	case LATIN1:
+		return charmap.ISO8859_1
+	case CP1252:
+		return charmap.Windows1252
+	case GBK:
+		return simplifiedchinese.GBK
+	case BIG5:
+		return traditionalchinese.Big5
+	}
+	return nil
+}
+
+func NewDecodingReader(r io.Reader, enc text.Encoding) io.Reader {
+	if e := getEncoding(enc); e != nil {
+		return transform.NewReader(r, e.NewDecoder())
+	}
+	return r
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (w nopWriteCloser) Close() error {
+	return nil
+}
+
+type bomWriter struct {
+	io.WriteCloser
+	w       io.Writer
+	bom     []byte
+	written bool
+}
+
+func (w *bomWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		if _, err := w.w.Write(w.bom); err != nil {
+			return 0, err
+		}
+	}
+	return w.WriteCloser.Write(p)
+}
+
+func NewEncodingWriter(w io.Writer, enc text.Encoding) io.WriteCloser {
+	var wc io.WriteCloser = nopWriteCloser{w}
+	if e := getEncoding(enc); e != nil {
+		wc = transform.NewWriter(w, encoding.ReplaceUnsupported(e.NewEncoder()))
+	}
+	if bom, ok := byteOrderMarks[enc]; ok {
+		wc = &bomWriter{WriteCloser: wc, w: w, bom: bom}
+	}
+	return wc
+}
+
+func ByteSize(s string, enc text.Encoding) int {
+	switch enc {
+	case text.SJIS:
+		return text.ByteSize(s, text.SJIS)
+	}
+	if e := getEncoding(enc); e != nil {
+		b, _ := encoding.ReplaceUnsupported(e.NewEncoder()).String(s)
+		return len(b)
+	}
+	return len(s)
+}
+
+// DetectEncoding resolves the encoding of the data read from r.
+// Byte order marks are consumed from the returned reader.
+func DetectEncoding(r io.Reader, enc text.Encoding) (io.Reader, text.Encoding, error) {
+	br := bufio.NewReaderSize(r, EncodingDetectionSize)
+	b, err := br.Peek(EncodingDetectionSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, enc, err
+	}
+
+	bomEnc := text.Encoding("")
+	for e, bom := range byteOrderMarks {
+		if bytes.HasPrefix(b, bom) {
+			bomEnc = e
+			break
+		}
+	}
+
+	switch enc {
+	case AUTO:
+		if 0 < len(bomEnc) {
+			enc = bomEnc
+		} else {
+			enc = guessEncoding(b, err == io.EOF)
+		}
+	case UTF16:
+		if bomEnc == UTF16BEM || bomEnc == UTF16LEM {
+			enc = bomEnc
+		} else if e, ok := guessUTF16(b); ok {
+			enc = e
+		} else {
+			enc = UTF16BE
+		}
+	case text.UTF8:
+		if bomEnc == UTF8M {
+			enc = UTF8M
+		}
+	case UTF16BE:
+		if bomEnc == UTF16BEM {
+			enc = UTF16BEM
+		}
+	case UTF16LE:
+		if bomEnc == UTF16LEM {
+			enc = UTF16LEM
+		}
+	}
+
+	if 0 < len(bomEnc) && enc == bomEnc {
+		if _, err = br.Discard(len(byteOrderMarks[bomEnc])); err != nil {
+			return nil, enc, err
+		}
+	}
+	return br, enc, nil
+}
+
+var multiByteEncodings = []struct {
+	Encoding text.Encoding
+	Scan     func(b []byte, atEOF bool) (int, bool)
+}{
+	{Encoding: EUCJP, Scan: scanEUCJP},
+	{Encoding: text.SJIS, Scan: scanSJIS},
+	{Encoding: GBK, Scan: scanGBK},
+	{Encoding: BIG5, Scan: scanBig5},
+}
+
+// guessEncoding selects the encoding in which b is valid and which has the most characters
+// in the ranges of frequently used characters.
+// If no multi-byte encoding is found, CP1252 is selected.
+// Short data can be ambiguous, so the encoding should be specified explicitly in that case.
+func guessEncoding(b []byte, atEOF bool) text.Encoding {
+	if e, ok := guessUTF16(b); ok {
+		return e
+	}
+	if isValidUTF8(b, atEOF) {
+		return text.UTF8
+	}
+
+	enc := CP1252
+	score := 0
+	for _, mb := range multiByteEncodings {
+		if s, ok := mb.Scan(b, atEOF); ok && score < s {
+			enc = mb.Encoding
+			score = s
+		}
+	}
+	return enc
+}
+
+func guessUTF16(b []byte) (text.Encoding, bool) {
+	pairs := len(b) / 2
+	if pairs < 1 {
+		return "", false
+	}
+
+	evenZeros := 0
+	oddZeros := 0
+	for i := 0; i < pairs*2; i = i + 2 {
+		if b[i] == 0 {
+			evenZeros++
+		}
+		if b[i+1] == 0 {
+			oddZeros++
+		}
+	}
+
+	switch {
+	case pairs < oddZeros*3 && evenZeros*20 < pairs:
+		return UTF16LE, true
+	case pairs < evenZeros*3 && oddZeros*20 < pairs:
+		return UTF16BE, true
+	}
+	return "", false
+}
+
+func isValidUTF8(b []byte, atEOF bool) bool {
+	if !atEOF {
+		for i := len(b) - 1; 0 <= i && len(b)-utf8.UTFMax < i; i-- {
+			if utf8.RuneStart(b[i]) {
+				if !utf8.FullRune(b[i:]) {
+					b = b[:i]
+				}
+				break
+			}
+		}
+	}
+	return utf8.Valid(b)
+}
+
+// scanEUCJP counts kana and JIS level 1 kanji.
+func scanEUCJP(b []byte, atEOF bool) (int, bool) {
+	score := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		size := 1
+		switch {
+		case c < 0x80:
+			continue
+		case c == 0x8E:
+			size = 2
+		case c == 0x8F:
+			size = 3
+		case 0xA1 <= c && c <= 0xFE:
+			size = 2
+		default:
+			return score, false
+		}
+
+		if len(b) < i+size {
+			return score, !atEOF
+		}
+		for j := 1; j < size; j++ {
+			if b[i+j] < 0xA1 || 0xFE < b[i+j] {
+				return score, false
+			}
+		}
+		if c == 0x8E && 0xDF < b[i+1] {
+			return score, false
+		}
+		if c == 0xA4 || c == 0xA5 || (0xB0 <= c && c <= 0xCF) {
+			score++
+		}
+		i = i + size - 1
+	}
+	return score, true
+}
+
+// scanSJIS counts kana and JIS level 1 kanji.
+func scanSJIS(b []byte, atEOF bool) (int, bool) {
+	score := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c < 0x80, 0xA1 <= c && c <= 0xDF:
+			continue
+		case (0x81 <= c && c <= 0x9F) || (0xE0 <= c && c <= 0xFC):
+			if len(b) < i+2 {
+				return score, !atEOF
+			}
+			t := b[i+1]
+			if t < 0x40 || t == 0x7F || 0xFC < t {
+				return score, false
+			}
+			if c == 0x82 || c == 0x83 || (0x88 <= c && c <= 0x98) {
+				score++
+			}
+			i++
+		default:
+			return score, false
+		}
+	}
+	return score, true
+}
+
+// scanGBK counts GB2312 level 1 hanzi.
+func scanGBK(b []byte, atEOF bool) (int, bool) {
+	score := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			continue
+		case 0x81 <= c && c <= 0xFE:
+			if len(b) < i+2 {
+				return score, !atEOF
+			}
+			t := b[i+1]
+			if t < 0x40 || t == 0x7F || 0xFE < t {
+				return score, false
+			}
+			if 0xB0 <= c && c <= 0xD7 && 0xA1 <= t {
+				score++
+			}
+			i++
+		default:
+			return score, false
+		}
+	}
+	return score, true
+}
+
+// scanBig5 counts Big5 level 1 hanzi.
+func scanBig5(b []byte, atEOF bool) (int, bool) {
+	score := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			continue
+		case 0x81 <= c && c <= 0xFE:
+			if len(b) < i+2 {
+				return score, !atEOF
+			}
+			t := b[i+1]
+			if t < 0x40 || (0x7E < t && t < 0xA1) || 0xFE < t {
+				return score, false
+			}
+			if 0xA4 <= c && c <= 0xC6 {
+				score++
+			}
+			i++
+		default:
+			return score, false
+		}
+	}
+	return score, true
+}
@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var parseEncodingTests = []struct {
+	Input  string
+	Expect text.Encoding
+	Error  string
+}{
+	{
+		Input:  "utf8",
+		Expect: text.UTF8,
+	},
+	{
+		Input:  "sjis",
+		Expect: text.SJIS,
+	},
+	{
+		Input:  "auto",
+		Expect: AUTO,
+	},
+	{
+		Input:  "UTF-16le",
+		Expect: UTF16LE,
+	},
+	{
+		Input:  "iso-8859-1",
+		Expect: LATIN1,
+	},
+	{
+		Input:  "Windows-1252",
+		Expect: CP1252,
+	},
+	{
+		Input: "error",
+		Error: "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5",
+	},
+}
+
+func TestParseEncoding(t *testing.T) {
+	for _, v := range parseEncodingTests {
+		e, err := ParseEncoding(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err.Error(), v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if e != v.Expect {
+			t.Errorf("encoding = %s, want %s for %q", e, v.Expect, v.Input)
+		}
+	}
+}
+
+func TestParseWriteEncoding(t *testing.T) {
+	e, err := ParseWriteEncoding("utf8m")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != UTF8M {
+		t.Errorf("encoding = %s, want %s for %s", e, UTF8M, "utf8m")
+	}
+
+	expectErr := "encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5"
+	_, err = ParseWriteEncoding("auto")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "auto")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "auto")
+	}
+}
+
+var detectEncodingTests = []struct {
+	Name     string
+	Input    []byte
+	Encoding text.Encoding
+	Expect   text.Encoding
+	Result   string
+}{
+	{
+		Name:     "UTF8",
+		Input:    []byte("a,b\nc,d"),
+		Encoding: AUTO,
+		Expect:   text.UTF8,
+		Result:   "a,b\nc,d",
+	},
+	{
+		Name:     "UTF8 with BOM",
+		Input:    []byte("\xef\xbb\xbfa,b"),
+		Encoding: AUTO,
+		Expect:   UTF8M,
+		Result:   "a,b",
+	},
+	{
+		Name:     "UTF8 with BOM Specified",
+		Input:    []byte("\xef\xbb\xbfa,b"),
+		Encoding: text.UTF8,
+		Expect:   UTF8M,
+		Result:   "a,b",
+	},
+	{
+		Name:     "UTF16LE with BOM",
+		Input:    []byte("\xff\xfea\x00,\x00b\x00"),
+		Encoding: AUTO,
+		Expect:   UTF16LEM,
+		Result:   "a,b",
+	},
+	{
+		Name:     "UTF16BE without BOM",
+		Input:    []byte("\x00a\x00,\x00b"),
+		Encoding: AUTO,
+		Expect:   UTF16BE,
+		Result:   "a,b",
+	},
+	{
+		Name:     "UTF16 without BOM",
+		Input:    []byte("a\x00,\x00b\x00"),
+		Encoding: UTF16,
+		Expect:   UTF16LE,
+		Result:   "a,b",
+	},
+	{
+		Name:     "EUCJP",
+		Input:    []byte("\xa4\xa2,\xa4\xa4"),
+		Encoding: AUTO,
+		Expect:   EUCJP,
+		Result:   "あ,い",
+	},
+	{
+		Name:     "EUCJP Kanji and Katakana",
+		Input:    []byte("\xc6\xfc\xcb\xdc\xb8\xec,\xa5\xc6\xa5\xad\xa5\xb9\xa5\xc8"),
+		Encoding: AUTO,
+		Expect:   EUCJP,
+		Result:   "日本語,テキスト",
+	},
+	{
+		Name:     "GBK",
+		Input:    []byte("\xd5\xc5\xc8\xfd,\xb1\xb1\xbe\xa9\xca\xd0\xb3\xaf\xd1\xf4\xc7\xf8"),
+		Encoding: AUTO,
+		Expect:   GBK,
+		Result:   "张三,北京市朝阳区",
+	},
+	{
+		Name:     "BIG5",
+		Input:    []byte("\xa5x\xa5_\xa5\xab,\xa4\xa4\xa4\xe5"),
+		Encoding: AUTO,
+		Expect:   BIG5,
+		Result:   "台北市,中文",
+	},
+	{
+		Name:     "SJIS",
+		Input:    []byte("\x82\xa0,\x82\xa2"),
+		Encoding: AUTO,
+		Expect:   text.SJIS,
+		Result:   "\x82\xa0,\x82\xa2",
+	},
+	{
+		Name:     "CP1252",
+		Input:    []byte("caf\xe9,\x80"),
+		Encoding: AUTO,
+		Expect:   CP1252,
+		Result:   "café,€",
+	},
+	{
+		Name:     "LATIN1 Specified",
+		Input:    []byte("caf\xe9"),
+		Encoding: LATIN1,
+		Expect:   LATIN1,
+		Result:   "café",
+	},
+}
+
+func TestDetectEncoding(t *testing.T) {
+	for _, v := range detectEncodingTests {
+		r, enc, err := DetectEncoding(bytes.NewReader(v.Input), v.Encoding)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err.Error())
+			continue
+		}
+		if enc != v.Expect {
+			t.Errorf("%s: encoding = %s, want %s", v.Name, enc, v.Expect)
+			continue
+		}
+		b, _ := ioutil.ReadAll(NewDecodingReader(r, enc))
+		if string(b) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(b), v.Result)
+		}
+	}
+}
+
+var encodingWriterTests = []struct {
+	Encoding text.Encoding
+	Input    string
+	Expect   []byte
+}{
+	{
+		Encoding: text.UTF8,
+		Input:    "a,é",
+		Expect:   []byte("a,é"),
+	},
+	{
+		Encoding: UTF8M,
+		Input:    "a,é",
+		Expect:   []byte("\xef\xbb\xbfa,é"),
+	},
+	{
+		Encoding: UTF16LEM,
+		Input:    "a,é",
+		Expect:   []byte("\xff\xfea\x00,\x00\xe9\x00"),
+	},
+	{
+		Encoding: UTF16BE,
+		Input:    "a,é",
+		Expect:   []byte("\x00a\x00,\x00\xe9"),
+	},
+	{
+		Encoding: LATIN1,
+		Input:    "a,é,€",
+		Expect:   []byte("a,\xe9,\x1a"),
+	},
+	{
+		Encoding: CP1252,
+		Input:    "a,é,€",
+		Expect:   []byte("a,\xe9,\x80"),
+	},
+}
+
+func TestNewEncodingWriter(t *testing.T) {
+	for _, v := range encodingWriterTests {
+		buf := &bytes.Buffer{}
+		w := NewEncodingWriter(buf, v.Encoding)
+		if _, err := w.Write([]byte(v.Input)); err != nil {
+			t.Errorf("unexpected error %q for %s", err.Error(), v.Encoding)
+			continue
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("unexpected error %q for %s", err.Error(), v.Encoding)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), v.Expect) {
+			t.Errorf("result = %q, want %q for %s", buf.Bytes(), v.Expect, v.Encoding)
+		}
+	}
+}
+
+func TestByteSize(t *testing.T) {
+	s := "aあé"
+	for enc, expect := range map[text.Encoding]int{
+		text.UTF8: 6,
+		text.SJIS: 5,
+		UTF16LE:   6,
+		EUCJP:     6,
+		LATIN1:    3,
+	} {
+		if size := ByteSize(s, enc); size != expect {
+			t.Errorf("byte size = %d, want %d for %s", size, expect, enc)
+		}
+	}
+}
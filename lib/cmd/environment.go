@@ -110,7 +110,7 @@ func LoadEnvironment() error {
 		var h *file.Handler
 		var buf []byte
 
-		h, err = file.NewHandlerForRead(fpath)
+		h, err = file.NewHandlerForRead(fpath, file.DefaultWaitTimeout, file.DefaultRetryInterval)
 		if err != nil {
 			return errors.New(fmt.Sprintf("failed to load %q: %s", fpath, err.Error()))
 		}
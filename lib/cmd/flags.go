@@ -14,8 +14,6 @@ import (
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
 	txjson "github.com/mithrandie/go-text/json"
-
-	"github.com/mithrandie/csvq/lib/file"
 )
 
 const (
@@ -36,6 +34,8 @@ const (
 	EncodingFlag             = "ENCODING"
 	NoHeaderFlag             = "NO_HEADER"
 	WithoutNullFlag          = "WITHOUT_NULL"
+	NumberAsDecimalFlag      = "NUMBER_AS_DECIMAL"
+	InferTypesFlag           = "INFER_TYPES"
 	FormatFlag               = "FORMAT"
 	WriteEncodingFlag        = "WRITE_ENCODING"
 	WriteDelimiterFlag       = "WRITE_DELIMITER"
@@ -44,12 +44,14 @@ const (
 	EncloseAll               = "ENCLOSE_ALL"
 	JsonEscape               = "JSON_ESCAPE"
 	PrettyPrintFlag          = "PRETTY_PRINT"
+	CompressionFlag          = "COMPRESSION"
 	EastAsianEncodingFlag    = "EAST_ASIAN_ENCODING"
 	CountDiacriticalSignFlag = "COUNT_DIACRITICAL_SIGN"
 	CountFormatCodeFlag      = "COUNT_FORMAT_CODE"
 	ColorFlag                = "COLOR"
 	QuietFlag                = "QUIET"
 	CPUFlag                  = "CPU"
+	MemoryLimitFlag          = "MEMORY_LIMIT"
 	StatsFlag                = "STATS"
 )
 
@@ -63,6 +65,8 @@ var FlagList = []string{
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	NumberAsDecimalFlag,
+	InferTypesFlag,
 	FormatFlag,
 	WriteEncodingFlag,
 	WriteDelimiterFlag,
@@ -71,12 +75,14 @@ var FlagList = []string{
 	EncloseAll,
 	JsonEscape,
 	PrettyPrintFlag,
+	CompressionFlag,
 	EastAsianEncodingFlag,
 	CountDiacriticalSignFlag,
 	CountFormatCodeFlag,
 	ColorFlag,
 	QuietFlag,
 	CPUFlag,
+	MemoryLimitFlag,
 	StatsFlag,
 }
 
@@ -92,6 +98,7 @@ const (
 	GFM
 	ORG
 	TEXT
+	XLSX
 )
 
 var FormatLiteral = map[Format]string{
@@ -103,12 +110,35 @@ var FormatLiteral = map[Format]string{
 	GFM:   "GFM",
 	ORG:   "ORG",
 	TEXT:  "TEXT",
+	XLSX:  "XLSX",
 }
 
 func (f Format) String() string {
 	return FormatLiteral[f]
 }
 
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	GZIP
+	BZIP2
+	ZSTD
+	XZ
+)
+
+var CompressionLiteral = map[Compression]string{
+	NoCompression: "NONE",
+	GZIP:          "GZIP",
+	BZIP2:         "BZIP2",
+	ZSTD:          "ZSTD",
+	XZ:            "XZ",
+}
+
+func (c Compression) String() string {
+	return CompressionLiteral[c]
+}
+
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
 	txjson.Backslash:        "BACKSLASH",
 	txjson.HexDigits:        "HEX",
@@ -127,10 +157,20 @@ const (
 	LtsvExt     = ".ltsv"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
+	XlsxExt     = ".xlsx"
 	SqlExt      = ".sql"
 	CsvqProcExt = ".cql"
 )
 
+const (
+	GzipExt  = ".gz"
+	Bzip2Ext = ".bz2"
+	ZstdExt  = ".zst"
+	XzExt    = ".xz"
+)
+
+var CompressionExtList = []string{GzipExt, Bzip2Ext, ZstdExt, XzExt}
+
 type Flags struct {
 	// Common Settings
 	Repository     string
@@ -138,12 +178,16 @@ type Flags struct {
 	DatetimeFormat []string
 	WaitTimeout    float64
 
+	location *time.Location
+
 	// For Import
-	Delimiter   rune
-	JsonQuery   string
-	Encoding    text.Encoding
-	NoHeader    bool
-	WithoutNull bool
+	Delimiter       rune
+	JsonQuery       string
+	Encoding        text.Encoding
+	NoHeader        bool
+	WithoutNull     bool
+	NumberAsDecimal bool
+	InferTypes      int
 
 	// For Export
 	Format         Format
@@ -154,6 +198,7 @@ type Flags struct {
 	EncloseAll     bool
 	JsonEscape     txjson.EscapeType
 	PrettyPrint    bool
+	Compression    Compression
 
 	// For Calculation of String Width
 	EastAsianEncoding    bool
@@ -164,9 +209,10 @@ type Flags struct {
 	Color bool
 
 	// System Use
-	Quiet bool
-	CPU   int
-	Stats bool
+	Quiet       bool
+	CPU         int
+	MemoryLimit int
+	Stats       bool
 
 	// For CSV
 	// For Fixed-Length Format
@@ -213,6 +259,8 @@ func GetFlags() *Flags {
 			Encoding:                text.UTF8,
 			NoHeader:                false,
 			WithoutNull:             false,
+			NumberAsDecimal:         false,
+			InferTypes:              0,
 			Format:                  TEXT,
 			WriteEncoding:           text.UTF8,
 			WriteDelimiter:          ',',
@@ -221,12 +269,14 @@ func GetFlags() *Flags {
 			EncloseAll:              false,
 			JsonEscape:              txjson.Backslash,
 			PrettyPrint:             false,
+			Compression:             NoCompression,
 			EastAsianEncoding:       false,
 			CountDiacriticalSign:    false,
 			CountFormatCode:         false,
 			Color:                   false,
 			Quiet:                   false,
 			CPU:                     GetDefaultNumberOfCPU(),
+			MemoryLimit:             0,
 			Stats:                   false,
 			DelimitAutomatically:    false,
 			DelimiterPositions:      nil,
@@ -238,6 +288,14 @@ func GetFlags() *Flags {
 	return flags
 }
 
+func (f *Flags) Copy() *Flags {
+	c := *f
+	c.DatetimeFormat = append([]string(nil), f.DatetimeFormat...)
+	c.DelimiterPositions = append([]int(nil), f.DelimiterPositions...)
+	c.WriteDelimiterPositions = append([]int(nil), f.WriteDelimiterPositions...)
+	return &c
+}
+
 func (f *Flags) SelectImportFormat() Format {
 	if 0 < len(f.JsonQuery) {
 		return JSON
@@ -287,10 +345,26 @@ func (f *Flags) SetLocation(s string) error {
 	}
 
 	f.Location = s
-	time.Local = location
+	f.location = location
 	return nil
 }
 
+func (f *Flags) GetLocation() *time.Location {
+	if f.location != nil {
+		return f.location
+	}
+	return time.Local
+}
+
+// CurrentTime returns the time that the Now flag specifies, or the current time if the flag is not set.
+func (f *Flags) CurrentTime() time.Time {
+	if 0 < len(f.Now) {
+		t, _ := time.ParseInLocation("2006-01-02 15:04:05.999999999", f.Now, f.GetLocation())
+		return t
+	}
+	return time.Now().In(f.GetLocation())
+}
+
 func (f *Flags) SetDatetimeFormat(s string) {
 	if len(s) < 1 {
 		return
@@ -311,8 +385,7 @@ func (f *Flags) SetWaitTimeout(t float64) {
 		t = 0
 	}
 
-	flags.WaitTimeout = t
-	file.UpdateWaitTimeout(flags.WaitTimeout, flags.RetryInterval)
+	f.WaitTimeout = t
 	return
 }
 
@@ -358,6 +431,17 @@ func (f *Flags) SetWithoutNull(b bool) {
 	f.WithoutNull = b
 }
 
+func (f *Flags) SetNumberAsDecimal(b bool) {
+	f.NumberAsDecimal = b
+}
+
+func (f *Flags) SetInferTypes(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.InferTypes = i
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -365,6 +449,7 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 
 	switch s {
 	case "":
+		outfile, _ = SplitCompressionExt(outfile)
 		switch strings.ToLower(filepath.Ext(outfile)) {
 		case CsvExt:
 			fm = CSV
@@ -380,6 +465,8 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = GFM
 		case OrgExt:
 			fm = ORG
+		case XlsxExt:
+			fm = XLSX
 		default:
 			return nil
 		}
@@ -399,7 +486,7 @@ func (f *Flags) SetWriteEncoding(s string) error {
 		return nil
 	}
 
-	encoding, err := ParseEncoding(s)
+	encoding, err := ParseWriteEncoding(s)
 	if err != nil {
 		return err
 	}
@@ -457,6 +544,22 @@ func (f *Flags) SetPrettyPrint(b bool) {
 	f.PrettyPrint = b
 }
 
+func (f *Flags) SetCompression(s string, outfile string) error {
+	var c Compression
+	var err error
+
+	if len(s) < 1 {
+		if _, c = SplitCompressionExt(outfile); c == NoCompression {
+			return nil
+		}
+	} else if c, err = ParseCompression(s); err != nil {
+		return err
+	}
+
+	f.Compression = c
+	return nil
+}
+
 func (f *Flags) SetEncloseAll(b bool) {
 	f.EncloseAll = b
 }
@@ -494,6 +597,13 @@ func (f *Flags) SetCPU(i int) {
 	f.CPU = i
 }
 
+func (f *Flags) SetMemoryLimit(i int) {
+	if i < 0 {
+		i = 0
+	}
+	f.MemoryLimit = i
+}
+
 func (f *Flags) SetStats(b bool) {
 	f.Stats = b
 }
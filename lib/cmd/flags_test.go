@@ -5,11 +5,10 @@ import (
 	"reflect"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/json"
-
-	"github.com/mithrandie/csvq/lib/file"
 )
 
 func TestFlags_SelectImportFormat(t *testing.T) {
@@ -45,6 +44,25 @@ func TestFlags_SelectImportFormat(t *testing.T) {
 	}
 }
 
+func TestFlags_Copy(t *testing.T) {
+	flags := &Flags{
+		Repository:         "dir",
+		DatetimeFormat:     []string{"%Y%m%d"},
+		DelimiterPositions: []int{2, 5},
+	}
+
+	c := flags.Copy()
+	if !reflect.DeepEqual(c, flags) {
+		t.Errorf("copy = %v, want %v", c, flags)
+	}
+
+	c.DatetimeFormat[0] = "%d"
+	c.DelimiterPositions[0] = 3
+	if flags.DatetimeFormat[0] != "%Y%m%d" || flags.DelimiterPositions[0] != 2 {
+		t.Errorf("original flags are changed by modifying the copy")
+	}
+}
+
 func TestFlags_SetRepository(t *testing.T) {
 	flags := GetFlags()
 
@@ -92,11 +110,18 @@ func TestFlags_SetLocation(t *testing.T) {
 		t.Errorf("location = %s, expect to set %s for %q", flags.Location, "Local", s)
 	}
 
+	local := time.Local
 	s = "utc"
 	flags.SetLocation(s)
 	if flags.Location != "UTC" {
 		t.Errorf("location = %s, expect to set %s for %q", flags.Location, "UTC", s)
 	}
+	if GetLocation() != time.UTC {
+		t.Errorf("location = %s, expect to return %s for %q", GetLocation(), time.UTC, s)
+	}
+	if time.Local != local {
+		t.Errorf("time.Local is changed to %s for %q", time.Local, s)
+	}
 
 	s = "America/NotExist"
 	expectErr := "timezone \"America/NotExist\" does not exist"
@@ -155,8 +180,10 @@ func TestFlags_SetWaitTimeout(t *testing.T) {
 		t.Errorf("wait timeout = %f, expect to set %f for %f", flags.WaitTimeout, 15.0, f)
 	}
 
-	if file.WaitTimeout != 15 {
-		t.Errorf("wait timeout in the file package = %f, expect to set %f for %f", file.WaitTimeout, 15.0, f)
+	copied := flags.Copy()
+	copied.SetWaitTimeout(5)
+	if flags.WaitTimeout != 15 {
+		t.Errorf("wait timeout = %f, expect not to be changed by a copy", flags.WaitTimeout)
 	}
 }
 
@@ -223,7 +250,7 @@ func TestFlags_SetEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
+	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5"
 	err := flags.SetEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -250,6 +277,16 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetNumberAsDecimal(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetNumberAsDecimal(true)
+	if !flags.NumberAsDecimal {
+		t.Errorf("number-as-decimal = %t, expect to set %t", flags.NumberAsDecimal, true)
+	}
+	flags.SetNumberAsDecimal(false)
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := GetFlags()
 
@@ -293,6 +330,16 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, ORG, "foo.org")
 	}
 
+	flags.SetFormat("", "foo.xlsx")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, XLSX, "foo.xlsx")
+	}
+
+	flags.SetFormat("", "foo.json.gz")
+	if flags.Format != JSON {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.Format, JSON, "foo.json.gz")
+	}
+
 	flags.SetFormat("csv", "")
 	if flags.Format != CSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, CSV, "csv")
@@ -349,7 +396,12 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	flags.SetFormat("xlsx", "")
+	if flags.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.Format, XLSX, "xlsx")
+	}
+
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XLSX"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -366,7 +418,7 @@ func TestFlags_SetWriteEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.WriteEncoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
+	expectErr := "encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5"
 	err := flags.SetWriteEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -492,6 +544,40 @@ func TestFlags_SetPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestFlags_SetCompression(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetCompression("", "foo.csv")
+	if flags.Compression != NoCompression {
+		t.Errorf("compression = %s, expect to set %s for empty string with file %q", flags.Compression, NoCompression, "foo.csv")
+	}
+
+	flags.SetCompression("", "foo.csv.gz")
+	if flags.Compression != GZIP {
+		t.Errorf("compression = %s, expect to set %s for empty string with file %q", flags.Compression, GZIP, "foo.csv.gz")
+	}
+
+	flags.SetCompression("none", "")
+	if flags.Compression != NoCompression {
+		t.Errorf("compression = %s, expect to set %s for %s", flags.Compression, NoCompression, "none")
+	}
+
+	flags.SetCompression("bzip2", "")
+	if flags.Compression != BZIP2 {
+		t.Errorf("compression = %s, expect to set %s for %s", flags.Compression, BZIP2, "bzip2")
+	}
+
+	expectErr := "compression must be one of NONE|GZIP|BZIP2|ZSTD|XZ"
+	err := flags.SetCompression("error", "")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+
+	flags.SetCompression("none", "")
+}
+
 func TestFlags_SetEastAsianEncoding(t *testing.T) {
 	flags := GetFlags()
 
@@ -553,6 +639,38 @@ func TestFlags_SetCPU(t *testing.T) {
 	}
 }
 
+func TestFlags_SetInferTypes(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetInferTypes(-1)
+	if flags.InferTypes != 0 {
+		t.Errorf("infer types = %d, expect to set %d", flags.InferTypes, 0)
+	}
+
+	flags.SetInferTypes(100)
+	if flags.InferTypes != 100 {
+		t.Errorf("infer types = %d, expect to set %d", flags.InferTypes, 100)
+	}
+
+	flags.SetInferTypes(0)
+}
+
+func TestFlags_SetMemoryLimit(t *testing.T) {
+	flags := GetFlags()
+
+	flags.SetMemoryLimit(-1)
+	if flags.MemoryLimit != 0 {
+		t.Errorf("memory limit = %d, expect to set %d", flags.MemoryLimit, 0)
+	}
+
+	flags.SetMemoryLimit(512)
+	if flags.MemoryLimit != 512 {
+		t.Errorf("memory limit = %d, expect to set %d", flags.MemoryLimit, 512)
+	}
+
+	flags.SetMemoryLimit(0)
+}
+
 func TestFlags_SetStats(t *testing.T) {
 	flags := GetFlags()
 
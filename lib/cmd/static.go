@@ -11,21 +11,35 @@ var (
 	getRand sync.Once
 )
 
+// lockedSource makes the random number generator safe for concurrent use.
+type lockedSource struct {
+	mutex sync.Mutex
+	src   rand.Source
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.src.Seed(seed)
+}
+
 func GetRand() *rand.Rand {
 	getRand.Do(func() {
-		random = rand.New(rand.NewSource(time.Now().UnixNano()))
+		random = rand.New(&lockedSource{src: rand.NewSource(time.Now().UnixNano())})
 	})
 	return random
 }
 
 func GetLocation() *time.Location {
-	return time.Local
+	return GetFlags().GetLocation()
 }
 
 func Now() time.Time {
-	if 0 < len(GetFlags().Now) {
-		t, _ := time.ParseInLocation("2006-01-02 15:04:05.999999999", GetFlags().Now, GetLocation())
-		return t
-	}
-	return time.Now()
+	return GetFlags().CurrentTime()
 }
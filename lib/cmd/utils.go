@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"unicode"
@@ -271,19 +272,6 @@ func IsReadableFromPipeOrRedirection() bool {
 	return false
 }
 
-func ParseEncoding(s string) (text.Encoding, error) {
-	var encoding text.Encoding
-	switch strings.ToUpper(s) {
-	case "UTF8":
-		encoding = text.UTF8
-	case "SJIS":
-		encoding = text.SJIS
-	default:
-		return text.UTF8, errors.New("encoding must be one of UTF8|SJIS")
-	}
-	return encoding, nil
-}
-
 func ParseLineBreak(s string) (text.LineBreak, error) {
 	var lb text.LineBreak
 	switch strings.ToUpper(s) {
@@ -347,6 +335,8 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = ORG
 	case "TEXT":
 		fm = TEXT
+	case "XLSX":
+		fm = XLSX
 	case "JSONH":
 		fm = JSON
 		et = txjson.HexDigits
@@ -354,11 +344,48 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XLSX")
 	}
 	return fm, et, nil
 }
 
+func ParseCompression(s string) (Compression, error) {
+	var c Compression
+	switch strings.ToUpper(s) {
+	case "NONE":
+		c = NoCompression
+	case "GZIP":
+		c = GZIP
+	case "BZIP2":
+		c = BZIP2
+	case "ZSTD":
+		c = ZSTD
+	case "XZ":
+		c = XZ
+	default:
+		return c, errors.New("compression must be one of NONE|GZIP|BZIP2|ZSTD|XZ")
+	}
+	return c, nil
+}
+
+func SplitCompressionExt(fpath string) (string, Compression) {
+	var c Compression
+	ext := filepath.Ext(fpath)
+	switch strings.ToLower(ext) {
+	case GzipExt:
+		c = GZIP
+	case Bzip2Ext:
+		c = BZIP2
+	case ZstdExt:
+		c = ZSTD
+	case XzExt:
+		c = XZ
+	default:
+		return fpath, NoCompression
+	}
+	return fpath[:len(fpath)-len(ext)], c
+}
+
 func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	var escape txjson.EscapeType
 	switch strings.ToUpper(s) {
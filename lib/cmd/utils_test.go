@@ -5,8 +5,6 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
-
-	"github.com/mithrandie/go-text"
 )
 
 func TestEscapeString(t *testing.T) {
@@ -223,29 +221,44 @@ func TestIsReadableFromPipeOrRedirection(t *testing.T) {
 	}
 }
 
-func TestParseEncoding(t *testing.T) {
-	e, err := ParseEncoding("utf8")
-	if err != nil {
-		t.Errorf("unexpected error: %q", err.Error())
-	}
-	if e != text.UTF8 {
-		t.Errorf("encoding = %s, expect to set %s for %s", e, text.UTF8, "utf8")
-	}
-
-	e, err = ParseEncoding("sjis")
-	if err != nil {
-		t.Errorf("unexpected error: %q", err.Error())
-	}
-	if e != text.SJIS {
-		t.Errorf("encoding = %s, expect to set %s for %s", e, text.SJIS, "sjis")
-	}
+var splitCompressionExtTests = []struct {
+	Path        string
+	Expect      string
+	Compression Compression
+}{
+	{
+		Path:        "/path/to/foo.csv",
+		Expect:      "/path/to/foo.csv",
+		Compression: NoCompression,
+	},
+	{
+		Path:        "/path/to/foo.csv.gz",
+		Expect:      "/path/to/foo.csv",
+		Compression: GZIP,
+	},
+	{
+		Path:        "foo.TSV.BZ2",
+		Expect:      "foo.TSV",
+		Compression: BZIP2,
+	},
+	{
+		Path:        "foo.json.zst",
+		Expect:      "foo.json",
+		Compression: ZSTD,
+	},
+	{
+		Path:        "foo.xz",
+		Expect:      "foo",
+		Compression: XZ,
+	},
+}
 
-	expectErr := "encoding must be one of UTF8|SJIS"
-	_, err = ParseEncoding("error")
-	if err == nil {
-		t.Errorf("no error, want error %q for %s", expectErr, "error")
-	} else if err.Error() != expectErr {
-		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+func TestSplitCompressionExt(t *testing.T) {
+	for _, v := range splitCompressionExtTests {
+		p, c := SplitCompressionExt(v.Path)
+		if p != v.Expect || c != v.Compression {
+			t.Errorf("result = %q, %s, want %q, %s for %q", p, c, v.Expect, v.Compression, v.Path)
+		}
 	}
 }
 
@@ -0,0 +1,331 @@
+package csvq
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+type Options struct {
+	Repository     string
+	Timezone       string
+	DatetimeFormat []string
+	WaitTimeout    float64
+
+	Delimiter   string
+	JsonQuery   string
+	Encoding    string
+	NoHeader    bool
+	WithoutNull bool
+
+	Format         string
+	WriteEncoding  string
+	WriteDelimiter string
+	WithoutHeader  bool
+	LineBreak      string
+
+	CPU int
+
+	Output io.Writer
+}
+
+type Result struct {
+	AffectedRows int
+}
+
+// DB is a handle that keeps its own flags, variables, loaded tables and uncommitted changes.
+//
+// A DB can be used from multiple goroutines; statements of a DB are executed one at a time,
+// and different DB handles execute statements concurrently.
+type DB struct {
+	session *query.Session
+	output  io.Writer
+}
+
+func Open(options Options) (*DB, error) {
+	return OpenContext(context.Background(), options)
+}
+
+// OpenContext is the same as Open. ctx is checked before the handle is opened.
+func OpenContext(ctx context.Context, options Options) (*DB, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	db := &DB{
+		session: query.NewSession(cmd.GetFlags()),
+		output:  options.Output,
+	}
+
+	if err := applyOptions(db.session.Flags(), options); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func applyOptions(flags *cmd.Flags, options Options) error {
+	if 0 < len(options.Repository) {
+		if err := flags.SetRepository(options.Repository); err != nil {
+			return err
+		}
+	}
+	if 0 < len(options.Timezone) {
+		if err := flags.SetLocation(options.Timezone); err != nil {
+			return err
+		}
+	}
+	for _, f := range options.DatetimeFormat {
+		flags.DatetimeFormat = cmd.AppendStrIfNotExist(flags.DatetimeFormat, f)
+	}
+	if 0 < options.WaitTimeout {
+		flags.SetWaitTimeout(options.WaitTimeout)
+	}
+
+	if 0 < len(options.Delimiter) {
+		if err := flags.SetDelimiter(options.Delimiter); err != nil {
+			return err
+		}
+	}
+	flags.SetJsonQuery(options.JsonQuery)
+	if err := flags.SetEncoding(options.Encoding); err != nil {
+		return err
+	}
+	flags.SetNoHeader(options.NoHeader)
+	flags.SetWithoutNull(options.WithoutNull)
+
+	if 0 < len(options.Format) {
+		if err := flags.SetFormat(options.Format, ""); err != nil {
+			return err
+		}
+	}
+	if err := flags.SetWriteEncoding(options.WriteEncoding); err != nil {
+		return err
+	}
+	if err := flags.SetWriteDelimiter(options.WriteDelimiter); err != nil {
+		return err
+	}
+	flags.SetWithoutHeader(options.WithoutHeader)
+	if err := flags.SetLineBreak(options.LineBreak); err != nil {
+		return err
+	}
+
+	if 0 < options.CPU {
+		flags.SetCPU(options.CPU)
+	}
+	return nil
+}
+
+func (db *DB) Prepare(sql string) (*Stmt, error) {
+	return db.PrepareContext(context.Background(), sql)
+}
+
+// PrepareContext is the same as Prepare, but ctx bounds the time waiting for other statements of the handle.
+func (db *DB) PrepareContext(ctx context.Context, sql string) (*Stmt, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Statements are parsed in the session because datetime literals depend on its flags.
+	if err := db.session.Enter(ctx, nil, nil); err != nil {
+		return nil, err
+	}
+	statements, err := parse(sql, db.session.Flags())
+	db.session.Leave()
+
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{
+		db:         db,
+		statements: statements,
+	}, nil
+}
+
+// Query executes statements and returns the result set of the last statement that must be a select query.
+// ctx bounds the time waiting for other statements of the handle, and aborts the execution between statements when it is done.
+func (db *DB) Query(ctx context.Context, sql string, args ...interface{}) (*Rows, error) {
+	stmt, err := db.PrepareContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Query(ctx, args...)
+}
+
+// Exec executes statements and returns the number of affected records.
+// ctx bounds the time waiting for other statements of the handle, and aborts the execution between statements when it is done.
+func (db *DB) Exec(ctx context.Context, sql string, args ...interface{}) (Result, error) {
+	stmt, err := db.PrepareContext(ctx, sql)
+	if err != nil {
+		return Result{}, err
+	}
+	return stmt.Exec(ctx, args...)
+}
+
+func (db *DB) Commit(ctx context.Context) error {
+	return db.execute(ctx, nil, func(proc *query.Procedure) error {
+		return query.Commit(nil, proc.Filter)
+	})
+}
+
+func (db *DB) Rollback(ctx context.Context) error {
+	return db.execute(ctx, nil, func(proc *query.Procedure) error {
+		return query.Rollback(nil, proc.Filter)
+	})
+}
+
+func (db *DB) Close() error {
+	return db.session.Close(context.Background(), db.output)
+}
+
+func (db *DB) execute(ctx context.Context, args []interface{}, fn func(proc *query.Procedure) error) error {
+	if err := db.session.Enter(ctx, db.output, db.output); err != nil {
+		return err
+	}
+	defer db.session.Leave()
+
+	proc := db.session.Procedure
+	variables, err := bindArgs(proc.Filter, args)
+	defer func() {
+		for _, v := range variables {
+			proc.Filter.Variables[0].Dispose(v)
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	return fn(proc)
+}
+
+func parse(sql string, flags *cmd.Flags) ([]parser.Statement, error) {
+	statements, err := parser.ParseWithPlaceholders(sql, "", flags.DatetimeFormat, flags.GetLocation())
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+	if len(statements) < 1 {
+		return nil, errors.New("no statement to execute")
+	}
+	return statements, nil
+}
+
+func bindArgs(filter *query.Filter, args []interface{}) ([]parser.Variable, error) {
+	variables := make([]parser.Variable, 0, len(args))
+	ordinal := 0
+	for _, arg := range args {
+		var name string
+		if named, ok := arg.(NamedArg); ok {
+			name = parser.NamedPlaceholderName(named.Name)
+			arg = named.Value
+		} else {
+			ordinal++
+			name = parser.PlaceholderName(ordinal)
+		}
+
+		p, err := ToPrimary(arg)
+		if err != nil {
+			return variables, err
+		}
+
+		variable := parser.Variable{Name: name}
+		if err = filter.Variables[0].Add(variable, p); err != nil {
+			return variables, err
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+type Stmt struct {
+	db         *DB
+	statements []parser.Statement
+}
+
+func (stmt *Stmt) Query(ctx context.Context, args ...interface{}) (*Rows, error) {
+	selectQuery, ok := stmt.statements[len(stmt.statements)-1].(parser.SelectQuery)
+	if !ok {
+		return nil, errors.New("last statement is not a select query")
+	}
+
+	var rows *Rows
+	err := stmt.db.execute(ctx, args, func(proc *query.Procedure) error {
+		if _, err := proc.Execute(stmt.statements[:len(stmt.statements)-1]); err != nil {
+			return err
+		}
+		if err := proc.Filter.Err(); err != nil {
+			return err
+		}
+
+		view, err := query.Select(selectQuery, proc.Filter)
+		if err != nil {
+			return err
+		}
+		rows = newRows(view)
+		return nil
+	})
+	return rows, err
+}
+
+func (stmt *Stmt) Exec(ctx context.Context, args ...interface{}) (Result, error) {
+	var result Result
+	err := stmt.db.execute(ctx, args, func(proc *query.Procedure) error {
+		proc.ResetAffectedRows()
+		_, err := proc.Execute(stmt.statements)
+		result.AffectedRows = proc.AffectedRows()
+		return err
+	})
+	return result, err
+}
+
+type Rows struct {
+	columns []string
+	records [][]value.Primary
+	current int
+}
+
+func newRows(view *query.View) *Rows {
+	records := make([][]value.Primary, 0, view.RecordLen())
+	for _, record := range view.RecordSet {
+		row := make([]value.Primary, 0, len(record))
+		for _, cell := range record {
+			row = append(row, cell.Value())
+		}
+		records = append(records, row)
+	}
+
+	return &Rows{
+		columns: view.Header.TableColumnNames(),
+		records: records,
+		current: -1,
+	}
+}
+
+func (r *Rows) Columns() []string {
+	return r.columns
+}
+
+func (r *Rows) Next() bool {
+	if len(r.records) <= r.current+1 {
+		r.current = len(r.records)
+		return false
+	}
+	r.current++
+	return true
+}
+
+func (r *Rows) Values() []value.Primary {
+	if r.current < 0 || len(r.records) <= r.current {
+		return nil
+	}
+	return r.records[r.current]
+}
+
+func (r *Rows) Close() error {
+	r.records = nil
+	r.current = 0
+	return nil
+}
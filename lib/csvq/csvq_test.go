@@ -0,0 +1,342 @@
+package csvq
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var dbQueryTests = []struct {
+	Name    string
+	Query   string
+	Args    []interface{}
+	Columns []string
+	Values  [][]value.Primary
+	Error   string
+}{
+	{
+		Name:    "Query with Positional Arguments",
+		Query:   "select column2 from table1 where column1 = ? or column2 = ?",
+		Args:    []interface{}{2, "str3"},
+		Columns: []string{"column2"},
+		Values: [][]value.Primary{
+			{value.NewString("str2")},
+			{value.NewString("str3")},
+		},
+	},
+	{
+		Name:    "Query with Named Argument",
+		Query:   "select column1 + :add as c from table1 where column1 = 1",
+		Args:    []interface{}{Named("add", 10)},
+		Columns: []string{"c"},
+		Values: [][]value.Primary{
+			{value.NewInteger(11)},
+		},
+	},
+	{
+		Name:    "Query with Named Argument and Variable of the Same Name",
+		Query:   "var @add := 1; select column1 + @add + :add as c from table1 where column1 = 1",
+		Args:    []interface{}{Named("add", 10)},
+		Columns: []string{"c"},
+		Values: [][]value.Primary{
+			{value.NewInteger(12)},
+		},
+	},
+	{
+		Name:    "Query with Preceding Statements",
+		Query:   "var @a := 'x'; select @a || column2 as c from table1 where column1 = 1",
+		Columns: []string{"c"},
+		Values: [][]value.Primary{
+			{value.NewString("xstr1")},
+		},
+	},
+	{
+		Name:  "Query Last Statement Error",
+		Query: "select 1; print 1;",
+		Error: "last statement is not a select query",
+	},
+	{
+		Name:  "Query Syntax Error",
+		Query: "select from",
+		Error: "[L:1 C:8] syntax error: unexpected token \"from\"",
+	},
+	{
+		Name:  "Query Unsupported Argument Error",
+		Query: "select ?",
+		Args:  []interface{}{struct{}{}},
+		Error: "unsupported argument type struct {}",
+	},
+}
+
+func TestDB_Query(t *testing.T) {
+	db, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	for _, v := range dbQueryTests {
+		rows, err := db.Query(context.Background(), v.Query, v.Args...)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(rows.Columns(), v.Columns) {
+			t.Errorf("%s: columns = %q, want %q", v.Name, rows.Columns(), v.Columns)
+		}
+		values := make([][]value.Primary, 0)
+		for rows.Next() {
+			values = append(values, rows.Values())
+		}
+		rows.Close()
+		if !reflect.DeepEqual(values, v.Values) {
+			t.Errorf("%s: values = %s, want %s", v.Name, values, v.Values)
+		}
+	}
+
+	if _, err := db.Query(context.Background(), "select @a"); err != nil {
+		t.Errorf("variable declared in the previous query is not kept: %s", err)
+	}
+	if _, err := db.Query(context.Background(), "select ?"); err == nil {
+		t.Errorf("variable bound to an argument remains")
+	}
+}
+
+func TestDB_Exec(t *testing.T) {
+	out := &bytes.Buffer{}
+	db, err := Open(Options{Repository: TestDir, Output: out, Format: "CSV"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	result, err := db.Exec(context.Background(), "insert into table1 values (?, ?), (5, 'str5'); update table1 set column2 = 'upd' where column1 = 1; select 1 as c;", 4, "str4")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if result.AffectedRows != 3 {
+		t.Errorf("affected rows = %d, want %d", result.AffectedRows, 3)
+	}
+	expectOutput := fmt.Sprintf("2 records inserted on %q.\n1 record updated on %q.\nc\n1\n", GetTestFilePath("table1.csv"), GetTestFilePath("table1.csv"))
+	if out.String() != expectOutput {
+		t.Errorf("output = %q, want %q", out.String(), expectOutput)
+	}
+
+	if _, err = db.Exec(context.Background(), "commit"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	db2, _ := Open(Options{Repository: TestDir})
+	defer db2.Close()
+	rows, err := db2.Query(context.Background(), "select count(*) from table1")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	rows.Next()
+	if !reflect.DeepEqual(rows.Values(), []value.Primary{value.NewInteger(5)}) {
+		t.Errorf("count = %s, want %s", rows.Values(), []value.Primary{value.NewInteger(5)})
+	}
+}
+
+func TestDB_Independence(t *testing.T) {
+	semicolon, err := Open(Options{Repository: TestDir, Delimiter: ";"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer semicolon.Close()
+
+	comma, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer comma.Close()
+
+	check := func(db *DB, expect []string, errs chan<- error) {
+		for i := 0; i < 20; i++ {
+			rows, err := db.Query(context.Background(), "select * from semicolon")
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !reflect.DeepEqual(rows.Columns(), expect) {
+				errs <- fmt.Errorf("columns = %q, want %q", rows.Columns(), expect)
+				return
+			}
+		}
+	}
+
+	errs := make(chan error, 2)
+	wg := &sync.WaitGroup{}
+	for _, v := range []struct {
+		DB     *DB
+		Expect []string
+	}{
+		{DB: semicolon, Expect: []string{"c1", "c2"}},
+		{DB: comma, Expect: []string{"c1;c2"}},
+	} {
+		wg.Add(1)
+		go func(db *DB, expect []string) {
+			defer wg.Done()
+			check(db, expect, errs)
+		}(v.DB, v.Expect)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestDB_Concurrency(t *testing.T) {
+	busy, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer busy.Close()
+
+	db, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	busyCtx, cancelBusy := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := busy.Exec(busyCtx, "var @i := 0; while true do @i := @i + 1; end while;")
+		done <- err
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rows, err := db.Query(ctx, "select count(*) from semicolon")
+	if err != nil {
+		t.Errorf("unexpected error %q while another handle is executing statements", err)
+	} else {
+		rows.Next()
+		if !reflect.DeepEqual(rows.Values(), []value.Primary{value.NewInteger(2)}) {
+			t.Errorf("count = %s, want %s", rows.Values(), []value.Primary{value.NewInteger(2)})
+		}
+	}
+
+	cancelBusy()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDB_Cancel(t *testing.T) {
+	db, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = db.Exec(ctx, "var @i := 0; while true do @i := @i + 1; end while;")
+	if err != context.DeadlineExceeded {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, err = db.Exec(context.Background(), "select 1"); err != nil {
+		t.Errorf("unexpected error %q after cancellation", err)
+	}
+}
+
+func TestDB_Timezone(t *testing.T) {
+	local := time.Local
+
+	db, err := Open(Options{Repository: TestDir, Timezone: "America/Los_Angeles"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query(context.Background(), "select datetime_format(datetime('2012-02-03 09:18:15'), '%z')")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	rows.Next()
+	if !reflect.DeepEqual(rows.Values(), []value.Primary{value.NewString("PST")}) {
+		t.Errorf("timezone = %s, want %s", rows.Values(), []value.Primary{value.NewString("PST")})
+	}
+
+	if time.Local != local {
+		t.Errorf("time.Local is changed to %s", time.Local)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	_, err := Open(Options{Delimiter: "invalid"})
+	if err == nil {
+		t.Error("no error, want error for invalid delimiter")
+	}
+}
+
+func TestDB_Prepare(t *testing.T) {
+	db, err := Open(Options{Repository: TestDir})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	stmt, err := db.Prepare("select column2 from table1 where column1 = ? or column2 = :name")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for _, v := range []struct {
+		Args   []interface{}
+		Values [][]value.Primary
+	}{
+		{
+			Args:   []interface{}{Named("name", "str2"), 3},
+			Values: [][]value.Primary{{value.NewString("str2")}, {value.NewString("str3")}},
+		},
+		{
+			Args:   []interface{}{2, Named("name", "none")},
+			Values: [][]value.Primary{{value.NewString("str2")}},
+		},
+	} {
+		rows, err := stmt.Query(context.Background(), v.Args...)
+		if err != nil {
+			t.Errorf("unexpected error %q for %v", err, v.Args)
+			continue
+		}
+		values := make([][]value.Primary, 0)
+		for rows.Next() {
+			values = append(values, rows.Values())
+		}
+		if !reflect.DeepEqual(values, v.Values) {
+			t.Errorf("values = %s, want %s for %v", values, v.Values, v.Args)
+		}
+	}
+
+	if _, err := db.Prepare("select ?,"); err == nil {
+		t.Error("no error, want syntax error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := db.PrepareContext(ctx, "select ?"); err != context.Canceled {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
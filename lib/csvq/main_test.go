@@ -0,0 +1,41 @@
+package csvq
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var TestDir = filepath.Join(os.TempDir(), "csvq_embed_test")
+
+func GetTestFilePath(filename string) string {
+	return filepath.Join(TestDir, filename)
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	defer teardown()
+
+	setup()
+	return m.Run()
+}
+
+func setup() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+	os.Mkdir(TestDir, 0755)
+
+	ioutil.WriteFile(GetTestFilePath("table1.csv"), []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"), 0644)
+	ioutil.WriteFile(GetTestFilePath("semicolon.csv"), []byte("c1;c2\n1;a\n2;b\n"), 0644)
+}
+
+func teardown() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+}
@@ -0,0 +1,63 @@
+package csvq
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+type NamedArg struct {
+	Name  string
+	Value interface{}
+}
+
+func Named(name string, v interface{}) NamedArg {
+	return NamedArg{
+		Name:  name,
+		Value: v,
+	}
+}
+
+func ToPrimary(v interface{}) (value.Primary, error) {
+	switch v.(type) {
+	case nil:
+		return value.NewNull(), nil
+	case value.Primary:
+		return v.(value.Primary), nil
+	case string:
+		return value.NewString(v.(string)), nil
+	case []byte:
+		return value.NewString(string(v.([]byte))), nil
+	case int:
+		return value.NewInteger(int64(v.(int))), nil
+	case int8:
+		return value.NewInteger(int64(v.(int8))), nil
+	case int16:
+		return value.NewInteger(int64(v.(int16))), nil
+	case int32:
+		return value.NewInteger(int64(v.(int32))), nil
+	case int64:
+		return value.NewInteger(v.(int64)), nil
+	case uint8:
+		return value.NewInteger(int64(v.(uint8))), nil
+	case uint16:
+		return value.NewInteger(int64(v.(uint16))), nil
+	case uint32:
+		return value.NewInteger(int64(v.(uint32))), nil
+	case float32:
+		return value.NewFloat(float64(v.(float32))), nil
+	case float64:
+		return value.NewFloat(v.(float64)), nil
+	case bool:
+		return value.NewBoolean(v.(bool)), nil
+	case ternary.Value:
+		return value.NewTernary(v.(ternary.Value)), nil
+	case time.Time:
+		return value.NewDatetime(v.(time.Time)), nil
+	}
+	return nil, errors.New(fmt.Sprintf("unsupported argument type %T", v))
+}
@@ -0,0 +1,53 @@
+package csvq
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var toPrimaryTests = []struct {
+	Value  interface{}
+	Result value.Primary
+	Error  string
+}{
+	{Value: nil, Result: value.NewNull()},
+	{Value: value.NewInteger(1), Result: value.NewInteger(1)},
+	{Value: "str", Result: value.NewString("str")},
+	{Value: []byte("str"), Result: value.NewString("str")},
+	{Value: 1, Result: value.NewInteger(1)},
+	{Value: int8(1), Result: value.NewInteger(1)},
+	{Value: int64(1), Result: value.NewInteger(1)},
+	{Value: uint32(1), Result: value.NewInteger(1)},
+	{Value: float32(1.5), Result: value.NewFloat(1.5)},
+	{Value: 1.5, Result: value.NewFloat(1.5)},
+	{Value: true, Result: value.NewBoolean(true)},
+	{Value: ternary.UNKNOWN, Result: value.NewTernary(ternary.UNKNOWN)},
+	{Value: time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC), Result: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC))},
+	{Value: uint64(1), Error: "unsupported argument type uint64"},
+}
+
+func TestToPrimary(t *testing.T) {
+	for _, v := range toPrimaryTests {
+		result, err := ToPrimary(v.Value)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %#v", err, v.Value)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %#v", err.Error(), v.Error, v.Value)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %#v", v.Error, v.Value)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %#v", result, v.Result, v.Value)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package file
 
 import "time"
 
-var WaitTimeout = 30.0
-var RetryInterval = 50 * time.Millisecond
+const (
+	DefaultWaitTimeout   = 30.0
+	DefaultRetryInterval = 50 * time.Millisecond
+)
 
 const (
 	LockFileSuffix = ".lock"
@@ -6,41 +6,46 @@ import (
 	"strings"
 )
 
-var container = make(map[string]*Handler)
+// Container keeps the handlers that lock files to be created or updated until they are committed or closed.
+type Container map[string]*Handler
 
-func addToContainer(path string, handler *Handler) error {
+func NewContainer() Container {
+	return make(Container)
+}
+
+func (c Container) add(path string, handler *Handler) error {
 	key := strings.ToUpper(path)
-	if _, ok := container[key]; ok {
+	if _, ok := c[key]; ok {
 		return errors.New(fmt.Sprintf("file %s already opened", path))
 	}
-	container[key] = handler
+	c[key] = handler
 	return nil
 }
 
-func removeFromContainer(path string) {
+func (c Container) remove(path string) {
 	key := strings.ToUpper(path)
-	if _, ok := container[key]; ok {
-		delete(container, key)
+	if _, ok := c[key]; ok {
+		delete(c, key)
 	}
 }
 
-func UnlockAll() error {
-	for k := range container {
-		if err := container[k].Close(); err != nil {
+func (c Container) UnlockAll() error {
+	for k := range c {
+		if err := c[k].Close(); err != nil {
 			return err
 		}
-		delete(container, k)
+		delete(c, k)
 	}
 	return nil
 }
 
-func UnlockAllWithErrors() error {
+func (c Container) UnlockAllWithErrors() error {
 	var errs []error
-	for k := range container {
-		if err := container[k].CloseWithErrors(); err != nil {
+	for k := range c {
+		if err := c[k].CloseWithErrors(); err != nil {
 			errs = append(errs, err.(*ForcedUnlockError).Errors...)
 		}
-		delete(container, k)
+		delete(c, k)
 	}
 
 	if errs != nil {
@@ -3,18 +3,8 @@ package file
 import (
 	"os"
 	"path/filepath"
-	"time"
-
-	"github.com/mithrandie/go-file"
 )
 
-func UpdateWaitTimeout(waitTimeout float64, retryInterval time.Duration) {
-	WaitTimeout = waitTimeout
-	RetryInterval = retryInterval
-	file.WaitTimeout = waitTimeout
-	file.RetryInterval = retryInterval
-}
-
 func LockFilePath(path string) string {
 	dir := filepath.Dir(path)
 	basename := filepath.Base(path)
@@ -28,32 +28,39 @@ type Handler struct {
 	tempFilePath string
 	tempFp       *os.File
 
+	container     Container
+	waitTimeout   float64
+	retryInterval time.Duration
+
 	closed bool
 }
 
-func NewHandlerForRead(path string) (*Handler, error) {
+func NewHandlerForRead(path string, waitTimeout float64, retryInterval time.Duration) (*Handler, error) {
 	h := &Handler{
-		path:     path,
-		openType: ForRead,
+		path:          path,
+		openType:      ForRead,
+		waitTimeout:   waitTimeout,
+		retryInterval: retryInterval,
 	}
 
 	if err := h.PrepareToRead(); err != nil {
 		return h, err
 	}
 
-	fp, err := file.OpenToReadWithTimeout(h.path)
+	fp, err := h.openWithTimeout(os.O_RDONLY, 0400, file.SHARED_LOCK)
 	if err != nil {
-		return h, ParseError(err)
+		return h, err
 	}
 	h.fp = fp
 
 	return h, nil
 }
 
-func NewHandlerForCreate(path string) (*Handler, error) {
+func NewHandlerForCreate(container Container, path string) (*Handler, error) {
 	h := &Handler{
-		path:     path,
-		openType: ForCreate,
+		path:      path,
+		openType:  ForCreate,
+		container: container,
 	}
 
 	if Exists(h.path) {
@@ -70,16 +77,19 @@ func NewHandlerForCreate(path string) (*Handler, error) {
 	}
 	h.fp = fp
 
-	if err := addToContainer(h.path, h); err != nil {
+	if err := h.container.add(h.path, h); err != nil {
 		return h, err
 	}
 	return h, nil
 }
 
-func NewHandlerForUpdate(path string) (*Handler, error) {
+func NewHandlerForUpdate(container Container, path string, waitTimeout float64, retryInterval time.Duration) (*Handler, error) {
 	h := &Handler{
-		path:     path,
-		openType: ForUpdate,
+		path:          path,
+		openType:      ForUpdate,
+		container:     container,
+		waitTimeout:   waitTimeout,
+		retryInterval: retryInterval,
 	}
 
 	if !Exists(h.path) {
@@ -90,10 +100,10 @@ func NewHandlerForUpdate(path string) (*Handler, error) {
 		return h, err
 	}
 
-	fp, err := file.OpenToUpdateWithTimeout(path)
+	fp, err := h.openWithTimeout(os.O_RDWR, 0600, file.EXCLUSIVE_LOCK)
 	if err != nil {
 		h.Close()
-		return h, ParseError(err)
+		return h, err
 	}
 	h.fp = fp
 
@@ -101,12 +111,36 @@ func NewHandlerForUpdate(path string) (*Handler, error) {
 		return h, err
 	}
 
-	if err := addToContainer(h.path, h); err != nil {
+	if err := h.container.add(h.path, h); err != nil {
 		return h, err
 	}
 	return h, nil
 }
 
+func (h *Handler) openWithTimeout(flag int, perm os.FileMode, lockType file.LockType) (*os.File, error) {
+	fp, err := os.OpenFile(h.path, flag, perm)
+	if err != nil {
+		return nil, NewIOError(err.Error())
+	}
+
+	var start time.Time
+
+	for {
+		if start.IsZero() {
+			start = time.Now()
+		} else if time.Since(start).Seconds() > h.waitTimeout {
+			fp.Close()
+			return nil, NewTimeoutError(h.path)
+		}
+
+		if err := file.TryLock(fp, lockType); err == nil {
+			break
+		}
+		time.Sleep(h.retryInterval)
+	}
+	return fp, nil
+}
+
 func (h *Handler) Path() string {
 	return h.path
 }
@@ -167,7 +201,7 @@ func (h *Handler) Close() error {
 	}
 
 	h.closed = true
-	removeFromContainer(h.path)
+	h.container.remove(h.path)
 	return nil
 }
 
@@ -229,10 +263,31 @@ func (h *Handler) Commit() error {
 	}
 
 	h.closed = true
-	removeFromContainer(h.path)
+	h.container.remove(h.path)
 	return nil
 }
 
+func (h *Handler) Remove() error {
+	if h.closed {
+		return nil
+	}
+
+	if h.fp != nil {
+		if err := file.Close(h.fp); err != nil {
+			return err
+		}
+		h.fp = nil
+	}
+
+	if Exists(h.path) {
+		if err := os.Remove(h.path); err != nil {
+			return err
+		}
+	}
+
+	return h.Close()
+}
+
 func (h *Handler) CloseWithErrors() error {
 	if h.closed {
 		return nil
@@ -294,14 +349,14 @@ func (h *Handler) TryCreateLockFileWithTimeout() error {
 	for {
 		if start.IsZero() {
 			start = time.Now()
-		} else if time.Since(start).Seconds() > WaitTimeout {
+		} else if time.Since(start).Seconds() > h.waitTimeout {
 			return NewTimeoutError(h.path)
 		}
 
 		if err := h.TryCreateLockFile(); err == nil {
 			break
 		}
-		time.Sleep(RetryInterval)
+		time.Sleep(h.retryInterval)
 	}
 	return nil
 }
@@ -356,7 +411,7 @@ func (h *Handler) PrepareToRead() error {
 	for {
 		if start.IsZero() {
 			start = time.Now()
-		} else if time.Since(start).Seconds() > WaitTimeout {
+		} else if time.Since(start).Seconds() > h.waitTimeout {
 			return NewTimeoutError(h.path)
 		}
 
@@ -364,7 +419,7 @@ func (h *Handler) PrepareToRead() error {
 			break
 		}
 
-		time.Sleep(RetryInterval)
+		time.Sleep(h.retryInterval)
 	}
 
 	return nil
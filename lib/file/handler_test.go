@@ -8,8 +8,9 @@ func TestHandler(t *testing.T) {
 	fileForRead := GetTestFilePath("open.txt")
 	fileForUpdate := GetTestFilePath("update.txt")
 	fileForCreate := GetTestFilePath("create.txt")
+	container := NewContainer()
 
-	rh, err := NewHandlerForRead(fileForCreate)
+	rh, err := NewHandlerForRead(fileForCreate, waitTimeoutForTests, retryIntervalForTests)
 	if err == nil {
 		rh.Close()
 		t.Fatalf("no error, want IOError")
@@ -18,13 +19,13 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, want IOError", err)
 	}
 
-	rh, err = NewHandlerForRead(fileForRead)
+	rh, err = NewHandlerForRead(fileForRead, waitTimeoutForTests, retryIntervalForTests)
 	if err != nil {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
 	rh.Close()
 
-	uh, err := NewHandlerForUpdate(fileForCreate)
+	uh, err := NewHandlerForUpdate(container, fileForCreate, waitTimeoutForTests, retryIntervalForTests)
 	if err == nil {
 		uh.Close()
 		t.Fatalf("no error, want IOError")
@@ -33,7 +34,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, want IOError", err)
 	}
 
-	ch, err := NewHandlerForCreate(fileForRead)
+	ch, err := NewHandlerForCreate(container, fileForRead)
 	if err == nil {
 		ch.Close()
 		t.Fatalf("no error, want IOError")
@@ -42,7 +43,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, want IOError", err)
 	}
 
-	ch, err = NewHandlerForCreate(fileForCreate)
+	ch, err = NewHandlerForCreate(container, fileForCreate)
 	if err != nil {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
@@ -57,7 +58,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("filename to update = %q, expect %q", ch.FileForUpdate().Name(), fileForCreate)
 	}
 
-	rh, err = NewHandlerForRead(fileForCreate)
+	rh, err = NewHandlerForRead(fileForCreate, waitTimeoutForTests, retryIntervalForTests)
 	if err == nil {
 		rh.Close()
 		ch.Close()
@@ -68,7 +69,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, want TimeoutError", err)
 	}
 
-	ch2, err := NewHandlerForCreate(fileForCreate)
+	ch2, err := NewHandlerForCreate(container, fileForCreate)
 	if err == nil {
 		ch.Close()
 		ch2.Close()
@@ -81,13 +82,13 @@ func TestHandler(t *testing.T) {
 
 	ch.Commit()
 
-	rh, err = NewHandlerForRead(fileForCreate)
+	rh, err = NewHandlerForRead(fileForCreate, waitTimeoutForTests, retryIntervalForTests)
 	if err != nil {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
 	rh.Close()
 
-	uh, err = NewHandlerForUpdate(fileForUpdate)
+	uh, err = NewHandlerForUpdate(container, fileForUpdate, waitTimeoutForTests, retryIntervalForTests)
 	if err != nil {
 		uh.Close()
 		t.Fatalf("error = %#v, expect no error", err)
@@ -103,7 +104,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("filename to update = %q, expect %q", uh.FileForUpdate().Name(), TempFilePath(fileForUpdate))
 	}
 
-	rh, err = NewHandlerForRead(fileForUpdate)
+	rh, err = NewHandlerForRead(fileForUpdate, waitTimeoutForTests, retryIntervalForTests)
 	if err == nil {
 		rh.Close()
 		uh.Close()
@@ -114,7 +115,7 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, want TimeoutError", err)
 	}
 
-	uh2, err := NewHandlerForUpdate(fileForUpdate)
+	uh2, err := NewHandlerForUpdate(container, fileForUpdate, waitTimeoutForTests, retryIntervalForTests)
 	if err == nil {
 		uh2.Close()
 		uh.Close()
@@ -127,9 +128,24 @@ func TestHandler(t *testing.T) {
 
 	uh.Commit()
 
-	rh, err = NewHandlerForRead(fileForUpdate)
+	rh, err = NewHandlerForRead(fileForUpdate, waitTimeoutForTests, retryIntervalForTests)
 	if err != nil {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
 	rh.Close()
+
+	uh, err = NewHandlerForUpdate(container, fileForCreate, waitTimeoutForTests, retryIntervalForTests)
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+
+	if err = uh.Remove(); err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+
+	for _, fpath := range []string{fileForCreate, LockFilePath(fileForCreate), TempFilePath(fileForCreate)} {
+		if Exists(fpath) {
+			t.Fatalf("file %q exists, expect to be removed", fpath)
+		}
+	}
 }
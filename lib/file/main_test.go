@@ -41,8 +41,6 @@ func setup() {
 
 	fp, _ = os.Create(GetTestFilePath("update.txt"))
 	fp.Close()
-
-	UpdateWaitTimeout(waitTimeoutForTests, retryIntervalForTests)
 }
 
 func teardown() {
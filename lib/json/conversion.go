@@ -166,6 +166,10 @@ func ParseValueToStructure(val value.Primary) json.Structure {
 		s = json.Number(val.(value.Integer).Raw())
 	case value.Float:
 		s = json.Number(val.(value.Float).Raw())
+	case value.Decimal:
+		s = json.Number(val.(value.Decimal).Float64())
+	case value.Interval:
+		s = json.String(val.(value.Interval).ISO8601())
 	case value.Boolean:
 		s = json.Boolean(val.(value.Boolean).Raw())
 	case value.Ternary:
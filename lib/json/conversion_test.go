@@ -3,6 +3,7 @@ package json
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 	"github.com/mithrandie/go-text/json"
@@ -360,7 +361,7 @@ var convertTableValueToJsonStructureTests = []struct {
 				value.NewBoolean(false),
 				value.NewTernary(ternary.TRUE),
 				value.NewTernary(ternary.UNKNOWN),
-				value.NewDatetimeFromString("2012-02-02 22:22:22 -07:00"),
+				value.NewDatetimeFromString("2012-02-02 22:22:22 -07:00", nil, time.Local),
 				value.NewNull(),
 			},
 		},
@@ -121,10 +121,17 @@ func NewTernaryValue(t ternary.Value) PrimitiveType {
 	}
 }
 
-func NewDatetimeValueFromString(s string) PrimitiveType {
+func NewDatetimeValueFromString(s string, datetimeFormat []string, location *time.Location) PrimitiveType {
 	return PrimitiveType{
 		Literal: s,
-		Value:   value.NewDatetimeFromString(s),
+		Value:   value.NewDatetimeFromString(s, datetimeFormat, location),
+	}
+}
+
+func NewIntervalValueFromString(s string) PrimitiveType {
+	return PrimitiveType{
+		Literal: s,
+		Value:   value.NewIntervalFromString(s),
 	}
 }
 
@@ -148,6 +155,13 @@ func NewNullValue() PrimitiveType {
 }
 
 func (e PrimitiveType) String() string {
+	if iv, ok := e.Value.(value.Interval); ok {
+		if len(e.Literal) < 1 {
+			return IntervalPrefix + " " + quoteString(iv.ISO8601())
+		}
+		return IntervalPrefix + " " + quoteString(e.Literal)
+	}
+
 	if 0 < len(e.Literal) {
 		switch e.Value.(type) {
 		case value.String, value.Datetime:
@@ -288,6 +302,7 @@ type SelectEntity struct {
 	WhereClause   QueryExpression
 	GroupByClause QueryExpression
 	HavingClause  QueryExpression
+	WindowClause  QueryExpression
 }
 
 func (e SelectEntity) String() string {
@@ -304,6 +319,9 @@ func (e SelectEntity) String() string {
 	if e.HavingClause != nil {
 		s = append(s, e.HavingClause.String())
 	}
+	if e.WindowClause != nil {
+		s = append(s, e.WindowClause.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -360,6 +378,36 @@ func (gb GroupByClause) String() string {
 	return joinWithSpace(s)
 }
 
+type Rollup struct {
+	*BaseExpr
+	Rollup string
+	Items  []QueryExpression
+}
+
+func (e Rollup) String() string {
+	return e.Rollup + " " + putParentheses(listQueryExpressions(e.Items))
+}
+
+type Cube struct {
+	*BaseExpr
+	Cube  string
+	Items []QueryExpression
+}
+
+func (e Cube) String() string {
+	return e.Cube + " " + putParentheses(listQueryExpressions(e.Items))
+}
+
+type GroupingSets struct {
+	*BaseExpr
+	GroupingSets string
+	Sets         []QueryExpression
+}
+
+func (e GroupingSets) String() string {
+	return e.GroupingSets + " " + putParentheses(listQueryExpressions(e.Sets))
+}
+
 type HavingClause struct {
 	*BaseExpr
 	Having string
@@ -371,6 +419,29 @@ func (h HavingClause) String() string {
 	return joinWithSpace(s)
 }
 
+type WindowClause struct {
+	*BaseExpr
+	Window  string
+	Windows []QueryExpression
+}
+
+func (e WindowClause) String() string {
+	s := []string{e.Window, listQueryExpressions(e.Windows)}
+	return joinWithSpace(s)
+}
+
+type WindowDefinition struct {
+	*BaseExpr
+	Name   Identifier
+	As     string
+	Clause AnalyticClause
+}
+
+func (e WindowDefinition) String() string {
+	s := []string{e.Name.String(), e.As, "(" + e.Clause.String() + ")"}
+	return joinWithSpace(s)
+}
+
 type OrderByClause struct {
 	*BaseExpr
 	OrderBy string
@@ -511,6 +582,16 @@ func (e JsonQuery) String() string {
 	return e.JsonQuery + putParentheses(e.Query.String()+", "+e.JsonText.String())
 }
 
+type TableFunction struct {
+	*BaseExpr
+	Name string
+	Args []QueryExpression
+}
+
+func (e TableFunction) String() string {
+	return e.Name + "(" + listQueryExpressions(e.Args) + ")"
+}
+
 type Comparison struct {
 	*BaseExpr
 	LHS      QueryExpression
@@ -635,6 +716,27 @@ func (l Like) String() string {
 	return joinWithSpace(s)
 }
 
+type Regexp struct {
+	*BaseExpr
+	Regexp   string
+	LHS      QueryExpression
+	Pattern  QueryExpression
+	Negation Token
+}
+
+func (r Regexp) IsNegated() bool {
+	return !r.Negation.IsEmpty()
+}
+
+func (r Regexp) String() string {
+	s := []string{r.LHS.String()}
+	if r.IsNegated() {
+		s = append(s, r.Negation.Literal)
+	}
+	s = append(s, r.Regexp, r.Pattern.String())
+	return joinWithSpace(s)
+}
+
 type Exists struct {
 	*BaseExpr
 	Exists string
@@ -722,6 +824,7 @@ type AggregateFunction struct {
 	Name     string
 	Distinct Token
 	Args     []QueryExpression
+	Filter   QueryExpression
 }
 
 func (e AggregateFunction) String() string {
@@ -731,7 +834,11 @@ func (e AggregateFunction) String() string {
 	}
 	s = append(s, listQueryExpressions(e.Args))
 
-	return e.Name + "(" + joinWithSpace(s) + ")"
+	fn := e.Name + "(" + joinWithSpace(s) + ")"
+	if e.Filter != nil {
+		fn = joinWithSpace([]string{fn, e.Filter.String()})
+	}
+	return fn
 }
 
 func (e AggregateFunction) IsDistinct() bool {
@@ -740,13 +847,18 @@ func (e AggregateFunction) IsDistinct() bool {
 
 type Table struct {
 	*BaseExpr
-	Object QueryExpression
-	As     string
-	Alias  QueryExpression
+	Lateral Token
+	Object  QueryExpression
+	As      string
+	Alias   QueryExpression
 }
 
 func (t Table) String() string {
-	s := []string{t.Object.String()}
+	s := make([]string, 0, 4)
+	if !t.Lateral.IsEmpty() {
+		s = append(s, t.Lateral.Literal)
+	}
+	s = append(s, t.Object.String())
 	if 0 < len(t.As) {
 		s = append(s, t.As)
 	}
@@ -756,6 +868,10 @@ func (t Table) String() string {
 	return joinWithSpace(s)
 }
 
+func (t Table) IsLateral() bool {
+	return !t.Lateral.IsEmpty()
+}
+
 func (t Table) Name() Identifier {
 	if t.Alias != nil {
 		return t.Alias.(Identifier)
@@ -804,6 +920,38 @@ func (j Join) String() string {
 	return joinWithSpace(s)
 }
 
+type Pivot struct {
+	*BaseExpr
+	Table     QueryExpression
+	Pivot     string
+	Aggregate QueryExpression
+	For       string
+	Field     QueryExpression
+	In        string
+	Values    []QueryExpression
+}
+
+func (e Pivot) String() string {
+	s := []string{e.Aggregate.String(), e.For, e.Field.String(), e.In, putParentheses(listQueryExpressions(e.Values))}
+	return joinWithSpace([]string{e.Table.String(), e.Pivot, putParentheses(joinWithSpace(s))})
+}
+
+type Unpivot struct {
+	*BaseExpr
+	Table       QueryExpression
+	Unpivot     string
+	ValueColumn Identifier
+	For         string
+	NameColumn  Identifier
+	In          string
+	Fields      []QueryExpression
+}
+
+func (e Unpivot) String() string {
+	s := []string{e.ValueColumn.String(), e.For, e.NameColumn.String(), e.In, putParentheses(listQueryExpressions(e.Fields))}
+	return joinWithSpace([]string{e.Table.String(), e.Unpivot, putParentheses(joinWithSpace(s))})
+}
+
 type JoinCondition struct {
 	*BaseExpr
 	Literal string
@@ -952,6 +1100,7 @@ type ListFunction struct {
 	Args        []QueryExpression
 	WithinGroup string
 	OrderBy     QueryExpression
+	Filter      QueryExpression
 }
 
 func (e ListFunction) String() string {
@@ -970,6 +1119,9 @@ func (e ListFunction) String() string {
 			s = append(s, "()")
 		}
 	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -977,6 +1129,16 @@ func (e ListFunction) IsDistinct() bool {
 	return !e.Distinct.IsEmpty()
 }
 
+type FilterClause struct {
+	*BaseExpr
+	Filter string
+	Where  QueryExpression
+}
+
+func (e FilterClause) String() string {
+	return e.Filter + " (" + e.Where.String() + ")"
+}
+
 type AnalyticFunction struct {
 	*BaseExpr
 	Name           string
@@ -984,6 +1146,8 @@ type AnalyticFunction struct {
 	Args           []QueryExpression
 	IgnoreNulls    bool
 	IgnoreNullsLit string
+	WithinGroup    string
+	Filter         QueryExpression
 	Over           string
 	AnalyticClause AnalyticClause
 }
@@ -1000,10 +1164,32 @@ func (e AnalyticFunction) String() string {
 		option = append(option, e.IgnoreNullsLit)
 	}
 
-	s := []string{
-		e.Name + "(" + joinWithSpace(option) + ")",
-		e.Over,
-		"(" + e.AnalyticClause.String() + ")",
+	s := []string{e.Name + "(" + joinWithSpace(option) + ")"}
+	if 0 < len(e.WithinGroup) {
+		s = append(s, e.WithinGroup)
+		if e.AnalyticClause.OrderByClause != nil {
+			s = append(s, "("+e.AnalyticClause.OrderByClause.String()+")")
+		} else {
+			s = append(s, "()")
+		}
+		if e.Filter != nil {
+			s = append(s, e.Filter.String())
+		}
+		s = append(s, e.Over)
+		if e.AnalyticClause.PartitionClause != nil {
+			s = append(s, "("+e.AnalyticClause.PartitionClause.String()+")")
+		} else {
+			s = append(s, "()")
+		}
+	} else {
+		if e.Filter != nil {
+			s = append(s, e.Filter.String())
+		}
+		if 0 < len(e.AnalyticClause.WindowName.Literal) {
+			s = append(s, e.Over, e.AnalyticClause.WindowName.String())
+		} else {
+			s = append(s, e.Over, "("+e.AnalyticClause.String()+")")
+		}
 	}
 	return joinWithSpace(s)
 }
@@ -1014,12 +1200,17 @@ func (e AnalyticFunction) IsDistinct() bool {
 
 type AnalyticClause struct {
 	*BaseExpr
+	WindowName      Identifier
 	PartitionClause QueryExpression
 	OrderByClause   QueryExpression
 	WindowingClause QueryExpression
 }
 
 func (e AnalyticClause) String() string {
+	if 0 < len(e.WindowName.Literal) {
+		return e.WindowName.String()
+	}
+
 	s := make([]string, 0)
 	if e.PartitionClause != nil {
 		s = append(s, e.PartitionClause.String())
@@ -1053,7 +1244,7 @@ func (e PartitionClause) String() string {
 
 type WindowingClause struct {
 	*BaseExpr
-	Rows      string
+	Unit      Token
 	FrameLow  QueryExpression
 	FrameHigh QueryExpression
 	Between   string
@@ -1061,7 +1252,7 @@ type WindowingClause struct {
 }
 
 func (e WindowingClause) String() string {
-	s := []string{e.Rows}
+	s := []string{e.Unit.Literal}
 	if e.FrameHigh == nil {
 		s = append(s, e.FrameLow.String())
 	} else {
@@ -1072,10 +1263,11 @@ func (e WindowingClause) String() string {
 
 type WindowFramePosition struct {
 	*BaseExpr
-	Direction int
-	Unbounded bool
-	Offset    int
-	Literal   string
+	Direction   int
+	Unbounded   bool
+	Offset      int
+	OffsetValue QueryExpression
+	Literal     string
 }
 
 func (e WindowFramePosition) String() string {
@@ -1088,6 +1280,9 @@ type Variable struct {
 }
 
 func (v Variable) String() string {
+	if isPlaceholderName(v.Name) {
+		return v.Name
+	}
 	return string(VariableSign) + v.Name
 }
 
@@ -1184,6 +1379,25 @@ type DeleteQuery struct {
 	WhereClause QueryExpression
 }
 
+type MergeQuery struct {
+	*BaseExpr
+	WithClause QueryExpression
+	Table      Table
+	Source     QueryExpression
+	Condition  QueryExpression
+	WhenList   []MergeWhen
+}
+
+type MergeWhen struct {
+	*BaseExpr
+	Matched   bool
+	Condition QueryExpression
+	Action    Token
+	SetList   []UpdateSet
+	Fields    []QueryExpression
+	Values    QueryExpression
+}
+
 type CreateTable struct {
 	*BaseExpr
 	Table  Identifier
@@ -1191,6 +1405,13 @@ type CreateTable struct {
 	Query  QueryExpression
 }
 
+type CreateIndex struct {
+	*BaseExpr
+	Name    Identifier
+	Table   Identifier
+	Columns []QueryExpression
+}
+
 type AddColumns struct {
 	*BaseExpr
 	Table    QueryExpression
@@ -1204,6 +1425,59 @@ type ColumnDefault struct {
 	Value  QueryExpression
 }
 
+type ColumnDefinition struct {
+	*BaseExpr
+	Column      Identifier
+	Type        Identifier
+	Constraints []QueryExpression
+}
+
+func (e ColumnDefinition) String() string {
+	s := []string{e.Column.String()}
+	if 0 < len(e.Type.Literal) {
+		s = append(s, e.Type.String())
+	}
+	for _, c := range e.Constraints {
+		s = append(s, c.String())
+	}
+	return joinWithSpace(s)
+}
+
+type TableConstraint struct {
+	*BaseExpr
+	Constraint string
+	Name       Identifier
+	Type       Token
+	Columns    []QueryExpression
+	Check      QueryExpression
+}
+
+func (e TableConstraint) String() string {
+	s := make([]string, 0, 4)
+	if 0 < len(e.Constraint) {
+		s = append(s, e.Constraint, e.Name.String())
+	}
+	s = append(s, e.Type.Literal)
+	if e.Check != nil {
+		s = append(s, putParentheses(e.Check.String()))
+	} else if e.Columns != nil {
+		s = append(s, putParentheses(listQueryExpressions(e.Columns)))
+	}
+	return joinWithSpace(s)
+}
+
+type AddConstraint struct {
+	*BaseExpr
+	Table      QueryExpression
+	Constraint TableConstraint
+}
+
+type DropConstraint struct {
+	*BaseExpr
+	Table QueryExpression
+	Name  Identifier
+}
+
 type ColumnPosition struct {
 	*BaseExpr
 	Position Token
@@ -1223,6 +1497,23 @@ type RenameColumn struct {
 	New   Identifier
 }
 
+type RenameTable struct {
+	*BaseExpr
+	Table QueryExpression
+	New   Identifier
+}
+
+type DropTable struct {
+	*BaseExpr
+	Table    QueryExpression
+	IfExists bool
+}
+
+type TruncateTable struct {
+	*BaseExpr
+	Table QueryExpression
+}
+
 type SetTableAttribute struct {
 	*BaseExpr
 	Table     QueryExpression
@@ -1245,6 +1536,31 @@ type AggregateDeclaration struct {
 	Statements []Statement
 }
 
+type ProcedureDeclaration struct {
+	*BaseExpr
+	Name       Identifier
+	Parameters []ProcedureParameter
+	Statements []Statement
+}
+
+type ProcedureParameter struct {
+	*BaseExpr
+	Variable Variable
+	Value    QueryExpression
+	Out      bool
+}
+
+type DisposeProcedure struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type CallProcedure struct {
+	*BaseExpr
+	Name Identifier
+	Args []QueryExpression
+}
+
 type DisposeFunction struct {
 	*BaseExpr
 	Name Identifier
@@ -1329,6 +1645,12 @@ type ShowObjects struct {
 	Type Identifier
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze bool
+	Query   SelectQuery
+}
+
 type ShowFields struct {
 	*BaseExpr
 	Type  Identifier
@@ -1372,6 +1694,12 @@ type CaseElse struct {
 	Statements []Statement
 }
 
+type Try struct {
+	*BaseExpr
+	Statements      []Statement
+	CatchStatements []Statement
+}
+
 type While struct {
 	*BaseExpr
 	Condition  QueryExpression
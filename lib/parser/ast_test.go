@@ -92,6 +92,18 @@ func TestPrimitiveType_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
 	}
+
+	e = NewIntervalValueFromString("1 day")
+	expect = "INTERVAL '1 day'"
+	if e.String() != expect {
+		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
+	}
+
+	e = PrimitiveType{Value: value.NewInterval(0, 1, 0)}
+	expect = "INTERVAL 'P1D'"
+	if e.String() != expect {
+		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
+	}
 }
 
 func TestPrimitiveType_IsInteger(t *testing.T) {
@@ -403,6 +415,52 @@ func TestGroupByClause_String(t *testing.T) {
 	}
 }
 
+func TestRollup_String(t *testing.T) {
+	e := Rollup{
+		Rollup: "rollup",
+		Items: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "rollup (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestCube_String(t *testing.T) {
+	e := Cube{
+		Cube: "cube",
+		Items: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "cube (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestGroupingSets_String(t *testing.T) {
+	e := GroupingSets{
+		GroupingSets: "grouping sets",
+		Sets: []QueryExpression{
+			ValueList{Values: []QueryExpression{
+				Identifier{Literal: "column1"},
+				Identifier{Literal: "column2"},
+			}},
+			Identifier{Literal: "column1"},
+			ValueList{},
+		},
+	}
+	expect := "grouping sets ((column1, column2), column1, ())"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestHavingClause_String(t *testing.T) {
 	e := HavingClause{
 		Having: "having",
@@ -862,6 +920,31 @@ func TestLike_String(t *testing.T) {
 	}
 }
 
+func TestRegexp_IsNegated(t *testing.T) {
+	e := Regexp{}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = Regexp{Negation: Token{Token: NOT, Literal: "not"}}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestRegexp_String(t *testing.T) {
+	e := Regexp{
+		Regexp:   "regexp",
+		LHS:      Identifier{Literal: "column"},
+		Pattern:  NewStringValue("^pattern$"),
+		Negation: Token{Token: NOT, Literal: "not"},
+	}
+	expect := "column not regexp '^pattern$'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestExists_String(t *testing.T) {
 	e := Exists{
 		Exists: "exists",
@@ -983,6 +1066,24 @@ func TestAggregateFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AggregateFunction{
+		Name: "count",
+		Args: []QueryExpression{
+			AllColumns{},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where: WhereClause{
+				Where:  "where",
+				Filter: Identifier{Literal: "column"},
+			},
+		},
+	}
+	expect = "count(*) filter (where column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_IsDistinct(t *testing.T) {
@@ -1015,6 +1116,45 @@ func TestTable_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Table{
+		Lateral: Token{Token: LATERAL, Literal: "lateral"},
+		Object: TableFunction{
+			Name: "generate_series",
+			Args: []QueryExpression{NewIntegerValue(1), NewIntegerValue(3)},
+		},
+		Alias: Identifier{Literal: "alias"},
+	}
+	expect = "lateral generate_series(1, 3) alias"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestTable_IsLateral(t *testing.T) {
+	e := Table{Object: Identifier{Literal: "table"}}
+	if e.IsLateral() {
+		t.Errorf("lateral = %t, want %t for %#v", e.IsLateral(), false, e)
+	}
+
+	e = Table{Lateral: Token{Token: LATERAL, Literal: "lateral"}, Object: Identifier{Literal: "table"}}
+	if !e.IsLateral() {
+		t.Errorf("lateral = %t, want %t for %#v", e.IsLateral(), true, e)
+	}
+}
+
+func TestTableFunction_String(t *testing.T) {
+	e := TableFunction{
+		Name: "split_to_table",
+		Args: []QueryExpression{
+			FieldReference{Column: Identifier{Literal: "column1"}},
+			NewStringValue(";"),
+		},
+	}
+	expect := "split_to_table(column1, ';')"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestTable_Name(t *testing.T) {
@@ -1062,6 +1202,44 @@ func TestTable_Name(t *testing.T) {
 	}
 }
 
+func TestPivot_String(t *testing.T) {
+	e := Pivot{
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		Pivot:     "pivot",
+		Aggregate: AggregateFunction{Name: "sum", Args: []QueryExpression{FieldReference{Column: Identifier{Literal: "column1"}}}},
+		For:       "for",
+		Field:     FieldReference{Column: Identifier{Literal: "column2"}},
+		In:        "in",
+		Values: []QueryExpression{
+			Field{Object: NewStringValue("a"), As: "as", Alias: Identifier{Literal: "x"}},
+			Field{Object: NewStringValue("b")},
+		},
+	}
+	expect := "table1 pivot (sum(column1) for column2 in ('a' as x, 'b'))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnpivot_String(t *testing.T) {
+	e := Unpivot{
+		Table:       Table{Object: Identifier{Literal: "table1"}},
+		Unpivot:     "unpivot",
+		ValueColumn: Identifier{Literal: "value"},
+		For:         "for",
+		NameColumn:  Identifier{Literal: "name"},
+		In:          "in",
+		Fields: []QueryExpression{
+			Field{Object: FieldReference{Column: Identifier{Literal: "column1"}}},
+			Field{Object: FieldReference{Column: Identifier{Literal: "column2"}}},
+		},
+	}
+	expect := "table1 unpivot (value for name in (column1, column2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestJoin_String(t *testing.T) {
 	e := Join{
 		Join:      "join",
@@ -1168,7 +1346,7 @@ func TestField_Name(t *testing.T) {
 	}
 
 	e = Field{
-		Object: NewDatetimeValueFromString("2012-01-01 00:00:00 +00:00"),
+		Object: NewDatetimeValueFromString("2012-01-01 00:00:00 +00:00", nil, time.Local),
 	}
 	expect = "2012-01-01 00:00:00 +00:00"
 	if e.Name() != expect {
@@ -1344,6 +1522,24 @@ func TestListFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = ListFunction{
+		Name: "listagg",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where: WhereClause{
+				Where:  "where",
+				Filter: Identifier{Literal: "column2"},
+			},
+		},
+	}
+	expect = "listagg(column1) filter (where column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestListFunction_IsDistinct(t *testing.T) {
@@ -1388,6 +1584,78 @@ func TestAnalyticFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValue(0.5),
+		},
+		WithinGroup: "within group",
+		Over:        "over",
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				PartitionBy: "partition by",
+				Values: []QueryExpression{
+					Identifier{Literal: "column1"},
+				},
+			},
+			OrderByClause: OrderByClause{
+				OrderBy: "order by",
+				Items: []QueryExpression{
+					OrderItem{Value: Identifier{Literal: "column2"}},
+				},
+			},
+		},
+	}
+	expect = "percentile_cont(0.5) within group (order by column2) over (partition by column1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValue(0.5),
+		},
+		WithinGroup: "within group",
+		Over:        "over",
+	}
+	expect = "percentile_cont(0.5) within group () over ()"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Over:           "over",
+		AnalyticClause: AnalyticClause{WindowName: Identifier{Literal: "w"}},
+	}
+	expect = "sum(column1) over w"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Filter: FilterClause{
+			Filter: "filter",
+			Where: WhereClause{
+				Where:  "where",
+				Filter: Identifier{Literal: "column2"},
+			},
+		},
+		Over: "over",
+	}
+	expect = "sum(column1) filter (where column2) over ()"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAnalyticFunction_IsDistinct(t *testing.T) {
@@ -1418,7 +1686,7 @@ func TestAnalyticClause_String(t *testing.T) {
 			},
 		},
 		WindowingClause: WindowingClause{
-			Rows: "rows",
+			Unit: Token{Token: ROWS, Literal: "rows"},
 			FrameLow: WindowFramePosition{
 				Direction: CURRENT,
 				Literal:   "current row",
@@ -1472,7 +1740,7 @@ func TestPartition_String(t *testing.T) {
 
 func TestWindowingClause_String(t *testing.T) {
 	e := WindowingClause{
-		Rows: "rows",
+		Unit: Token{Token: ROWS, Literal: "rows"},
 		FrameLow: WindowFramePosition{
 			Direction: CURRENT,
 			Literal:   "current row",
@@ -1484,7 +1752,7 @@ func TestWindowingClause_String(t *testing.T) {
 	}
 
 	e = WindowingClause{
-		Rows: "rows",
+		Unit: Token{Token: ROWS, Literal: "rows"},
 		FrameLow: WindowFramePosition{
 			Direction: PRECEDING,
 			Offset:    1,
@@ -1504,6 +1772,35 @@ func TestWindowingClause_String(t *testing.T) {
 	}
 }
 
+func TestWindowClause_String(t *testing.T) {
+	e := WindowClause{
+		Window: "window",
+		Windows: []QueryExpression{
+			WindowDefinition{
+				Name: Identifier{Literal: "w1"},
+				As:   "as",
+				Clause: AnalyticClause{
+					PartitionClause: PartitionClause{
+						PartitionBy: "partition by",
+						Values: []QueryExpression{
+							Identifier{Literal: "column1"},
+						},
+					},
+				},
+			},
+			WindowDefinition{
+				Name:   Identifier{Literal: "w2"},
+				As:     "as",
+				Clause: AnalyticClause{},
+			},
+		},
+	}
+	expect := "window w1 as (partition by column1), w2 as ()"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestVariable_String(t *testing.T) {
 	e := Variable{
 		Name: "var",
@@ -1512,6 +1809,14 @@ func TestVariable_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Variable{
+		Name: NamedPlaceholderName("var"),
+	}
+	expect = ":var"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestVariableSubstitution_String(t *testing.T) {
@@ -1527,6 +1832,43 @@ func TestVariableSubstitution_String(t *testing.T) {
 	}
 }
 
+func TestColumnDefinition_String(t *testing.T) {
+	e := ColumnDefinition{
+		Column: Identifier{Literal: "column1"},
+		Type:   Identifier{Literal: "INTEGER"},
+	}
+	expect := "column1 INTEGER"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestTableConstraint_String(t *testing.T) {
+	e := TableConstraint{
+		Constraint: "CONSTRAINT",
+		Name:       Identifier{Literal: "pk"},
+		Type:       Token{Token: PRIMARY, Literal: "PRIMARY KEY"},
+		Columns:    []QueryExpression{Identifier{Literal: "column1"}, Identifier{Literal: "column2"}},
+	}
+	expect := "CONSTRAINT pk PRIMARY KEY (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = TableConstraint{
+		Type: Token{Token: CHECK, Literal: "CHECK"},
+		Check: Comparison{
+			LHS:      FieldReference{Column: Identifier{Literal: "column1"}},
+			Operator: ">",
+			RHS:      NewIntegerValueFromString("0"),
+		},
+	}
+	expect = "CHECK (column1 > 0)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestEnvironmentVariable_String(t *testing.T) {
 	e := EnvironmentVariable{
 		Name: "envvar",
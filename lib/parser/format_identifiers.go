@@ -5,11 +5,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 func FormatTableName(s string) string {
-	return strings.TrimSuffix(filepath.Base(s), filepath.Ext(s))
+	s, _ = cmd.SplitCompressionExt(filepath.Base(s))
+	return strings.TrimSuffix(s, filepath.Ext(s))
 }
 
 func FormatFieldIdentifier(e QueryExpression) string {
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -14,7 +15,7 @@ func TestFormatFieldIdentifier(t *testing.T) {
 		t.Errorf("field identifier = %q, want %q for %#v", result, expect, e)
 	}
 
-	e = NewDatetimeValueFromString("2006-01-02 15:04:05 -08:00")
+	e = NewDatetimeValueFromString("2006-01-02 15:04:05 -08:00", nil, time.Local)
 	expect = "2006-01-02T15:04:05-08:00"
 	result = FormatFieldIdentifier(e)
 	if result != expect {
@@ -42,3 +43,16 @@ func TestFormatFieldIdentifier(t *testing.T) {
 		t.Errorf("field identifier = %q, want %q for %#v", result, expect, e)
 	}
 }
+
+func TestFormatTableName(t *testing.T) {
+	for s, expect := range map[string]string{
+		"/path/to/table1.csv":     "table1",
+		"/path/to/table9.csv.bz2": "table9",
+		"table8.csv.gz":           "table8",
+		"table":                   "table",
+	} {
+		if result := FormatTableName(s); result != expect {
+			t.Errorf("table name = %q, want %q for %q", result, expect, s)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by goyacc -o parser.go -v parser.output parser.y. DO NOT EDIT.
+// Code generated by goyacc -o parser.go -v /tmp/new.output parser.y. DO NOT EDIT.
 
 //line parser.y:2
 package parser
@@ -9,11 +9,12 @@ import __yyfmt__ "fmt"
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-//line parser.y:11
+//line parser.y:12
 type yySymType struct {
 	yys         int
 	program     []Statement
@@ -38,6 +39,10 @@ type yySymType struct {
 	casewhen    []CaseWhen
 	caseelse    CaseElse
 	fetchpos    FetchPosition
+	procparam   ProcedureParameter
+	procparams  []ProcedureParameter
+	mergewhen   MergeWhen
+	mergewhens  []MergeWhen
 	token       Token
 }
 
@@ -48,143 +53,172 @@ const FLOAT = 57349
 const BOOLEAN = 57350
 const TERNARY = 57351
 const DATETIME = 57352
-const VARIABLE = 57353
-const FLAG = 57354
-const ENVIRONMENT_VARIABLE = 57355
-const RUNTIME_INFORMATION = 57356
-const EXTERNAL_COMMAND = 57357
-const SELECT = 57358
-const FROM = 57359
-const UPDATE = 57360
-const SET = 57361
-const UNSET = 57362
-const DELETE = 57363
-const WHERE = 57364
-const INSERT = 57365
-const INTO = 57366
-const VALUES = 57367
-const AS = 57368
-const DUAL = 57369
-const STDIN = 57370
-const RECURSIVE = 57371
-const CREATE = 57372
-const ADD = 57373
-const DROP = 57374
-const ALTER = 57375
-const TABLE = 57376
-const FIRST = 57377
-const LAST = 57378
-const AFTER = 57379
-const BEFORE = 57380
-const DEFAULT = 57381
-const RENAME = 57382
-const TO = 57383
-const VIEW = 57384
-const ORDER = 57385
-const GROUP = 57386
-const HAVING = 57387
-const BY = 57388
-const ASC = 57389
-const DESC = 57390
-const LIMIT = 57391
-const OFFSET = 57392
-const PERCENT = 57393
-const JOIN = 57394
-const INNER = 57395
-const OUTER = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const FULL = 57399
-const CROSS = 57400
-const ON = 57401
-const USING = 57402
-const NATURAL = 57403
-const UNION = 57404
-const INTERSECT = 57405
-const EXCEPT = 57406
-const ALL = 57407
-const ANY = 57408
-const EXISTS = 57409
-const IN = 57410
-const AND = 57411
-const OR = 57412
-const NOT = 57413
-const BETWEEN = 57414
-const LIKE = 57415
-const IS = 57416
-const NULL = 57417
-const DISTINCT = 57418
-const WITH = 57419
-const RANGE = 57420
-const UNBOUNDED = 57421
-const PRECEDING = 57422
-const FOLLOWING = 57423
-const CURRENT = 57424
-const ROW = 57425
-const CASE = 57426
-const IF = 57427
-const ELSEIF = 57428
-const WHILE = 57429
-const WHEN = 57430
-const THEN = 57431
-const ELSE = 57432
-const DO = 57433
-const END = 57434
-const DECLARE = 57435
-const CURSOR = 57436
-const FOR = 57437
-const FETCH = 57438
-const OPEN = 57439
-const CLOSE = 57440
-const DISPOSE = 57441
-const NEXT = 57442
-const PRIOR = 57443
-const ABSOLUTE = 57444
-const RELATIVE = 57445
-const SEPARATOR = 57446
-const PARTITION = 57447
-const OVER = 57448
-const COMMIT = 57449
-const ROLLBACK = 57450
-const CONTINUE = 57451
-const BREAK = 57452
-const EXIT = 57453
-const ECHO = 57454
-const PRINT = 57455
-const PRINTF = 57456
-const SOURCE = 57457
-const EXECUTE = 57458
-const CHDIR = 57459
-const PWD = 57460
-const RELOAD = 57461
-const REMOVE = 57462
-const SYNTAX = 57463
-const TRIGGER = 57464
-const FUNCTION = 57465
-const AGGREGATE = 57466
-const BEGIN = 57467
-const RETURN = 57468
-const IGNORE = 57469
-const WITHIN = 57470
-const VAR = 57471
-const SHOW = 57472
-const TIES = 57473
-const NULLS = 57474
-const ROWS = 57475
-const JSON_ROW = 57476
-const JSON_TABLE = 57477
-const COUNT = 57478
-const JSON_OBJECT = 57479
-const AGGREGATE_FUNCTION = 57480
-const LIST_FUNCTION = 57481
-const ANALYTIC_FUNCTION = 57482
-const FUNCTION_NTH = 57483
-const FUNCTION_WITH_INS = 57484
-const COMPARISON_OP = 57485
-const STRING_OP = 57486
-const SUBSTITUTION_OP = 57487
-const UMINUS = 57488
-const UPLUS = 57489
+const INTERVAL = 57353
+const VARIABLE = 57354
+const FLAG = 57355
+const ENVIRONMENT_VARIABLE = 57356
+const RUNTIME_INFORMATION = 57357
+const EXTERNAL_COMMAND = 57358
+const SELECT = 57359
+const FROM = 57360
+const UPDATE = 57361
+const SET = 57362
+const UNSET = 57363
+const DELETE = 57364
+const WHERE = 57365
+const INSERT = 57366
+const INTO = 57367
+const VALUES = 57368
+const AS = 57369
+const DUAL = 57370
+const STDIN = 57371
+const RECURSIVE = 57372
+const CREATE = 57373
+const ADD = 57374
+const DROP = 57375
+const ALTER = 57376
+const TABLE = 57377
+const FIRST = 57378
+const LAST = 57379
+const AFTER = 57380
+const BEFORE = 57381
+const DEFAULT = 57382
+const RENAME = 57383
+const TO = 57384
+const VIEW = 57385
+const INDEX = 57386
+const CONSTRAINT = 57387
+const PRIMARY = 57388
+const KEY = 57389
+const UNIQUE = 57390
+const CHECK = 57391
+const TRUNCATE = 57392
+const ORDER = 57393
+const GROUP = 57394
+const HAVING = 57395
+const BY = 57396
+const ASC = 57397
+const DESC = 57398
+const LIMIT = 57399
+const OFFSET = 57400
+const PERCENT = 57401
+const GROUPING = 57402
+const SETS = 57403
+const ROLLUP = 57404
+const CUBE = 57405
+const JOIN = 57406
+const INNER = 57407
+const OUTER = 57408
+const LEFT = 57409
+const RIGHT = 57410
+const FULL = 57411
+const CROSS = 57412
+const ON = 57413
+const USING = 57414
+const NATURAL = 57415
+const PIVOT = 57416
+const UNPIVOT = 57417
+const LATERAL = 57418
+const UNION = 57419
+const INTERSECT = 57420
+const EXCEPT = 57421
+const ALL = 57422
+const ANY = 57423
+const EXISTS = 57424
+const IN = 57425
+const AND = 57426
+const OR = 57427
+const NOT = 57428
+const BETWEEN = 57429
+const LIKE = 57430
+const REGEXP = 57431
+const IS = 57432
+const NULL = 57433
+const DISTINCT = 57434
+const WITH = 57435
+const RANGE = 57436
+const UNBOUNDED = 57437
+const PRECEDING = 57438
+const FOLLOWING = 57439
+const CURRENT = 57440
+const ROW = 57441
+const CASE = 57442
+const IF = 57443
+const ELSEIF = 57444
+const WHILE = 57445
+const WHEN = 57446
+const THEN = 57447
+const ELSE = 57448
+const DO = 57449
+const END = 57450
+const TRY = 57451
+const CATCH = 57452
+const DECLARE = 57453
+const CURSOR = 57454
+const FOR = 57455
+const FETCH = 57456
+const OPEN = 57457
+const CLOSE = 57458
+const DISPOSE = 57459
+const NEXT = 57460
+const PRIOR = 57461
+const ABSOLUTE = 57462
+const RELATIVE = 57463
+const SEPARATOR = 57464
+const PARTITION = 57465
+const OVER = 57466
+const COMMIT = 57467
+const ROLLBACK = 57468
+const CONTINUE = 57469
+const BREAK = 57470
+const EXIT = 57471
+const ECHO = 57472
+const PRINT = 57473
+const PRINTF = 57474
+const SOURCE = 57475
+const EXECUTE = 57476
+const CHDIR = 57477
+const PWD = 57478
+const RELOAD = 57479
+const REMOVE = 57480
+const SYNTAX = 57481
+const TRIGGER = 57482
+const FUNCTION = 57483
+const AGGREGATE = 57484
+const BEGIN = 57485
+const RETURN = 57486
+const PROCEDURE = 57487
+const CALL = 57488
+const OUT = 57489
+const MERGE = 57490
+const MATCHED = 57491
+const IGNORE = 57492
+const WITHIN = 57493
+const VAR = 57494
+const SHOW = 57495
+const EXPLAIN = 57496
+const ANALYZE = 57497
+const TIES = 57498
+const NULLS = 57499
+const ROWS = 57500
+const GROUPS = 57501
+const WINDOW = 57502
+const FILTER = 57503
+const JSON_ROW = 57504
+const JSON_TABLE = 57505
+const COUNT = 57506
+const JSON_OBJECT = 57507
+const AGGREGATE_FUNCTION = 57508
+const LIST_FUNCTION = 57509
+const ANALYTIC_FUNCTION = 57510
+const FUNCTION_NTH = 57511
+const FUNCTION_WITH_INS = 57512
+const TABLE_FUNCTION = 57513
+const COMPARISON_OP = 57514
+const STRING_OP = 57515
+const SUBSTITUTION_OP = 57516
+const UMINUS = 57517
+const UPLUS = 57518
 
 var yyToknames = [...]string{
 	"$end",
@@ -197,6 +231,7 @@ var yyToknames = [...]string{
 	"BOOLEAN",
 	"TERNARY",
 	"DATETIME",
+	"INTERVAL",
 	"VARIABLE",
 	"FLAG",
 	"ENVIRONMENT_VARIABLE",
@@ -229,6 +264,13 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"INDEX",
+	"CONSTRAINT",
+	"PRIMARY",
+	"KEY",
+	"UNIQUE",
+	"CHECK",
+	"TRUNCATE",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -238,6 +280,10 @@ var yyToknames = [...]string{
 	"LIMIT",
 	"OFFSET",
 	"PERCENT",
+	"GROUPING",
+	"SETS",
+	"ROLLUP",
+	"CUBE",
 	"JOIN",
 	"INNER",
 	"OUTER",
@@ -248,6 +294,9 @@ var yyToknames = [...]string{
 	"ON",
 	"USING",
 	"NATURAL",
+	"PIVOT",
+	"UNPIVOT",
+	"LATERAL",
 	"UNION",
 	"INTERSECT",
 	"EXCEPT",
@@ -260,6 +309,7 @@ var yyToknames = [...]string{
 	"NOT",
 	"BETWEEN",
 	"LIKE",
+	"REGEXP",
 	"IS",
 	"NULL",
 	"DISTINCT",
@@ -279,6 +329,8 @@ var yyToknames = [...]string{
 	"ELSE",
 	"DO",
 	"END",
+	"TRY",
+	"CATCH",
 	"DECLARE",
 	"CURSOR",
 	"FOR",
@@ -313,13 +365,23 @@ var yyToknames = [...]string{
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"PROCEDURE",
+	"CALL",
+	"OUT",
+	"MERGE",
+	"MATCHED",
 	"IGNORE",
 	"WITHIN",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
 	"TIES",
 	"NULLS",
 	"ROWS",
+	"GROUPS",
+	"WINDOW",
+	"FILTER",
 	"JSON_ROW",
 	"JSON_TABLE",
 	"COUNT",
@@ -329,6 +391,7 @@ var yyToknames = [...]string{
 	"ANALYTIC_FUNCTION",
 	"FUNCTION_NTH",
 	"FUNCTION_WITH_INS",
+	"TABLE_FUNCTION",
 	"COMPARISON_OP",
 	"STRING_OP",
 	"SUBSTITUTION_OP",
@@ -353,16 +416,24 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2313
+//line parser.y:2930
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
 	yyErrorVerbose = verbose
 }
 
-func Parse(s string, sourceFile string) ([]Statement, error) {
+func Parse(s string, sourceFile string, datetimeFormat []string, location *time.Location) ([]Statement, error) {
 	l := new(Lexer)
-	l.Init(s, sourceFile)
+	l.Init(s, sourceFile, datetimeFormat, location)
+	yyParse(l)
+	return l.program, l.err
+}
+
+func ParseWithPlaceholders(s string, sourceFile string, datetimeFormat []string, location *time.Location) ([]Statement, error) {
+	l := new(Lexer)
+	l.Init(s, sourceFile, datetimeFormat, location)
+	l.EnablePlaceholders()
 	yyParse(l)
 	return l.program, l.err
 }
@@ -371,1091 +442,1610 @@ func Parse(s string, sourceFile string) ([]Statement, error) {
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 186,
+	-2, 247,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 73,
-	86, 73,
-	88, 73,
-	90, 73,
-	92, 73,
-	148, 73,
-	-2, 216,
-	-1, 99,
-	16, 186,
-	18, 186,
-	21, 186,
-	23, 186,
+	-1, 25,
+	110, 1,
+	-2, 247,
+	-1, 37,
+	1, 81,
+	102, 81,
+	104, 81,
+	106, 81,
+	108, 81,
+	110, 81,
+	177, 81,
+	-2, 278,
+	-1, 130,
+	17, 247,
+	19, 247,
+	22, 247,
+	24, 247,
 	-2, 1,
-	-1, 117,
-	155, 273,
-	-2, 186,
-	-1, 123,
-	62, 166,
-	63, 166,
-	64, 166,
-	-2, 177,
-	-1, 162,
-	1, 146,
-	86, 146,
-	88, 146,
-	90, 146,
-	92, 146,
-	148, 146,
-	-2, 200,
-	-1, 167,
-	1, 154,
-	86, 154,
-	88, 154,
-	90, 154,
-	92, 154,
-	148, 154,
-	-2, 200,
-	-1, 208,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 243,
-	-1, 209,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 245,
-	-1, 218,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 255,
-	-1, 228,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
+	-1, 153,
+	184, 337,
+	-2, 247,
+	-1, 164,
+	77, 211,
+	78, 211,
+	79, 211,
+	-2, 238,
+	-1, 217,
+	1, 191,
+	102, 191,
+	104, 191,
+	106, 191,
+	108, 191,
+	110, 191,
+	177, 191,
+	-2, 262,
+	-1, 222,
+	1, 199,
+	102, 199,
+	104, 199,
+	106, 199,
+	108, 199,
+	110, 199,
+	177, 199,
+	-2, 262,
+	-1, 261,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 305,
+	-1, 262,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 307,
+	-1, 272,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 317,
+	-1, 273,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 319,
 	-1, 283,
-	92, 4,
-	-2, 186,
-	-1, 330,
-	68, 0,
-	72, 0,
-	73, 0,
-	74, 0,
-	143, 0,
-	150, 0,
-	-2, 256,
-	-1, 337,
-	92, 1,
-	-2, 186,
-	-1, 349,
-	52, 425,
-	-2, 357,
-	-1, 382,
-	1, 76,
-	86, 76,
-	88, 76,
-	90, 76,
-	92, 76,
-	148, 76,
-	-2, 200,
-	-1, 384,
-	1, 78,
-	86, 78,
-	88, 78,
-	90, 78,
-	92, 78,
-	148, 78,
-	-2, 200,
-	-1, 385,
-	1, 134,
-	86, 134,
-	88, 134,
-	90, 134,
-	92, 134,
-	148, 134,
-	-2, 200,
-	-1, 387,
-	1, 136,
-	86, 136,
-	88, 136,
-	90, 136,
-	92, 136,
-	148, 136,
-	-2, 200,
-	-1, 447,
-	92, 1,
-	-2, 186,
-	-1, 454,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 519,
-	86, 4,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 522,
-	92, 4,
-	-2, 186,
-	-1, 523,
-	92, 4,
-	-2, 186,
-	-1, 591,
-	16, 435,
-	77, 435,
-	154, 435,
-	-2, 82,
-	-1, 614,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 619,
-	92, 4,
-	-2, 186,
-	-1, 620,
-	92, 4,
-	-2, 186,
-	-1, 641,
-	86, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 676,
-	1, 90,
-	86, 90,
-	88, 90,
-	90, 90,
-	92, 90,
-	148, 90,
-	-2, 200,
-	-1, 679,
-	92, 6,
-	-2, 186,
-	-1, 690,
-	92, 4,
-	-2, 186,
-	-1, 746,
-	92, 6,
-	-2, 186,
-	-1, 747,
-	92, 6,
-	-2, 186,
-	-1, 751,
-	92, 4,
-	-2, 186,
-	-1, 755,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
+	102, 1,
+	106, 1,
+	108, 1,
+	-2, 247,
+	-1, 291,
+	108, 1,
+	-2, 247,
+	-1, 354,
+	108, 4,
+	-2, 247,
+	-1, 400,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 318,
+	-1, 401,
+	83, 0,
+	87, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	172, 0,
+	179, 0,
+	-2, 320,
+	-1, 408,
+	108, 1,
+	-2, 247,
+	-1, 420,
+	64, 553,
+	-2, 448,
+	-1, 467,
+	1, 84,
+	102, 84,
+	104, 84,
+	106, 84,
+	108, 84,
+	110, 84,
+	177, 84,
+	-2, 262,
+	-1, 469,
+	1, 86,
+	102, 86,
+	104, 86,
+	106, 86,
+	108, 86,
+	110, 86,
+	177, 86,
+	-2, 262,
+	-1, 470,
+	1, 179,
+	102, 179,
+	104, 179,
+	106, 179,
+	108, 179,
+	110, 179,
+	177, 179,
+	-2, 262,
+	-1, 472,
+	1, 181,
+	102, 181,
+	104, 181,
+	106, 181,
+	108, 181,
+	110, 181,
+	177, 181,
+	-2, 262,
+	-1, 491,
+	110, 4,
+	-2, 247,
+	-1, 534,
+	108, 1,
+	-2, 247,
+	-1, 541,
+	104, 1,
+	106, 1,
+	108, 1,
+	-2, 247,
+	-1, 638,
+	17, 247,
+	19, 247,
+	22, 247,
+	24, 247,
+	-2, 4,
+	-1, 642,
+	108, 4,
+	-2, 247,
+	-1, 643,
+	108, 4,
+	-2, 247,
+	-1, 723,
+	17, 563,
+	93, 563,
+	183, 563,
+	-2, 90,
+	-1, 769,
+	102, 4,
+	106, 4,
+	108, 4,
+	-2, 247,
+	-1, 772,
+	108, 4,
+	-2, 247,
 	-1, 775,
-	88, 1,
-	90, 1,
-	92, 1,
-	-2, 186,
-	-1, 788,
-	86, 6,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 828,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 831,
-	92, 8,
-	-2, 186,
-	-1, 836,
-	92, 6,
-	-2, 186,
-	-1, 839,
-	86, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 862,
-	92, 6,
-	-2, 186,
-	-1, 890,
-	92, 6,
-	-2, 186,
-	-1, 894,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 896,
-	86, 8,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 899,
-	92, 8,
-	-2, 186,
-	-1, 900,
-	92, 8,
-	-2, 186,
-	-1, 903,
-	88, 4,
-	90, 4,
-	92, 4,
-	-2, 186,
-	-1, 915,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 924,
-	86, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 929,
-	92, 8,
-	-2, 186,
-	-1, 943,
-	92, 8,
-	-2, 186,
-	-1, 947,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 959,
-	88, 6,
-	90, 6,
-	92, 6,
-	-2, 186,
-	-1, 973,
-	86, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
-	-1, 984,
-	88, 8,
-	90, 8,
-	92, 8,
-	-2, 186,
+	108, 4,
+	-2, 247,
+	-1, 776,
+	108, 4,
+	-2, 247,
+	-1, 802,
+	102, 1,
+	106, 1,
+	108, 1,
+	-2, 247,
+	-1, 873,
+	1, 101,
+	102, 101,
+	104, 101,
+	106, 101,
+	108, 101,
+	110, 101,
+	177, 101,
+	-2, 262,
+	-1, 876,
+	108, 6,
+	-2, 247,
+	-1, 885,
+	108, 6,
+	-2, 247,
+	-1, 891,
+	108, 4,
+	-2, 247,
+	-1, 966,
+	110, 6,
+	-2, 247,
+	-1, 971,
+	108, 6,
+	-2, 247,
+	-1, 972,
+	108, 6,
+	-2, 247,
+	-1, 975,
+	108, 6,
+	-2, 247,
+	-1, 978,
+	108, 4,
+	-2, 247,
+	-1, 982,
+	104, 4,
+	106, 4,
+	108, 4,
+	-2, 247,
+	-1, 1007,
+	104, 1,
+	106, 1,
+	108, 1,
+	-2, 247,
+	-1, 1036,
+	17, 247,
+	19, 247,
+	22, 247,
+	24, 247,
+	-2, 6,
+	-1, 1094,
+	102, 6,
+	106, 6,
+	108, 6,
+	-2, 247,
+	-1, 1097,
+	108, 6,
+	-2, 247,
+	-1, 1098,
+	108, 8,
+	-2, 247,
+	-1, 1103,
+	108, 6,
+	-2, 247,
+	-1, 1107,
+	102, 4,
+	106, 4,
+	108, 4,
+	-2, 247,
+	-1, 1140,
+	108, 6,
+	-2, 247,
+	-1, 1149,
+	110, 8,
+	-2, 247,
+	-1, 1174,
+	108, 6,
+	-2, 247,
+	-1, 1178,
+	104, 6,
+	106, 6,
+	108, 6,
+	-2, 247,
+	-1, 1181,
+	17, 247,
+	19, 247,
+	22, 247,
+	24, 247,
+	-2, 8,
+	-1, 1185,
+	108, 8,
+	-2, 247,
+	-1, 1186,
+	108, 8,
+	-2, 247,
+	-1, 1189,
+	104, 4,
+	106, 4,
+	108, 4,
+	-2, 247,
+	-1, 1210,
+	102, 8,
+	106, 8,
+	108, 8,
+	-2, 247,
+	-1, 1213,
+	108, 8,
+	-2, 247,
+	-1, 1231,
+	102, 6,
+	106, 6,
+	108, 6,
+	-2, 247,
+	-1, 1236,
+	108, 8,
+	-2, 247,
+	-1, 1258,
+	108, 8,
+	-2, 247,
+	-1, 1262,
+	104, 8,
+	106, 8,
+	108, 8,
+	-2, 247,
+	-1, 1283,
+	104, 6,
+	106, 6,
+	108, 6,
+	-2, 247,
+	-1, 1305,
+	102, 8,
+	106, 8,
+	108, 8,
+	-2, 247,
+	-1, 1319,
+	104, 8,
+	106, 8,
+	108, 8,
+	-2, 247,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3689
+const yyLast = 7001
 
 var yyAct = [...]int{
 
-	18, 942, 952, 941, 916, 304, 743, 889, 888, 121,
-	750, 829, 615, 458, 809, 178, 543, 295, 808, 118,
-	29, 116, 122, 496, 405, 23, 912, 844, 404, 22,
-	24, 349, 230, 749, 446, 598, 721, 234, 803, 155,
-	156, 593, 159, 160, 161, 163, 164, 166, 168, 83,
-	568, 510, 513, 807, 576, 742, 368, 468, 558, 1,
-	512, 359, 560, 233, 165, 965, 172, 176, 302, 476,
-	475, 245, 299, 348, 445, 599, 197, 345, 190, 191,
-	183, 362, 350, 173, 187, 128, 201, 202, 76, 406,
-	188, 239, 493, 134, 434, 187, 175, 400, 3, 74,
-	832, 207, 208, 209, 785, 211, 413, 786, 218, 61,
-	221, 222, 223, 224, 225, 226, 227, 123, 172, 29,
-	423, 122, 137, 189, 23, 187, 188, 782, 22, 610,
-	232, 187, 611, 672, 651, 229, 634, 136, 136, 480,
-	139, 481, 482, 477, 474, 111, 51, 478, 175, 284,
-	608, 236, 112, 113, 268, 269, 215, 607, 206, 188,
-	662, 100, 175, 663, 187, 592, 111, 572, 110, 109,
-	563, 277, 279, 112, 113, 87, 177, 171, 285, 480,
-	210, 481, 482, 477, 474, 421, 347, 478, 289, 166,
-	285, 254, 906, 303, 905, 288, 285, 3, 885, 884,
-	129, 68, 125, 240, 240, 126, 324, 124, 463, 883,
-	882, 253, 881, 328, 859, 330, 858, 166, 244, 857,
-	111, 293, 110, 109, 91, 855, 171, 112, 113, 853,
-	852, 91, 166, 843, 173, 842, 340, 784, 748, 285,
-	703, 129, 98, 702, 479, 315, 316, 175, 29, 701,
-	700, 303, 699, 23, 696, 70, 375, 22, 98, 674,
-	68, 91, 216, 329, 381, 383, 386, 388, 123, 331,
-	332, 671, 650, 633, 166, 166, 166, 166, 216, 397,
-	631, 583, 630, 629, 353, 242, 623, 333, 622, 287,
-	606, 393, 394, 395, 396, 166, 604, 591, 548, 326,
-	541, 410, 540, 29, 539, 528, 250, 420, 418, 91,
-	325, 334, 281, 282, 166, 166, 416, 856, 266, 509,
-	361, 344, 398, 366, 166, 437, 3, 419, 443, 854,
-	364, 365, 353, 242, 68, 378, 449, 464, 131, 815,
-	453, 369, 814, 457, 461, 435, 430, 431, 374, 462,
-	813, 92, 93, 94, 812, 811, 441, 29, 92, 93,
-	94, 491, 23, 778, 136, 773, 22, 433, 770, 768,
-	415, 767, 761, 760, 500, 175, 545, 526, 487, 131,
-	486, 503, 429, 432, 428, 175, 485, 427, 92, 93,
-	94, 426, 356, 425, 424, 411, 451, 380, 440, 175,
-	379, 507, 231, 520, 122, 517, 205, 175, 204, 175,
-	473, 354, 131, 521, 438, 439, 194, 193, 192, 199,
-	264, 573, 303, 896, 166, 240, 788, 265, 166, 166,
-	166, 519, 488, 99, 255, 3, 92, 93, 94, 472,
-	356, 527, 171, 549, 417, 550, 499, 921, 492, 554,
-	494, 495, 321, 91, 771, 557, 531, 559, 91, 354,
-	536, 537, 538, 377, 769, 649, 175, 29, 647, 367,
-	91, 243, 23, 637, 29, 766, 22, 70, 836, 23,
-	567, 707, 242, 22, 747, 544, 91, 584, 586, 515,
-	746, 195, 484, 470, 529, 294, 679, 637, 196, 411,
-	313, 314, 708, 705, 821, 819, 553, 765, 764, 91,
-	242, 323, 763, 544, 322, 91, 762, 297, 502, 504,
-	704, 552, 698, 810, 706, 376, 972, 960, 91, 263,
-	292, 467, 578, 166, 166, 166, 166, 257, 945, 29,
-	571, 547, 29, 29, 91, 3, 635, 91, 580, 579,
-	587, 87, 3, 581, 601, 157, 642, 175, 613, 91,
-	932, 617, 618, 931, 461, 624, 625, 626, 628, 462,
-	546, 87, 923, 907, 654, 901, 895, 648, 892, 838,
-	92, 93, 94, 835, 834, 92, 93, 94, 798, 256,
-	665, 166, 632, 787, 759, 643, 758, 92, 93, 94,
-	753, 673, 141, 627, 677, 693, 655, 656, 666, 692,
-	685, 569, 640, 92, 93, 94, 668, 691, 258, 259,
-	551, 518, 644, 452, 646, 450, 900, 151, 152, 899,
-	653, 949, 620, 652, 29, 660, 92, 93, 94, 29,
-	29, 619, 92, 93, 94, 944, 714, 523, 667, 943,
-	569, 709, 522, 688, 140, 92, 93, 94, 694, 695,
-	681, 29, 729, 687, 166, 943, 23, 929, 682, 683,
-	22, 92, 93, 94, 92, 93, 94, 890, 862, 751,
-	643, 175, 975, 142, 544, 690, 92, 93, 94, 724,
-	725, 726, 149, 150, 153, 154, 730, 891, 175, 29,
-	713, 890, 720, 752, 447, 339, 736, 751, 734, 175,
-	29, 772, 448, 733, 5, 337, 447, 515, 684, 470,
-	926, 515, 917, 777, 841, 830, 645, 616, 335, 754,
-	532, 533, 534, 535, 235, 776, 948, 913, 805, 3,
-	804, 789, 122, 669, 670, 791, 794, 757, 774, 756,
-	612, 790, 944, 801, 891, 752, 557, 448, 979, 781,
-	793, 779, 971, 938, 922, 876, 29, 29, 544, 837,
-	712, 29, 639, 795, 796, 29, 953, 738, 964, 911,
-	174, 825, 817, 802, 799, 817, 816, 166, 556, 820,
-	800, 175, 108, 970, 957, 29, 968, 969, 569, 982,
-	23, 823, 824, 967, 22, 956, 955, 636, 29, 718,
-	68, 562, 251, 199, 966, 827, 840, 95, 936, 826,
-	213, 833, 818, 953, 212, 214, 542, 414, 286, 318,
-	817, 863, 174, 317, 851, 248, 320, 319, 871, 220,
-	219, 363, 878, 577, 738, 738, 174, 166, 29, 977,
-	727, 29, 954, 659, 792, 860, 29, 658, 657, 29,
-	342, 68, 575, 875, 880, 847, 848, 849, 850, 897,
-	122, 817, 574, 3, 456, 887, 198, 879, 877, 898,
-	461, 846, 29, 96, 934, 462, 738, 870, 902, 893,
-	590, 935, 910, 904, 937, 557, 951, 864, 908, 954,
-	247, 248, 249, 871, 565, 566, 871, 871, 886, 480,
-	29, 481, 482, 343, 29, 589, 29, 909, 930, 29,
-	29, 872, 871, 29, 925, 711, 738, 940, 490, 866,
-	237, 174, 845, 603, 738, 29, 871, 594, 595, 596,
-	597, 602, 609, 600, 29, 963, 961, 958, 557, 29,
-	871, 939, 870, 133, 871, 870, 870, 716, 717, 132,
-	738, 186, 914, 29, 797, 918, 919, 29, 62, 978,
-	974, 870, 697, 69, 686, 680, 981, 678, 369, 29,
-	871, 927, 983, 605, 422, 870, 872, 389, 738, 872,
-	872, 871, 738, 29, 866, 946, 238, 866, 866, 870,
-	143, 145, 138, 870, 29, 872, 360, 146, 147, 962,
-	246, 346, 358, 866, 158, 272, 144, 88, 162, 872,
-	88, 167, 738, 169, 170, 391, 390, 866, 87, 870,
-	182, 185, 63, 872, 135, 928, 861, 872, 689, 980,
-	870, 866, 336, 8, 480, 866, 481, 482, 477, 474,
-	780, 55, 478, 469, 373, 7, 6, 738, 338, 465,
-	58, 300, 301, 872, 203, 352, 370, 371, 351, 174,
-	976, 866, 950, 933, 872, 372, 130, 920, 82, 57,
-	56, 60, 866, 498, 53, 59, 54, 715, 564, 460,
-	459, 506, 52, 508, 184, 455, 341, 588, 489, 241,
-	241, 127, 17, 16, 64, 148, 252, 241, 14, 514,
-	511, 13, 12, 9, 260, 261, 262, 15, 11, 10,
-	867, 739, 267, 865, 737, 401, 106, 115, 114, 105,
-	104, 107, 103, 399, 4, 480, 200, 481, 482, 477,
-	474, 722, 723, 478, 179, 2, 0, 0, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 217, 290,
-	0, 291, 0, 296, 0, 0, 306, 0, 0, 0,
-	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	106, 115, 114, 105, 104, 107, 103, 0, 0, 0,
+	21, 1257, 1211, 554, 1269, 690, 1069, 1173, 1095, 1172,
+	1256, 977, 1084, 161, 367, 770, 1067, 292, 944, 976,
+	372, 546, 937, 533, 152, 162, 834, 593, 745, 646,
+	60, 923, 493, 27, 740, 631, 232, 732, 93, 664,
+	623, 289, 433, 626, 340, 625, 599, 449, 210, 211,
+	726, 214, 215, 216, 218, 219, 221, 223, 27, 595,
+	492, 26, 682, 697, 562, 679, 1, 288, 370, 1206,
+	66, 101, 419, 561, 422, 227, 230, 301, 532, 421,
+	598, 309, 746, 295, 248, 174, 26, 240, 241, 521,
+	436, 163, 183, 1099, 85, 82, 252, 253, 355, 1019,
+	220, 1054, 1020, 568, 169, 569, 570, 563, 560, 968,
+	238, 564, 565, 566, 237, 237, 238, 828, 501, 228,
+	829, 237, 568, 997, 569, 570, 563, 560, 508, 187,
+	564, 565, 566, 237, 238, 1016, 260, 261, 262, 237,
+	264, 239, 70, 272, 273, 1130, 276, 277, 278, 279,
+	280, 281, 282, 135, 227, 1021, 940, 869, 147, 162,
+	146, 145, 812, 27, 794, 148, 149, 147, 760, 146,
+	145, 761, 287, 764, 148, 149, 1308, 758, 186, 186,
+	269, 191, 757, 724, 226, 147, 722, 693, 420, 226,
+	685, 26, 148, 149, 97, 356, 257, 356, 284, 506,
+	418, 320, 356, 311, 134, 97, 1302, 336, 337, 108,
+	221, 967, 170, 551, 166, 1251, 1250, 167, 231, 165,
+	259, 1224, 356, 263, 1223, 567, 348, 350, 1222, 1198,
+	270, 943, 1289, 1193, 1192, 1190, 1165, 1129, 1127, 221,
+	1124, 709, 1123, 371, 221, 1117, 1092, 1083, 296, 296,
+	1082, 300, 1034, 308, 1033, 1018, 973, 394, 956, 953,
+	905, 904, 315, 316, 318, 903, 398, 902, 400, 401,
+	359, 221, 64, 108, 901, 900, 871, 868, 962, 3,
+	134, 363, 842, 827, 811, 385, 386, 221, 793, 788,
+	494, 411, 787, 786, 270, 170, 779, 763, 756, 754,
+	723, 171, 721, 669, 3, 399, 662, 371, 661, 660,
+	648, 636, 618, 402, 403, 228, 27, 524, 516, 505,
+	221, 440, 459, 503, 27, 476, 462, 450, 405, 634,
+	446, 352, 466, 468, 471, 473, 353, 97, 522, 221,
+	1266, 1126, 1125, 1116, 26, 221, 221, 221, 221, 404,
+	484, 1081, 26, 243, 1026, 1010, 1005, 412, 396, 395,
+	737, 736, 855, 948, 221, 250, 630, 251, 942, 941,
+	552, 931, 1252, 864, 416, 435, 358, 622, 172, 861,
+	777, 442, 739, 580, 221, 221, 1199, 703, 702, 480,
+	481, 482, 483, 498, 221, 438, 439, 445, 530, 666,
+	592, 579, 578, 515, 514, 513, 171, 536, 512, 3,
+	511, 540, 510, 458, 271, 545, 549, 509, 465, 464,
+	463, 184, 338, 209, 286, 256, 520, 255, 172, 245,
+	244, 243, 242, 694, 334, 584, 550, 1181, 1036, 332,
+	638, 27, 130, 321, 226, 996, 647, 798, 556, 391,
+	832, 246, 306, 519, 1003, 647, 485, 647, 247, 1001,
+	808, 172, 559, 186, 1220, 810, 319, 909, 1029, 26,
+	907, 1090, 134, 1103, 538, 97, 527, 310, 975, 525,
+	526, 972, 461, 448, 612, 614, 447, 323, 971, 620,
+	639, 162, 885, 910, 571, 876, 908, 575, 573, 499,
+	296, 1241, 558, 1070, 640, 581, 1213, 371, 184, 221,
+	1072, 635, 1071, 1065, 221, 221, 221, 1064, 97, 271,
+	271, 649, 1063, 392, 591, 586, 383, 384, 1219, 1221,
+	670, 1028, 671, 609, 132, 607, 675, 393, 617, 271,
+	1062, 585, 678, 587, 588, 681, 1061, 271, 271, 193,
+	1060, 989, 204, 205, 164, 906, 322, 1097, 932, 930,
+	668, 665, 3, 460, 772, 291, 1290, 27, 1207, 1055,
+	3, 333, 428, 680, 27, 428, 331, 1304, 1284, 710,
+	221, 713, 1263, 691, 1260, 324, 325, 1240, 1239, 326,
+	665, 689, 667, 641, 1230, 26, 1201, 1187, 1180, 1179,
+	674, 1176, 26, 1106, 1104, 1102, 628, 1101, 633, 1049,
+	634, 1047, 673, 1035, 1258, 987, 577, 499, 192, 749,
+	986, 983, 980, 895, 714, 894, 801, 699, 672, 701,
+	589, 637, 692, 487, 202, 203, 206, 207, 700, 542,
+	704, 539, 537, 1186, 691, 706, 1259, 194, 753, 1185,
+	1258, 195, 776, 221, 221, 221, 221, 221, 775, 164,
+	271, 523, 523, 523, 1175, 979, 643, 795, 1174, 978,
+	535, 642, 1236, 1174, 534, 1140, 978, 803, 778, 891,
+	1137, 534, 1089, 410, 408, 1307, 549, 3, 1233, 1212,
+	789, 790, 791, 1109, 1096, 815, 428, 814, 946, 797,
+	428, 1136, 792, 1088, 806, 428, 550, 809, 771, 171,
+	406, 171, 171, 290, 428, 1265, 1264, 833, 836, 840,
+	804, 1208, 1057, 783, 556, 1056, 985, 1245, 1246, 984,
+	154, 37, 1247, 767, 1259, 813, 1270, 1271, 863, 1175,
+	768, 1272, 979, 535, 773, 774, 807, 805, 870, 1313,
+	844, 1303, 1253, 874, 1229, 1156, 37, 1270, 1271, 1105,
+	882, 914, 1272, 800, 862, 821, 888, 865, 1288, 1205,
+	487, 845, 892, 822, 866, 867, 847, 766, 852, 1053,
+	677, 857, 1300, 1278, 846, 1298, 1299, 1296, 1297, 1316,
+	652, 653, 654, 655, 656, 271, 1294, 1295, 1293, 1277,
+	887, 878, 884, 879, 880, 1276, 1275, 916, 1243, 899,
+	1274, 796, 144, 3, 105, 134, 1244, 684, 922, 1248,
+	3, 920, 77, 307, 271, 1310, 856, 934, 1273, 738,
+	221, 250, 266, 665, 911, 27, 265, 267, 268, 1292,
+	428, 663, 1100, 691, 388, 804, 1268, 1078, 387, 1273,
+	849, 1077, 850, 851, 848, 849, 951, 850, 851, 188,
+	502, 37, 357, 26, 199, 200, 456, 208, 915, 390,
+	389, 889, 213, 437, 893, 304, 217, 896, 897, 222,
+	719, 224, 225, 441, 816, 817, 275, 274, 134, 952,
+	733, 950, 955, 451, 698, 733, 106, 929, 958, 988,
+	628, 881, 820, 957, 628, 819, 249, 633, 841, 858,
+	818, 859, 860, 1004, 303, 304, 305, 487, 696, 695,
+	544, 487, 487, 254, 568, 1009, 569, 570, 687, 688,
+	990, 991, 992, 993, 994, 995, 271, 414, 1160, 1006,
+	1120, 836, 221, 221, 717, 415, 960, 716, 1030, 604,
+	28, 1066, 913, 583, 293, 974, 1119, 133, 1011, 665,
+	1008, 1014, 735, 854, 1027, 1037, 162, 1023, 428, 428,
+	1040, 1043, 178, 752, 741, 742, 743, 744, 750, 1038,
+	1052, 179, 759, 678, 765, 747, 1024, 1025, 71, 297,
+	297, 182, 428, 981, 918, 919, 297, 1050, 455, 312,
+	181, 313, 314, 297, 297, 297, 1042, 926, 927, 928,
+	452, 453, 180, 1076, 37, 327, 328, 329, 330, 454,
+	177, 221, 37, 1074, 335, 133, 1046, 1086, 196, 198,
+	1022, 1000, 886, 1002, 883, 877, 1039, 875, 1075, 450,
+	27, 1044, 1045, 843, 1080, 1048, 762, 755, 487, 1091,
+	507, 487, 1312, 1279, 487, 487, 474, 444, 294, 173,
+	360, 1228, 364, 1170, 898, 374, 434, 271, 26, 568,
+	1108, 569, 570, 563, 560, 924, 925, 564, 565, 566,
+	1051, 3, 1225, 133, 1168, 37, 1196, 1118, 1132, 1197,
+	1135, 428, 428, 428, 417, 98, 1133, 1141, 1128, 1134,
+	302, 432, 343, 478, 133, 477, 1093, 197, 98, 97,
+	1158, 236, 1041, 1059, 176, 72, 185, 297, 1013, 1235,
+	1139, 221, 431, 133, 890, 431, 371, 371, 407, 374,
+	945, 1086, 228, 10, 1159, 9, 555, 8, 1171, 37,
+	1163, 1164, 7, 1166, 727, 596, 409, 67, 1182, 162,
+	368, 369, 425, 1162, 467, 469, 470, 472, 424, 1309,
+	1267, 549, 1183, 1242, 1138, 1161, 479, 1142, 221, 1188,
+	487, 938, 1195, 1155, 831, 1218, 1204, 1217, 497, 678,
+	500, 550, 1191, 1202, 1110, 1111, 1112, 1113, 1114, 1115,
+	1068, 92, 65, 271, 69, 1121, 1122, 62, 68, 556,
+	63, 917, 428, 686, 548, 1227, 547, 61, 1151, 1157,
+	1177, 175, 1194, 1237, 543, 1232, 413, 715, 1085, 835,
+	1216, 133, 37, 582, 168, 20, 19, 73, 201, 1249,
+	691, 17, 632, 16, 1255, 627, 624, 1226, 374, 15,
+	557, 297, 14, 11, 1203, 18, 574, 13, 12, 1146,
+	431, 963, 1144, 961, 488, 431, 556, 487, 1281, 1151,
+	1287, 487, 1282, 678, 431, 37, 590, 297, 1285, 486,
+	594, 597, 37, 1291, 606, 610, 557, 557, 616, 297,
+	4, 233, 2, 691, 594, 0, 3, 629, 0, 0,
+	1280, 1151, 1306, 0, 0, 1151, 1151, 1311, 0, 0,
+	0, 1254, 0, 0, 0, 0, 0, 0, 1315, 0,
+	1150, 0, 0, 0, 0, 0, 1318, 0, 1301, 0,
+	1151, 0, 0, 1151, 644, 645, 5, 0, 0, 374,
+	650, 1143, 568, 131, 569, 570, 563, 560, 1012, 0,
+	564, 565, 566, 0, 0, 0, 1151, 0, 0, 0,
+	285, 1317, 0, 0, 568, 0, 569, 570, 563, 560,
+	947, 1150, 564, 565, 566, 0, 0, 133, 1151, 37,
+	0, 0, 1151, 37, 37, 557, 0, 1145, 133, 0,
+	0, 0, 1184, 0, 0, 0, 487, 0, 0, 1152,
+	431, 0, 0, 1150, 0, 705, 0, 1150, 1150, 708,
+	133, 229, 0, 712, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 133, 1209, 1151, 0, 0, 1214, 1215,
+	725, 0, 1150, 734, 0, 1150, 0, 0, 1145, 1151,
+	0, 610, 0, 0, 748, 0, 557, 0, 751, 0,
+	1152, 0, 0, 1234, 0, 0, 1238, 568, 1150, 569,
+	570, 563, 560, 718, 0, 564, 565, 566, 0, 258,
+	1145, 0, 0, 0, 1145, 1145, 0, 0, 487, 1261,
+	1150, 271, 1152, 133, 1150, 0, 1152, 1152, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 1145,
+	0, 1286, 1145, 0, 0, 0, 0, 0, 0, 229,
+	37, 1152, 0, 37, 1152, 0, 37, 37, 374, 0,
+	0, 0, 0, 0, 0, 1145, 557, 1150, 431, 431,
+	0, 0, 0, 0, 271, 823, 824, 1152, 0, 0,
+	825, 1150, 0, 37, 0, 0, 0, 1145, 1314, 0,
+	0, 1145, 431, 133, 0, 594, 0, 597, 0, 1152,
+	0, 853, 271, 1152, 0, 0, 0, 0, 0, 594,
+	339, 0, 594, 0, 0, 0, 557, 557, 0, 0,
+	0, 0, 0, 872, 0, 873, 0, 0, 0, 0,
+	0, 0, 0, 0, 1145, 271, 0, 0, 0, 362,
+	0, 0, 0, 0, 382, 0, 1152, 229, 1145, 0,
+	0, 0, 0, 0, 0, 0, 0, 37, 0, 0,
+	1152, 0, 0, 0, 0, 0, 37, 0, 0, 0,
+	0, 0, 37, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 557, 0, 0, 0, 0,
+	0, 431, 431, 431, 0, 0, 0, 0, 0, 933,
+	0, 0, 0, 936, 0, 939, 0, 0, 0, 0,
+	0, 141, 151, 150, 140, 139, 142, 143, 138, 0,
+	457, 853, 0, 0, 0, 0, 141, 151, 150, 140,
+	139, 142, 143, 138, 594, 1098, 0, 594, 0, 475,
+	0, 0, 610, 0, 0, 0, 0, 37, 0, 0,
+	0, 0, 37, 37, 0, 0, 37, 0, 0, 37,
+	0, 0, 0, 37, 504, 0, 0, 0, 141, 151,
+	150, 140, 139, 142, 143, 138, 0, 0, 0, 999,
+	999, 0, 999, 0, 517, 518, 0, 0, 37, 0,
+	0, 0, 0, 553, 528, 0, 0, 0, 0, 0,
+	136, 135, 431, 557, 229, 1015, 147, 137, 146, 145,
+	0, 0, 133, 148, 149, 136, 135, 37, 0, 0,
+	0, 147, 137, 146, 145, 0, 605, 351, 148, 149,
+	1167, 0, 0, 0, 0, 0, 0, 619, 0, 621,
+	0, 0, 0, 0, 0, 0, 133, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 136, 135, 0,
+	0, 0, 999, 147, 137, 146, 145, 0, 0, 351,
+	148, 149, 347, 0, 0, 37, 133, 0, 37, 37,
+	0, 0, 0, 594, 37, 0, 141, 151, 37, 140,
+	139, 142, 143, 138, 939, 0, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 651,
+	0, 0, 0, 0, 657, 658, 659, 0, 0, 0,
+	0, 37, 0, 0, 0, 0, 0, 0, 0, 0,
+	37, 0, 0, 999, 999, 999, 999, 999, 999, 0,
+	0, 0, 0, 0, 999, 999, 0, 0, 0, 133,
+	0, 0, 0, 0, 0, 37, 0, 0, 0, 37,
+	0, 0, 37, 0, 0, 0, 37, 37, 0, 720,
+	37, 0, 1153, 1154, 0, 136, 135, 0, 0, 0,
+	711, 147, 137, 146, 145, 0, 0, 0, 148, 149,
+	0, 37, 0, 0, 37, 0, 0, 0, 374, 374,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 37, 0, 0, 0, 0, 37, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 0, 0, 37,
+	0, 557, 0, 37, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 780, 781, 782, 784, 785, 0, 0,
+	0, 0, 0, 0, 37, 345, 0, 0, 0, 0,
+	0, 0, 557, 0, 0, 0, 141, 151, 150, 140,
+	139, 142, 143, 138, 0, 0, 37, 0, 133, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 557, 0,
+	37, 0, 0, 0, 0, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 22, 0, 0,
+	0, 39, 40, 0, 0, 557, 0, 0, 0, 0,
+	78, 0, 31, 47, 33, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 34, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 120, 121, 122, 136, 135, 0, 0, 0,
+	0, 147, 137, 146, 145, 102, 103, 123, 148, 149,
+	344, 0, 0, 94, 0, 0, 0, 95, 921, 0,
+	124, 0, 106, 0, 30, 0, 0, 0, 0, 0,
+	0, 1148, 1147, 0, 969, 0, 0, 0, 0, 0,
+	1149, 0, 36, 100, 0, 43, 41, 42, 38, 0,
+	0, 0, 949, 0, 0, 0, 45, 46, 495, 496,
+	935, 50, 51, 52, 53, 54, 56, 57, 58, 48,
+	55, 59, 0, 0, 0, 970, 125, 44, 126, 29,
+	127, 0, 959, 35, 49, 6, 128, 110, 111, 112,
+	113, 104, 129, 108, 0, 91, 88, 90, 107, 0,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	0, 86, 87, 96, 74, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 22, 0, 0,
+	0, 39, 40, 0, 0, 1031, 0, 0, 0, 0,
+	78, 0, 31, 47, 33, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 34, 0, 0, 0, 0, 0, 0, 0, 136,
+	135, 89, 120, 121, 122, 147, 137, 146, 145, 0,
+	0, 0, 148, 149, 912, 102, 103, 123, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	124, 0, 106, 0, 30, 0, 0, 0, 0, 0,
+	0, 490, 489, 0, 75, 0, 0, 0, 0, 0,
+	491, 0, 36, 100, 0, 43, 41, 42, 38, 0,
+	0, 1079, 0, 0, 0, 0, 45, 46, 495, 496,
+	76, 50, 51, 52, 53, 54, 56, 57, 58, 48,
+	55, 59, 0, 0, 0, 0, 125, 44, 126, 29,
+	127, 0, 0, 35, 49, 6, 128, 110, 111, 112,
+	113, 104, 129, 108, 229, 91, 88, 90, 107, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 241, 280, 112, 113, 276, 357, 0, 0,
-	357, 0, 0, 0, 306, 106, 115, 130, 105, 104,
-	107, 103, 0, 0, 0, 0, 0, 382, 384, 385,
-	387, 621, 0, 0, 0, 0, 392, 217, 217, 0,
-	0, 0, 0, 0, 0, 101, 100, 0, 409, 0,
-	412, 111, 102, 110, 109, 217, 0, 0, 112, 113,
-	273, 217, 217, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 355, 0, 0, 355, 0,
-	101, 100, 0, 0, 0, 0, 111, 102, 110, 109,
-	0, 0, 0, 112, 113, 0, 0, 306, 0, 466,
-	471, 241, 0, 0, 0, 483, 0, 0, 357, 0,
-	0, 0, 357, 0, 0, 0, 0, 0, 0, 0,
-	0, 497, 0, 0, 501, 471, 471, 505, 0, 101,
-	100, 497, 0, 0, 516, 111, 102, 110, 109, 0,
-	0, 0, 112, 113, 710, 719, 0, 0, 0, 217,
-	436, 436, 436, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 732, 0, 0, 0, 0, 524, 525, 0,
-	0, 497, 0, 735, 0, 306, 530, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 355, 0, 0, 0,
-	355, 0, 0, 0, 130, 0, 130, 130, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 471, 0,
-	0, 570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 357, 0, 0, 0, 0, 582, 0,
-	0, 585, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 806, 0, 471, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 217, 562, 0,
-	0, 0, 0, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 664, 111,
-	102, 110, 109, 0, 0, 217, 112, 113, 661, 0,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 355, 0, 0, 0, 0, 0, 306, 0, 0,
-	0, 0, 0, 0, 70, 0, 471, 0, 357, 357,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 497, 112, 113, 0,
-	471, 471, 0, 0, 0, 0, 675, 676, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 0,
-	0, 96, 0, 0, 217, 0, 0, 0, 0, 0,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 0, 0, 106, 115, 114, 105, 104, 107,
-	103, 0, 0, 0, 0, 471, 355, 355, 0, 0,
-	0, 357, 357, 357, 0, 728, 0, 0, 731, 0,
-	0, 0, 0, 0, 0, 0, 501, 92, 93, 94,
-	98, 0, 308, 79, 307, 309, 310, 311, 312, 0,
-	0, 0, 0, 0, 0, 305, 0, 77, 78, 86,
-	65, 298, 0, 0, 0, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 217, 0, 0, 101,
-	100, 0, 0, 0, 0, 111, 102, 110, 109, 0,
-	0, 357, 112, 113, 442, 0, 0, 0, 0, 355,
-	355, 355, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 19, 0, 0, 0, 31, 32, 0,
-	0, 0, 0, 0, 0, 0, 70, 0, 25, 38,
-	0, 26, 497, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 276, 0,
-	217, 0, 0, 0, 0, 0, 0, 0, 0, 355,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 85,
-	0, 0, 0, 96, 0, 68, 0, 0, 0, 0,
-	0, 0, 869, 868, 0, 744, 873, 874, 0, 0,
-	0, 28, 90, 0, 35, 33, 34, 30, 0, 0,
-	0, 0, 0, 0, 0, 36, 37, 407, 408, 0,
-	41, 42, 43, 44, 45, 47, 48, 49, 39, 46,
-	50, 0, 0, 0, 745, 0, 0, 27, 40, 92,
-	93, 94, 98, 306, 81, 79, 80, 97, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 65, 91, 71, 72, 73, 0, 95, 75,
-	87, 0, 88, 89, 19, 0, 0, 0, 31, 32,
-	0, 0, 0, 0, 0, 0, 0, 70, 0, 25,
-	38, 0, 26, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 87, 96, 74, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 22, 0, 0,
+	0, 39, 40, 0, 0, 0, 0, 0, 0, 0,
+	78, 0, 31, 47, 33, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 34, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	124, 0, 106, 0, 30, 0, 0, 0, 0, 0,
+	0, 965, 964, 0, 969, 0, 0, 0, 0, 0,
+	966, 0, 36, 100, 0, 43, 41, 42, 38, 0,
+	0, 0, 0, 0, 0, 0, 45, 46, 0, 0,
+	0, 50, 51, 52, 53, 54, 56, 57, 58, 48,
+	55, 59, 0, 0, 0, 970, 125, 44, 126, 29,
+	127, 0, 0, 35, 49, 6, 128, 110, 111, 112,
+	113, 104, 129, 108, 0, 91, 88, 90, 107, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 87, 96, 74, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 22, 0, 0,
+	0, 39, 40, 0, 0, 0, 0, 0, 0, 0,
+	78, 0, 31, 47, 33, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 34, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	124, 0, 106, 0, 30, 0, 0, 0, 0, 0,
+	0, 24, 23, 0, 75, 0, 0, 0, 0, 0,
+	25, 0, 36, 100, 0, 43, 41, 42, 38, 0,
+	0, 0, 0, 0, 0, 0, 45, 46, 0, 0,
+	76, 50, 51, 52, 53, 54, 56, 57, 58, 48,
+	55, 59, 0, 0, 0, 0, 125, 44, 126, 29,
+	127, 0, 0, 35, 49, 6, 128, 110, 111, 112,
+	113, 104, 129, 108, 0, 91, 88, 90, 107, 109,
+	79, 80, 81, 0, 105, 83, 84, 97, 0, 98,
+	99, 86, 87, 96, 74, 141, 151, 150, 140, 139,
+	142, 143, 138, 0, 78, 141, 151, 150, 140, 139,
+	142, 143, 138, 0, 0, 0, 0, 0, 0, 115,
+	116, 117, 114, 118, 119, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 89, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	103, 123, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 95, 0, 0, 124, 0, 106, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 155, 0, 0, 0,
+	0, 0, 0, 0, 136, 135, 0, 100, 0, 0,
+	147, 137, 146, 145, 136, 135, 0, 148, 149, 830,
+	147, 137, 146, 145, 0, 0, 0, 148, 149, 826,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 68, 0, 0, 0,
-	0, 0, 0, 403, 402, 0, 66, 0, 0, 0,
-	0, 0, 28, 90, 0, 35, 33, 34, 30, 0,
-	0, 0, 0, 0, 0, 0, 36, 37, 407, 408,
-	67, 41, 42, 43, 44, 45, 47, 48, 49, 39,
-	46, 50, 0, 0, 0, 0, 0, 0, 27, 40,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 0,
+	125, 156, 126, 160, 127, 0, 0, 377, 0, 0,
+	128, 110, 111, 112, 113, 104, 129, 108, 0, 376,
+	88, 375, 378, 379, 380, 381, 0, 0, 0, 0,
+	683, 0, 0, 373, 0, 86, 87, 96, 74, 366,
+	109, 79, 80, 81, 0, 105, 83, 84, 97, 0,
+	98, 99, 0, 0, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 0, 684, 78, 0, 0, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 0,
+	115, 116, 117, 114, 118, 119, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 89, 120, 121, 122,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	77, 78, 86, 65, 91, 71, 72, 73, 0, 95,
-	75, 87, 0, 88, 89, 19, 0, 0, 0, 31,
-	32, 0, 0, 0, 0, 0, 0, 0, 70, 0,
-	25, 38, 0, 26, 0, 0, 0, 0, 0, 0,
+	102, 103, 123, 0, 0, 0, 0, 0, 94, 0,
+	0, 0, 95, 0, 0, 124, 0, 106, 0, 0,
+	0, 0, 0, 0, 0, 0, 158, 155, 0, 0,
+	0, 0, 0, 136, 135, 0, 0, 0, 100, 147,
+	137, 146, 145, 0, 0, 0, 148, 149, 136, 135,
+	0, 0, 0, 0, 147, 137, 146, 145, 0, 0,
+	0, 148, 149, 529, 0, 0, 0, 0, 0, 0,
+	0, 125, 156, 126, 160, 127, 0, 0, 377, 0,
+	0, 128, 110, 111, 112, 113, 104, 129, 108, 0,
+	376, 88, 375, 378, 379, 380, 381, 0, 0, 0,
+	0, 0, 0, 0, 373, 0, 86, 87, 96, 74,
+	109, 79, 80, 81, 0, 105, 83, 84, 97, 0,
+	98, 99, 0, 0, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 0, 0, 78, 0, 0, 0, 0,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	115, 116, 117, 114, 118, 119, 159, 0, 0, 0,
+	0, 0, 1319, 0, 0, 0, 89, 120, 121, 122,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 103, 123, 0, 0, 0, 0, 0, 94, 0,
+	0, 0, 95, 0, 0, 124, 0, 106, 0, 0,
+	0, 0, 0, 0, 0, 0, 158, 155, 0, 0,
+	0, 0, 0, 136, 135, 0, 0, 0, 100, 147,
+	137, 146, 145, 0, 0, 0, 148, 149, 347, 136,
+	135, 0, 0, 0, 0, 147, 137, 146, 145, 0,
+	0, 0, 148, 149, 0, 0, 0, 0, 0, 0,
+	0, 125, 156, 126, 160, 127, 0, 0, 377, 0,
+	0, 128, 110, 111, 112, 113, 104, 129, 108, 0,
+	376, 88, 375, 378, 379, 380, 381, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 87, 96, 74,
+	109, 79, 80, 81, 0, 105, 83, 84, 97, 0,
+	98, 99, 0, 0, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 0, 0, 78, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1305, 0, 0, 0,
+	115, 116, 117, 114, 118, 119, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 89, 120, 121, 122,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 0, 0, 96, 0, 68, 0, 0,
-	0, 0, 0, 0, 741, 740, 0, 744, 0, 0,
-	0, 0, 0, 28, 90, 0, 35, 33, 34, 30,
-	0, 0, 0, 0, 0, 0, 0, 36, 37, 0,
-	0, 0, 41, 42, 43, 44, 45, 47, 48, 49,
-	39, 46, 50, 0, 0, 0, 745, 0, 0, 27,
-	40, 92, 93, 94, 98, 0, 81, 79, 80, 97,
+	102, 103, 123, 0, 0, 0, 0, 0, 94, 0,
+	0, 0, 95, 0, 0, 124, 0, 106, 0, 134,
+	0, 0, 0, 0, 0, 0, 158, 155, 0, 0,
+	0, 0, 0, 136, 135, 0, 0, 0, 100, 147,
+	137, 146, 145, 0, 0, 0, 148, 149, 0, 0,
+	0, 0, 0, 0, 0, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 0, 0, 0,
+	0, 125, 156, 126, 160, 127, 0, 0, 157, 0,
+	78, 128, 110, 111, 112, 113, 104, 129, 108, 0,
+	91, 88, 90, 107, 0, 115, 116, 117, 114, 118,
+	119, 159, 0, 0, 0, 0, 86, 87, 96, 74,
+	1131, 89, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	124, 0, 106, 0, 0, 0, 0, 0, 0, 0,
+	141, 158, 155, 140, 139, 142, 143, 138, 0, 0,
+	0, 0, 0, 100, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
+	79, 80, 81, 0, 105, 83, 84, 97, 0, 98,
+	99, 0, 0, 0, 0, 0, 125, 156, 126, 160,
+	127, 0, 0, 157, 78, 0, 128, 110, 111, 112,
+	113, 104, 129, 108, 0, 91, 88, 90, 107, 115,
+	116, 117, 114, 118, 119, 159, 0, 0, 0, 373,
+	0, 86, 87, 96, 74, 89, 120, 121, 122, 136,
+	135, 0, 0, 0, 0, 147, 137, 146, 145, 102,
+	103, 123, 148, 149, 0, 0, 0, 94, 0, 0,
+	0, 95, 0, 0, 124, 0, 106, 307, 0, 0,
+	0, 0, 0, 0, 0, 158, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 77, 78, 86, 65, 91, 71, 72, 73, 0,
-	95, 75, 87, 0, 88, 89, 19, 0, 0, 0,
-	31, 32, 0, 0, 0, 0, 0, 0, 0, 70,
-	0, 25, 38, 0, 26, 0, 0, 0, 0, 0,
+	0, 0, 0, 109, 79, 80, 81, 0, 105, 83,
+	84, 97, 0, 98, 99, 0, 0, 0, 0, 0,
+	125, 156, 126, 160, 127, 0, 0, 157, 78, 0,
+	128, 110, 111, 112, 113, 104, 129, 108, 0, 91,
+	88, 90, 107, 115, 116, 117, 114, 118, 119, 159,
+	0, 0, 0, 0, 0, 86, 87, 96, 74, 89,
+	120, 121, 122, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 103, 123, 0, 0, 0, 0,
+	0, 94, 0, 0, 0, 95, 0, 0, 124, 0,
+	106, 0, 134, 0, 0, 0, 0, 0, 0, 158,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 109, 79, 80,
+	81, 0, 105, 83, 84, 97, 0, 98, 99, 0,
+	0, 0, 0, 0, 125, 156, 126, 160, 127, 0,
+	0, 157, 78, 0, 128, 110, 111, 112, 113, 104,
+	129, 108, 0, 91, 88, 90, 107, 115, 116, 117,
+	114, 118, 119, 159, 0, 0, 0, 0, 0, 86,
+	87, 96, 74, 89, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 123,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 95,
+	0, 0, 124, 0, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 235, 100, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 109, 79, 80, 81, 0, 105, 83, 84, 97,
+	0, 98, 99, 0, 0, 0, 0, 0, 125, 156,
+	126, 160, 127, 0, 0, 234, 78, 0, 128, 110,
+	111, 112, 113, 104, 129, 108, 0, 91, 88, 90,
+	107, 115, 116, 117, 114, 118, 119, 159, 0, 0,
+	0, 0, 0, 86, 87, 96, 74, 89, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 123, 0, 0, 0, 0, 0, 94,
+	0, 0, 0, 95, 0, 0, 124, 0, 106, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 100,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
-	0, 0, 85, 0, 0, 0, 96, 0, 68, 0,
-	0, 0, 0, 0, 0, 21, 20, 0, 66, 0,
-	0, 0, 0, 0, 28, 90, 0, 35, 33, 34,
-	30, 0, 0, 0, 0, 0, 0, 0, 36, 37,
-	0, 0, 67, 41, 42, 43, 44, 45, 47, 48,
-	49, 39, 46, 50, 0, 0, 0, 0, 0, 0,
-	27, 40, 92, 93, 94, 98, 0, 81, 79, 80,
-	97, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 77, 78, 86, 65, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 70, 91, 71, 72, 73,
-	0, 95, 75, 87, 0, 88, 89, 0, 0, 0,
+	0, 0, 0, 0, 0, 109, 79, 80, 81, 0,
+	105, 83, 84, 97, 0, 98, 99, 0, 0, 0,
+	0, 0, 125, 156, 126, 160, 127, 0, 0, 157,
+	78, 0, 128, 110, 111, 112, 113, 104, 129, 108,
+	0, 91, 88, 90, 107, 115, 116, 117, 114, 118,
+	119, 159, 0, 0, 0, 0, 0, 86, 87, 96,
+	74, 89, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	124, 0, 106, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
+	79, 80, 81, 0, 105, 83, 84, 97, 0, 98,
+	99, 0, 0, 0, 0, 0, 125, 156, 126, 160,
+	127, 0, 0, 157, 78, 0, 128, 110, 111, 112,
+	113, 104, 129, 108, 0, 91, 88, 90, 107, 115,
+	116, 117, 114, 118, 119, 159, 0, 0, 0, 0,
+	0, 86, 87, 96, 153, 89, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	103, 123, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 95, 0, 0, 124, 0, 106, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	70, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 119, 0, 0, 0, 0, 0, 0, 84,
-	0, 90, 0, 85, 0, 0, 0, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 120, 119, 0, 0,
-	0, 0, 0, 0, 0, 0, 90, 91, 71, 72,
-	73, 0, 95, 75, 87, 0, 88, 89, 92, 93,
-	94, 98, 0, 308, 79, 307, 309, 310, 311, 312,
-	0, 70, 0, 0, 0, 0, 305, 0, 77, 78,
-	86, 65, 0, 92, 93, 94, 98, 0, 308, 79,
-	307, 309, 310, 311, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 77, 78, 86, 65, 0, 0, 0,
-	84, 0, 0, 0, 85, 0, 0, 0, 96, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 119, 0,
-	0, 0, 0, 0, 0, 0, 181, 90, 91, 71,
-	72, 73, 0, 95, 75, 87, 0, 88, 89, 0,
+	0, 0, 0, 109, 79, 80, 81, 0, 105, 83,
+	84, 97, 0, 98, 99, 0, 0, 0, 0, 0,
+	125, 156, 126, 160, 127, 0, 0, 157, 78, 0,
+	128, 110, 111, 112, 113, 104, 129, 108, 0, 91,
+	88, 90, 107, 115, 116, 117, 114, 118, 119, 159,
+	0, 0, 0, 0, 0, 86, 87, 96, 1087, 839,
+	120, 837, 838, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 103, 123, 0, 0, 0, 0,
+	0, 94, 0, 0, 0, 95, 0, 0, 124, 0,
+	106, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 109, 79, 349,
+	81, 0, 105, 83, 84, 97, 0, 98, 99, 0,
+	0, 0, 0, 0, 125, 156, 126, 160, 127, 0,
+	0, 157, 78, 0, 128, 110, 111, 112, 113, 104,
+	129, 108, 0, 91, 88, 90, 107, 115, 116, 117,
+	114, 118, 119, 159, 0, 0, 0, 0, 0, 86,
+	87, 96, 74, 89, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 123,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 95,
+	0, 0, 124, 0, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 70, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 92, 93, 94, 98, 0, 81,
-	79, 80, 97, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 77, 78, 86, 65, 0, 0,
-	0, 84, 0, 0, 0, 85, 0, 0, 0, 96,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 119,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
-	71, 72, 73, 0, 95, 75, 87, 0, 88, 89,
-	91, 71, 72, 73, 0, 95, 75, 87, 0, 88,
-	89, 0, 0, 70, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 70, 92, 93, 94, 98, 0,
-	81, 79, 80, 97, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 0, 77, 78, 86, 65, 0,
-	0, 0, 84, 0, 0, 0, 85, 0, 0, 0,
-	96, 251, 0, 84, 0, 0, 0, 85, 0, 120,
-	119, 96, 0, 68, 0, 0, 0, 0, 0, 90,
-	120, 119, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 91, 71, 72, 73, 0, 95, 75, 87, 0,
-	88, 89, 91, 71, 72, 73, 0, 95, 75, 87,
-	0, 88, 89, 0, 0, 70, 92, 93, 94, 98,
-	0, 81, 79, 80, 97, 0, 70, 92, 93, 94,
-	98, 0, 81, 79, 80, 97, 77, 78, 86, 65,
-	0, 0, 0, 0, 0, 0, 0, 77, 78, 86,
-	65, 0, 0, 0, 84, 0, 0, 0, 85, 0,
-	0, 0, 96, 0, 0, 84, 0, 0, 0, 85,
-	0, 120, 119, 96, 0, 0, 0, 0, 0, 0,
-	0, 90, 120, 119, 0, 0, 0, 0, 0, 0,
-	0, 0, 90, 91, 71, 278, 73, 0, 95, 75,
-	87, 0, 88, 89, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 70, 92, 93,
-	94, 98, 0, 81, 79, 80, 97, 984, 0, 92,
-	93, 94, 98, 0, 81, 79, 80, 97, 77, 78,
-	86, 65, 0, 0, 0, 0, 0, 0, 0, 77,
-	78, 86, 117, 0, 0, 0, 84, 0, 0, 0,
-	85, 0, 0, 0, 96, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 119, 0, 0, 0, 0, 0,
-	0, 101, 100, 90, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 973, 0, 0,
-	92, 93, 94, 98, 0, 81, 79, 80, 97, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	77, 78, 86, 65, 0, 0, 0, 0, 0, 0,
-	959, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 947, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 924, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 915, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 0,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 903, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 894, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 839, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 831, 0, 111, 102, 110, 109, 0, 0, 828,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 101, 100, 0, 112, 113, 0, 111, 102, 110,
-	109, 0, 0, 0, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 101, 100, 0, 112, 113, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 0, 335, 822,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 0, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 775, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 783, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 100, 0, 0, 755, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 101, 100, 0,
-	0, 0, 0, 111, 102, 110, 109, 101, 100, 641,
-	112, 113, 0, 111, 102, 110, 109, 0, 0, 638,
-	112, 113, 106, 115, 114, 105, 104, 107, 103, 0,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 0, 0, 614, 112, 113, 106, 115, 114, 105,
-	104, 107, 103, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 271, 101, 100, 0, 0, 555, 0, 111,
-	102, 110, 109, 0, 0, 0, 112, 113, 106, 115,
-	114, 105, 104, 107, 103, 0, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 101, 100, 454,
-	0, 0, 0, 111, 102, 110, 109, 0, 0, 0,
-	112, 113, 283, 106, 115, 114, 105, 104, 107, 103,
-	0, 101, 100, 0, 0, 0, 0, 111, 102, 110,
-	109, 275, 0, 0, 112, 113, 0, 0, 0, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 270, 101, 100, 0, 0, 0, 0, 111,
-	102, 110, 109, 0, 101, 100, 112, 113, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 106, 115, 114, 105, 104, 107, 103,
-	0, 0, 0, 106, 101, 100, 105, 104, 107, 103,
-	111, 102, 110, 109, 228, 0, 0, 112, 113, 106,
-	115, 114, 105, 104, 107, 103, 0, 0, 0, 106,
-	444, 114, 105, 104, 107, 103, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 106,
-	327, 114, 105, 104, 107, 103, 0, 0, 101, 100,
-	0, 0, 0, 0, 111, 102, 110, 109, 101, 100,
-	0, 112, 113, 0, 111, 102, 110, 109, 0, 0,
-	0, 112, 113, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 101, 100, 0, 112, 113, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 156,
+	126, 160, 127, 0, 0, 157, 426, 298, 128, 110,
+	111, 112, 113, 104, 129, 108, 0, 91, 88, 90,
+	107, 0, 115, 116, 117, 114, 118, 119, 159, 0,
+	0, 0, 109, 86, 87, 96, 74, 0, 189, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 423, 0, 426, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 134, 115, 116, 117, 114, 118, 119, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 423, 0, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 125, 190, 126, 160, 127, 0, 0,
+	0, 0, 0, 128, 110, 111, 112, 113, 104, 129,
+	0, 429, 0, 0, 0, 0, 0, 0, 0, 430,
+	115, 116, 117, 114, 118, 119, 159, 0, 0, 0,
+	0, 427, 0, 0, 0, 0, 189, 120, 121, 122,
+	0, 0, 109, 125, 190, 126, 160, 127, 0, 0,
+	102, 103, 123, 128, 110, 111, 112, 113, 104, 129,
+	0, 429, 0, 0, 0, 124, 0, 78, 0, 430,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 100, 0, 0, 0, 0,
-	111, 102, 110, 109, 0, 0, 0, 112, 113,
+	0, 427, 115, 611, 117, 114, 118, 119, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 123, 0, 0, 0, 0, 0,
+	109, 125, 190, 126, 160, 127, 0, 124, 0, 0,
+	0, 128, 110, 111, 112, 113, 104, 129, 0, 429,
+	0, 0, 0, 0, 0, 0, 0, 430, 0, 0,
+	0, 141, 151, 150, 140, 139, 142, 143, 138, 172,
+	115, 600, 601, 114, 602, 603, 159, 109, 0, 0,
+	0, 0, 0, 1283, 0, 0, 189, 120, 121, 122,
+	0, 0, 0, 125, 190, 126, 160, 127, 0, 0,
+	102, 103, 123, 128, 110, 111, 112, 113, 104, 129,
+	0, 0, 604, 0, 0, 124, 0, 115, 116, 117,
+	114, 118, 119, 159, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 189, 120, 121, 122, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 123,
+	136, 135, 0, 0, 109, 0, 147, 137, 146, 145,
+	0, 0, 124, 148, 149, 0, 0, 0, 0, 0,
+	0, 125, 190, 126, 160, 127, 0, 0, 0, 0,
+	0, 128, 110, 111, 112, 113, 104, 129, 0, 0,
+	0, 0, 0, 0, 115, 116, 117, 114, 118, 119,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 608,
+	189, 120, 121, 122, 0, 0, 0, 0, 125, 190,
+	126, 160, 127, 0, 102, 103, 123, 0, 128, 110,
+	111, 112, 113, 104, 129, 0, 0, 0, 0, 124,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	0, 0, 0, 0, 0, 0, 998, 0, 0, 0,
+	0, 0, 1262, 141, 151, 150, 140, 139, 142, 143,
+	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1231, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 190, 126, 160, 127,
+	0, 0, 0, 0, 0, 128, 110, 111, 112, 113,
+	104, 129, 141, 151, 150, 140, 139, 142, 143, 138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	135, 0, 0, 209, 1210, 147, 137, 146, 145, 0,
+	0, 0, 148, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 136, 135, 0, 0, 0, 0, 147, 137,
+	146, 145, 0, 0, 0, 148, 149, 141, 151, 150,
+	140, 139, 142, 143, 138, 0, 0, 141, 151, 150,
+	140, 139, 142, 143, 138, 0, 0, 0, 0, 1200,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1189,
+	0, 136, 135, 0, 0, 0, 0, 147, 137, 146,
+	145, 0, 0, 0, 148, 149, 141, 151, 150, 140,
+	139, 142, 143, 138, 0, 0, 141, 151, 150, 140,
+	139, 142, 143, 138, 0, 0, 0, 0, 1178, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1169, 0,
+	0, 0, 0, 0, 0, 0, 136, 135, 0, 0,
+	0, 0, 147, 137, 146, 145, 136, 135, 0, 148,
+	149, 0, 147, 137, 146, 145, 0, 0, 0, 148,
+	149, 141, 151, 150, 140, 139, 142, 143, 138, 0,
+	0, 141, 151, 150, 140, 139, 142, 143, 138, 0,
+	0, 0, 0, 1107, 0, 136, 135, 0, 0, 0,
+	0, 147, 137, 146, 145, 136, 135, 0, 148, 149,
+	0, 147, 137, 146, 145, 0, 0, 0, 148, 149,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	0, 0, 1094, 0, 0, 0, 0, 0, 0, 0,
+	0, 946, 141, 151, 150, 140, 139, 142, 143, 138,
+	136, 135, 0, 0, 0, 0, 147, 137, 146, 145,
+	136, 135, 0, 148, 149, 0, 147, 137, 146, 145,
+	0, 0, 1073, 148, 149, 141, 151, 150, 140, 139,
+	142, 143, 138, 0, 0, 141, 151, 150, 140, 139,
+	142, 143, 138, 0, 0, 0, 0, 0, 0, 136,
+	135, 0, 0, 0, 0, 147, 137, 146, 145, 136,
+	135, 0, 148, 149, 0, 147, 137, 146, 145, 0,
+	0, 0, 148, 149, 0, 0, 0, 0, 0, 0,
+	0, 136, 135, 0, 0, 0, 0, 147, 137, 146,
+	145, 0, 0, 1058, 148, 149, 141, 151, 150, 140,
+	139, 142, 143, 138, 0, 0, 141, 151, 150, 140,
+	139, 142, 143, 138, 136, 135, 0, 0, 1007, 0,
+	147, 137, 146, 145, 136, 135, 1032, 148, 149, 0,
+	147, 137, 146, 145, 0, 0, 1017, 148, 149, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 0,
+	0, 982, 0, 0, 0, 0, 0, 0, 0, 0,
+	406, 0, 0, 0, 0, 141, 151, 150, 140, 139,
+	142, 143, 138, 0, 0, 136, 135, 0, 0, 0,
+	0, 147, 137, 146, 145, 136, 135, 802, 148, 149,
+	0, 147, 137, 146, 145, 0, 0, 954, 148, 149,
+	141, 151, 150, 140, 139, 142, 143, 138, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 136, 135,
+	0, 0, 0, 0, 147, 137, 146, 145, 136, 135,
+	0, 148, 149, 0, 147, 137, 146, 145, 0, 0,
+	0, 148, 149, 141, 151, 150, 140, 139, 142, 143,
+	138, 0, 0, 0, 136, 135, 0, 0, 0, 0,
+	147, 137, 146, 145, 0, 769, 0, 148, 149, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	135, 676, 0, 0, 0, 147, 137, 146, 145, 0,
+	0, 799, 148, 149, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 0, 0, 0, 0, 0, 0, 342,
+	0, 0, 0, 0, 0, 0, 541, 0, 0, 0,
+	0, 0, 136, 135, 0, 0, 0, 0, 147, 137,
+	146, 145, 0, 0, 0, 148, 149, 0, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 136, 135,
+	0, 0, 0, 346, 147, 137, 146, 145, 341, 0,
+	0, 148, 149, 354, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 0, 141, 151, 150, 140, 139, 142,
+	143, 138, 0, 136, 135, 0, 0, 0, 0, 147,
+	137, 146, 145, 0, 0, 0, 148, 149, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 0, 141,
+	151, 150, 140, 139, 142, 143, 138, 0, 136, 135,
+	0, 283, 0, 0, 147, 137, 146, 145, 0, 0,
+	0, 148, 149, 141, 531, 150, 140, 139, 142, 143,
+	138, 0, 0, 136, 135, 0, 0, 0, 0, 147,
+	137, 146, 145, 136, 135, 0, 148, 149, 0, 147,
+	137, 146, 145, 0, 0, 0, 148, 149, 141, 397,
+	150, 140, 139, 142, 143, 138, 0, 0, 136, 135,
+	0, 0, 0, 0, 147, 137, 146, 145, 136, 135,
+	0, 148, 149, 0, 147, 137, 146, 145, 136, 135,
+	0, 148, 149, 109, 147, 137, 146, 145, 0, 0,
+	0, 148, 149, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 136, 135, 0, 0, 0, 0, 147, 137,
+	146, 145, 0, 0, 0, 148, 149, 0, 0, 0,
+	0, 0, 0, 115, 116, 117, 114, 118, 119, 159,
+	0, 109, 0, 0, 0, 0, 0, 136, 135, 189,
+	120, 121, 122, 147, 137, 146, 145, 0, 0, 0,
+	148, 149, 0, 102, 103, 123, 78, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 615,
+	0, 115, 116, 117, 114, 118, 119, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 120, 121,
+	122, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 123, 0, 0, 0, 109, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 0, 125, 190, 126, 160, 127, 0,
+	0, 157, 298, 0, 128, 110, 111, 112, 113, 104,
+	129, 0, 0, 91, 0, 90, 107, 115, 116, 117,
+	114, 118, 119, 159, 0, 109, 0, 0, 0, 0,
+	0, 0, 0, 189, 120, 121, 122, 0, 0, 299,
+	0, 0, 125, 190, 126, 160, 127, 102, 103, 123,
+	298, 0, 128, 110, 111, 112, 113, 104, 129, 0,
+	0, 0, 124, 0, 0, 115, 116, 117, 114, 118,
+	119, 159, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 189, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 0, 125, 190,
+	126, 160, 127, 0, 0, 0, 78, 0, 128, 110,
+	111, 112, 113, 104, 129, 0, 0, 0, 0, 0,
+	0, 115, 116, 117, 114, 118, 119, 159, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 120, 121,
+	122, 0, 0, 0, 0, 0, 125, 190, 126, 160,
+	127, 102, 103, 123, 0, 0, 128, 110, 111, 112,
+	113, 104, 129, 0, 0, 0, 124, 0, 115, 600,
+	601, 114, 602, 603, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 120, 121, 122, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 103,
+	123, 0, 0, 0, 109, 0, 0, 0, 0, 0,
+	604, 0, 0, 124, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 190, 126, 160, 127, 0, 0, 0,
+	0, 0, 128, 110, 111, 112, 113, 104, 129, 0,
+	0, 0, 0, 0, 115, 728, 729, 114, 730, 731,
+	159, 0, 109, 0, 0, 0, 0, 0, 0, 0,
+	189, 120, 121, 122, 0, 0, 0, 0, 0, 125,
+	190, 126, 160, 127, 102, 103, 123, 298, 0, 128,
+	110, 111, 112, 113, 104, 129, 733, 0, 0, 124,
+	0, 0, 115, 116, 117, 114, 118, 119, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 120,
+	121, 122, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 123, 0, 0, 0, 109, 0,
+	365, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 0, 125, 190, 126, 160, 127,
+	0, 0, 0, 0, 0, 128, 110, 111, 112, 113,
+	104, 129, 0, 0, 0, 0, 0, 0, 115, 116,
+	117, 114, 118, 119, 159, 109, 0, 361, 0, 0,
+	0, 0, 0, 0, 189, 120, 121, 122, 0, 0,
+	0, 0, 0, 125, 190, 126, 160, 127, 102, 103,
+	123, 0, 0, 128, 110, 111, 112, 113, 104, 129,
+	0, 0, 0, 124, 0, 115, 116, 117, 114, 118,
+	119, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 120, 121, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 123, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
+	124, 212, 0, 0, 0, 0, 0, 0, 0, 125,
+	190, 126, 160, 127, 0, 0, 0, 0, 0, 128,
+	110, 111, 112, 113, 104, 129, 0, 0, 0, 0,
+	0, 0, 115, 116, 117, 114, 118, 119, 159, 109,
+	0, 0, 0, 0, 0, 0, 0, 97, 189, 120,
+	121, 122, 0, 0, 0, 0, 125, 190, 126, 160,
+	127, 0, 102, 103, 123, 0, 128, 110, 111, 112,
+	113, 104, 129, 0, 0, 0, 0, 124, 0, 115,
+	116, 117, 114, 118, 119, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	103, 123, 0, 0, 0, 109, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 190, 126, 160, 127, 0, 0,
+	0, 0, 0, 128, 110, 111, 112, 113, 104, 129,
+	0, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 159, 0, 0, 0, 109, 0, 0, 0, 0,
+	0, 189, 120, 121, 122, 0, 0, 0, 0, 0,
+	125, 190, 126, 160, 127, 102, 103, 123, 707, 109,
+	128, 110, 111, 112, 113, 104, 129, 0, 0, 0,
+	124, 0, 0, 0, 0, 115, 116, 117, 114, 118,
+	119, 159, 576, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 120, 121, 122, 0, 0, 0, 0, 115,
+	116, 117, 114, 118, 119, 159, 0, 123, 0, 109,
+	0, 0, 0, 0, 0, 189, 120, 121, 122, 0,
+	124, 0, 0, 0, 0, 0, 125, 190, 126, 160,
+	127, 123, 572, 0, 0, 0, 128, 110, 111, 112,
+	113, 104, 129, 0, 124, 0, 0, 0, 0, 115,
+	116, 117, 114, 118, 119, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 120, 121, 122, 0,
+	0, 0, 0, 0, 0, 0, 125, 190, 126, 160,
+	127, 123, 0, 109, 0, 0, 128, 110, 111, 112,
+	113, 0, 129, 0, 124, 0, 0, 0, 0, 0,
+	125, 190, 126, 160, 127, 0, 443, 0, 0, 0,
+	128, 110, 111, 112, 113, 0, 129, 0, 0, 0,
+	0, 0, 0, 115, 116, 117, 114, 118, 119, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	120, 121, 122, 0, 0, 0, 0, 0, 0, 0,
+	125, 190, 126, 160, 127, 123, 0, 0, 0, 0,
+	128, 110, 111, 112, 113, 0, 129, 0, 124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 190, 126, 160, 127, 0,
+	0, 0, 0, 0, 128, 110, 111, 112, 113, 0,
+	129,
 }
 var yyPact = [...]int{
 
-	2171, -1000, 285, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, -1000,
-	2718, 2707, -1000, -1000, 184, 925, 919, 1017, 540, -1000,
-	560, 1004, 1007, 555, 555, 592, -1000, -1000, 2707, 2707,
-	543, 2707, 2707, 2707, 2707, 2707, 2707, 2707, -1000, 555,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 297, -1000, -1000, -1000, 2616, 2423, 1024, 932, -64,
-	-36, -1000, -1000, -1000, -1000, -1000, -1000, 2707, 2707, 264,
-	263, 262, -1000, 348, 258, 2707, 2707, -1000, -1000, -1000,
-	555, -1000, -1000, -1000, -1000, -1000, -1000, 254, 252, 2171,
-	2707, 2707, 2707, 742, 2707, 752, 108, 2707, 774, 2707,
-	2707, 2707, 2707, 2707, 2707, 2707, 3465, 2616, -1000, 248,
-	2707, 646, 3491, 887, 972, 482, 454, 993, 838, 736,
-	-1000, 733, 555, 482, -1000, 33, 289, -1000, 495, -1000,
-	555, 555, 555, 379, 277, -1000, -1000, -1000, 555, -1000,
-	-1000, -1000, -1000, 2707, 2707, 3441, 3375, -1000, 998, 3491,
-	3491, 1112, -64, 3491, 3401, -1000, 1610, -64, 3491, -1000,
-	2809, 2707, 1058, 157, 158, 225, 3351, 81, 760, 1017,
-	-1000, -1000, -1000, -1000, 30, 555, -1000, 524, 2605, 511,
-	-1000, -1000, 1516, 736, 736, 108, 108, 761, 771, -1000,
-	-1000, 3475, -1000, 378, 736, 2707, -1000, 71, 17, 17,
-	808, 3531, 2707, 108, 2707, -1000, 2616, -1000, 17, 108,
-	108, -4, -4, -1000, -1000, -1000, 1157, 3475, 2171, 157,
-	156, 2707, 640, 625, 615, 2707, 811, 867, 482, 992,
-	28, -1000, -1000, 305, 995, 984, 305, 776, 776, 776,
-	2307, -1000, 315, 1035, 1017, 2707, 430, 309, 246, 243,
-	-1000, -1000, -1000, 2707, 2707, 2707, 2707, 963, 3491, 3491,
-	1014, 1013, 555, 2707, 2707, 2707, 2707, 3491, 2707, 3491,
-	-1000, -1000, -1000, 1869, 555, 1017, 555, 38, 759, 932,
-	290, -1000, -1000, 153, 2707, -1000, -1000, -1000, -1000, 152,
-	27, 958, -1000, 3491, -1000, -1000, -34, 240, 239, 237,
-	233, 230, 228, 2707, 2514, -1000, -1000, 108, 191, 191,
-	191, 742, -1000, 2707, 1546, -1000, -1000, 2707, 3501, -1000,
-	17, -1000, -1000, 626, -1000, 2707, 533, 2171, 531, 2707,
-	3340, 824, 2707, 2332, 183, 505, 449, 482, 984, 86,
-	-1000, 466, -1000, -1000, 257, -1000, 226, 224, 305, 884,
-	2707, -1000, 225, -1000, 225, 225, -1000, 555, 733, -1000,
-	220, 227, 449, 555, -1000, 3491, 733, 555, 733, 164,
-	555, 3491, -64, 3491, -64, -64, 3491, -64, 3491, 1017,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3491, 529, 283,
-	-1000, -1000, 2718, 2707, -1000, -1000, -1000, -1000, -1000, 561,
-	-1000, 20, 556, 555, 555, -1000, 223, 555, -1000, 150,
-	-1000, 2307, 555, 2605, 736, 736, 736, 2707, 2707, 2707,
-	149, 147, 145, 757, -1000, 124, -1000, 222, -1000, -1000,
-	473, 143, 2707, 3475, 2707, 528, 614, 2171, 2707, 3308,
-	704, -1000, -1000, 3491, 2171, -1000, 2707, 1411, -1000, 12,
-	857, 3491, -1000, 108, 449, -1000, -1000, 555, 993, 9,
-	271, -75, -1000, -1000, 820, 810, 789, 789, 856, 305,
-	-1000, -1000, -1000, -1000, 555, 126, 2707, 2707, 984, 870,
-	844, 3491, 772, -1000, -1000, 772, 142, 7, -1000, 902,
-	555, 904, -1000, 449, 900, 892, -1000, 141, -1000, 957,
-	135, -1, -1000, -1000, -8, 903, -26, -1000, 663, 1869,
-	3284, 639, 1869, 1869, 550, 541, 733, 133, -1000, -1000,
-	-1000, 131, 2707, 2707, 2514, 2707, 128, 127, 125, -1000,
-	-1000, -1000, 108, 118, -22, 2707, -1000, 729, 345, 3194,
-	3475, 687, 520, -1000, 3250, 2707, -1000, 3160, 638, 3491,
-	-1000, 734, 337, 2332, 333, -1000, -1000, -1000, 117, -24,
-	-1000, 984, 449, 2707, 305, 305, 806, -1000, 805, 801,
-	789, -1000, -1000, -1000, 1360, 5, 1350, -1000, -1000, 2707,
-	2707, 952, 555, -1000, -1000, -1000, 449, 449, 116, -25,
-	2707, 104, 555, 2707, 951, 371, 949, 1017, 1017, 2707,
-	948, 1017, -1000, -1000, 1869, 595, 2707, 517, 513, 1869,
-	1869, 99, 946, 416, 97, 95, 94, 88, 85, 414,
-	397, 375, -1000, -1000, 108, 1206, -1000, 881, -1000, -1000,
-	685, 2171, 3160, -1000, -1000, 2707, -1000, -1000, -1000, 922,
-	784, 449, -1000, -1000, 3491, 856, 1082, 305, 305, 305,
-	798, 2707, -1000, 2707, 555, 3491, -1000, 733, -1000, -1000,
-	-1000, 902, 555, 3491, -1000, -1000, -64, 3491, 733, 2020,
-	365, -1000, -1000, -1000, 903, 3491, 359, 83, 617, 508,
-	1869, 3218, 662, 660, 504, 502, -1000, 219, 218, 410,
-	406, 402, 401, 369, 217, 215, 332, 214, 322, -1000,
-	2707, 211, -1000, 671, 3184, -1000, -1000, -1000, 108, -1000,
-	-1000, -1000, 2707, 209, 1082, 991, 856, 305, -28, 3128,
-	82, -51, -1000, -1000, -1000, -1000, 501, 278, -1000, -1000,
-	2718, 2707, -1000, -1000, 2707, 2707, 2020, 2020, 938, 496,
-	589, 1869, 2707, 699, -1000, 1869, -1000, -1000, 653, 651,
-	733, 418, 201, 200, 196, 188, 185, 418, 418, 399,
-	418, 398, 3094, 887, -1000, 2171, -1000, 3491, 555, -1000,
-	2707, 856, -1000, -1000, -1000, -1000, 2707, -1000, 2020, 3070,
-	637, 3060, 32, 753, 3491, 492, 491, 353, 684, 487,
-	-1000, 3038, -1000, 636, -1000, -1000, 80, 78, -1000, 889,
-	835, 418, 418, 418, 418, 418, 75, 887, 74, 175,
-	70, 163, -1000, 64, 61, 3491, 59, -1000, 2020, 588,
-	2707, 1718, 555, 555, -1000, -1000, 2020, -1000, 680, 1869,
-	-1000, 2707, -1000, -1000, -1000, 831, 2707, 57, 55, 54,
-	44, 43, -1000, -1000, 418, -1000, 418, -1000, -1000, -1000,
-	611, 486, 2020, 3028, 484, 275, -1000, -1000, 2718, 2707,
-	-1000, -1000, -1000, 538, 535, 483, -1000, 669, 3004, 2332,
-	-1000, -1000, -1000, -1000, -1000, -1000, 39, 37, 481, 587,
-	2020, 2707, 695, -1000, 2020, 650, 1718, 2938, 634, 1718,
-	1718, -1000, -1000, 1869, 314, -1000, -1000, 679, 480, -1000,
-	2914, -1000, 632, -1000, -1000, 1718, 577, 2707, 471, 468,
-	-1000, 812, -1000, 678, 2020, -1000, 2707, 559, 446, 1718,
-	2904, 649, 544, -1000, 817, 726, 725, 711, -1000, 668,
-	2881, 435, 575, 1718, 2707, 694, -1000, 1718, -1000, -1000,
-	745, 723, -1000, 716, 710, -1000, -1000, -1000, -1000, 2020,
-	677, 434, -1000, 2848, -1000, 594, 770, -1000, -1000, -1000,
-	-1000, -1000, 673, 1718, -1000, 2707, -1000, 718, -1000, -1000,
-	666, 2758, -1000, -1000, 1718,
+	2611, -1000, 265, -1000, -1000, -1000, 379, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 5726, -1000, 4061, 3937, 2611, -1000, -1000, 195, 1034,
+	990, 937, 977, 965, 956, 325, 6565, -1000, 506, 1094,
+	1081, 6641, 6641, 516, 4950, -1000, -1000, 3937, 3937, 6518,
+	3937, 3937, 3937, 3937, 3937, 3937, 3937, -1000, 6641, 6641,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	270, -1000, -1000, -1000, 3689, 3813, 1105, -73, -47, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3937, 3937, 249, 248,
+	247, 246, -1000, 279, 245, 3937, 3937, -1000, -1000, -1000,
+	6641, -1000, -1000, -1000, -1000, -1000, -1000, 244, 242, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2611, -1000, 722, 278, 990, 3937, 3937, 3937, 745, 3937,
+	749, 47, 3937, 3937, 806, 3937, 3937, 3937, 3937, 3937,
+	3937, 3937, 5716, 3689, -1000, 241, 240, 238, 3937, -1000,
+	-1000, 609, 5726, 455, 903, 1033, 6318, 6071, 1082, 837,
+	731, -1000, 722, 6318, 329, 16, 6641, -1000, 6641, 6641,
+	6318, 6023, 6318, -1000, 731, 14, 269, -1000, 444, -1000,
+	-1000, -1000, 6641, 6641, 6641, 6641, 397, 392, -1000, -1000,
+	-1000, 6641, -1000, -1000, -1000, -1000, 3937, 3937, 239, 3937,
+	5706, 5681, -1000, 1084, 5726, 5726, 1943, -73, 5726, 5671,
+	-1000, 3071, -73, 5726, -1000, 4433, 3937, 1635, 147, 152,
+	5646, 15, 779, 1097, 238, -1000, -1000, 6441, 3565, 6394,
+	-1000, -1000, 2775, 3937, 731, 731, 47, 47, 761, 789,
+	-1000, -1000, 3457, -1000, 359, 731, 3937, -1000, -1000, -1000,
+	-11, -20, -20, 805, 5785, 3937, 47, 3937, 3937, -1000,
+	3689, -1000, -20, -20, 47, 47, 7, 7, -1000, -1000,
+	-1000, 1753, 3457, 2611, 147, 144, 3937, 606, 578, 577,
+	3937, 2611, 880, 891, 6318, 1074, 13, -1000, -1000, 4608,
+	1083, 1043, 4608, 793, 793, 793, 2956, -1000, 811, 6839,
+	1032, 990, 303, 300, 822, 978, -1000, 784, -1000, 3937,
+	1097, 3937, 450, 299, 237, 236, 235, -1000, -1000, -1000,
+	-1000, 3937, 3937, 3937, 3937, 1031, 5726, 5726, 3937, 141,
+	-1000, 1092, 1090, 6641, 3937, 3937, 3937, 3937, 5726, 3937,
+	5726, -1000, -1000, -1000, 2251, 6641, 1097, 6641, 35, 777,
+	-1000, -1000, 139, 3937, -1000, -1000, -1000, 135, 12, 1023,
+	-1000, 5726, -1000, -1000, -55, 234, 229, 227, 225, 222,
+	221, 220, 134, 3937, 3441, -1000, -1000, 47, 155, 155,
+	155, 745, -1000, 3937, 2906, -1000, -1000, 3937, 5750, -1000,
+	-20, -20, -1000, -1000, 568, -1000, 3937, 534, 2611, 533,
+	3937, 5601, 531, 862, 3937, 3136, 187, 6147, 6318, 1043,
+	38, -1000, 6765, 4686, -1000, 6715, -1000, 4558, -1000, 219,
+	218, 200, 4608, 901, 3937, -1000, 278, -1000, 278, 278,
+	-1000, 4608, -1000, 6641, 6318, -1000, 217, 6641, 6194, 722,
+	-1000, 6641, 4826, 4748, 5947, 6641, 6318, 128, -1000, 5726,
+	722, 6641, 722, 193, 6641, 182, 5726, -73, 5726, -73,
+	-73, 5726, -73, 5726, 1097, 127, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 5726, 523, 263, -1000, -1000, 4061,
+	3937, 2251, -1000, -1000, -1000, -1000, -1000, 564, -1000, 8,
+	559, 6641, 6641, 285, 126, -1000, 2956, 6641, 3565, 731,
+	731, 731, 731, 3937, 3937, 3937, -1000, 125, 124, 122,
+	757, -1000, 111, -1000, 216, -1000, -1000, 477, 119, 3937,
+	3457, 3937, 520, 575, 2611, 3937, 5566, 680, -1000, -1000,
+	5726, 2611, 464, -1000, 3937, 2891, -1000, 3, 873, 5726,
+	-1000, 47, 6147, -1000, 1082, 0, 254, -74, -1000, -1000,
+	855, 854, 828, 828, 859, 205, 204, 4608, -1000, -1000,
+	-1000, -1000, 6641, 6691, 200, -1000, 6641, 57, 3937, 3937,
+	3937, 1043, 894, 890, 5726, 797, -1000, -1000, 797, 1382,
+	-1000, 808, 722, 118, -1, 116, -4, 6270, -1000, -1000,
+	6641, 915, 178, 177, 738, -1000, 199, 938, 6641, -1000,
+	945, 6641, -1000, 6147, 936, 6641, 931, -1000, 285, -1000,
+	115, -1000, 1020, 114, -5, -1000, -1000, -10, 942, -16,
+	1019, 113, -14, 944, 1097, -1000, -1000, 630, 2251, 5540,
+	604, 454, 2251, 2251, 551, 545, -1000, 197, 285, -1000,
+	-1000, 112, 3937, 3937, 3441, 3937, 3937, 109, 108, 105,
+	285, 285, 285, 47, 104, -23, 3937, -1000, 717, 296,
+	5497, 3457, 662, 518, -1000, 5462, 3937, -1000, 5436, 600,
+	-1000, 5726, -1000, 724, 304, 3136, 308, -1000, -1000, -1000,
+	100, -25, 1043, 6147, 3937, 4608, 4608, 846, -1000, 841,
+	838, 828, 5899, 6641, -1000, -1000, -1000, 6641, -1000, -1000,
+	2722, 99, -67, 2712, -1000, 290, 3937, 4309, 3937, 4608,
+	98, 1016, 6641, 1012, 6194, 809, -1000, 809, 6641, 916,
+	-1000, 179, -1000, 735, 863, 196, 6641, 3937, 190, 6641,
+	-1000, -1000, -1000, 6147, 6147, 93, -30, 3937, -1000, 92,
+	6641, -1000, 3937, -1000, 1010, 352, 1008, 1097, 1097, 3937,
+	1007, 1097, 349, 1005, 463, 3937, -1000, -1000, -1000, 2251,
+	573, 3937, 2251, 517, 515, 2251, 2251, 1041, -1000, 285,
+	91, 90, 83, 81, 77, 76, 431, 346, 343, -1000,
+	-1000, -1000, -1000, -1000, 47, 2137, -1000, -1000, 900, -1000,
+	-1000, 660, 2611, 5436, -1000, -1000, 3937, -1000, -1000, -1000,
+	958, 795, 6147, -1000, -1000, 5726, 859, 1004, 4608, 4608,
+	4608, 833, 446, 188, 445, -1000, 3937, -1000, -1000, 3937,
+	6641, -1000, 6641, 5726, -1000, -31, 5726, 186, 185, 170,
+	5257, 1289, -1000, 180, -1000, 722, -1000, -1000, 6641, 916,
+	-1000, 179, -1000, 804, -1000, 3937, -1000, -1000, 915, 178,
+	177, 6641, 75, 5393, 6641, 74, -1000, -1000, 938, 6641,
+	5726, -1000, -1000, -73, 5726, 722, 2431, 345, -1000, -1000,
+	-1000, 942, 5726, 338, 72, 2431, 335, -1000, 5726, 563,
+	514, 2251, 5426, 513, 626, 623, 512, 507, 3937, 427,
+	285, 285, 285, 285, 285, 294, 4873, 4873, 302, 4873,
+	297, -1000, 3937, 173, -1000, 641, 5383, -1000, -1000, -1000,
+	47, -1000, -1000, -1000, 3937, 172, 1004, 1267, 859, 4608,
+	6147, 731, 6641, -49, 5322, 71, -85, -1000, -32, 1003,
+	4309, 3937, 3937, 171, -1000, 594, 382, 3937, 722, -1000,
+	-1000, 5312, 70, -1000, -1000, 68, -1000, -1000, -1000, -1000,
+	505, 261, -1000, -1000, 4061, 3937, 2431, -1000, -1000, 3937,
+	3937, 2431, 2431, 999, 503, 2431, 501, 570, 2251, 3937,
+	679, -1000, 2251, 460, -1000, -1000, 622, 619, 5279, 4873,
+	426, 422, 416, 398, 393, 389, 899, -1000, 380, -1000,
+	-1000, 388, -1000, 386, 5208, 903, -1000, 2611, -1000, 5726,
+	6641, -1000, 3937, 859, 768, 764, -1000, -1000, -1000, -1000,
+	3937, 6641, 168, -1000, 66, 63, 4185, -1000, 598, 322,
+	5257, 62, -1000, -1000, -1000, -1000, 2431, 5247, 590, 447,
+	1578, 10, 759, 5726, 499, 497, 330, -1000, 496, 658,
+	495, -1000, 5198, -1000, 589, -1000, -1000, -1000, -1000, -1000,
+	4873, 4873, 4873, 4873, 4873, 4873, 160, 61, -1000, 905,
+	886, 4873, 4873, -1000, 58, 56, 5726, 159, 158, 54,
+	-1000, 380, -1000, -1000, 53, -42, 5726, 3316, 1077, 3937,
+	596, -1000, -1000, -1000, 2431, 569, 3937, 2431, 2051, 6641,
+	6641, -1000, -1000, 2431, -1000, -1000, 654, 2251, -1000, 3937,
+	-1000, -1000, -1000, -1000, -1000, -1000, 903, -1000, -1000, 884,
+	3937, -1000, -1000, 285, -1000, 2956, 2956, -1000, 52, -1000,
+	4185, -1000, 1593, 1064, -1000, 5143, 1039, 3937, 562, 493,
+	2431, 5133, 491, 490, 260, -1000, -1000, 4061, 3937, 2051,
+	-1000, -1000, -1000, 542, 536, 489, -1000, 640, 5094, 51,
+	3136, -1000, -1000, 50, 49, -1000, -1000, 3937, 6147, 1067,
+	203, 5084, 488, 567, 2431, 3937, 669, -1000, 2431, 459,
+	618, 2051, 5029, 585, 396, 2051, 2051, -1000, -1000, 2251,
+	285, 370, 44, 40, 37, -1000, 1062, -1000, 47, 6147,
+	1037, 653, 486, -1000, 4980, -1000, 584, -1000, -1000, -1000,
+	2051, 566, 3937, 2051, 480, 479, 377, -1000, 721, -1000,
+	-1000, -1000, -1000, -1000, -1000, 6147, -1000, 32, 189, -1000,
+	651, 2431, -1000, 3937, 544, 476, 2051, 4957, 474, 613,
+	612, 157, -1000, 751, 714, 710, 709, 703, 684, -1000,
+	1027, 47, 6147, -1000, 637, 4778, 470, 508, 2051, 3937,
+	668, -1000, 2051, 457, -1000, -1000, 380, 755, 702, -1000,
+	700, 691, 689, 683, -1000, -1000, -1000, -1000, -1000, 47,
+	-1000, 22, -1000, 2431, 650, 469, -1000, 3251, -1000, 581,
+	-1000, -8, 730, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1026, -1000, 648, 2051, -1000, 3937, -1000, -1000,
+	692, -1000, 47, -1000, 632, 3087, -1000, -1000, -1000, 2051,
 }
 var yyPgo = [...]int{
 
-	0, 58, 38, 26, 65, 97, 89, 1145, 28, 1144,
-	24, 1134, 1133, 1125, 1124, 55, 6, 1123, 1121, 1120,
-	1119, 1118, 1117, 1113, 75, 35, 41, 1112, 1111, 52,
-	1110, 1109, 60, 51, 1108, 1105, 1104, 1103, 1102, 714,
-	92, 85, 1101, 71, 61, 1098, 1097, 27, 1096, 62,
-	1095, 30, 1094, 80, 1092, 99, 88, 146, 0, 68,
-	49, 16, 13, 1090, 1089, 1088, 1087, 1051, 1086, 94,
-	1085, 1084, 1081, 32, 1080, 1079, 1078, 5, 18, 53,
-	14, 1077, 1073, 2, 1072, 1070, 77, 82, 91, 1068,
-	31, 1065, 36, 1062, 1061, 1060, 9, 37, 1058, 50,
-	17, 73, 23, 72, 1056, 1055, 1053, 57, 1043, 34,
-	74, 10, 33, 7, 8, 1, 3, 63, 1042, 12,
-	1038, 11, 1036, 4, 1035, 973, 109, 15, 19, 1034,
-	93, 968, 1032, 306, 76, 70, 54, 69, 81, 1031,
-	56, 792,
+	0, 65, 101, 69, 232, 278, 290, 1282, 60, 1281,
+	32, 1280, 1269, 1254, 1253, 211, 109, 1252, 1251, 1249,
+	1248, 1247, 1245, 1243, 82, 28, 34, 1242, 1239, 43,
+	1236, 1235, 45, 40, 1233, 1232, 35, 1231, 1228, 1227,
+	1226, 1225, 1326, 525, 104, 1224, 77, 42, 1223, 1219,
+	1218, 1217, 17, 1216, 62, 1214, 950, 1211, 85, 1207,
+	95, 94, 30, 0, 68, 38, 39, 21, 26, 12,
+	1206, 1204, 1203, 1201, 272, 1200, 89, 1198, 1197, 1194,
+	1350, 1192, 70, 1191, 29, 20, 1190, 16, 6, 1177,
+	1175, 123, 1174, 1171, 22, 1163, 4, 1160, 1159, 81,
+	79, 83, 74, 188, 1158, 1152, 31, 1151, 1150, 1147,
+	13, 41, 1146, 5, 44, 72, 27, 1145, 59, 1144,
+	37, 50, 80, 46, 14, 1142, 1137, 1136, 3, 1135,
+	1133, 1130, 18, 23, 78, 11, 19, 7, 9, 1,
+	10, 67, 1128, 15, 1124, 8, 1120, 2, 1119, 822,
+	71, 142, 36, 730, 1116, 92, 988, 1115, 281, 84,
+	73, 63, 64, 90, 1114, 47, 812,
 }
 var yyR1 = [...]int{
 
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 6, 6, 7, 7, 8,
-	8, 8, 8, 8, 9, 9, 10, 10, 12, 12,
-	11, 11, 11, 11, 11, 13, 13, 13, 13, 13,
-	13, 14, 14, 15, 15, 15, 16, 16, 17, 17,
-	18, 18, 18, 18, 18, 19, 19, 19, 19, 19,
-	19, 20, 20, 20, 20, 21, 21, 21, 21, 21,
-	22, 22, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 24, 24, 25, 25, 26, 26, 26, 26,
-	26, 27, 27, 27, 27, 27, 28, 28, 28, 28,
-	29, 30, 30, 31, 32, 32, 33, 33, 33, 34,
-	34, 34, 34, 34, 35, 35, 35, 35, 35, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 38, 38,
-	38, 39, 40, 40, 40, 40, 41, 41, 42, 43,
-	43, 44, 44, 45, 45, 46, 46, 47, 47, 48,
-	48, 48, 49, 49, 50, 50, 51, 51, 52, 52,
-	53, 53, 54, 54, 54, 54, 54, 54, 55, 56,
-	57, 57, 57, 57, 57, 58, 58, 58, 58, 58,
-	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
-	58, 59, 60, 60, 60, 61, 61, 62, 62, 63,
-	63, 64, 64, 65, 65, 65, 66, 66, 67, 68,
-	69, 69, 69, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 70, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 71, 71, 71, 71, 71, 71, 71, 72,
-	72, 72, 72, 73, 73, 74, 74, 74, 74, 75,
-	75, 75, 75, 75, 76, 76, 77, 77, 77, 77,
-	77, 77, 77, 77, 77, 77, 77, 78, 79, 79,
-	80, 80, 81, 81, 82, 82, 82, 83, 83, 83,
-	84, 84, 85, 85, 86, 86, 87, 87, 87, 89,
-	89, 89, 89, 89, 89, 89, 90, 90, 90, 90,
-	90, 90, 90, 91, 91, 91, 91, 91, 91, 92,
-	92, 93, 93, 94, 94, 94, 95, 96, 96, 97,
-	97, 98, 98, 99, 99, 100, 100, 101, 101, 88,
-	88, 102, 102, 103, 103, 104, 104, 104, 104, 105,
-	106, 107, 107, 108, 108, 109, 109, 110, 110, 111,
-	111, 112, 112, 113, 113, 114, 114, 115, 115, 116,
-	116, 117, 117, 118, 118, 119, 119, 120, 120, 121,
-	121, 122, 122, 123, 123, 124, 124, 125, 125, 125,
-	125, 126, 127, 127, 128, 129, 129, 130, 130, 131,
-	132, 133, 133, 134, 134, 135, 135, 136, 136, 137,
-	137, 138, 138, 139, 139, 140, 140, 141, 141,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 6,
+	6, 7, 7, 8, 8, 8, 8, 8, 9, 9,
+	10, 10, 12, 12, 11, 11, 11, 11, 11, 11,
+	13, 13, 13, 13, 13, 13, 13, 14, 14, 15,
+	15, 15, 16, 16, 17, 17, 18, 18, 18, 18,
+	18, 18, 19, 19, 19, 19, 19, 19, 19, 20,
+	20, 20, 20, 21, 21, 21, 21, 21, 22, 22,
+	23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 117, 117, 117,
+	117, 117, 118, 118, 120, 120, 120, 120, 119, 119,
+	121, 121, 123, 123, 123, 123, 122, 122, 24, 24,
+	25, 25, 26, 26, 26, 26, 26, 27, 27, 27,
+	27, 27, 28, 28, 28, 28, 29, 30, 30, 31,
+	32, 32, 33, 33, 33, 34, 34, 34, 34, 34,
+	35, 35, 35, 36, 36, 37, 37, 37, 37, 38,
+	38, 38, 38, 38, 38, 38, 39, 39, 39, 40,
+	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 41, 41, 41, 42, 43, 43, 43,
+	43, 44, 44, 45, 46, 46, 47, 47, 48, 48,
+	49, 49, 49, 49, 68, 68, 50, 50, 50, 69,
+	69, 51, 51, 92, 92, 93, 94, 94, 52, 52,
+	53, 53, 53, 54, 54, 55, 55, 56, 56, 57,
+	57, 58, 58, 59, 59, 59, 59, 59, 59, 59,
+	60, 61, 62, 62, 62, 62, 62, 63, 63, 63,
+	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
+	63, 63, 63, 64, 65, 65, 65, 66, 66, 67,
+	67, 70, 70, 71, 71, 72, 72, 72, 73, 73,
+	74, 75, 76, 76, 76, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 78, 78, 78, 78,
+	78, 78, 78, 79, 79, 79, 79, 80, 80, 81,
+	81, 81, 81, 81, 81, 82, 82, 82, 82, 82,
+	82, 83, 83, 84, 84, 85, 85, 85, 85, 85,
+	85, 85, 85, 85, 85, 85, 85, 85, 91, 91,
+	86, 87, 87, 88, 88, 89, 89, 90, 90, 90,
+	95, 95, 95, 95, 95, 96, 96, 96, 96, 96,
+	96, 96, 97, 97, 98, 98, 99, 99, 100, 100,
+	100, 102, 102, 102, 102, 102, 102, 102, 102, 103,
+	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
+	103, 103, 104, 104, 104, 104, 104, 104, 105, 105,
+	106, 106, 107, 107, 108, 108, 108, 109, 110, 110,
+	111, 111, 112, 112, 113, 113, 114, 114, 115, 115,
+	101, 101, 116, 116, 124, 124, 125, 125, 125, 125,
+	126, 127, 128, 128, 129, 129, 130, 130, 131, 131,
+	131, 131, 131, 131, 131, 131, 132, 132, 133, 133,
+	134, 134, 135, 135, 136, 136, 137, 137, 138, 138,
+	139, 139, 140, 140, 141, 141, 142, 142, 143, 143,
+	144, 144, 145, 145, 146, 146, 147, 147, 148, 148,
+	149, 149, 149, 149, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 151,
+	152, 152, 153, 154, 154, 155, 155, 156, 157, 158,
+	158, 159, 159, 160, 160, 161, 161, 162, 162, 163,
+	163, 164, 164, 165, 165, 166, 166,
 }
 var yyR2 = [...]int{
 
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
+	1, 2, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 6, 8, 8, 9, 9, 1, 1,
+	1, 2, 1, 1, 7, 8, 6, 6, 1, 1,
+	7, 8, 6, 6, 1, 1, 1, 1, 1, 6,
+	8, 8, 1, 2, 1, 1, 7, 8, 6, 6,
+	1, 1, 7, 8, 6, 6, 1, 1, 1, 2,
+	2, 1, 2, 4, 4, 4, 4, 2, 1, 1,
+	6, 8, 5, 8, 6, 8, 5, 6, 5, 7,
+	7, 7, 7, 6, 3, 5, 3, 1, 2, 2,
+	3, 1, 1, 3, 2, 1, 2, 4, 1, 3,
+	1, 2, 5, 4, 5, 4, 1, 3, 1, 3,
+	1, 3, 0, 1, 1, 2, 2, 5, 2, 2,
+	3, 5, 6, 8, 5, 3, 1, 1, 3, 3,
+	1, 3, 1, 1, 3, 9, 10, 10, 12, 3,
+	1, 3, 2, 1, 3, 9, 10, 3, 5, 0,
+	1, 1, 1, 1, 2, 2, 5, 6, 3, 4,
+	4, 4, 4, 4, 4, 2, 2, 2, 2, 4,
+	4, 2, 2, 2, 4, 1, 2, 2, 4, 2,
+	2, 1, 2, 2, 3, 4, 5, 6, 4, 4,
+	4, 1, 1, 3, 0, 2, 0, 2, 0, 3,
+	1, 4, 4, 5, 1, 3, 1, 2, 5, 1,
+	3, 0, 2, 0, 2, 5, 1, 3, 0, 3,
+	0, 3, 4, 0, 2, 0, 2, 0, 2, 6,
+	9, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 1, 6, 1, 3, 1,
+	3, 2, 4, 1, 1, 0, 1, 1, 1, 1,
+	3, 3, 3, 1, 6, 3, 3, 3, 3, 4,
+	4, 5, 6, 6, 3, 4, 4, 3, 4, 3,
+	4, 4, 4, 4, 4, 2, 3, 3, 3, 3,
+	3, 2, 2, 3, 3, 2, 2, 0, 1, 5,
+	3, 4, 4, 4, 4, 6, 6, 6, 6, 6,
+	1, 6, 11, 0, 5, 7, 8, 8, 8, 8,
+	8, 8, 15, 6, 6, 8, 6, 8, 3, 1,
+	2, 1, 5, 0, 3, 2, 5, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 1, 2, 1, 1, 1, 1, 2,
+	3, 1, 6, 6, 4, 4, 6, 6, 8, 1,
+	1, 2, 3, 2, 3, 4, 1, 1, 2, 3,
+	1, 3, 4, 5, 6, 7, 5, 6, 11, 11,
+	2, 4, 1, 1, 1, 3, 1, 5, 0, 1,
+	4, 5, 0, 2, 1, 3, 1, 3, 1, 3,
+	1, 3, 1, 3, 1, 3, 6, 9, 5, 8,
+	7, 3, 1, 3, 5, 6, 8, 10, 6, 8,
+	4, 6, 7, 10, 9, 12, 1, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 6,
-	8, 8, 9, 9, 1, 1, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 1, 1, 6, 8, 8, 1, 2, 1, 1,
-	7, 8, 6, 1, 1, 7, 8, 6, 1, 1,
-	1, 2, 2, 1, 2, 4, 4, 4, 4, 2,
-	1, 1, 6, 8, 5, 6, 8, 5, 7, 7,
-	7, 7, 1, 3, 1, 3, 0, 1, 1, 2,
-	2, 5, 2, 2, 3, 5, 6, 8, 5, 3,
-	1, 1, 3, 3, 1, 3, 1, 1, 3, 9,
-	10, 10, 12, 3, 0, 1, 1, 1, 1, 2,
-	2, 5, 6, 3, 4, 4, 4, 4, 4, 4,
-	2, 2, 2, 2, 4, 4, 2, 2, 2, 4,
-	1, 2, 2, 4, 2, 2, 1, 2, 2, 3,
-	4, 5, 5, 4, 4, 4, 1, 1, 3, 0,
-	2, 0, 2, 0, 3, 0, 2, 0, 3, 0,
-	3, 4, 0, 2, 0, 2, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 1, 6, 1, 3, 1, 3, 2,
-	4, 1, 1, 0, 1, 1, 1, 1, 3, 3,
-	3, 1, 6, 3, 3, 3, 3, 4, 4, 5,
-	6, 6, 3, 4, 4, 3, 4, 4, 4, 4,
-	4, 2, 3, 3, 3, 3, 3, 2, 2, 3,
-	3, 2, 2, 0, 1, 4, 3, 4, 4, 5,
-	5, 5, 5, 1, 5, 10, 8, 9, 9, 9,
-	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
-	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
-	2, 1, 2, 1, 1, 1, 1, 2, 3, 1,
-	6, 6, 4, 6, 6, 8, 1, 1, 2, 3,
-	1, 1, 3, 4, 5, 6, 7, 5, 6, 2,
-	4, 1, 1, 1, 3, 1, 5, 0, 1, 4,
-	5, 0, 2, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 1, 3, 6, 9, 5, 8, 7,
-	3, 1, 3, 5, 6, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 0, 1, 0, 1, 0, 1, 0, 1, 1,
-	1, 0, 1, 0, 1, 0, 1, 1, 1,
+	1, 3, 3, 1, 3, 1, 3, 1, 1, 0,
+	1, 0, 1, 0, 1, 0, 1, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -39, -104, -105, -108, -23,
-	-20, -21, -27, -28, -34, -22, -37, -38, -58, 15,
-	85, 84, -8, -10, -51, 30, 33, 129, 93, -128,
-	99, 19, 20, 97, 98, 96, 107, 108, 31, 120,
-	130, 112, 113, 114, 115, 116, 121, 117, 118, 119,
-	122, -57, -54, -71, -68, -67, -74, -75, -95, -70,
-	-72, -126, -131, -132, -36, 154, 87, 111, 77, -125,
-	28, 5, 6, 7, -55, 10, -56, 151, 152, 137,
-	138, 136, -76, -60, 67, 71, 153, 11, 13, 14,
-	94, 4, 131, 132, 133, 9, 75, 139, 134, 148,
-	144, 143, 150, 74, 72, 71, 68, 73, -141, 152,
-	151, 149, 156, 157, 70, 69, -58, 154, -128, 85,
-	84, -96, -58, -40, 23, 18, 21, -42, -41, 16,
-	-67, 154, 34, 34, -130, -129, -126, -130, -125, -126,
-	94, 42, 123, -131, 12, -131, -125, -125, -35, 100,
-	101, 35, 36, 102, 103, -58, -58, 12, -125, -58,
-	-58, -58, -125, -58, -58, -100, -58, -125, -58, -125,
-	-125, 145, -58, -100, -39, -51, -58, -126, -127, -9,
-	129, 93, 6, -53, -52, -139, 29, 159, 154, 159,
-	-58, -58, 154, 154, 154, 143, 150, -134, -141, 71,
-	-67, -58, -58, -125, 154, 154, -1, -58, -58, -58,
-	-134, -58, 72, 68, 73, -60, 154, -67, -58, 66,
-	65, -58, -58, -58, -58, -58, -58, -58, 89, -100,
-	-73, 154, -96, -117, -97, 88, -47, 43, 24, -88,
-	-86, -125, 28, 17, -88, -43, 17, 62, 63, 64,
-	-133, 76, -125, -86, 158, 145, 94, 42, 123, 124,
-	-125, -125, -125, 150, 41, 150, 41, -125, -58, -58,
-	41, 17, 17, 158, 60, 60, 158, -58, 6, -58,
-	155, 155, 155, 91, 68, 158, 68, -126, -127, 158,
-	-125, -125, 6, -73, -133, -100, -125, 6, 155, -103,
-	-94, -93, -59, -58, -77, 149, -125, 138, 136, 139,
-	140, 141, 142, -133, -133, -60, -60, 72, 68, 66,
-	65, 74, 136, -133, -58, -55, -56, 69, -58, -60,
-	-58, -60, -60, -1, 155, 88, -118, 90, -98, 90,
-	-58, -48, 49, 46, -87, -86, 19, 158, -101, -90,
-	-87, -89, -91, 27, 154, -67, 135, -125, 17, -44,
-	22, -101, -138, 65, -138, -138, -103, 154, -140, 26,
-	31, 32, 40, 19, -130, -58, 95, 154, 26, 154,
-	154, -58, -125, -58, -125, -125, -58, -125, -58, 24,
-	12, 12, -125, -100, -100, -100, -100, -58, -2, -12,
-	-5, -13, 85, 84, -8, -10, -6, 109, 110, -125,
-	-127, -126, -125, 68, 68, -53, 26, 154, 155, -73,
-	155, 158, 26, 154, 154, 154, 154, 154, 154, 154,
-	-73, -73, -59, -60, -69, 154, -67, 134, -69, -69,
-	-134, -73, 158, -58, 69, -110, -109, 90, 86, -58,
-	92, -1, 92, -58, 89, -50, 50, -58, -62, -63,
-	-64, -58, -77, 25, 154, -39, -125, 26, -107, -106,
-	-57, -125, -88, -44, 58, -135, -137, 57, 61, 158,
-	53, 55, 56, -125, 26, -90, 154, 154, -101, -45,
-	44, -58, -41, -40, -41, -41, -102, -125, -39, -24,
-	154, -125, -57, 154, -57, -125, -39, -102, -39, 155,
-	-33, -30, -32, -29, -31, -126, -125, -127, 92, 148,
-	-58, -96, 91, 91, -125, -125, 154, -102, 155, -103,
-	-125, -73, -133, -133, -133, -133, -73, -73, -73, 155,
-	155, 155, 69, -61, -60, 154, 97, 68, 155, -58,
-	-58, 92, -110, -1, -58, 89, 84, -58, -1, -58,
-	-49, 51, 77, 158, -65, 47, 48, -61, -99, -57,
-	-125, -43, 158, 150, 52, 52, -136, 54, -136, -135,
-	-137, -101, -125, 155, -58, -125, -58, -44, -46, 45,
-	46, 155, 158, -26, 35, 36, 37, 38, -25, -24,
-	39, -99, 41, 41, 155, 26, 155, 158, 158, 39,
-	155, 158, 87, -2, 89, -119, 88, -2, -2, 91,
-	91, -39, 155, 155, -73, -73, -73, -59, -73, 155,
-	155, 155, -60, 155, 158, -58, 78, 128, 155, 85,
-	92, 89, -58, -97, -117, 88, -49, 131, -62, 132,
-	155, 158, -44, -107, -58, -90, -90, 52, 52, 52,
-	-136, 158, 155, 158, 158, -58, -100, -140, -102, -57,
-	-57, 155, 158, -58, 155, -125, -125, -58, 26, 125,
-	26, -29, -32, -32, -126, -58, 26, -33, -2, -120,
-	90, -58, 92, 92, -2, -2, 155, 26, 106, 155,
-	155, 155, 155, 155, 106, 106, 127, 106, 127, -61,
-	158, 44, 85, -1, -58, -66, 35, 36, 25, -39,
-	-99, -92, 59, 60, -90, -90, -90, 52, -125, -58,
-	-73, -125, -39, -26, -25, -39, -3, -14, -5, -18,
-	85, 84, -15, -16, 87, 126, 125, 125, 155, -112,
-	-111, 90, 86, 92, -2, 89, 87, 87, 92, 92,
-	154, 154, 106, 106, 106, 106, 106, 154, 154, 132,
-	154, 132, -58, 154, -109, 89, -61, -58, 154, -92,
-	59, -90, 155, 155, 155, 155, 158, 92, 148, -58,
-	-96, -58, -126, -127, -58, -3, -3, 26, 92, -112,
-	-2, -58, 84, -2, 87, 87, -39, -79, -78, -80,
-	105, 154, 154, 154, 154, 154, -78, -80, -79, 106,
-	-78, 106, 155, -47, -102, -58, -73, -3, 89, -121,
-	88, 91, 68, 68, 92, 92, 125, 85, 92, 89,
-	-119, 88, 155, 155, -47, 43, 46, -79, -79, -79,
-	-79, -78, 155, 155, 154, 155, 154, 155, 155, 155,
-	-3, -122, 90, -58, -4, -17, -5, -19, 85, 84,
-	-15, -16, -6, -125, -125, -3, 85, -2, -58, 46,
-	-100, 155, 155, 155, 155, 155, -79, -78, -114, -113,
-	90, 86, 92, -3, 89, 92, 148, -58, -96, 91,
-	91, 92, -111, 89, -62, 155, 155, 92, -114, -3,
-	-58, 84, -3, 87, -4, 89, -123, 88, -4, -4,
-	-81, 133, 85, 92, 89, -121, 88, -4, -124, 90,
-	-58, 92, 92, -82, 72, 79, 6, 82, 85, -3,
-	-58, -116, -115, 90, 86, 92, -4, 89, 87, 87,
-	-84, 79, -83, 6, 82, 80, 80, 83, -113, 89,
-	92, -116, -4, -58, 84, -4, 69, 80, 80, 81,
-	83, 85, 92, 89, -123, 88, -85, 79, -83, 85,
-	-4, -58, 81, -115, 89,
+	-1000, -1, -7, -5, -11, -42, 154, -125, -126, -129,
+	-130, -23, -20, -21, -27, -28, -34, -37, -22, -40,
+	-41, -63, 16, 101, 100, 109, -8, -10, -56, 148,
+	93, 31, 34, 33, 50, 152, 111, -153, 117, 20,
+	21, 115, 116, 114, 146, 125, 126, 32, 138, 153,
+	130, 131, 132, 133, 134, 139, 135, 136, 137, 140,
+	-62, -59, -78, -75, -74, -81, -82, -109, -77, -79,
+	-151, -156, -157, -39, 183, 103, 129, -149, 29, 5,
+	6, 7, -60, 10, 11, -61, 180, 181, 165, 60,
+	166, 164, -83, -65, 82, 86, 182, 12, 14, 15,
+	112, -150, 74, 75, 160, 9, 91, 167, 162, 4,
+	156, 157, 158, 159, 47, 44, 45, 46, 48, 49,
+	61, 62, 63, 76, 89, 145, 147, 149, 155, 161,
+	177, -42, 155, -56, 93, 173, 172, 179, 90, 87,
+	86, 83, 88, 89, -166, 181, 180, 178, 185, 186,
+	85, 84, -63, 183, -153, 101, 146, 152, 100, 50,
+	148, -110, -63, -1, -43, 24, 19, 22, -45, -44,
+	17, -74, 183, 25, -58, -57, -164, 30, 35, 44,
+	35, 35, 35, -155, 183, -154, -151, -155, -149, 60,
+	146, -151, 112, 43, 141, 145, -156, 13, -156, -149,
+	-149, -38, 118, 119, 36, 37, 120, 121, -149, 183,
+	-63, -63, 13, -149, -63, -63, -63, -149, -63, -63,
+	-114, -63, -149, -63, -149, -149, 174, -63, -114, -42,
+	-63, -151, -152, -9, 152, 111, 6, 188, 183, 188,
+	-63, -63, 183, 183, 183, 183, 172, 179, -159, -166,
+	86, -74, -63, -63, -149, 183, 183, -1, -42, -58,
+	-63, -63, -63, -159, -63, 87, 83, 88, 89, -65,
+	183, -74, -63, -63, 81, 80, -63, -63, -63, -63,
+	-63, -63, -63, 105, -114, -80, 183, -110, -141, -111,
+	104, 110, -52, 51, 25, -101, -99, -149, 29, 18,
+	-101, -46, 18, 77, 78, 79, -158, 92, -100, -99,
+	148, 187, -149, -149, -149, -99, -99, 101, -99, -158,
+	187, 174, 112, 43, 141, 142, 145, -149, -149, -149,
+	-149, 179, 42, 179, 42, -149, -63, -63, 183, -80,
+	-114, 42, 18, 18, 187, 72, 72, 187, -63, 6,
+	-63, 184, 184, 184, 107, 83, 187, 83, -151, -152,
+	-149, 6, -80, -158, -149, 6, 184, -124, -108, -107,
+	-64, -63, -85, 178, -149, 166, 164, 152, 167, 168,
+	169, 170, -80, -158, -158, -65, -65, 87, 83, 81,
+	80, 90, 164, -158, -63, -60, -61, 84, -63, -65,
+	-63, -63, -65, -65, -1, 184, 104, -142, 106, -112,
+	106, -63, -1, -53, 57, 54, -100, 20, 187, -115,
+	-103, -100, -102, 76, -104, -105, 28, 183, -74, 163,
+	171, -149, 18, -47, 23, -115, -163, 80, -163, -163,
+	-124, 72, -150, 27, 25, -58, 27, 183, 183, -165,
+	27, 71, 32, 33, 41, 20, 82, -80, -155, -63,
+	113, 183, 27, 183, 183, 183, -63, -149, -63, -149,
+	-149, -63, -149, -63, 25, -80, 184, 13, 13, -149,
+	-114, -114, -114, -114, -63, -2, -12, -5, -13, 101,
+	100, 109, -8, -10, -6, 127, 128, -149, -152, -151,
+	-149, 83, 83, 184, -80, 184, 187, 27, 183, 183,
+	183, 183, 183, 183, 183, 183, 184, -80, -80, -64,
+	-65, -76, 183, -74, 162, -76, -76, -159, -80, 187,
+	-63, 84, -134, -133, 106, 102, -63, 108, -1, 108,
+	-63, 105, 108, -55, 58, -63, -67, -70, -71, -63,
+	-85, 26, 183, -42, -128, -127, -62, -149, -101, -47,
+	70, -160, -162, 69, 73, 74, 75, 187, 65, 67,
+	68, -150, 27, -102, -149, -150, 27, -103, 183, 183,
+	183, -115, -48, 52, -63, -44, -43, -44, -44, -103,
+	-149, -100, 183, -116, -149, -118, -117, -149, -122, -123,
+	45, 46, 48, 49, 86, -42, -149, -24, 183, -122,
+	-149, 45, -62, 183, -62, 42, -149, -99, 184, -42,
+	-116, -42, 184, -33, -30, -32, -29, -31, -151, -149,
+	184, -36, -35, -151, 147, -152, 184, 108, 177, -63,
+	-110, -2, 107, 107, -149, -149, -84, 161, 184, -124,
+	-149, -80, -158, -158, -158, -158, -158, -80, -80, -80,
+	184, 184, 184, 84, -66, -65, 183, 115, 83, 184,
+	-63, -63, 108, -134, -1, -63, 105, 100, -63, -1,
+	109, -63, -54, 59, 93, 187, -72, 55, 56, -66,
+	-113, -62, -46, 187, 179, 64, 64, -161, 66, -161,
+	-160, -162, 183, 183, -115, -149, -150, 27, -149, 184,
+	-63, -80, -149, -63, -47, -51, 53, 54, 71, 72,
+	-42, 184, 187, 184, 187, -149, -121, -119, 45, 46,
+	48, 49, -120, 86, -149, 47, 183, 183, 91, 183,
+	-26, 36, 37, 38, 39, -25, -24, 40, -149, -113,
+	42, -149, 42, -84, 184, 27, 184, 187, 187, 40,
+	184, 187, 27, 184, 187, 40, -151, 103, -2, 105,
+	-143, 104, 110, -2, -2, 107, 107, 183, -84, 184,
+	-80, -80, -80, -64, -80, -80, 184, 184, 184, -84,
+	-84, -84, -65, 184, 187, -63, 94, -84, 151, 184,
+	101, 108, 105, -63, -111, -141, 104, -54, 156, -67,
+	157, 184, 187, -47, -128, -63, -103, -103, 64, 64,
+	64, -161, -82, -149, -149, -149, 187, 184, 184, 187,
+	187, -92, 160, -63, -68, -49, -63, 62, 63, 60,
+	-63, -103, 184, 27, -116, -165, -118, -121, 45, 46,
+	48, 49, -121, -149, 47, 183, 91, -123, 46, 48,
+	49, 183, -116, -63, 183, -116, -62, -62, 184, 187,
+	-63, 184, -149, -149, -63, 27, 143, 27, -29, -32,
+	-32, -151, -63, 27, -33, 143, 27, -36, -63, -2,
+	-144, 106, -63, -2, 108, 108, -2, -2, 23, -84,
+	184, 184, 184, 184, 184, 184, 124, 124, 150, 124,
+	150, -66, 187, 52, 101, -1, -63, -73, 36, 37,
+	26, -42, -113, -106, 71, 72, -103, -103, -103, 64,
+	113, 183, 113, -149, -63, -80, -149, -94, -93, -149,
+	187, 183, 183, 61, -132, -131, 104, 71, 183, -42,
+	-120, -63, -116, 184, 184, -116, 184, -26, -25, -42,
+	-3, -14, -5, -18, 101, 100, 109, -15, -16, 103,
+	144, 143, 143, 184, -3, 143, -136, -135, 106, 102,
+	108, -2, 105, 108, 103, 103, 108, 108, -63, 124,
+	-84, -84, -84, -84, -84, -84, 151, -91, 183, -149,
+	-91, 157, -91, 157, -63, 183, -133, 105, -66, -63,
+	183, -106, 71, -103, -62, -149, 184, 184, 184, 184,
+	187, 187, 27, -68, -114, -114, 183, -132, 149, 86,
+	-63, -42, 184, 184, 184, 108, 177, -63, -110, -3,
+	-63, -151, -152, -63, -3, -3, 27, 108, -3, 108,
+	-136, -2, -63, 100, -2, 109, 103, 103, 184, -91,
+	124, 124, 124, 124, 124, 124, 52, -87, -86, -88,
+	123, 124, 124, 184, -52, -116, -63, 83, 83, -80,
+	-94, 183, 184, 184, -69, -50, -63, 183, 105, 84,
+	149, -132, 184, -3, 105, -145, 104, 110, 107, 83,
+	83, 108, 108, 143, 108, 101, 108, 105, -143, 104,
+	-91, -91, -91, -91, -91, -91, 183, 184, -52, 51,
+	54, -91, -91, 184, 184, 183, 183, 184, -87, 184,
+	187, 184, -63, 19, 22, -63, 105, 84, -3, -146,
+	106, -63, -3, -4, -17, -5, -19, 101, 100, 109,
+	-15, -16, -6, -149, -149, -3, 101, -2, -63, -52,
+	54, -114, -84, -124, -124, 184, -69, 187, 20, 105,
+	24, -63, -138, -137, 106, 102, 108, -3, 105, 108,
+	108, 177, -63, -110, -4, 107, 107, 108, -135, 105,
+	184, -67, 184, 184, -114, -128, 19, 22, 26, 183,
+	105, 108, -138, -3, -63, 100, -3, 109, 103, -4,
+	105, -147, 104, 110, -4, -4, -84, -89, -90, 158,
+	94, 159, 184, 184, 184, 20, -65, -113, 24, 101,
+	108, 105, -145, 104, -4, -148, 106, -63, -4, 108,
+	108, 124, -95, 87, 95, 6, 7, 11, 98, -128,
+	184, 26, 183, 101, -3, -63, -140, -139, 106, 102,
+	108, -4, 105, 108, 103, 103, 183, -97, 95, -96,
+	6, 7, 11, 98, 96, 96, 96, 96, 99, 26,
+	-65, -113, -137, 105, 108, -140, -4, -63, 100, -4,
+	109, -88, 84, 96, 96, 97, 96, 97, 96, 97,
+	99, -65, 184, 101, 108, 105, -147, 104, 184, -98,
+	95, -96, 26, 101, -4, -63, 97, -65, -139, 105,
 }
 var yyDef = [...]int{
 
-	-2, -2, 2, 27, 28, 10, 11, 12, 13, 14,
-	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	0, 347, 43, 44, 0, 0, 0, 0, 0, -2,
-	0, 0, 0, 0, 0, 124, 80, 81, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 0, 156, 0,
-	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 217, 218, 219, 186, 0, 36, 433, 200,
-	0, 192, 193, 194, 195, 196, 197, 0, 0, 0,
-	0, 0, 283, 423, 0, 0, 0, 411, 419, 420,
-	0, 407, 408, 409, 410, 198, 199, 0, 0, -2,
-	0, 437, 438, 423, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, -2, 216, 0,
-	347, 0, 348, -2, 0, 0, 0, 169, 0, 421,
-	167, 186, 0, 0, 71, 417, 415, 72, 0, 74,
-	0, 0, 0, 0, 0, 79, 102, 103, 0, 125,
-	126, 127, 128, 0, 0, 0, 0, 140, 152, 141,
-	142, 143, -2, 147, 148, 151, 355, -2, 155, 157,
-	158, 0, 0, 0, 0, 0, 0, 215, 0, 0,
-	34, 35, 37, 187, 190, 0, 434, 0, 273, 0,
-	267, 268, 0, 421, 421, 437, 438, 0, 0, 424,
-	261, 271, 272, 0, 421, 0, 3, 239, -2, -2,
-	0, 0, 0, 0, 0, 252, 186, 223, -2, 0,
-	0, 262, 263, 264, 265, 266, 269, 270, -2, 0,
-	0, 273, 0, 393, 351, 0, 179, 0, 0, 0,
-	359, 314, 315, 0, 0, 171, 0, 431, 431, 431,
-	0, 422, 435, 0, 0, 0, 0, 0, 0, 0,
-	104, 109, 123, 0, 0, 0, 0, 0, 129, 130,
-	0, 0, 0, 0, 0, 0, 0, 159, 193, 414,
-	220, 222, 238, -2, 0, 0, 0, 0, 0, 433,
-	0, 201, 203, 0, 273, 274, 202, 204, 276, 0,
-	363, 343, 345, 341, 342, 221, 200, 0, 0, 0,
-	0, 0, 0, 273, 273, 244, 246, 0, 0, 0,
-	0, 423, 133, 273, 0, 247, 248, 0, 0, 253,
-	-2, 257, 259, 377, 278, 0, 0, -2, 0, 0,
-	0, 184, 0, 0, 186, 316, 0, 0, 171, -2,
-	326, 327, 330, 331, 186, 319, 0, 314, 0, 173,
-	0, 170, 0, 432, 0, 0, 168, 0, 186, 436,
-	0, 0, 0, 0, 418, 416, 186, 0, 186, 0,
-	0, 75, -2, 77, -2, -2, 135, -2, 137, 0,
-	138, 139, 153, 144, 145, 149, 356, 160, 0, 0,
-	38, 39, 0, 347, 48, 49, 50, 25, 26, 0,
-	413, 412, 0, 0, 0, 191, 0, 0, 275, 0,
-	277, 0, 0, 273, 421, 421, 421, 273, 273, 273,
-	0, 0, 0, 0, 254, 186, 241, 0, 258, 260,
-	0, 0, 0, 249, 0, 0, 377, -2, 0, 0,
-	0, 394, 346, 352, -2, 161, 0, 182, 178, 227,
-	233, 231, 232, 0, 0, 367, 317, 0, 169, 371,
-	0, 200, 360, 373, 0, 0, 427, 427, 425, 0,
-	426, 429, 430, 328, 0, 425, 0, 0, 171, 175,
-	0, 172, 163, 166, 164, 165, 0, 361, 84, 96,
-	0, 92, 87, 0, 0, 0, 101, 0, 108, 0,
-	0, 116, 117, 111, 114, 110, 0, 105, 0, -2,
-	0, 0, -2, -2, 0, 0, 186, 0, 279, 364,
-	344, 0, 273, 273, 273, 273, 0, 0, 0, 280,
-	281, 282, 0, 0, 225, 0, 131, 0, 284, 0,
-	250, 0, 0, 378, 0, 0, 42, 23, 391, 185,
-	180, 182, 0, 0, 229, 234, 235, 365, 0, 353,
-	318, 171, 0, 0, 0, 0, 0, 428, 0, 0,
-	427, 358, 329, 332, 0, 200, 0, 374, 162, 0,
-	0, -2, 0, 85, 97, 98, 0, 0, 0, 94,
-	0, 0, 0, 0, 106, 0, 0, 0, 0, 0,
-	0, 0, 29, 5, -2, 397, 0, 0, 0, -2,
-	-2, 0, 0, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 251, 240, 0, 0, 132, 0, 224, 40,
-	0, -2, 349, 350, 392, 0, 181, 183, 228, 0,
-	186, 0, 369, 372, 370, 333, 425, 0, 0, 0,
-	0, 0, 322, 273, 0, 176, 174, 186, 362, 99,
-	100, 96, 0, 93, 88, 89, -2, 91, 186, -2,
-	0, 112, 118, 115, 0, 113, 0, 0, 381, 0,
-	-2, 0, 0, 0, 0, 0, 188, 0, 0, 279,
-	280, 281, 282, 284, 0, 0, 0, 0, 0, 226,
-	0, 0, 41, 375, 0, 230, 236, 237, 0, 368,
-	354, 334, 0, 0, 425, 425, 337, 0, 200, 0,
-	0, 0, 83, 86, 95, 107, 0, 0, 51, 52,
-	0, 347, 63, 64, 0, 56, -2, -2, 0, 0,
-	381, -2, 0, 0, 398, -2, 30, 31, 0, 0,
-	186, 300, 0, 0, 0, 0, 0, 300, 300, 0,
-	300, 0, 0, 177, 376, -2, 366, 339, 0, 335,
-	0, 338, 320, 321, 323, 324, 273, 119, -2, 0,
-	0, 0, 215, 0, 57, 0, 0, 0, 0, 0,
-	382, 0, 47, 395, 32, 33, 0, 0, 298, 177,
-	0, 300, 300, 300, 300, 300, 0, 177, 0, 0,
-	0, 0, 242, 0, 0, 336, 0, 7, -2, 401,
-	0, -2, 0, 0, 120, 121, -2, 45, 0, -2,
-	396, 0, 189, 286, 297, 0, 0, 0, 0, 0,
-	0, 0, 292, 293, 300, 295, 300, 285, 340, 325,
-	385, 0, -2, 0, 0, 0, 58, 59, 0, 347,
-	68, 69, 70, 0, 0, 0, 46, 379, 0, 0,
-	301, 287, 288, 289, 290, 291, 0, 0, 0, 385,
-	-2, 0, 0, 402, -2, 0, -2, 0, 0, -2,
-	-2, 122, 380, -2, 178, 294, 296, 0, 0, 386,
-	0, 62, 399, 53, 9, -2, 405, 0, 0, 0,
-	299, 0, 60, 0, -2, 400, 0, 389, 0, -2,
-	0, 0, 0, 302, 0, 0, 0, 0, 61, 383,
-	0, 0, 389, -2, 0, 0, 406, -2, 54, 55,
-	0, 0, 311, 0, 0, 304, 305, 306, 384, -2,
-	0, 0, 390, 0, 67, 403, 0, 310, 307, 308,
-	309, 65, 0, -2, 404, 0, 303, 0, 313, 66,
-	387, 0, 312, 388, -2,
+	-2, -2, 2, 31, 32, 10, 247, 13, 14, 15,
+	16, 17, 18, 19, 20, 21, 22, 23, 24, 25,
+	26, 27, 28, 0, 438, -2, 48, 49, 0, 538,
+	561, 0, 0, 0, 525, 0, 0, -2, 0, 0,
+	0, 0, 0, 169, 533, 88, 89, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 0, 201, 0, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 279, 280, 281, 247, 0, 40, 262, 0, 253,
+	254, 255, 256, 257, 258, 259, 0, 0, 0, 526,
+	0, 0, 350, 551, 0, 0, 0, 539, 547, 548,
+	0, 510, 511, 512, 513, 260, 261, 0, 0, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	527, 528, 529, 530, 531, 532, 534, 535, 536, 537,
+	-2, 11, 247, 0, 561, 0, 565, 566, 551, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, -2, 278, 0, 533, 0, 438, 525,
+	538, 0, 439, 0, -2, 0, 0, 0, 214, 0,
+	549, 212, 247, 0, 248, 251, 0, 562, 0, 0,
+	0, 0, 0, 79, 549, 545, 543, 80, 0, 526,
+	533, 82, 0, 0, 0, 0, 0, 0, 87, 138,
+	139, 0, 170, 171, 172, 173, 0, 0, 0, 337,
+	0, 0, 185, 197, 186, 187, 188, -2, 192, 193,
+	196, 446, -2, 200, 202, 203, 0, 0, 0, 0,
+	0, 277, 0, 0, 38, 39, 41, 0, 337, 0,
+	331, 332, 0, 337, 549, 549, 565, 566, 0, 0,
+	552, 325, 335, 336, 0, 549, 0, 3, 12, 248,
+	301, -2, -2, 0, 0, 0, 0, 0, 0, 314,
+	247, 285, -2, -2, 0, 0, 326, 327, 328, 329,
+	330, 333, 334, -2, 0, 0, 337, 0, 496, 442,
+	0, -2, 240, 0, 0, 0, 450, 396, 397, 0,
+	0, 216, 0, 559, 559, 559, 0, 550, 0, 398,
+	0, 561, 0, 563, 0, 0, 104, 0, 106, 337,
+	0, 0, 0, 0, 0, 0, 0, 140, 145, 159,
+	167, 0, 0, 0, 0, 0, 174, 175, 337, 0,
+	338, 0, 0, 0, 0, 0, 0, 0, 204, 254,
+	542, 282, 284, 300, -2, 0, 0, 0, 0, 0,
+	263, 265, 0, 337, 264, 266, 340, 0, 454, 434,
+	436, 432, 433, 283, 262, 0, 0, 0, 0, 0,
+	0, 0, 0, 337, 337, 306, 308, 0, 0, 0,
+	0, 551, 178, 337, 0, 309, 310, 0, 0, 315,
+	-2, -2, 321, 323, 480, 342, 0, 0, -2, 0,
+	0, 0, 0, 245, 0, 0, 247, 0, 0, 216,
+	-2, 409, 410, 530, 416, 417, 420, 247, 401, 0,
+	0, 396, 0, 218, 0, 215, 0, 560, 0, 0,
+	213, 0, 399, 0, 0, 252, 0, 0, 0, 247,
+	564, 0, 0, 0, 0, 0, 0, 0, 546, 544,
+	247, 0, 247, 0, 0, 0, 83, -2, 85, -2,
+	-2, 180, -2, 182, 0, 0, 343, 183, 184, 198,
+	189, 190, 194, 447, 205, 0, 0, 42, 43, 0,
+	438, -2, 54, 55, 56, 29, 30, 0, 541, 540,
+	0, 0, 0, 353, 0, 341, 0, 0, 337, 549,
+	549, 549, 549, 337, 337, 337, 344, 0, 0, 0,
+	0, 316, 247, 303, 0, 322, 324, 0, 0, 0,
+	311, 0, 0, 480, -2, 0, 0, 0, 497, 437,
+	443, -2, 0, 206, 0, 243, 239, 289, 295, 293,
+	294, 0, 0, 458, 214, 462, 0, 262, 451, 464,
+	0, 0, 555, 555, 553, 0, 0, 0, 554, 557,
+	558, 411, 0, 413, 0, 418, 0, 553, 0, 337,
+	0, 216, 231, 0, 217, 208, 211, 209, 210, 553,
+	400, 0, 247, 0, 452, 0, 112, 107, 111, 126,
+	521, 522, 523, 524, 0, 92, 0, 132, 0, 96,
+	128, 521, 98, 0, 0, 0, 0, 105, 353, 137,
+	0, 144, 0, 0, 152, 153, 147, 150, 146, 0,
+	0, 0, 163, 160, 0, 141, 168, 0, -2, 0,
+	0, 0, -2, -2, 0, 0, 339, 0, 353, 455,
+	435, 0, 337, 337, 337, 337, 337, 0, 0, 0,
+	353, 353, 353, 0, 0, 287, 0, 176, 0, 353,
+	0, 312, 0, 0, 481, 0, 0, 46, 27, 494,
+	47, 246, 241, 243, 0, 0, 291, 296, 297, 456,
+	0, 444, 216, 0, 0, 0, 0, 0, 556, 0,
+	0, 555, 0, 0, 449, 412, 414, 0, 419, 421,
+	0, 0, 262, 0, 465, 233, 0, 0, 0, 0,
+	0, 0, 0, -2, 0, 108, 109, 120, 521, 522,
+	115, 524, 118, 0, 0, 0, 0, 0, 0, 0,
+	94, 133, 134, 0, 0, 0, 130, 0, 97, 0,
+	0, 103, 0, 349, 142, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 33, 5, -2,
+	500, 0, -2, 0, 0, -2, -2, 0, 345, 353,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 346,
+	347, 348, 313, 302, 0, 0, 177, 351, 0, 286,
+	44, 0, -2, 440, 441, 495, 0, 242, 244, 290,
+	0, 247, 0, 460, 463, 461, 422, 553, 0, 0,
+	0, 0, 0, 0, 0, 415, 0, 404, 405, 337,
+	0, 207, 0, 232, 219, 224, 220, 528, 529, 526,
+	0, 553, 249, 0, 453, 247, 113, 110, 0, 0,
+	115, 0, 121, 0, 114, 0, 116, 127, 0, 0,
+	0, 0, 0, 0, 0, 0, 135, 136, 132, 0,
+	129, 99, 100, -2, 102, 247, -2, 0, 148, 154,
+	151, 0, 149, 0, 0, -2, 0, 164, 161, 484,
+	0, -2, 0, 0, 0, 0, 0, 0, 0, 339,
+	353, 353, 353, 353, 353, 353, 0, 0, 0, 0,
+	0, 288, 0, 0, 45, 478, 0, 292, 298, 299,
+	0, 459, 445, 423, 0, 0, 553, 553, 426, 0,
+	0, 549, 0, 262, 0, 0, 0, 234, 236, 0,
+	0, 0, 0, 0, 466, 476, 0, 0, 247, 91,
+	119, 0, 0, 123, 125, 0, 93, 95, 131, 143,
+	0, 0, 57, 58, 0, 438, -2, 70, 71, 0,
+	62, -2, -2, 0, 0, -2, 0, 484, -2, 0,
+	0, 501, -2, 0, 34, 35, 0, 0, 0, 0,
+	345, 346, 347, 348, 349, 351, 0, 363, 373, 369,
+	364, 0, 366, 0, 0, 238, 479, -2, 457, 430,
+	0, 424, 0, 427, 0, 0, 402, 403, 406, 407,
+	337, 0, 0, 225, 0, 0, 0, 477, 0, 0,
+	0, 0, 117, 122, 124, 155, -2, 0, 0, 0,
+	0, 277, 0, 63, 0, 0, 0, 165, 0, 0,
+	0, 485, 0, 52, 498, 53, 36, 37, 354, 355,
+	0, 0, 0, 0, 0, 0, 0, 0, 371, 238,
+	0, 0, 0, 304, 0, 0, 425, 0, 0, 0,
+	237, 373, 221, 222, 0, 229, 226, 247, 0, 0,
+	0, 467, 250, 7, -2, 504, 0, -2, -2, 0,
+	0, 156, 157, -2, 166, 50, 0, -2, 499, 0,
+	356, 357, 358, 359, 360, 361, 238, 368, 370, 0,
+	0, 365, 367, 353, 431, 0, 0, 408, 0, 223,
+	0, 227, 0, 0, 470, 0, 0, 0, 488, 0,
+	-2, 0, 0, 0, 0, 64, 65, 0, 438, -2,
+	76, 77, 78, 0, 0, 0, 51, 482, 0, 0,
+	0, 374, 352, 0, 0, 235, 230, 0, 0, 0,
+	0, 0, 0, 488, -2, 0, 0, 505, -2, 0,
+	0, -2, 0, 0, 0, -2, -2, 158, 483, -2,
+	353, 239, 0, 0, 0, 468, 0, 471, 0, 0,
+	0, 0, 0, 489, 0, 68, 502, 69, 59, 9,
+	-2, 508, 0, -2, 0, 0, 352, 372, 0, 377,
+	378, 379, 428, 429, 228, 0, 472, 0, 0, 66,
+	0, -2, 503, 0, 492, 0, -2, 0, 0, 0,
+	0, 0, 375, 0, 0, 0, 0, 0, 0, 469,
+	0, 0, 0, 67, 486, 0, 0, 492, -2, 0,
+	0, 509, -2, 0, 60, 61, 373, 0, 0, 393,
+	0, 0, 0, 0, 380, 381, 382, 383, 384, 0,
+	474, 0, 487, -2, 0, 0, 493, 0, 74, 506,
+	75, 0, 0, 392, 385, 388, 386, 389, 387, 390,
+	391, 473, 0, 72, 0, -2, 507, 0, 362, 376,
+	0, 395, 0, 73, 490, 0, 394, 475, 491, -2,
 }
 var yyTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 153, 3, 3, 3, 157, 3, 3,
-	154, 155, 149, 152, 158, 151, 159, 156, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 148,
-	3, 150,
+	3, 3, 3, 182, 3, 3, 3, 186, 3, 3,
+	183, 184, 178, 181, 187, 180, 188, 185, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 177,
+	3, 179,
 }
 var yyTok2 = [...]int{
 
@@ -1473,7 +2063,10 @@ var yyTok2 = [...]int{
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	142, 143, 144, 145, 146, 147,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176,
 }
 var yyTok3 = [...]int{
 	0,
@@ -1818,970 +2411,1248 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:226
+//line parser.y:264
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:231
+//line parser.y:269
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:236
+//line parser.y:274
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:243
+//line parser.y:281
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:247
+//line parser.y:285
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:253
+//line parser.y:291
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:257
+//line parser.y:295
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:263
+//line parser.y:301
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:267
+//line parser.y:305
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:273
+//line parser.y:311
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:277
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:315
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
 	case 12:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:281
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:319
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: true, Query: yyDollar[3].queryexpr.(SelectQuery)}
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:285
+//line parser.y:323
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:289
+//line parser.y:327
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:293
+//line parser.y:331
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:297
+//line parser.y:335
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:301
+//line parser.y:339
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:305
+//line parser.y:343
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:309
+//line parser.y:347
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:313
+//line parser.y:351
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:317
+//line parser.y:355
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:321
+//line parser.y:359
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:325
+//line parser.y:363
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:329
+//line parser.y:367
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:371
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+//line parser.y:375
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:345
+//line parser.y:379
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:349
+//line parser.y:383
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 29:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:389
+		{
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:393
+		{
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:399
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:403
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:355
+//line parser.y:409
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 30:
+	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:359
+//line parser.y:413
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 31:
+	case 35:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:363
+//line parser.y:417
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 32:
+	case 36:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:367
+//line parser.y:421
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 33:
+	case 37:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:371
+//line parser.y:425
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 34:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:377
+//line parser.y:431
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 35:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:381
+//line parser.y:435
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 36:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:387
+//line parser.y:441
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 37:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:391
+//line parser.y:445
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 38:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:397
+//line parser.y:451
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 39:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:401
+//line parser.y:455
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 40:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:407
+//line parser.y:461
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 41:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:411
+//line parser.y:465
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 42:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:415
+//line parser.y:469
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 43:
+	case 47:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:473
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:419
+//line parser.y:477
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 44:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:423
+//line parser.y:481
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 45:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:429
+//line parser.y:487
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 46:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:433
+//line parser.y:491
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 47:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:437
+//line parser.y:495
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 48:
+	case 53:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:499
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:441
+//line parser.y:503
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 49:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:445
+//line parser.y:507
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 50:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:449
+//line parser.y:511
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 51:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:455
+//line parser.y:517
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:459
+//line parser.y:521
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 59:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:465
+//line parser.y:527
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 54:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:469
+//line parser.y:531
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 55:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:473
+//line parser.y:535
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 56:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:541
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 57:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:483
+//line parser.y:545
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 58:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+//line parser.y:551
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 59:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+//line parser.y:555
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 60:
+	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:499
+//line parser.y:561
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 61:
+	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+//line parser.y:565
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 62:
+	case 68:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:507
+//line parser.y:569
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 63:
+	case 69:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:573
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:511
+//line parser.y:577
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:515
+//line parser.y:581
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:521
+//line parser.y:587
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:525
+//line parser.y:591
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:529
+//line parser.y:595
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 75:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:599
+		{
+			yyVAL.statement = Try{Statements: yyDollar[2].program, CatchStatements: yyDollar[4].program}
+		}
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:533
+//line parser.y:603
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:537
+//line parser.y:607
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:541
+//line parser.y:611
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 71:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:547
+//line parser.y:617
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 72:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:551
+//line parser.y:621
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 73:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:555
+//line parser.y:625
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 74:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:559
+//line parser.y:629
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 75:
+	case 83:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:565
+//line parser.y:635
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 76:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:569
+//line parser.y:639
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 77:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:573
+//line parser.y:643
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 78:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:577
+//line parser.y:647
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 79:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:581
+//line parser.y:651
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 80:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:587
+//line parser.y:657
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 81:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:591
+//line parser.y:661
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 82:
+	case 90:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:597
+//line parser.y:667
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 83:
+	case 91:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:601
+//line parser.y:671
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 84:
+	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:605
+//line parser.y:675
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 85:
+	case 93:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:679
+		{
+			yyVAL.statement = CreateIndex{Name: yyDollar[3].identifier, Table: yyDollar[5].identifier, Columns: yyDollar[7].queryexprs}
+		}
+	case 94:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:609
+//line parser.y:683
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 86:
+	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:613
+//line parser.y:687
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 87:
+	case 96:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:691
+		{
+			yyVAL.statement = AddConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Constraint: yyDollar[5].queryexpr.(TableConstraint)}
+		}
+	case 97:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:695
+		{
+			yyVAL.statement = DropConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Name: yyDollar[6].identifier}
+		}
+	case 98:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:617
+//line parser.y:699
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 88:
+	case 99:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:621
+//line parser.y:703
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 89:
+	case 100:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:625
+//line parser.y:707
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 90:
+	case 101:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:629
+//line parser.y:711
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 91:
+	case 102:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:633
+//line parser.y:715
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 92:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:639
+	case 103:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:719
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
+			yyVAL.statement = RenameTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, New: yyDollar[6].identifier}
 		}
-	case 93:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:643
+//line parser.y:723
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
+			yyVAL.statement = DropTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr}
 		}
-	case 94:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:649
+	case 105:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:727
 		{
-			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
+			yyVAL.statement = DropTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[5].queryexpr, IfExists: true}
 		}
-	case 95:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:653
-		{
-			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
-		}
-	case 96:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:659
-		{
-			yyVAL.expression = nil
-		}
-	case 97:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:663
+//line parser.y:731
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.statement = TruncateTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr}
 		}
-	case 98:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:667
+//line parser.y:737
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 99:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:671
+//line parser.y:741
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
 		}
-	case 100:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:675
+//line parser.y:745
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Constraints: yyDollar[2].queryexprs}
 		}
-	case 101:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:681
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:749
 		{
-			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, Constraints: yyDollar[3].queryexprs}
 		}
-	case 102:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:685
+	case 111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:753
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:759
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 113:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:763
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:769
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Token{Token: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}}
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:773
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token}
+		}
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:777
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Token{Token: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}}
+		}
+	case 117:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:781
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Check: yyDollar[3].queryexpr}
+		}
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:787
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 119:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:791
+		{
+			c := yyDollar[3].queryexpr.(TableConstraint)
+			c.BaseExpr = NewBaseExpr(yyDollar[1].token)
+			c.Constraint = yyDollar[1].token.Literal
+			c.Name = yyDollar[2].identifier
+			yyVAL.queryexpr = c
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:801
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 121:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:805
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[2].queryexprs...)
+		}
+	case 122:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:811
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Token{Token: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}, Columns: yyDollar[4].queryexprs}
+		}
+	case 123:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:815
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Columns: yyDollar[3].queryexprs}
+		}
+	case 124:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:819
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: Token{Token: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}, Columns: yyDollar[4].queryexprs}
+		}
+	case 125:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:823
+		{
+			yyVAL.queryexpr = TableConstraint{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Check: yyDollar[3].queryexpr}
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:829
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 127:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:833
+		{
+			c := yyDollar[3].queryexpr.(TableConstraint)
+			c.BaseExpr = NewBaseExpr(yyDollar[1].token)
+			c.Constraint = yyDollar[1].token.Literal
+			c.Name = yyDollar[2].identifier
+			yyVAL.queryexpr = c
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:843
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
+		}
+	case 129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:847
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:853
+		{
+			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
+		}
+	case 131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:857
+		{
+			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
+		}
+	case 132:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:863
+		{
+			yyVAL.expression = nil
+		}
+	case 133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:867
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+		}
+	case 134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:871
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+		}
+	case 135:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:875
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:879
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 137:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:885
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
+		}
+	case 138:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:889
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 103:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:689
+//line parser.y:893
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 104:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:693
+//line parser.y:897
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 105:
+	case 141:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:697
+//line parser.y:901
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 106:
+	case 142:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:703
+//line parser.y:907
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 107:
+	case 143:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:707
+//line parser.y:911
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 108:
+	case 144:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:711
+//line parser.y:915
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 109:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:715
+//line parser.y:919
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
 		}
-	case 110:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:721
+//line parser.y:925
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 111:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:727
+//line parser.y:931
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 112:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:731
+//line parser.y:935
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 113:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:737
+//line parser.y:941
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 114:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:743
+//line parser.y:947
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 115:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:747
+//line parser.y:951
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 116:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:753
+//line parser.y:957
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 117:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:757
+//line parser.y:961
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 118:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:761
+//line parser.y:965
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 119:
+	case 155:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:767
+//line parser.y:971
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 120:
+	case 156:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:771
+//line parser.y:975
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 121:
+	case 157:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:775
+//line parser.y:979
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 122:
+	case 158:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:779
+//line parser.y:983
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 123:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:783
+//line parser.y:987
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 124:
+	case 160:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:993
+		{
+			yyVAL.procparam = ProcedureParameter{Variable: yyDollar[1].variable}
+		}
+	case 161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:997
+		{
+			yyVAL.procparam = ProcedureParameter{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1001
+		{
+			yyVAL.procparam = ProcedureParameter{Variable: yyDollar[2].variable, Out: true}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1007
+		{
+			yyVAL.procparams = []ProcedureParameter{yyDollar[1].procparam}
+		}
+	case 164:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1011
+		{
+			yyVAL.procparams = append([]ProcedureParameter{yyDollar[1].procparam}, yyDollar[3].procparams...)
+		}
+	case 165:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:1017
+		{
+			yyVAL.statement = ProcedureDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
+		}
+	case 166:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:1021
+		{
+			yyVAL.statement = ProcedureDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].procparams, Statements: yyDollar[9].program}
+		}
+	case 167:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1025
+		{
+			yyVAL.statement = DisposeProcedure{Name: yyDollar[3].identifier}
+		}
+	case 168:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1029
+		{
+			yyVAL.statement = CallProcedure{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Args: yyDollar[4].queryexprs}
+		}
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:789
+//line parser.y:1035
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 125:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:793
+//line parser.y:1039
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 126:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:797
+//line parser.y:1043
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 127:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:801
+//line parser.y:1047
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 128:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:805
+//line parser.y:1051
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 129:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:809
+//line parser.y:1055
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 130:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:813
+//line parser.y:1059
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 131:
+	case 176:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:819
+//line parser.y:1065
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[5].token.Token, TypeLit: yyDollar[5].token.Literal}
 		}
-	case 132:
+	case 177:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:823
+//line parser.y:1069
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[6].token.Token, TypeLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal}
 		}
-	case 133:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:827
+//line parser.y:1073
 		{
 			yyVAL.queryexpr = CursorAttrebute{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 134:
+	case 179:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:833
+//line parser.y:1079
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 135:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:837
+//line parser.y:1083
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 136:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:841
+//line parser.y:1087
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].identifier}
 		}
-	case 137:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:845
+//line parser.y:1091
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr}
 		}
-	case 138:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:849
+//line parser.y:1095
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 139:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:853
+//line parser.y:1099
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[4].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 140:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:857
+//line parser.y:1103
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal}
 		}
-	case 141:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:861
+//line parser.y:1107
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 142:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:865
+//line parser.y:1111
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 143:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:869
+//line parser.y:1115
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 144:
+	case 189:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:873
+//line parser.y:1119
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 145:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:877
+//line parser.y:1123
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 146:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:881
+//line parser.y:1127
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 147:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:885
+//line parser.y:1131
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 148:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:889
+//line parser.y:1135
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 149:
+	case 194:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:893
+//line parser.y:1139
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 150:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:897
+//line parser.y:1143
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 151:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+//line parser.y:1147
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 152:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:905
+//line parser.y:1151
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 153:
+	case 198:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:909
+//line parser.y:1155
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].identifier}
 		}
-	case 154:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:913
+//line parser.y:1159
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 155:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:917
+//line parser.y:1163
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 156:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:921
+//line parser.y:1167
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 157:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:925
+//line parser.y:1171
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 158:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:931
+//line parser.y:1177
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 159:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:935
+//line parser.y:1181
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 160:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:939
+//line parser.y:1185
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 161:
+	case 206:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:945
+//line parser.y:1191
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -2791,9 +3662,9 @@ yydefault:
 				OffsetClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 162:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:957
+	case 207:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1203
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -2801,11 +3672,12 @@ yydefault:
 				WhereClause:   yyDollar[3].queryexpr,
 				GroupByClause: yyDollar[4].queryexpr,
 				HavingClause:  yyDollar[5].queryexpr,
+				WindowClause:  yyDollar[6].queryexpr,
 			}
 		}
-	case 163:
+	case 208:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:967
+//line parser.y:1214
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2814,9 +3686,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 164:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:976
+//line parser.y:1223
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2825,9 +3697,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 165:
+	case 210:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:985
+//line parser.y:1232
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2836,447 +3708,549 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 166:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:996
+//line parser.y:1243
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 167:
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1247
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1253
+		{
+			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
+		}
+	case 214:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1259
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 215:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1263
+		{
+			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
+		}
+	case 216:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1269
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 217:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1273
+		{
+			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
+		}
+	case 218:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1279
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1283
+		{
+			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1289
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 221:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1293
+		{
+			yyVAL.queryexpr = Rollup{BaseExpr: NewBaseExpr(yyDollar[1].token), Rollup: yyDollar[1].token.Literal, Items: yyDollar[3].queryexprs}
+		}
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1297
+		{
+			yyVAL.queryexpr = Cube{BaseExpr: NewBaseExpr(yyDollar[1].token), Cube: yyDollar[1].token.Literal, Items: yyDollar[3].queryexprs}
+		}
+	case 223:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1301
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), GroupingSets: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Sets: yyDollar[4].queryexprs}
+		}
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1307
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1311
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 226:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1317
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1321
+		{
+			yyVAL.queryexpr = ValueList{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 228:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1325
+		{
+			yyVAL.queryexpr = ValueList{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: append([]QueryExpression{yyDollar[2].queryexpr}, yyDollar[4].queryexprs...)}
+		}
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1000
+//line parser.y:1331
 		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 168:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1006
+//line parser.y:1335
 		{
-			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 169:
+	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1012
+//line parser.y:1341
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 170:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1016
+//line parser.y:1345
 		{
-			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
+			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 171:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1022
+//line parser.y:1351
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 172:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1026
+//line parser.y:1355
 		{
-			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
-		}
-	case 173:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1032
-		{
-			yyVAL.queryexpr = nil
+			yyVAL.queryexpr = WindowClause{Window: yyDollar[1].token.Literal, Windows: yyDollar[2].queryexprs}
 		}
-	case 174:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1036
+	case 235:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1361
 		{
-			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
+			yyVAL.queryexpr = WindowDefinition{Name: yyDollar[1].identifier, As: yyDollar[2].token.Literal, Clause: yyDollar[4].queryexpr.(AnalyticClause)}
 		}
-	case 175:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1042
+	case 236:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1367
 		{
-			yyVAL.queryexpr = nil
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 176:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1046
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1371
 		{
-			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 177:
+	case 238:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1052
+//line parser.y:1377
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 178:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1056
+//line parser.y:1381
 		{
 			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 179:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1062
+//line parser.y:1387
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 180:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1066
+//line parser.y:1391
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, With: yyDollar[3].queryexpr}
 		}
-	case 181:
+	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1070
+//line parser.y:1395
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, Percent: yyDollar[3].token.Literal, With: yyDollar[4].queryexpr}
 		}
-	case 182:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1076
+//line parser.y:1401
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 183:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1080
+//line parser.y:1405
 		{
 			yyVAL.queryexpr = LimitWith{With: yyDollar[1].token.Literal, Type: yyDollar[2].token}
 		}
-	case 184:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1086
+//line parser.y:1411
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 185:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1090
+//line parser.y:1415
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Offset: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 186:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1096
+//line parser.y:1421
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 187:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1100
+//line parser.y:1425
 		{
 			yyVAL.queryexpr = WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
 		}
-	case 188:
+	case 249:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1106
+//line parser.y:1431
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, As: yyDollar[3].token.Literal, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 189:
+	case 250:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1110
+//line parser.y:1435
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, As: yyDollar[6].token.Literal, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 190:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1116
+//line parser.y:1441
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 191:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1120
+//line parser.y:1445
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 192:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1126
+//line parser.y:1451
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 193:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1130
+//line parser.y:1455
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 194:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1134
+//line parser.y:1459
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 195:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1463
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 196:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1142
+//line parser.y:1467
 		{
-			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).datetimeFormat, yylex.(*Lexer).location)
 		}
-	case 197:
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1471
+		{
+			yyVAL.queryexpr = NewIntervalValueFromString(yyDollar[1].token.Literal)
+		}
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1146
+//line parser.y:1475
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 198:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1152
+//line parser.y:1481
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 199:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1158
+//line parser.y:1487
 		{
 			yyVAL.queryexpr = NewNullValueFromString(yyDollar[1].token.Literal)
 		}
-	case 200:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1493
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 201:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1168
+//line parser.y:1497
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 202:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1172
+//line parser.y:1501
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 203:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1176
+//line parser.y:1505
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 204:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1180
+//line parser.y:1509
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 205:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1186
+//line parser.y:1515
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 206:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1190
+//line parser.y:1519
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 207:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1523
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 208:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1198
+//line parser.y:1527
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 209:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1202
+//line parser.y:1531
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 210:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1206
+//line parser.y:1535
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 211:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1210
+//line parser.y:1539
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 212:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1214
+//line parser.y:1543
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 213:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1547
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 214:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1222
+//line parser.y:1551
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 215:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1226
+//line parser.y:1555
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 216:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1230
+//line parser.y:1559
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 217:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1234
+//line parser.y:1563
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 218:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+//line parser.y:1567
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 219:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+//line parser.y:1571
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 220:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1246
+//line parser.y:1575
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 221:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+//line parser.y:1581
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 222:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1258
+//line parser.y:1587
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 223:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+//line parser.y:1591
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 224:
+	case 286:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1266
+//line parser.y:1595
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 225:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1272
+//line parser.y:1601
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 226:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1276
+//line parser.y:1605
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 227:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1282
+//line parser.y:1611
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 228:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1286
+//line parser.y:1615
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 229:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1292
+//line parser.y:1621
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 230:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1296
+//line parser.y:1625
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, Nulls: yyDollar[3].token.Literal, Position: yyDollar[4].token}
 		}
-	case 231:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1302
+//line parser.y:1631
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 232:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1306
+//line parser.y:1635
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 233:
+	case 295:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1312
+//line parser.y:1641
 		{
 			yyVAL.token = Token{}
 		}
-	case 234:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+//line parser.y:1645
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 235:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1320
+//line parser.y:1649
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 236:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+//line parser.y:1655
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 237:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+//line parser.y:1659
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 238:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1336
+//line parser.y:1665
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 239:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1342
+//line parser.y:1671
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3297,1203 +4271,1607 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 240:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1365
+//line parser.y:1694
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 241:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1369
+//line parser.y:1698
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 304:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1373
+//line parser.y:1702
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 243:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1379
+//line parser.y:1708
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 244:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1383
+//line parser.y:1712
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 245:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1387
+//line parser.y:1716
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 246:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1391
+//line parser.y:1720
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 247:
+	case 309:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1395
+//line parser.y:1724
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 248:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1399
+//line parser.y:1728
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 249:
+	case 311:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1403
+//line parser.y:1732
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 250:
+	case 312:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1407
+//line parser.y:1736
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 251:
+	case 313:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1411
+//line parser.y:1740
 		{
 			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 252:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1415
+//line parser.y:1744
 		{
 			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 253:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1419
+//line parser.y:1748
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 254:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1423
+//line parser.y:1752
 		{
 			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 255:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1427
+//line parser.y:1756
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 256:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1431
+//line parser.y:1760
 		{
 			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 257:
+	case 319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1764
+		{
+			yyVAL.queryexpr = Regexp{Regexp: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 320:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1768
+		{
+			yyVAL.queryexpr = Regexp{Regexp: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1435
+//line parser.y:1772
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 258:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1439
+//line parser.y:1776
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 259:
+	case 323:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1443
+//line parser.y:1780
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 260:
+	case 324:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1447
+//line parser.y:1784
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 261:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1451
+//line parser.y:1788
 		{
 			yyVAL.queryexpr = Exists{Exists: yyDollar[1].token.Literal, Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 262:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1457
+//line parser.y:1794
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
 		}
-	case 263:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1461
+//line parser.y:1798
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
 		}
-	case 264:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1465
+//line parser.y:1802
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
 		}
-	case 265:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1469
+//line parser.y:1806
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
 		}
-	case 266:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1473
+//line parser.y:1810
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
 		}
-	case 267:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1477
+//line parser.y:1814
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 268:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1481
+//line parser.y:1818
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 269:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1487
+//line parser.y:1824
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 270:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1491
+//line parser.y:1828
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 271:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1495
+//line parser.y:1832
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 272:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1499
+//line parser.y:1836
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 273:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1505
+//line parser.y:1842
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 274:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1509
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1846
+		{
+			yyVAL.queryexprs = yyDollar[1].queryexprs
+		}
+	case 339:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1852
+		{
+			if yyDollar[5].queryexpr == nil {
+				yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
+			} else {
+				yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: yyDollar[5].queryexpr}
+			}
+		}
+	case 340:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1860
 		{
-			yyVAL.queryexprs = yyDollar[1].queryexprs
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 275:
+	case 341:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1515
+//line parser.y:1864
 		{
-			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 276:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1519
+	case 342:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1868
 		{
-			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 277:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1523
+//line parser.y:1872
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 278:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1527
+//line parser.y:1876
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 279:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1534
+	case 345:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1883
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 280:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1538
+	case 346:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1887
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 281:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1542
+	case 347:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1891
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 282:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1546
+	case 348:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1895
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr}
 		}
-	case 283:
+	case 349:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1899
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
+		}
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1550
+//line parser.y:1903
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 284:
+	case 351:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1909
+		{
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
+		}
+	case 352:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:1913
+		{
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr, Filter: yyDollar[11].queryexpr}
+		}
+	case 353:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1919
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1556
+//line parser.y:1923
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = FilterClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Filter: yyDollar[1].token.Literal, Where: WhereClause{BaseExpr: NewBaseExpr(yyDollar[3].token), Where: yyDollar[3].token.Literal, Filter: yyDollar[4].queryexpr}}
 		}
-	case 285:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1560
+	case 355:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1929
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: yyDollar[5].queryexpr, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 286:
+	case 356:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1566
+//line parser.y:1933
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 287:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1570
+	case 357:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1937
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 288:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1574
+	case 358:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1941
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 289:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1578
+	case 359:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1945
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 290:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1582
+	case 360:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1949
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 291:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1586
+	case 361:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1953
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 292:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1590
+	case 362:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line parser.y:1957
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, Filter: yyDollar[11].queryexpr, Over: yyDollar[12].token.Literal, AnalyticClause: AnalyticClause{PartitionClause: yyDollar[14].queryexpr, OrderByClause: yyDollar[9].queryexpr}}
 		}
-	case 293:
+	case 363:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1961
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 364:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1965
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 365:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1594
+//line parser.y:1969
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 294:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1598
+	case 366:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1973
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
 		}
-	case 295:
+	case 367:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1602
+//line parser.y:1977
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 296:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1606
+	case 368:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1983
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = yyDollar[2].queryexpr
 		}
-	case 297:
+	case 369:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1987
+		{
+			yyVAL.queryexpr = AnalyticClause{BaseExpr: yyDollar[1].identifier.BaseExpr, WindowName: yyDollar[1].identifier}
+		}
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1612
+//line parser.y:1993
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 298:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1618
+//line parser.y:1999
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 299:
+	case 372:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1622
+//line parser.y:2003
 		{
 			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 300:
+	case 373:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1629
+//line parser.y:2010
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 301:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1633
+//line parser.y:2014
 		{
 			yyVAL.queryexpr = PartitionClause{PartitionBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[3].queryexprs}
 		}
-	case 302:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1639
+//line parser.y:2020
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[2].queryexpr}
+			yyVAL.queryexpr = WindowingClause{Unit: yyDollar[1].token, FrameLow: yyDollar[2].queryexpr}
 		}
-	case 303:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1643
+//line parser.y:2024
 		{
-			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
+			yyVAL.queryexpr = WindowingClause{Unit: yyDollar[1].token, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
 		}
-	case 304:
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2030
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2034
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2038
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1649
+//line parser.y:2044
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 305:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1653
+//line parser.y:2048
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 306:
+	case 382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2053
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 383:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2057
+		{
+			offset := NewIntervalValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: offset, Literal: offset.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1658
+//line parser.y:2062
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 307:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1664
+//line parser.y:2068
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 308:
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2073
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2077
+		{
+			offset := NewIntervalValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: offset, Literal: offset.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1669
+//line parser.y:2082
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 309:
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2087
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: NewFloatValueFromString(yyDollar[1].token.Literal), Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
+		}
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2091
+		{
+			offset := NewIntervalValueFromString(yyDollar[1].token.Literal)
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, OffsetValue: offset, Literal: offset.String() + " " + yyDollar[2].token.Literal}
+		}
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1674
+//line parser.y:2096
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 310:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1680
+//line parser.y:2102
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 311:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1684
+//line parser.y:2106
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 312:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1690
+//line parser.y:2112
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 313:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1694
+//line parser.y:2116
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 314:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1700
+//line parser.y:2122
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 315:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1704
+//line parser.y:2126
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token), Stdin: yyDollar[1].token.Literal}
 		}
-	case 316:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1710
+//line parser.y:2132
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 317:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1714
+//line parser.y:2136
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 318:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1718
+//line parser.y:2140
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 319:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1724
+//line parser.y:2146
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 320:
+	case 402:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1728
+//line parser.y:2150
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 321:
+	case 403:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1732
+//line parser.y:2154
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token.Literal, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 322:
+	case 404:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2158
+		{
+			yyVAL.queryexpr = TableFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1736
+//line parser.y:2162
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: nil}
 		}
-	case 323:
+	case 406:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1740
+//line parser.y:2166
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, Path: yyDollar[3].identifier, Args: yyDollar[5].queryexprs}
 		}
-	case 324:
+	case 407:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1744
+//line parser.y:2170
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: nil}
 		}
-	case 325:
+	case 408:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1748
+//line parser.y:2174
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: yyDollar[1].identifier.BaseExpr, Type: yyDollar[1].identifier, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs}
 		}
-	case 326:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1754
+//line parser.y:2180
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 327:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1758
+//line parser.y:2184
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 328:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1762
+//line parser.y:2188
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 329:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1766
+//line parser.y:2192
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 330:
+	case 413:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2196
+		{
+			yyVAL.queryexpr = Table{Lateral: yyDollar[1].token, Object: yyDollar[2].queryexpr}
+		}
+	case 414:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2200
+		{
+			yyVAL.queryexpr = Table{Lateral: yyDollar[1].token, Object: yyDollar[2].queryexpr, Alias: yyDollar[3].identifier}
+		}
+	case 415:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2204
+		{
+			yyVAL.queryexpr = Table{Lateral: yyDollar[1].token, Object: yyDollar[2].queryexpr, As: yyDollar[3].token.Literal, Alias: yyDollar[4].identifier}
+		}
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1770
+//line parser.y:2208
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 331:
+	case 417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2212
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2216
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2220
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1774
+//line parser.y:2224
 		{
 			yyVAL.queryexpr = Table{Object: Dual{Dual: yyDollar[1].token.Literal}}
 		}
-	case 332:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1778
+//line parser.y:2228
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 333:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1784
+//line parser.y:2234
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 334:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+//line parser.y:2238
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 335:
+	case 424:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1792
+//line parser.y:2242
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 336:
+	case 425:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1796
+//line parser.y:2246
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: JoinCondition{Literal: yyDollar[6].token.Literal, On: yyDollar[7].queryexpr}}
 		}
-	case 337:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1800
+//line parser.y:2250
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 338:
+	case 427:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1804
+//line parser.y:2254
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 339:
+	case 428:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2260
+		{
+			yyVAL.queryexpr = Pivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Pivot: yyDollar[2].token.Literal, Aggregate: yyDollar[4].queryexpr, For: yyDollar[5].token.Literal, Field: yyDollar[6].queryexpr, In: yyDollar[7].token.Literal, Values: yyDollar[9].queryexprs}
+		}
+	case 429:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2264
+		{
+			yyVAL.queryexpr = Unpivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Unpivot: yyDollar[2].token.Literal, ValueColumn: yyDollar[4].identifier, For: yyDollar[5].token.Literal, NameColumn: yyDollar[6].identifier, In: yyDollar[7].token.Literal, Fields: yyDollar[9].queryexprs}
+		}
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1810
+//line parser.y:2270
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, On: yyDollar[2].queryexpr}
 		}
-	case 340:
+	case 431:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1814
+//line parser.y:2274
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, Using: yyDollar[3].queryexprs}
 		}
-	case 341:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1820
+//line parser.y:2280
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 342:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1824
+//line parser.y:2284
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 343:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1830
+//line parser.y:2290
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 344:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1834
+//line parser.y:2294
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 345:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1838
+//line parser.y:2298
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 346:
+	case 437:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1844
+//line parser.y:2304
 		{
 			yyVAL.queryexpr = CaseExpr{Case: yyDollar[1].token.Literal, End: yyDollar[5].token.Literal, Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 347:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1850
+//line parser.y:2310
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 348:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1854
+//line parser.y:2314
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 349:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1860
+//line parser.y:2320
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 350:
+	case 441:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1864
+//line parser.y:2324
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 351:
+	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1870
+//line parser.y:2330
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 352:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1874
+//line parser.y:2334
 		{
 			yyVAL.queryexpr = CaseExprElse{Else: yyDollar[1].token.Literal, Result: yyDollar[2].queryexpr}
 		}
-	case 353:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1880
+//line parser.y:2340
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 354:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1884
+//line parser.y:2344
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 355:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1890
+//line parser.y:2350
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 356:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2354
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 357:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1900
+//line parser.y:2360
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 358:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1904
+//line parser.y:2364
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 359:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1910
+//line parser.y:2370
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 360:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2374
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 361:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1920
+//line parser.y:2380
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 362:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1924
+//line parser.y:2384
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 363:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1930
+//line parser.y:2390
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 364:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1934
+//line parser.y:2394
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 365:
+	case 456:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1940
+//line parser.y:2400
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 366:
+	case 457:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1944
+//line parser.y:2404
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 367:
+	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1948
+//line parser.y:2408
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 368:
+	case 459:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1952
+//line parser.y:2412
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 369:
+	case 460:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1958
+//line parser.y:2418
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 370:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1964
+//line parser.y:2424
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 371:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1970
+//line parser.y:2430
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 372:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1974
+//line parser.y:2434
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 373:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1980
+//line parser.y:2440
 		{
 			from := FromClause{From: yyDollar[3].token.Literal, Tables: yyDollar[4].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: from, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 374:
+	case 465:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1985
+//line parser.y:2445
 		{
 			from := FromClause{From: yyDollar[4].token.Literal, Tables: yyDollar[5].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: from, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 375:
+	case 466:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2452
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].table, Source: yyDollar[5].queryexpr, Condition: yyDollar[7].queryexpr, WhenList: yyDollar[8].mergewhens}
+		}
+	case 467:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2456
+		{
+			with := WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[3].token), WithClause: with, Table: yyDollar[5].table, Source: yyDollar[7].queryexpr, Condition: yyDollar[9].queryexpr, WhenList: yyDollar[10].mergewhens}
+		}
+	case 468:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2463
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Action: yyDollar[4].token, SetList: yyDollar[6].updatesets}
+		}
+	case 469:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2467
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[4].queryexpr, Action: yyDollar[6].token, SetList: yyDollar[8].updatesets}
+		}
+	case 470:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2471
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Action: yyDollar[4].token}
+		}
+	case 471:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2475
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[4].queryexpr, Action: yyDollar[6].token}
+		}
+	case 472:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2479
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Action: yyDollar[5].token, Values: yyDollar[7].queryexpr}
+		}
+	case 473:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2483
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Action: yyDollar[5].token, Fields: yyDollar[7].queryexprs, Values: yyDollar[10].queryexpr}
+		}
+	case 474:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2487
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[5].queryexpr, Action: yyDollar[7].token, Values: yyDollar[9].queryexpr}
+		}
+	case 475:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2491
+		{
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[5].queryexpr, Action: yyDollar[7].token, Fields: yyDollar[9].queryexprs, Values: yyDollar[12].queryexpr}
+		}
+	case 476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2497
+		{
+			yyVAL.mergewhens = []MergeWhen{yyDollar[1].mergewhen}
+		}
+	case 477:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2501
+		{
+			yyVAL.mergewhens = append([]MergeWhen{yyDollar[1].mergewhen}, yyDollar[2].mergewhens...)
+		}
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1992
+//line parser.y:2507
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 376:
+	case 479:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1996
+//line parser.y:2511
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 377:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2002
+//line parser.y:2517
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 378:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2006
+//line parser.y:2521
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 379:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2012
+//line parser.y:2527
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 380:
+	case 483:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2016
+//line parser.y:2531
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 381:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2022
+//line parser.y:2537
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 382:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2026
+//line parser.y:2541
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 383:
+	case 486:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2032
+//line parser.y:2547
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 384:
+	case 487:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2036
+//line parser.y:2551
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 385:
+	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2042
+//line parser.y:2557
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 386:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2046
+//line parser.y:2561
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 387:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2052
+//line parser.y:2567
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 388:
+	case 491:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2056
+//line parser.y:2571
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 389:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2062
+//line parser.y:2577
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 390:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2066
+//line parser.y:2581
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 391:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2072
+//line parser.y:2587
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 392:
+	case 495:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2076
+//line parser.y:2591
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 393:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2082
+//line parser.y:2597
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 394:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2086
+//line parser.y:2601
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 395:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2092
+//line parser.y:2607
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 396:
+	case 499:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2096
+//line parser.y:2611
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 397:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2102
+//line parser.y:2617
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 398:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2106
+//line parser.y:2621
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 399:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2112
+//line parser.y:2627
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 400:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2116
+//line parser.y:2631
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 401:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2122
+//line parser.y:2637
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 402:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2126
+//line parser.y:2641
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 403:
+	case 506:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2132
+//line parser.y:2647
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 404:
+	case 507:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2136
+//line parser.y:2651
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 405:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2142
+//line parser.y:2657
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 406:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2146
+//line parser.y:2661
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 407:
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2667
+		{
+			yyVAL.identifier = yyDollar[1].identifier
+		}
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2152
+//line parser.y:2671
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 408:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2156
+//line parser.y:2675
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 409:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2160
+//line parser.y:2679
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 410:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2164
+//line parser.y:2685
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 411:
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2689
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 516:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2693
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2697
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2701
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2705
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2709
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 521:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2713
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2717
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 523:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2721
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 524:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2725
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 525:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2729
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 526:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2733
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2737
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2741
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2745
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2749
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2753
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2757
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 533:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2761
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 534:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2765
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2769
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2773
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2777
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 538:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2781
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2170
+//line parser.y:2787
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 412:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2176
+//line parser.y:2793
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 413:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2180
+//line parser.y:2797
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 414:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2186
+//line parser.y:2803
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 415:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2192
+//line parser.y:2809
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 416:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2196
+//line parser.y:2813
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 417:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2202
+//line parser.y:2819
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 418:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2206
+//line parser.y:2823
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 419:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2212
+//line parser.y:2829
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 420:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2218
+//line parser.y:2835
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 421:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2224
+//line parser.y:2841
 		{
 			yyVAL.token = Token{}
 		}
-	case 422:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2228
+//line parser.y:2845
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 423:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2234
+//line parser.y:2851
 		{
 			yyVAL.token = Token{}
 		}
-	case 424:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2238
+//line parser.y:2855
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 425:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2244
+//line parser.y:2861
 		{
 			yyVAL.token = Token{}
 		}
-	case 426:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2248
+//line parser.y:2865
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 427:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2254
+//line parser.y:2871
 		{
 			yyVAL.token = Token{}
 		}
-	case 428:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2258
+//line parser.y:2875
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 429:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2264
+//line parser.y:2881
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 430:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2268
+//line parser.y:2885
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 431:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2274
+//line parser.y:2891
 		{
 			yyVAL.token = Token{}
 		}
-	case 432:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2278
+//line parser.y:2895
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 433:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2284
+//line parser.y:2901
 		{
 			yyVAL.token = Token{}
 		}
-	case 434:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2288
+//line parser.y:2905
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 435:
+	case 563:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2294
+//line parser.y:2911
 		{
 			yyVAL.token = Token{}
 		}
-	case 436:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2298
+//line parser.y:2915
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 437:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2304
+//line parser.y:2921
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 438:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2308
+//line parser.y:2925
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
@@ -3,6 +3,7 @@ package parser
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -31,6 +32,10 @@ import (
     casewhen    []CaseWhen
     caseelse    CaseElse
     fetchpos    FetchPosition
+    procparam   ProcedureParameter
+    procparams  []ProcedureParameter
+    mergewhen   MergeWhen
+    mergewhens  []MergeWhen
     token       Token
 }
 
@@ -68,6 +73,9 @@ import (
 %type<varassigns>  optional_parameters
 %type<varassigns>  function_parameters
 %type<statement>   user_defined_function_statement
+%type<procparam>   procedure_parameter
+%type<procparams>  procedure_parameters
+%type<statement>   user_defined_procedure_statement
 %type<fetchpos>    fetch_position
 %type<queryexpr>   cursor_status
 %type<statement>   command_statement
@@ -79,6 +87,8 @@ import (
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
+%type<queryexpr>   grouping_element
+%type<queryexpr>   grouping_set
 %type<queryexpr>   having_clause
 %type<queryexpr>   order_by_clause
 %type<queryexpr>   limit_clause
@@ -96,6 +106,8 @@ import (
 %type<queryexpr>   row_value
 %type<queryexprs>  row_values
 %type<queryexprs>  order_items
+%type<queryexprs>  grouping_elements
+%type<queryexprs>  grouping_sets
 %type<queryexpr>   order_item
 %type<queryexpr>   order_value
 %type<token>       order_direction
@@ -110,11 +122,17 @@ import (
 %type<queryexpr>   function
 %type<queryexpr>   aggregate_function
 %type<queryexpr>   list_function
+%type<queryexpr>   filter_clause
 %type<queryexpr>   analytic_function
 %type<queryexpr>   analytic_clause
 %type<queryexpr>   analytic_clause_with_windowing
 %type<queryexpr>   partition_clause
 %type<queryexpr>   windowing_clause
+%type<token>       window_frame_unit
+%type<queryexpr>   window_specification
+%type<queryexpr>   window_clause
+%type<queryexpr>   window_definition
+%type<queryexprs>  window_definitions
 %type<queryexpr>   window_position
 %type<queryexpr>   window_relative_position
 %type<queryexpr>   window_frame_low
@@ -125,6 +143,7 @@ import (
 %type<queryexpr>   virtual_table_object
 %type<queryexpr>   table
 %type<queryexpr>   join
+%type<queryexpr>   pivot_table
 %type<queryexpr>   join_condition
 %type<queryexpr>   field_object
 %type<queryexpr>   field
@@ -136,12 +155,22 @@ import (
 %type<queryexprs>  values
 %type<queryexprs>  tables
 %type<queryexprs>  identifiers
+%type<queryexpr>   column_definition
+%type<queryexprs>  column_definitions
+%type<queryexpr>   column_constraint
+%type<queryexpr>   column_constraint_body
+%type<queryexprs>  column_constraints
+%type<queryexpr>   table_constraint
+%type<queryexpr>   table_constraint_body
 %type<queryexprs>  fields
 %type<expression>  insert_query
 %type<expression>  update_query
 %type<updateset>   update_set
 %type<updatesets>  update_set_list
 %type<expression>  delete_query
+%type<expression>  merge_query
+%type<mergewhen>   merge_when
+%type<mergewhens>  merge_when_list
 %type<elseif>      elseif
 %type<elseexpr>    else
 %type<elseif>      in_loop_elseif
@@ -159,6 +188,7 @@ import (
 %type<casewhen>    in_function_in_loop_case_when
 %type<caseelse>    in_function_in_loop_case_else
 %type<identifier>  identifier
+%type<identifier>  table_alias
 %type<variable>    variable
 %type<variables>   variables
 %type<queryexpr>   variable_substitution
@@ -176,19 +206,24 @@ import (
 %type<token>       as
 %type<token>       comparison_operator
 
-%token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
+%token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME INTERVAL
 %token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES AS DUAL STDIN
 %token<token> RECURSIVE
-%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW INDEX
+%token<token> CONSTRAINT PRIMARY KEY UNIQUE CHECK
+%token<token> TRUNCATE
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
+%token<token> GROUPING SETS ROLLUP CUBE
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL
+%token<token> PIVOT UNPIVOT LATERAL
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
-%token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> AND OR NOT BETWEEN LIKE REGEXP IS NULL
 %token<token> DISTINCT WITH
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
+%token<token> TRY CATCH
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE
 %token<token> NEXT PRIOR ABSOLUTE RELATIVE
 %token<token> SEPARATOR PARTITION OVER
@@ -196,12 +231,15 @@ import (
 %token<token> CONTINUE BREAK EXIT
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
+%token<token> PROCEDURE CALL OUT
+%token<token> MERGE MATCHED
 %token<token> IGNORE WITHIN
-%token<token> VAR SHOW
-%token<token> TIES NULLS ROWS
+%token<token> VAR SHOW EXPLAIN ANALYZE
+%token<token> TIES NULLS ROWS GROUPS
+%token<token> WINDOW FILTER
 %token<token> JSON_ROW JSON_TABLE
 %token<token> COUNT JSON_OBJECT
-%token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
+%token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS TABLE_FUNCTION
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
 %token<token> UMINUS UPLUS
 %token<token> ';' '*' '=' '-' '+' '!' '(' ')'
@@ -209,11 +247,11 @@ import (
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
-%left CROSS FULL NATURAL JOIN
+%left CROSS FULL NATURAL JOIN PIVOT UNPIVOT
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE REGEXP
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
@@ -273,6 +311,14 @@ common_statement
     {
         $$ = $1
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: true, Query: $3.(SelectQuery)}
+    }
     | insert_query
     {
         $$ = $1
@@ -285,6 +331,10 @@ common_statement
     {
         $$ = $1
     }
+    | merge_query
+    {
+        $$ = $1
+    }
     | table_operation_statement
     {
         $$ = $1
@@ -309,6 +359,10 @@ common_statement
     {
         $$ = $1
     }
+    | user_defined_procedure_statement
+    {
+        $$ = $1
+    }
     | transaction_statement
     {
         $$ = $1
@@ -415,6 +469,10 @@ flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | TRY program CATCH program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
     | while_statement
     {
         $$ = $1
@@ -437,6 +495,10 @@ loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | TRY loop_program CATCH loop_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
     | while_statement
     {
         $$ = $1
@@ -507,6 +569,10 @@ function_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | TRY function_program CATCH function_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
     | function_while_statement
     {
         $$ = $1
@@ -529,6 +595,10 @@ function_loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
+    | TRY function_loop_program CATCH function_loop_program END TRY
+    {
+        $$ = Try{Statements: $2, CatchStatements: $4}
+    }
     | function_while_statement
     {
         $$ = $1
@@ -593,11 +663,11 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' column_definitions ')'
     {
         $$ = CreateTable{Table: $3, Fields: $5}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' column_definitions ')' as select_query
     {
         $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
     }
@@ -605,6 +675,10 @@ table_operation_statement
     {
         $$ = CreateTable{Table: $3, Query: $5}
     }
+    | CREATE INDEX identifier ON identifier '(' identifiers ')'
+    {
+        $$ = CreateIndex{Name: $3, Table: $5, Columns: $7}
+    }
     | ALTER TABLE table_identifier ADD column_default column_position
     {
         $$ = AddColumns{Table: $3, Columns: []ColumnDefault{$5}, Position: $6}
@@ -613,6 +687,14 @@ table_operation_statement
     {
         $$ = AddColumns{Table: $3, Columns: $6, Position: $8}
     }
+    | ALTER TABLE table_identifier ADD table_constraint
+    {
+        $$ = AddConstraint{BaseExpr: NewBaseExpr($1), Table: $3, Constraint: $5.(TableConstraint)}
+    }
+    | ALTER TABLE table_identifier DROP CONSTRAINT identifier
+    {
+        $$ = DropConstraint{BaseExpr: NewBaseExpr($1), Table: $3, Name: $6}
+    }
     | ALTER TABLE table_identifier DROP field_reference
     {
         $$ = DropColumns{Table: $3, Columns: []QueryExpression{$5}}
@@ -633,6 +715,128 @@ table_operation_statement
     {
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
+    | ALTER TABLE table_identifier RENAME TO identifier
+    {
+        $$ = RenameTable{BaseExpr: NewBaseExpr($1), Table: $3, New: $6}
+    }
+    | DROP TABLE table_identifier
+    {
+        $$ = DropTable{BaseExpr: NewBaseExpr($1), Table: $3}
+    }
+    | DROP TABLE IF EXISTS table_identifier
+    {
+        $$ = DropTable{BaseExpr: NewBaseExpr($1), Table: $5, IfExists: true}
+    }
+    | TRUNCATE TABLE table_identifier
+    {
+        $$ = TruncateTable{BaseExpr: NewBaseExpr($1), Table: $3}
+    }
+
+column_definition
+    : identifier
+    {
+        $$ = $1
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+    | identifier column_constraints
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Constraints: $2}
+    }
+    | identifier identifier column_constraints
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2, Constraints: $3}
+    }
+    | table_constraint
+    {
+        $$ = $1
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_definition ',' column_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+column_constraint_body
+    : PRIMARY KEY
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: Token{Token: $1.Token, Literal: $1.Literal + " " + $2.Literal}}
+    }
+    | UNIQUE
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: $1}
+    }
+    | NOT NULL
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: Token{Token: $1.Token, Literal: $1.Literal + " " + $2.Literal}}
+    }
+    | CHECK '(' value ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: $1, Check: $3}
+    }
+
+column_constraint
+    : column_constraint_body
+    {
+        $$ = $1
+    }
+    | CONSTRAINT identifier column_constraint_body
+    {
+        c := $3.(TableConstraint)
+        c.BaseExpr = NewBaseExpr($1)
+        c.Constraint = $1.Literal
+        c.Name = $2
+        $$ = c
+    }
+
+column_constraints
+    : column_constraint
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_constraint column_constraints
+    {
+        $$ = append([]QueryExpression{$1}, $2...)
+    }
+
+table_constraint_body
+    : PRIMARY KEY '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: Token{Token: $1.Token, Literal: $1.Literal + " " + $2.Literal}, Columns: $4}
+    }
+    | UNIQUE '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: $1, Columns: $3}
+    }
+    | NOT NULL '(' identifiers ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: Token{Token: $1.Token, Literal: $1.Literal + " " + $2.Literal}, Columns: $4}
+    }
+    | CHECK '(' value ')'
+    {
+        $$ = TableConstraint{BaseExpr: NewBaseExpr($1), Type: $1, Check: $3}
+    }
+
+table_constraint
+    : table_constraint_body
+    {
+        $$ = $1
+    }
+    | CONSTRAINT identifier table_constraint_body
+    {
+        c := $3.(TableConstraint)
+        c.BaseExpr = NewBaseExpr($1)
+        c.Constraint = $1.Literal
+        c.Name = $2
+        $$ = c
+    }
 
 column_default
     : identifier
@@ -784,6 +988,48 @@ user_defined_function_statement
         $$ = DisposeFunction{Name: $3}
     }
 
+procedure_parameter
+    : variable
+    {
+        $$ = ProcedureParameter{Variable: $1}
+    }
+    | variable DEFAULT value
+    {
+        $$ = ProcedureParameter{Variable: $1, Value: $3}
+    }
+    | OUT variable
+    {
+        $$ = ProcedureParameter{Variable: $2, Out: true}
+    }
+
+procedure_parameters
+    : procedure_parameter
+    {
+        $$ = []ProcedureParameter{$1}
+    }
+    | procedure_parameter ',' procedure_parameters
+    {
+        $$ = append([]ProcedureParameter{$1}, $3...)
+    }
+
+user_defined_procedure_statement
+    : DECLARE identifier PROCEDURE '(' ')' AS BEGIN function_program END
+    {
+        $$ = ProcedureDeclaration{Name: $2, Statements: $8}
+    }
+    | DECLARE identifier PROCEDURE '(' procedure_parameters ')' AS BEGIN function_program END
+    {
+        $$ = ProcedureDeclaration{Name: $2, Parameters: $5, Statements: $9}
+    }
+    | DISPOSE PROCEDURE identifier
+    {
+        $$ = DisposeProcedure{Name: $3}
+    }
+    | CALL identifier '(' arguments ')'
+    {
+        $$ = CallProcedure{BaseExpr: NewBaseExpr($1), Name: $2, Args: $4}
+    }
+
 fetch_position
     :
     {
@@ -953,7 +1199,7 @@ select_query
     }
 
 select_entity
-    : select_clause from_clause where_clause group_by_clause having_clause
+    : select_clause from_clause where_clause group_by_clause having_clause window_clause
     {
         $$ = SelectEntity{
             SelectClause:  $1,
@@ -961,6 +1207,7 @@ select_entity
             WhereClause:   $3,
             GroupByClause: $4,
             HavingClause:  $5,
+            WindowClause:  $6,
         }
     }
     | select_set_entity UNION all select_set_entity
@@ -1032,11 +1279,63 @@ group_by_clause
     {
         $$ = nil
     }
-    | GROUP BY values
+    | GROUP BY grouping_elements
     {
         $$ = GroupByClause{GroupBy: $1.Literal + " " + $2.Literal, Items: $3}
     }
 
+grouping_element
+    : value
+    {
+        $$ = $1
+    }
+    | ROLLUP '(' values ')'
+    {
+        $$ = Rollup{BaseExpr: NewBaseExpr($1), Rollup: $1.Literal, Items: $3}
+    }
+    | CUBE '(' values ')'
+    {
+        $$ = Cube{BaseExpr: NewBaseExpr($1), Cube: $1.Literal, Items: $3}
+    }
+    | GROUPING SETS '(' grouping_sets ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), GroupingSets: $1.Literal + " " + $2.Literal, Sets: $4}
+    }
+
+grouping_elements
+    : grouping_element
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_element ',' grouping_elements
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+grouping_set
+    : value
+    {
+        $$ = $1
+    }
+    | '(' ')'
+    {
+        $$ = ValueList{BaseExpr: NewBaseExpr($1)}
+    }
+    | '(' value ',' values ')'
+    {
+        $$ = ValueList{BaseExpr: NewBaseExpr($1), Values: append([]QueryExpression{$2}, $4...)}
+    }
+
+grouping_sets
+    : grouping_set
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_set ',' grouping_sets
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 having_clause
     :
     {
@@ -1047,6 +1346,32 @@ having_clause
         $$ = HavingClause{Having: $1.Literal, Filter: $2}
     }
 
+window_clause
+    :
+    {
+        $$ = nil
+    }
+    | WINDOW window_definitions
+    {
+        $$ = WindowClause{Window: $1.Literal, Windows: $2}
+    }
+
+window_definition
+    : identifier AS '(' analytic_clause_with_windowing ')'
+    {
+        $$ = WindowDefinition{Name: $1, As: $2.Literal, Clause: $4.(AnalyticClause)}
+    }
+
+window_definitions
+    : window_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | window_definition ',' window_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 order_by_clause
     :
     {
@@ -1140,7 +1465,11 @@ primitive_type
     }
     | DATETIME
     {
-        $$ = NewDatetimeValueFromString($1.Literal)
+        $$ = NewDatetimeValueFromString($1.Literal, yylex.(*Lexer).datetimeFormat, yylex.(*Lexer).location)
+    }
+    | INTERVAL
+    {
+        $$ = NewIntervalValueFromString($1.Literal)
     }
     | null
     {
@@ -1431,6 +1760,14 @@ comparison
     {
         $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value REGEXP value
+    {
+        $$ = Regexp{Regexp: $2.Literal, LHS: $1, Pattern: $3}
+    }
+    | value NOT REGEXP value
+    {
+        $$ = Regexp{Regexp: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{Any: $3.Literal, LHS: $1, Operator: $2.Literal, Values: $4}
@@ -1511,9 +1848,13 @@ arguments
     }
 
 function
-    : identifier '(' arguments ')'
+    : identifier '(' arguments ')' filter_clause
     {
-        $$ = Function{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3}
+        if $5 == nil {
+            $$ = Function{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3}
+        } else {
+            $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: $5}
+        }
     }
     | JSON_OBJECT '(' ')'
     {
@@ -1527,24 +1868,36 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | CALL '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | GROUPING '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
-    : identifier '(' distinct arguments ')'
+    : identifier '(' distinct arguments ')' filter_clause
     {
-        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' filter_clause
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | COUNT '(' distinct arguments ')'
+    | COUNT '(' distinct arguments ')' filter_clause
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
-    | COUNT '(' distinct wildcard ')'
+    | COUNT '(' distinct wildcard ')' filter_clause
     {
-        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}}
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6}
+    }
+    | VAR '(' distinct arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
     }
     | list_function
     {
@@ -1552,59 +1905,87 @@ aggregate_function
     }
 
 list_function
-    : LIST_FUNCTION '(' distinct arguments ')'
+    : LIST_FUNCTION '(' distinct arguments ')' filter_clause
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' filter_clause
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9, Filter: $11}
+    }
+
+filter_clause
+    :
     {
-        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4}
+        $$ = nil
     }
-    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    | FILTER '(' WHERE value ')'
     {
-        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, OrderBy: $9}
+        $$ = FilterClause{BaseExpr: NewBaseExpr($1), Filter: $1.Literal, Where: WhereClause{BaseExpr: NewBaseExpr($3), Where: $3.Literal, Filter: $4}}
     }
 
 analytic_function
-    : identifier '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    : identifier '(' arguments ')' filter_clause OVER window_specification
+    {
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: $5, Over: $6.Literal, AnalyticClause: $7.(AnalyticClause)}
+    }
+    | identifier '(' distinct arguments ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | identifier '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct arguments ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | COUNT '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct wildcard ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | COUNT '(' distinct wildcard ')' OVER '(' analytic_clause_with_windowing ')'
+    | VAR '(' distinct arguments ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | LIST_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause ')'
+    | LIST_FUNCTION '(' distinct arguments ')' filter_clause OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Over: $6.Literal, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' filter_clause OVER '(' partition_clause ')'
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, WithinGroup: $6.Literal + " " + $7.Literal, Filter: $11, Over: $12.Literal, AnalyticClause: AnalyticClause{PartitionClause: $14, OrderByClause: $9}}
     }
-    | FUNCTION_NTH '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | ANALYTIC_FUNCTION '(' arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
     }
-    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause_with_windowing ')'
+    | FUNCTION_NTH '(' arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' OVER '(' analytic_clause ')'
+    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause ')'
+    | FUNCTION_WITH_INS '(' arguments ')' OVER window_specification
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, Over: $5.Literal, AnalyticClause: $6.(AnalyticClause)}
+    }
+    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER window_specification
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreNulls: true, IgnoreNullsLit: $5.Literal + " " + $6.Literal, Over: $7.Literal, AnalyticClause: $8.(AnalyticClause)}
+    }
+
+window_specification
+    : '(' analytic_clause_with_windowing ')'
+    {
+        $$ = $2
+    }
+    | identifier
+    {
+        $$ = AnalyticClause{BaseExpr: $1.BaseExpr, WindowName: $1}
     }
 
 analytic_clause
@@ -1635,13 +2016,27 @@ partition_clause
     }
 
 windowing_clause
-    : ROWS window_position
+    : window_frame_unit window_position
+    {
+        $$ = WindowingClause{Unit: $1, FrameLow: $2}
+    }
+    | window_frame_unit BETWEEN window_frame_low AND window_frame_high
+    {
+        $$ = WindowingClause{Unit: $1, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal}
+    }
+
+window_frame_unit
+    : ROWS
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $2}
+        $$ = $1
     }
-    | ROWS BETWEEN window_frame_low AND window_frame_high
+    | RANGE
     {
-        $$ = WindowingClause{Rows: $1.Literal, FrameLow: $3, FrameHigh: $5, Between: $2.Literal, And: $4.Literal}
+        $$ = $1
+    }
+    | GROUPS
+    {
+        $$ = $1
     }
 
 window_position
@@ -1654,6 +2049,15 @@ window_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
     }
+    | FLOAT PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | INTERVAL PRECEDING
+    {
+        offset := NewIntervalValueFromString($1.Literal)
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: offset, Literal: offset.String() + " " + $2.Literal}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1.Token, Literal: $1.Literal + " " + $2.Literal}
@@ -1665,11 +2069,29 @@ window_relative_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
     }
+    | FLOAT PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | INTERVAL PRECEDING
+    {
+        offset := NewIntervalValueFromString($1.Literal)
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: offset, Literal: offset.String() + " " + $2.Literal}
+    }
     | INTEGER FOLLOWING
     {
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2.Token, Offset: i, Literal: $1.Literal + " " + $2.Literal}
     }
+    | FLOAT FOLLOWING
+    {
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: NewFloatValueFromString($1.Literal), Literal: $1.Literal + " " + $2.Literal}
+    }
+    | INTERVAL FOLLOWING
+    {
+        offset := NewIntervalValueFromString($1.Literal)
+        $$ = WindowFramePosition{Direction: $2.Token, OffsetValue: offset, Literal: offset.String() + " " + $2.Literal}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1.Token, Literal: $1.Literal + " " + $2.Literal}
@@ -1710,7 +2132,7 @@ identified_table
     {
         $$ = Table{Object: $1}
     }
-    | table_identifier identifier
+    | table_identifier table_alias
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -1732,6 +2154,10 @@ virtual_table_object
     {
         $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1.Literal, Query: $3, JsonText: $5}
     }
+    | TABLE_FUNCTION '(' arguments ')'
+    {
+        $$ = TableFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
     | identifier '(' identifier ')'
     {
         $$ = TableObject{BaseExpr: $1.BaseExpr, Type: $1, Path: $3, Args: nil}
@@ -1758,7 +2184,7 @@ table
     {
         $$ = Table{Object: $1}
     }
-    | virtual_table_object identifier
+    | virtual_table_object table_alias
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -1766,10 +2192,34 @@ table
     {
         $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
     }
+    | LATERAL virtual_table_object
+    {
+        $$ = Table{Lateral: $1, Object: $2}
+    }
+    | LATERAL virtual_table_object table_alias
+    {
+        $$ = Table{Lateral: $1, Object: $2, Alias: $3}
+    }
+    | LATERAL virtual_table_object AS identifier
+    {
+        $$ = Table{Lateral: $1, Object: $2, As: $3.Literal, Alias: $4}
+    }
     | join
     {
         $$ = Table{Object: $1}
     }
+    | pivot_table
+    {
+        $$ = Table{Object: $1}
+    }
+    | pivot_table table_alias
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | pivot_table AS identifier
+    {
+        $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
+    }
     | DUAL
     {
         $$ = Table{Object: Dual{Dual: $1.Literal}}
@@ -1805,6 +2255,16 @@ join
         $$ = Join{Join: $5.Literal, Table: $1, JoinTable: $6, JoinType: $4, Direction: $3, Natural: $2}
     }
 
+pivot_table
+    : table PIVOT '(' aggregate_function FOR field_reference IN '(' fields ')' ')'
+    {
+        $$ = Pivot{BaseExpr: NewBaseExpr($2), Table: $1, Pivot: $2.Literal, Aggregate: $4, For: $5.Literal, Field: $6, In: $7.Literal, Values: $9}
+    }
+    | table UNPIVOT '(' identifier FOR identifier IN '(' fields ')' ')'
+    {
+        $$ = Unpivot{BaseExpr: NewBaseExpr($2), Table: $1, Unpivot: $2.Literal, ValueColumn: $4, For: $5.Literal, NameColumn: $6, In: $7.Literal, Fields: $9}
+    }
+
 join_condition
     : ON value
     {
@@ -1987,6 +2447,61 @@ delete_query
         $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: from, WhereClause: $6}
     }
 
+merge_query
+    : MERGE INTO identified_table USING table ON value merge_when_list
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($1), Table: $3, Source: $5, Condition: $7, WhenList: $8}
+    }
+    | WITH inline_tables MERGE INTO identified_table USING table ON value merge_when_list
+    {
+        with := WithClause{With: $1.Literal, InlineTables: $2}
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($3), WithClause: with, Table: $5, Source: $7, Condition: $9, WhenList: $10}
+    }
+
+merge_when
+    : WHEN MATCHED THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Action: $4, SetList: $6}
+    }
+    | WHEN MATCHED AND value THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $4, Action: $6, SetList: $8}
+    }
+    | WHEN MATCHED THEN DELETE
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Action: $4}
+    }
+    | WHEN MATCHED AND value THEN DELETE
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $4, Action: $6}
+    }
+    | WHEN NOT MATCHED THEN INSERT VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Action: $5, Values: $7}
+    }
+    | WHEN NOT MATCHED THEN INSERT '(' field_references ')' VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Action: $5, Fields: $7, Values: $10}
+    }
+    | WHEN NOT MATCHED AND value THEN INSERT VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $5, Action: $7, Values: $9}
+    }
+    | WHEN NOT MATCHED AND value THEN INSERT '(' field_references ')' VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $5, Action: $7, Fields: $9, Values: $12}
+    }
+
+merge_when_list
+    : merge_when
+    {
+        $$ = []MergeWhen{$1}
+    }
+    | merge_when merge_when_list
+    {
+        $$ = append([]MergeWhen{$1}, $2...)
+    }
+
 elseif
     : ELSEIF value THEN program
     {
@@ -2148,6 +2663,24 @@ in_function_in_loop_case_else
     }
 
 identifier
+    : table_alias
+    {
+        $$ = $1
+    }
+    | PIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNPIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | WINDOW
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+
+table_alias
     : IDENTIFIER
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2164,6 +2697,90 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | GROUPS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | KEY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INDEX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CONSTRAINT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PRIMARY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNIQUE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CHECK
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TRUNCATE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | GROUPING
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SETS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ROLLUP
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CUBE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | LATERAL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | REGEXP
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PROCEDURE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CALL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | OUT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MATCHED
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | FILTER
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MERGE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -2317,9 +2934,17 @@ func SetDebugLevel(level int, verbose bool) {
 	yyErrorVerbose = verbose
 }
 
-func Parse(s string, sourceFile string) ([]Statement, error) {
+func Parse(s string, sourceFile string, datetimeFormat []string, location *time.Location) ([]Statement, error) {
+    l := new(Lexer)
+    l.Init(s, sourceFile, datetimeFormat, location)
+    yyParse(l)
+    return l.program, l.err
+}
+
+func ParseWithPlaceholders(s string, sourceFile string, datetimeFormat []string, location *time.Location) ([]Statement, error) {
     l := new(Lexer)
-    l.Init(s, sourceFile)
+    l.Init(s, sourceFile, datetimeFormat, location)
+    l.EnablePlaceholders()
     yyParse(l)
     return l.program, l.err
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package parser
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -100,6 +101,60 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 as filter, merge, mode from dual",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: NewIntegerValueFromString("1"),
+								As:     "as",
+								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "filter"},
+							},
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 21}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "merge"}},
+							},
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 28}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "mode"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Dual{Dual: "dual"}},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select window from pivot as unpivot",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "window"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "pivot"},
+							As:     "as",
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "unpivot"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from stdin",
 		Output: []Statement{
@@ -234,6 +289,40 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from t, lateral split_to_table(t.c2, ';') as s",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "t"}},
+						Table{
+							Lateral: Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 19},
+							Object: TableFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 27},
+								Name:     "split_to_table",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "c2"}},
+									NewStringValue(";"),
+								},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "s"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from json_table('key', `table.json`)",
 		Output: []Statement{
@@ -527,6 +616,46 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select grouping(a, b) from dual group by rollup(a, b), cube(c), grouping sets((a, b), c, ())",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "grouping", Args: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "a"}},
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "b"}},
+							}}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+					GroupByClause: GroupByClause{
+						GroupBy: "group by",
+						Items: []QueryExpression{
+							Rollup{BaseExpr: &BaseExpr{line: 1, char: 42}, Rollup: "rollup", Items: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 49}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "a"}},
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "b"}},
+							}},
+							Cube{BaseExpr: &BaseExpr{line: 1, char: 56}, Cube: "cube", Items: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "c"}},
+							}},
+							GroupingSets{BaseExpr: &BaseExpr{line: 1, char: 65}, GroupingSets: "grouping sets", Sets: []QueryExpression{
+								ValueList{BaseExpr: &BaseExpr{line: 1, char: 79}, Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 80}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "a"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 83}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 83}, Literal: "b"}},
+								}},
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 87}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 87}, Literal: "c"}},
+								ValueList{BaseExpr: &BaseExpr{line: 1, char: 90}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select distinct * from dual",
 		Output: []Statement{
@@ -679,7 +808,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select ident, tbl.3, 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar') from dual",
+		Input: "select ident, tbl.3, 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar'), interval '1 day' from dual",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -693,9 +822,10 @@ var parseTests = []struct {
 							Field{Object: NewIntegerValueFromString("1")},
 							Field{Object: NewFloatValueFromString("1.234")},
 							Field{Object: NewTernaryValueFromString("true")},
-							Field{Object: NewDatetimeValueFromString("2010-01-01 12:00:00")},
+							Field{Object: NewDatetimeValueFromString("2010-01-01 12:00:00", nil, time.Local)},
 							Field{Object: NewNullValueFromString("null")},
 							Field{Object: Parentheses{Expr: NewStringValue("bar")}},
+							Field{Object: NewIntervalValueFromString("1 day")},
 						},
 					},
 					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
@@ -1276,6 +1406,35 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 regexp 'pattern1' and column2 not regexp 'pattern2'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Regexp{
+									Regexp:  "regexp",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern: NewStringValue("pattern1"),
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 34},
+								RHS: Regexp{
+									Regexp:   "regexp",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column2"}},
+									Pattern:  NewStringValue("pattern2"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 46},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 = any (select 1)",
 		Output: []Statement{
@@ -2059,6 +2218,105 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select count(*) filter (where c1 = 1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 17},
+									Filter:   "filter",
+									Where: WhereClause{
+										BaseExpr: &BaseExpr{line: 1, char: 25},
+										Where:    "where",
+										Filter: Comparison{
+											LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "c1"}},
+											Operator: "=",
+											RHS:      NewIntegerValueFromString("1"),
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select useraggfunc(c1) filter (where c2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "useraggfunc",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "c1"}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 24},
+									Filter:   "filter",
+									Where: WhereClause{
+										BaseExpr: &BaseExpr{line: 1, char: 32},
+										Where:    "where",
+										Filter:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "c2"}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select sum(c1) filter (where c2) over ()",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "sum",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "c1"}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									Filter:   "filter",
+									Where: WhereClause{
+										BaseExpr: &BaseExpr{line: 1, char: 24},
+										Where:    "where",
+										Filter:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "c2"}},
+									},
+								},
+								Over:           "over",
+								AnalyticClause: AnalyticClause{},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select count(column1)",
 		Output: []Statement{
@@ -2081,6 +2339,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select var(column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "var",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select count(distinct column1)",
 		Output: []Statement{
@@ -2335,7 +2615,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2350,7 +2630,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows unbounded preceding)",
+		Input: "select sum(c1) over w from t window w as (order by c1 range between 1.5 preceding and current row)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2360,27 +2640,55 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "userfunc",
-								Over:     "over",
+								Name:     "sum",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "c1"}},
+								},
+								Over: "over",
 								AnalyticClause: AnalyticClause{
+									BaseExpr:   &BaseExpr{line: 1, char: 21},
+									WindowName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "w"},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "t"}},
+						},
+					},
+					WindowClause: WindowClause{
+						Window: "window",
+						Windows: []QueryExpression{
+							WindowDefinition{
+								Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "w"},
+								As:   "as",
+								Clause: AnalyticClause{
 									OrderByClause: OrderByClause{
 										OrderBy: "order by",
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "c1"}},
 											},
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: RANGE, Literal: "range", Line: 1, Char: 55},
 										FrameLow: WindowFramePosition{
-											Direction: PRECEDING,
-											Unbounded: true,
-											Literal:   "unbounded preceding",
+											Direction:   PRECEDING,
+											OffsetValue: NewFloatValueFromString("1.5"),
+											Literal:     "1.5 preceding",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: CURRENT,
+											Literal:   "current row",
 										},
+										Between: "between",
+										And:     "and",
 									},
 								},
-							}},
+							},
 						},
 					},
 				},
@@ -2388,7 +2696,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows 1 preceding)",
+		Input: "select count(*) over (order by c1 groups between 1 preceding and 1 following)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2398,24 +2706,34 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "userfunc",
-								Over:     "over",
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Over: "over",
 								AnalyticClause: AnalyticClause{
 									OrderByClause: OrderByClause{
 										OrderBy: "order by",
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "c1"}},
 											},
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: GROUPS, Literal: "groups", Line: 1, Char: 35},
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Offset:    1,
 											Literal:   "1 preceding",
 										},
+										FrameHigh: WindowFramePosition{
+											Direction: FOLLOWING,
+											Offset:    1,
+											Literal:   "1 following",
+										},
+										Between: "between",
+										And:     "and",
 									},
 								},
 							}},
@@ -2426,7 +2744,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows between unbounded preceding and 1 following)",
+		Input: "select userfunc() over (order by column2 rows unbounded preceding)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2448,19 +2766,12 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Unbounded: true,
 											Literal:   "unbounded preceding",
 										},
-										FrameHigh: WindowFramePosition{
-											Direction: FOLLOWING,
-											Offset:    1,
-											Literal:   "1 following",
-										},
-										Between: "between",
-										And:     "and",
 									},
 								},
 							}},
@@ -2471,7 +2782,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select userfunc() over (order by column2 rows between 1 preceding and unbounded following)",
+		Input: "select userfunc() over (order by column2 rows 1 preceding)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2493,13 +2804,134 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: PRECEDING,
 											Offset:    1,
 											Literal:   "1 preceding",
 										},
-										FrameHigh: WindowFramePosition{
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 range interval '1 day' preceding)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Unit: Token{Token: RANGE, Literal: "range", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction:   PRECEDING,
+											OffsetValue: NewIntervalValueFromString("1 day"),
+											Literal:     "INTERVAL '1 day' preceding",
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 rows between unbounded preceding and 1 following)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: PRECEDING,
+											Unbounded: true,
+											Literal:   "unbounded preceding",
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: FOLLOWING,
+											Offset:    1,
+											Literal:   "1 following",
+										},
+										Between: "between",
+										And:     "and",
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 rows between 1 preceding and unbounded following)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								Over:     "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: PRECEDING,
+											Offset:    1,
+											Literal:   "1 preceding",
+										},
+										FrameHigh: WindowFramePosition{
 											Direction: FOLLOWING,
 											Unbounded: true,
 											Literal:   "unbounded following",
@@ -2538,7 +2970,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2670,7 +3102,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 65},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2716,7 +3148,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 67},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2762,7 +3194,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 61},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2815,6 +3247,70 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select var(column1) over ()",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "var",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+								Over:           "over",
+								AnalyticClause: AnalyticClause{},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select percentile_cont(0.5) within group (order by column1) over (partition by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_cont",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								WithinGroup: "within group",
+								Over:        "over",
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 80}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "column2"}},
+										},
+									},
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}},
+											},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select listagg(column1, ',') over (partition by column1 order by column2)",
 		Output: []Statement{
@@ -2923,7 +3419,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 73},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -2971,7 +3467,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
-										Rows: "rows",
+										Unit: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 86},
 										FrameLow: WindowFramePosition{
 											Direction: CURRENT,
 											Literal:   "current row",
@@ -3224,6 +3720,73 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 pivot (sum(c1) for c2 in ('a' as x, 1)) p",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Pivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Pivot:    "pivot",
+									Aggregate: AggregateFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 29},
+										Name:     "sum",
+										Args: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "c1"}},
+										},
+									},
+									For:   "for",
+									Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "c2"}},
+									In:    "in",
+									Values: []QueryExpression{
+										Field{Object: NewStringValue("a"), As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "x"}},
+										Field{Object: NewIntegerValueFromString("1")},
+									},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 unpivot (v for n in (c1, c2 as x))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Unpivot{
+									BaseExpr:    &BaseExpr{line: 1, char: 22},
+									Table:       Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Unpivot:     "unpivot",
+									ValueColumn: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "v"},
+									For:         "for",
+									NameColumn:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "n"},
+									In:          "in",
+									Fields: []QueryExpression{
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "c1"}}},
+										Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 47}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "c2"}}, As: "as", Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "x"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 left join table2 using(id) left join table3 using(id)",
 		Output: []Statement{
@@ -3722,6 +4285,96 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "merge into table1 t using table2 s on t.column1 = s.column3 when matched and s.column4 = 'x' then delete when matched then update set column2 = s.column4 when not matched then insert (column1, column2) values (s.column3, s.column4)",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t"}},
+				Source:   Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table2"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "s"}},
+				Condition: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}},
+					Operator: "=",
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column3"}},
+				},
+				WhenList: []MergeWhen{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 61},
+						Matched:  true,
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 78}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "column4"}},
+							Operator: "=",
+							RHS:      NewStringValue("x"),
+						},
+						Action: Token{Token: DELETE, Literal: "delete", Line: 1, Char: 99},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 106},
+						Matched:  true,
+						Action:   Token{Token: UPDATE, Literal: "update", Line: 1, Char: 124},
+						SetList: []UpdateSet{
+							{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 135}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 135}, Literal: "column2"}}, Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 145}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 145}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 147}, Literal: "column4"}}},
+						},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 155},
+						Action:   Token{Token: INSERT, Literal: "insert", Line: 1, Char: 177},
+						Fields: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 185}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 185}, Literal: "column1"}},
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 194}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 194}, Literal: "column2"}},
+						},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 210},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 211}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 211}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 213}, Literal: "column3"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 222}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 222}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 224}, Literal: "column4"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "with ct as (select 1) merge into t using ct on true when matched then delete",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
+				WithClause: WithClause{
+					With: "with",
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
+							As:   "as",
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 13},
+										Select:   "select",
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "t"}},
+				Source:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "ct"}},
+				Condition: NewTernaryValueFromString("true"),
+				WhenList: []MergeWhen{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 53},
+						Matched:  true,
+						Action:   Token{Token: DELETE, Literal: "delete", Line: 1, Char: 71},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2)",
 		Output: []Statement{
@@ -3734,6 +4387,66 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 integer, column2)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "integer"},
+					},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column2"},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (column1 integer primary key, column2 not null check (column2 <> ''), constraint uq unique (column1, column2))",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "integer"},
+						Constraints: []QueryExpression{
+							TableConstraint{BaseExpr: &BaseExpr{line: 1, char: 40}, Type: Token{Token: PRIMARY, Literal: "primary key"}},
+						},
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 53},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"},
+						Constraints: []QueryExpression{
+							TableConstraint{BaseExpr: &BaseExpr{line: 1, char: 61}, Type: Token{Token: NOT, Literal: "not null"}},
+							TableConstraint{
+								BaseExpr: &BaseExpr{line: 1, char: 70},
+								Type:     Token{Token: CHECK, Literal: "check", Line: 1, Char: 70},
+								Check: Comparison{
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 77}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "column2"}},
+									Operator: "<>",
+									RHS:      NewStringValue(""),
+								},
+							},
+						},
+					},
+					TableConstraint{
+						BaseExpr:   &BaseExpr{line: 1, char: 93},
+						Constraint: "constraint",
+						Name:       Identifier{BaseExpr: &BaseExpr{line: 1, char: 104}, Literal: "uq"},
+						Type:       Token{Token: UNIQUE, Literal: "unique", Line: 1, Char: 107},
+						Columns: []QueryExpression{
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 115}, Literal: "column1"},
+							Identifier{BaseExpr: &BaseExpr{line: 1, char: 124}, Literal: "column2"},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -3838,6 +4551,19 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create index idx on table1 (column1, column2)",
+		Output: []Statement{
+			CreateIndex{
+				Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "idx"},
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"},
+				Columns: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column2"},
+				},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 add column1",
 		Output: []Statement{
@@ -3921,6 +4647,50 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 add constraint pk primary key (column1)",
+		Output: []Statement{
+			AddConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Constraint: TableConstraint{
+					BaseExpr:   &BaseExpr{line: 1, char: 24},
+					Constraint: "constraint",
+					Name:       Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "pk"},
+					Type:       Token{Token: PRIMARY, Literal: "primary key"},
+					Columns: []QueryExpression{
+						Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "column1"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 add not null (key)",
+		Output: []Statement{
+			AddConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Constraint: TableConstraint{
+					BaseExpr: &BaseExpr{line: 1, char: 24},
+					Type:     Token{Token: NOT, Literal: "not null"},
+					Columns: []QueryExpression{
+						Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "key"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "alter table table1 drop constraint pk",
+		Output: []Statement{
+			DropConstraint{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "pk"},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 drop column1",
 		Output: []Statement{
@@ -3985,6 +4755,44 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 rename to table2",
+		Output: []Statement{
+			RenameTable{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				New:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "table2"},
+			},
+		},
+	},
+	{
+		Input: "drop table table1",
+		Output: []Statement{
+			DropTable{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "drop table if exists table1",
+		Output: []Statement{
+			DropTable{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table1"},
+				IfExists: true,
+			},
+		},
+	},
+	{
+		Input: "truncate table table1",
+		Output: []Statement{
+			TruncateTable{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "table1"},
+			},
+		},
+	},
 	{
 		Input: "commit",
 		Output: []Statement{
@@ -4223,6 +5031,29 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select foo",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 9}, Select: "select", Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "foo"}}}}},
+				}},
+			},
+		},
+	},
+	{
+		Input: "explain analyze select foo",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  true,
+				Query: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 17}, Select: "select", Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 24}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "foo"}}}}},
+				}},
+			},
+		},
+	},
 	{
 		Input: "show fields from table1",
 		Output: []Statement{
@@ -4823,6 +5654,82 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "try print 1; catch print 2; trigger error; end try",
+		Output: []Statement{
+			Try{
+				Statements: []Statement{
+					Print{Value: NewIntegerValueFromString("1")},
+				},
+				CatchStatements: []Statement{
+					Print{Value: NewIntegerValueFromString("2")},
+					Trigger{
+						BaseExpr: &BaseExpr{line: 1, char: 29},
+						Event:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "error"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "while true do try break; catch continue; end try; end while",
+		Output: []Statement{
+			While{
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					Try{
+						Statements: []Statement{
+							FlowControl{Token: BREAK},
+						},
+						CatchStatements: []Statement{
+							FlowControl{Token: CONTINUE},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin try return 1; catch return; end try; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					Try{
+						Statements: []Statement{
+							Return{Value: NewIntegerValueFromString("1")},
+						},
+						CatchStatements: []Statement{
+							Return{Value: NewNullValue()},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin while true do try continue; catch return; end try; end while; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							Try{
+								Statements: []Statement{
+									FlowControl{Token: CONTINUE},
+								},
+								CatchStatements: []Statement{
+									Return{Value: NewNullValue()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare func1 function () as begin end",
 		Output: []Statement{
@@ -5132,6 +6039,76 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare proc1 procedure () as begin select 1; end",
+		Output: []Statement{
+			ProcedureDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "proc1"},
+				Statements: []Statement{
+					SelectQuery{
+						SelectEntity: SelectEntity{
+							SelectClause: SelectClause{
+								BaseExpr: &BaseExpr{line: 1, char: 37},
+								Select:   "select",
+								Fields: []QueryExpression{
+									Field{Object: NewIntegerValueFromString("1")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare proc1 procedure (@arg1, out @arg2, @arg3 default 0) as begin return; end",
+		Output: []Statement{
+			ProcedureDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "proc1"},
+				Parameters: []ProcedureParameter{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 26}, Name: "arg1"}},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 37}, Name: "arg2"}, Out: true},
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 44}, Name: "arg3"}, Value: NewIntegerValueFromString("0")},
+				},
+				Statements: []Statement{
+					Return{Value: NewNullValue()},
+				},
+			},
+		},
+	},
+	{
+		Input: "dispose procedure proc1",
+		Output: []Statement{
+			DisposeProcedure{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "proc1"},
+			},
+		},
+	},
+	{
+		Input: "call proc1(1, @var1)",
+		Output: []Statement{
+			CallProcedure{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "proc1"},
+				Args: []QueryExpression{
+					NewIntegerValueFromString("1"),
+					Variable{BaseExpr: &BaseExpr{line: 1, char: 15}, Name: "var1"},
+				},
+			},
+		},
+	},
+	{
+		Input: "call('echo')",
+		Output: []Statement{
+			Function{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     "call",
+				Args: []QueryExpression{
+					NewStringValue("echo"),
+				},
+			},
+		},
+	},
 	{
 		Input: "select @var1 := @var2 + @var3",
 		Output: []Statement{
@@ -5428,7 +6405,7 @@ var parseTests = []struct {
 
 func TestParse(t *testing.T) {
 	for _, v := range parseTests {
-		prog, err := Parse(v.Input, v.SourceFile)
+		prog, err := Parse(v.Input, v.SourceFile, nil, time.Local)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("unexpected error %q for %q", err, v.Input)
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -30,8 +32,11 @@ const (
 	EnvironmentVariableSign = '%'
 	ExternalCommandSign     = '$'
 	RuntimeInformationSign  = '#'
+	PlaceholderSign         = '?'
+	NamedPlaceholderSign    = ':'
 
 	SubstitutionOperator = ":="
+	IntervalPrefix       = "INTERVAL"
 
 	BeginExpression = '{'
 	EndExpression   = '}'
@@ -57,11 +62,17 @@ var aggregateFunctions = []string{
 	"SUM",
 	"AVG",
 	"MEDIAN",
+	"STDEV",
+	"STDEVP",
+	"VARP",
+	"MODE",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
 	"JSON_AGG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
 }
 
 var analyticFunctions = []string{
@@ -84,6 +95,11 @@ var functionsWithIgnoreNulls = []string{
 	"LEAD",
 }
 
+var tableFunctions = []string{
+	"GENERATE_SERIES",
+	"SPLIT_TO_TABLE",
+}
+
 func TokenLiteral(token int) string {
 	if TokenFrom <= token && token <= TokenTo {
 		return yyToknames[token-TokenFrom+3]
@@ -91,6 +107,21 @@ func TokenLiteral(token int) string {
 	return string(token)
 }
 
+// PlaceholderName returns the name of the variable that a positional placeholder is bound to.
+// Placeholder names begin with a placeholder sign, so they never collide with variables written as @name.
+func PlaceholderName(ordinal int) string {
+	return string(PlaceholderSign) + strconv.Itoa(ordinal)
+}
+
+// NamedPlaceholderName returns the name of the variable that a named placeholder is bound to.
+func NamedPlaceholderName(name string) string {
+	return string(NamedPlaceholderSign) + name
+}
+
+func isPlaceholderName(name string) bool {
+	return 0 < len(name) && (name[0] == PlaceholderSign || name[0] == NamedPlaceholderSign)
+}
+
 type Scanner struct {
 	src     []rune
 	srcPos  int
@@ -100,9 +131,15 @@ type Scanner struct {
 	line       int
 	char       int
 	sourceFile string
+
+	datetimeFormat []string
+	location       *time.Location
+
+	placeholders       bool
+	placeholderOrdinal int
 }
 
-func (s *Scanner) Init(src string, sourceFile string) *Scanner {
+func (s *Scanner) Init(src string, sourceFile string, datetimeFormat []string, location *time.Location) *Scanner {
 	s.src = []rune(src)
 	s.srcPos = 0
 	s.literal = new(bytes.Buffer)
@@ -110,6 +147,14 @@ func (s *Scanner) Init(src string, sourceFile string) *Scanner {
 	s.line = 1
 	s.char = 0
 	s.sourceFile = sourceFile
+	s.datetimeFormat = datetimeFormat
+	s.location = location
+	s.placeholderOrdinal = 0
+	return s
+}
+
+func (s *Scanner) EnablePlaceholders() *Scanner {
+	s.placeholders = true
 	return s
 }
 
@@ -173,6 +218,14 @@ func (s *Scanner) Scan() (Token, error) {
 			token = TERNARY
 		} else if t, e := s.searchKeyword(literal); e == nil {
 			token = rune(t)
+		} else if strings.EqualFold(literal, IntervalPrefix) && s.scanIntervalLiteral() {
+			literal = cmd.UnescapeString(s.literal.String())
+			token = INTERVAL
+			if _, e := value.StrToInterval(literal); e != nil && s.err == nil {
+				s.err = errors.New("invalid interval literal")
+			}
+		} else if !s.isFollowedByParenthesis() {
+			token = IDENTIFIER
 		} else if s.isAggregateFunctions(literal) {
 			token = AGGREGATE_FUNCTION
 		} else if s.isListaggFunctions(literal) {
@@ -183,9 +236,19 @@ func (s *Scanner) Scan() (Token, error) {
 			token = FUNCTION_NTH
 		} else if s.isFunctionsWithIgnoreNulls(literal) {
 			token = FUNCTION_WITH_INS
+		} else if s.isTableFunctions(literal) {
+			token = TABLE_FUNCTION
 		} else {
 			token = IDENTIFIER
 		}
+	case s.placeholders && ch == PlaceholderSign:
+		s.placeholderOrdinal++
+		token = VARIABLE
+		literal = PlaceholderName(s.placeholderOrdinal)
+	case s.placeholders && ch == NamedPlaceholderSign && s.isIdentRune(s.peek()):
+		s.scanIdentifier(s.next())
+		token = VARIABLE
+		literal = NamedPlaceholderName(s.literal.String())
 	case s.isOperatorRune(ch):
 		s.scanOperator(ch)
 
@@ -247,7 +310,7 @@ func (s *Scanner) Scan() (Token, error) {
 		case '"', '\'':
 			s.scanString(ch)
 			literal = cmd.UnescapeString(s.literal.String())
-			if _, e := value.StrToTime(literal); e == nil {
+			if _, e := value.StrToTime(literal, s.datetimeFormat, s.location); e == nil {
 				token = DATETIME
 			} else {
 				token = STRING
@@ -288,6 +351,26 @@ func (s *Scanner) scanString(quote rune) {
 	}
 }
 
+func (s *Scanner) scanIntervalLiteral() bool {
+	for unicode.IsSpace(s.peek()) {
+		s.next()
+	}
+
+	switch quote := s.peek(); quote {
+	case '"', '\'':
+		s.scanString(s.next())
+		return true
+	}
+	return false
+}
+
+func (s *Scanner) isFollowedByParenthesis() bool {
+	for unicode.IsSpace(s.peek()) {
+		s.next()
+	}
+	return s.peek() == '('
+}
+
 func (s *Scanner) scanIdentifier(head rune) {
 	s.literal.Reset()
 
@@ -395,6 +478,15 @@ func (s *Scanner) isFunctionsWithIgnoreNulls(str string) bool {
 	return false
 }
 
+func (s *Scanner) isTableFunctions(str string) bool {
+	for _, v := range tableFunctions {
+		if strings.EqualFold(v, str) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Scanner) isComparisonOperators(str string) bool {
 	for _, v := range comparisonOperators {
 		if v == str {
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"testing"
+	"time"
 )
 
 type scanResult struct {
@@ -13,10 +14,11 @@ type scanResult struct {
 }
 
 var scanTests = []struct {
-	Name   string
-	Input  string
-	Output []scanResult
-	Error  string
+	Name         string
+	Input        string
+	Placeholders bool
+	Output       []scanResult
+	Error        string
 }{
 	{
 		Name:  "Identifier",
@@ -119,6 +121,24 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "Interval",
+		Input: "interval '3 days 2 hours' + interval",
+		Output: []scanResult{
+			{
+				Token:   INTERVAL,
+				Literal: "3 days 2 hours",
+			},
+			{
+				Token:   '+',
+				Literal: "+",
+			},
+			{
+				Token:   IDENTIFIER,
+				Literal: "interval",
+			},
+		},
+	},
 	{
 		Name:  "Flag",
 		Input: "@@flag",
@@ -232,42 +252,82 @@ var scanTests = []struct {
 	},
 	{
 		Name:  "AggregateFunction",
-		Input: "sum",
+		Input: "sum (",
 		Output: []scanResult{
 			{
 				Token:   AGGREGATE_FUNCTION,
 				Literal: "sum",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
+		},
+	},
+	{
+		Name:  "FunctionNameAsIdentifier",
+		Input: "mode",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "mode",
+			},
 		},
 	},
 	{
 		Name:  "AnalyticFunction",
-		Input: "rank",
+		Input: "rank (",
 		Output: []scanResult{
 			{
 				Token:   ANALYTIC_FUNCTION,
 				Literal: "rank",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
 		Name:  "FunctionNTH",
-		Input: "nth_value",
+		Input: "nth_value (",
 		Output: []scanResult{
 			{
 				Token:   FUNCTION_NTH,
 				Literal: "nth_value",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
 		Name:  "FunctionWithINS",
-		Input: "lag",
+		Input: "lag (",
 		Output: []scanResult{
 			{
 				Token:   FUNCTION_WITH_INS,
 				Literal: "lag",
 			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
+		},
+	},
+	{
+		Name:  "TableFunction",
+		Input: "generate_series (",
+		Output: []scanResult{
+			{
+				Token:   TABLE_FUNCTION,
+				Literal: "generate_series",
+			},
+			{
+				Token:   '(',
+				Literal: "(",
+			},
 		},
 	},
 	{
@@ -450,16 +510,49 @@ var scanTests = []struct {
 		Input: "\"",
 		Error: "literal not terminated",
 	},
+	{
+		Name:  "Invalid Interval Literal",
+		Input: "INTERVAL '3 fortnights'",
+		Error: "invalid interval literal",
+	},
 	{
 		Name:  "Invalid Variable Symbol",
 		Input: "@@@",
 		Error: "invalid variable symbol",
 	},
+	{
+		Name:         "Placeholders",
+		Input:        "? = :name and '?' = ? and @a := 1",
+		Placeholders: true,
+		Output: []scanResult{
+			{Token: VARIABLE, Literal: "?1"},
+			{Token: '=', Literal: "="},
+			{Token: VARIABLE, Literal: ":name"},
+			{Token: AND, Literal: "and"},
+			{Token: STRING, Literal: "?"},
+			{Token: '=', Literal: "="},
+			{Token: VARIABLE, Literal: "?2"},
+			{Token: AND, Literal: "and"},
+			{Token: VARIABLE, Literal: "a"},
+			{Token: SUBSTITUTION_OP, Literal: ":="},
+			{Token: INTEGER, Literal: "1"},
+		},
+	},
+	{
+		Name:  "Placeholders Disabled",
+		Input: "?",
+		Output: []scanResult{
+			{Token: '?', Literal: "?"},
+		},
+	},
 }
 
 func TestScanner_Scan(t *testing.T) {
 	for _, v := range scanTests {
-		s := new(Scanner).Init(v.Input, "")
+		s := new(Scanner).Init(v.Input, "", nil, time.Local)
+		if v.Placeholders {
+			s.EnablePlaceholders()
+		}
 
 		tokenCount := 0
 		for {
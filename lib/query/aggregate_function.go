@@ -1,9 +1,13 @@
 package query
 
 import (
+	"bytes"
+	"math"
+	"math/big"
 	"sort"
 	"strings"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/value"
 	txjson "github.com/mithrandie/go-text/json"
@@ -11,7 +15,7 @@ import (
 	"github.com/mithrandie/ternary"
 )
 
-type AggregateFunction func([]value.Primary) value.Primary
+type AggregateFunction func([]value.Primary, *cmd.Flags) value.Primary
 
 var AggregateFunctions = map[string]AggregateFunction{
 	"COUNT":  Count,
@@ -20,9 +24,14 @@ var AggregateFunctions = map[string]AggregateFunction{
 	"SUM":    Sum,
 	"AVG":    Avg,
 	"MEDIAN": Median,
+	"STDEV":  StdEV,
+	"STDEVP": StdEVP,
+	"VAR":    Var,
+	"VARP":   VarP,
+	"MODE":   Mode,
 }
 
-func Count(list []value.Primary) value.Primary {
+func Count(list []value.Primary, flags *cmd.Flags) value.Primary {
 	var count int64
 	for _, v := range list {
 		if !value.IsNull(v) {
@@ -33,7 +42,7 @@ func Count(list []value.Primary) value.Primary {
 	return value.NewInteger(count)
 }
 
-func Max(list []value.Primary) value.Primary {
+func Max(list []value.Primary, flags *cmd.Flags) value.Primary {
 	var result value.Primary
 	result = value.NewNull()
 
@@ -47,7 +56,7 @@ func Max(list []value.Primary) value.Primary {
 			continue
 		}
 
-		if value.Greater(v, result) == ternary.TRUE {
+		if value.Greater(v, result, flags.DatetimeFormat, flags.GetLocation()) == ternary.TRUE {
 			result = v
 		}
 	}
@@ -55,7 +64,7 @@ func Max(list []value.Primary) value.Primary {
 	return result
 }
 
-func Min(list []value.Primary) value.Primary {
+func Min(list []value.Primary, flags *cmd.Flags) value.Primary {
 	var result value.Primary
 	result = value.NewNull()
 
@@ -69,7 +78,7 @@ func Min(list []value.Primary) value.Primary {
 			continue
 		}
 
-		if value.Less(v, result) == ternary.TRUE {
+		if value.Less(v, result, flags.DatetimeFormat, flags.GetLocation()) == ternary.TRUE {
 			result = v
 		}
 	}
@@ -77,7 +86,23 @@ func Min(list []value.Primary) value.Primary {
 	return result
 }
 
-func Sum(list []value.Primary) value.Primary {
+func Sum(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if includesInterval(list) {
+		sum, count := sumInterval(list)
+		if count < 1 {
+			return value.NewNull()
+		}
+		return sum
+	}
+
+	if includesDecimalOperand(list, flags) {
+		sum, count := sumDecimal(list)
+		if count < 1 {
+			return value.NewNull()
+		}
+		return sum
+	}
+
 	var sum float64
 	var count int
 
@@ -97,7 +122,25 @@ func Sum(list []value.Primary) value.Primary {
 	return value.ParseFloat64(sum)
 }
 
-func Avg(list []value.Primary) value.Primary {
+func Avg(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if includesInterval(list) {
+		sum, count := sumInterval(list)
+		if count < 1 {
+			return value.NewNull()
+		}
+		avg, _ := sum.Div(float64(count))
+		return avg
+	}
+
+	if includesDecimalOperand(list, flags) {
+		sum, count := sumDecimal(list)
+		if count < 1 {
+			return value.NewNull()
+		}
+		avg, _ := sum.Div(value.NewDecimal(big.NewInt(int64(count)), 0))
+		return avg
+	}
+
 	var sum float64
 	var count int
 
@@ -119,7 +162,57 @@ func Avg(list []value.Primary) value.Primary {
 	return value.ParseFloat64(avg)
 }
 
-func Median(list []value.Primary) value.Primary {
+func includesDecimalOperand(list []value.Primary, flags *cmd.Flags) bool {
+	for _, v := range list {
+		if isDecimalOperand(v, flags) {
+			return true
+		}
+	}
+	return false
+}
+
+func sumDecimal(list []value.Primary) (value.Decimal, int) {
+	var sum value.Decimal
+	var count int
+
+	for _, v := range list {
+		d := value.ToDecimal(v)
+		if value.IsNull(d) {
+			continue
+		}
+
+		sum = sum.Add(d.(value.Decimal))
+		count++
+	}
+	return sum, count
+}
+
+func includesInterval(list []value.Primary) bool {
+	for _, v := range list {
+		if isIntervalValue(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func sumInterval(list []value.Primary) (value.Interval, int) {
+	var sum value.Interval
+	var count int
+
+	for _, v := range list {
+		iv := value.ToInterval(v)
+		if value.IsNull(iv) {
+			continue
+		}
+
+		sum = sum.Add(iv.(value.Interval))
+		count++
+	}
+	return sum, count
+}
+
+func Median(list []value.Primary, flags *cmd.Flags) value.Primary {
 	var values []float64
 
 	for _, v := range list {
@@ -127,7 +220,7 @@ func Median(list []value.Primary) value.Primary {
 			values = append(values, f.(value.Float).Raw())
 			continue
 		}
-		if d := value.ToDatetime(v); !value.IsNull(d) {
+		if d := value.ToDatetime(v, flags.DatetimeFormat, flags.GetLocation()); !value.IsNull(d) {
 			values = append(values, float64(d.(value.Datetime).Raw().UnixNano())/1e9)
 			continue
 		}
@@ -150,6 +243,144 @@ func Median(list []value.Primary) value.Primary {
 	return value.ParseFloat64(median)
 }
 
+func StdEV(list []value.Primary, flags *cmd.Flags) value.Primary {
+	v, ok := variance(list, false)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+func StdEVP(list []value.Primary, flags *cmd.Flags) value.Primary {
+	v, ok := variance(list, true)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(v))
+}
+
+func Var(list []value.Primary, flags *cmd.Flags) value.Primary {
+	v, ok := variance(list, false)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+func VarP(list []value.Primary, flags *cmd.Flags) value.Primary {
+	v, ok := variance(list, true)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(v)
+}
+
+func variance(list []value.Primary, isPopulation bool) (float64, bool) {
+	values := make([]float64, 0, len(list))
+	var sum float64
+
+	for _, v := range list {
+		f := value.ToFloat(v)
+		if value.IsNull(f) {
+			continue
+		}
+
+		values = append(values, f.(value.Float).Raw())
+		sum += f.(value.Float).Raw()
+	}
+
+	divisor := len(values)
+	if !isPopulation {
+		divisor--
+	}
+	if divisor < 1 {
+		return 0, false
+	}
+
+	avg := sum / float64(len(values))
+	var squares float64
+	for _, f := range values {
+		squares += (f - avg) * (f - avg)
+	}
+
+	return squares / float64(divisor), true
+}
+
+func Mode(list []value.Primary, flags *cmd.Flags) value.Primary {
+	counts := make(map[string]int)
+	firstValues := make(map[string]value.Primary)
+	keys := make([]string, 0, len(list))
+	keyBuf := new(bytes.Buffer)
+
+	for _, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, []value.Primary{v}, flags)
+		key := keyBuf.String()
+
+		if _, ok := counts[key]; !ok {
+			firstValues[key] = v
+			keys = append(keys, key)
+		}
+		counts[key]++
+	}
+
+	var result value.Primary
+	result = value.NewNull()
+	maxCount := 0
+	for _, key := range keys {
+		if maxCount < counts[key] {
+			maxCount = counts[key]
+			result = firstValues[key]
+		}
+	}
+	return result
+}
+
+func PercentileCont(list []value.Primary, fraction float64) value.Primary {
+	values := make([]float64, 0, len(list))
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(value.Float).Raw())
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	low := math.Floor(pos)
+	high := math.Ceil(pos)
+
+	if low == high {
+		return value.ParseFloat64(values[int(low)])
+	}
+	return value.ParseFloat64(values[int(low)] + (pos-low)*(values[int(high)]-values[int(low)]))
+}
+
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -35,7 +36,7 @@ var countTests = []aggregateTests{
 
 func TestCount(t *testing.T) {
 	for _, v := range countTests {
-		r := Count(v.List)
+		r := Count(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("count list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
@@ -64,7 +65,7 @@ var maxTests = []aggregateTests{
 
 func TestMax(t *testing.T) {
 	for _, v := range maxTests {
-		r := Max(v.List)
+		r := Max(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("max list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
@@ -93,7 +94,7 @@ var minTests = []aggregateTests{
 
 func TestMin(t *testing.T) {
 	for _, v := range minTests {
-		r := Min(v.List)
+		r := Min(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("min list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
@@ -112,6 +113,24 @@ var sumTests = []aggregateTests{
 		},
 		Result: value.NewInteger(8),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("0.10"),
+			value.NewString("0.2"),
+			value.NewNull(),
+			value.NewInteger(1),
+		},
+		Result: value.NewDecimalFromString("1.30"),
+	},
+	{
+		List: []value.Primary{
+			value.NewIntervalFromString("1 day"),
+			value.NewString("2 hours"),
+			value.NewNull(),
+			value.NewIntervalFromString("P1M"),
+		},
+		Result: value.NewInterval(1, 1, int64(2*time.Hour)),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -122,7 +141,7 @@ var sumTests = []aggregateTests{
 
 func TestSum(t *testing.T) {
 	for _, v := range sumTests {
-		r := Sum(v.List)
+		r := Sum(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("sum list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
@@ -141,6 +160,30 @@ var avgTests = []aggregateTests{
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("1.50"),
+			value.NewDecimalFromString("2.50"),
+			value.NewNull(),
+		},
+		Result: value.NewDecimalFromString("2.00"),
+	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("10.00"),
+			value.NewDecimalFromString("10.00"),
+			value.NewDecimalFromString("10.01"),
+		},
+		Result: value.NewDecimalFromString("10.0033333333333333"),
+	},
+	{
+		List: []value.Primary{
+			value.NewIntervalFromString("1 day"),
+			value.NewIntervalFromString("2 hours"),
+			value.NewNull(),
+		},
+		Result: value.NewInterval(0, 0, int64(13*time.Hour)),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -151,7 +194,7 @@ var avgTests = []aggregateTests{
 
 func TestAvg(t *testing.T) {
 	for _, v := range avgTests {
-		r := Avg(v.List)
+		r := Avg(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("avg list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
@@ -201,13 +244,291 @@ var medianTests = []aggregateTests{
 
 func TestMedian(t *testing.T) {
 	for _, v := range medianTests {
-		r := Median(v.List)
+		r := Median(v.List, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("median list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
 	}
 }
 
+var stdevTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(5),
+			value.NewString("5"),
+			value.NewInteger(7),
+			value.NewString("abc"),
+			value.NewInteger(9),
+		},
+		Result: value.NewFloat(2.138089935299395),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(3),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestStdEV(t *testing.T) {
+	for _, v := range stdevTests {
+		r := StdEV(v.List, cmd.GetFlags())
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("stdev list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var stdevpTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(5),
+			value.NewString("5"),
+			value.NewInteger(7),
+			value.NewString("abc"),
+			value.NewInteger(9),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(3),
+			value.NewNull(),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestStdEVP(t *testing.T) {
+	for _, v := range stdevpTests {
+		r := StdEVP(v.List, cmd.GetFlags())
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("stdevp list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var varianceTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(5),
+			value.NewString("5"),
+			value.NewInteger(7),
+			value.NewString("abc"),
+			value.NewInteger(9),
+		},
+		Result: value.NewFloat(4.571428571428571),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(3),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestVar(t *testing.T) {
+	for _, v := range varianceTests {
+		r := Var(v.List, cmd.GetFlags())
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("variance list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var varpTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(5),
+			value.NewString("5"),
+			value.NewInteger(7),
+			value.NewString("abc"),
+			value.NewInteger(9),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(3),
+			value.NewNull(),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestVarP(t *testing.T) {
+	for _, v := range varpTests {
+		r := VarP(v.List, cmd.GetFlags())
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("varp list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewString("b"),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("b"),
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewFloat(1),
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, cmd.GetFlags())
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var percentileTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Cont     value.Primary
+	Disc     value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewInteger(3),
+		Disc:     value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Fraction: 0.75,
+		Cont:     value.NewInteger(5),
+		Disc:     value.NewInteger(4),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Fraction: 0.25,
+		Cont:     value.NewFloat(1.75),
+		Disc:     value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Fraction: 0,
+		Cont:     value.NewInteger(1),
+		Disc:     value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewNull(),
+		Disc:     value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileCont(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Cont) {
+			t.Errorf("percentile_cont list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Cont)
+		}
+	}
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileDisc(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Disc) {
+			t.Errorf("percentile_disc list = %s: fraction = %f, result = %s, want %s", v.List, v.Fraction, r, v.Disc)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
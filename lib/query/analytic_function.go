@@ -4,25 +4,30 @@ import (
 	"bytes"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 var AnalyticFunctions = map[string]AnalyticFunction{
-	"ROW_NUMBER":   RowNumber{},
-	"RANK":         Rank{},
-	"DENSE_RANK":   DenseRank{},
-	"CUME_DIST":    CumeDist{},
-	"PERCENT_RANK": PercentRank{},
-	"NTILE":        NTile{},
-	"FIRST_VALUE":  FirstValue{},
-	"LAST_VALUE":   LastValue{},
-	"NTH_VALUE":    NthValue{},
-	"LAG":          Lag{},
-	"LEAD":         Lead{},
-	"LISTAGG":      AnalyticListAgg{},
-	"JSON_AGG":     AnalyticJsonAgg{},
+	"ROW_NUMBER":      RowNumber{},
+	"RANK":            Rank{},
+	"DENSE_RANK":      DenseRank{},
+	"CUME_DIST":       CumeDist{},
+	"PERCENT_RANK":    PercentRank{},
+	"NTILE":           NTile{},
+	"FIRST_VALUE":     FirstValue{},
+	"LAST_VALUE":      LastValue{},
+	"NTH_VALUE":       NthValue{},
+	"LAG":             Lag{},
+	"LEAD":            Lead{},
+	"LISTAGG":         AnalyticListAgg{},
+	"JSON_AGG":        AnalyticJsonAgg{},
+	"PERCENTILE_CONT": AnalyticPercentileCont{},
+	"PERCENTILE_DISC": AnalyticPercentileDisc{},
 }
 
 type AnalyticFunction interface {
@@ -51,6 +56,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 
 	fnType := -1
 	var err error
+	flags := view.Filter.flags()
 
 	uname := strings.ToUpper(fn.Name)
 	if f, ok := AnalyticFunctions[uname]; ok {
@@ -86,7 +92,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 	}
 
 	partitionKeys := make([]string, view.RecordLen())
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(func(index int) {
 		keyBuf := new(bytes.Buffer)
 
 		if view.sortValuesInEachCell[index] == nil {
@@ -98,7 +104,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 				if idx < len(view.sortValuesInEachCell[index]) && view.sortValuesInEachCell[index][idx] != nil {
 					sortValues[j] = view.sortValuesInEachCell[index][idx]
 				} else {
-					sortValues[j] = NewSortValue(view.RecordSet[index][idx].Value())
+					sortValues[j] = NewSortValue(view.RecordSet[index][idx].Value(), flags)
 					if idx < len(view.sortValuesInEachCell[index]) {
 						view.sortValuesInEachCell[index][idx] = sortValues[j]
 					}
@@ -121,7 +127,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 		}
 	}
 
-	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1)
+	gm := NewGoroutineTaskManager(len(partitionMapKeys), -1, flags.CPU)
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
 		go func(thIdx int) {
@@ -152,7 +158,11 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 
 					if fnType == Aggregate {
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet, e := WindowFrameSet(partition, fn, view)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
 
 						valueCache := make(map[int]value.Primary, len(partition))
 
@@ -162,7 +172,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 								gm.SetError(e)
 								break AnalyzeLoop
 							}
-							val := aggfn(values)
+							val := aggfn(values, flags)
 
 							for _, idx := range frame.Records {
 								view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
@@ -170,7 +180,11 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 						}
 					} else { //User Defined Function
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet, e := WindowFrameSet(partition, fn, view)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
 
 						valueCache := make(map[int]value.Primary, len(partition))
 
@@ -224,7 +238,7 @@ type WindowFrame struct {
 	Records []int
 }
 
-func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFrame {
+func WindowFrameSet(partition Partition, expr parser.AnalyticFunction, view *View) ([]WindowFrame, error) {
 	var singleFrameSet = func(partition Partition) []WindowFrame {
 		indices := make([]int, len(partition))
 		for i, idx := range partition {
@@ -233,39 +247,14 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 		return []WindowFrame{{Low: 0, High: len(partition) - 1, Records: indices}}
 	}
 
-	var frameIndex = func(current int, length int, framePosition parser.WindowFramePosition) int {
-		var idx int
-
-		switch framePosition.Direction {
-		case parser.CURRENT:
-			idx = current
-		case parser.PRECEDING:
-			if framePosition.Unbounded {
-				idx = 0
-			} else {
-				idx = current - framePosition.Offset
-			}
-		case parser.FOLLOWING:
-			if framePosition.Unbounded {
-				idx = length - 1
-			} else {
-				idx = current + framePosition.Offset
-			}
-		}
-
-		return idx
-	}
-
 	length := len(partition)
 
-	if expr.OrderByClause == nil {
-		return singleFrameSet(partition)
+	if expr.AnalyticClause.OrderByClause == nil {
+		return singleFrameSet(partition), nil
 	}
 
-	frameSet := make([]WindowFrame, 0, length)
-
 	var windowClause parser.WindowingClause
-	if expr.WindowingClause == nil {
+	if expr.AnalyticClause.WindowingClause == nil {
 		windowClause = parser.WindowingClause{
 			FrameLow: parser.WindowFramePosition{
 				Direction: parser.PRECEDING,
@@ -273,38 +262,312 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 			},
 		}
 	} else {
-		windowClause = expr.WindowingClause.(parser.WindowingClause)
+		windowClause = expr.AnalyticClause.WindowingClause.(parser.WindowingClause)
 	}
 	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
+	frameHigh := parser.WindowFramePosition{Direction: parser.CURRENT}
+	if windowClause.FrameHigh != nil {
+		frameHigh = windowClause.FrameHigh.(parser.WindowFramePosition)
+		if frameLow.Direction == parser.PRECEDING && frameLow.Unbounded && frameHigh.Direction == parser.FOLLOWING && frameHigh.Unbounded {
+			return singleFrameSet(partition), nil
+		}
+	}
+
+	var frameIndex func(current int, framePosition parser.WindowFramePosition, isHigh bool) int
+
+	switch windowClause.Unit.Token {
+	case parser.RANGE:
+		frame, err := newRangeFrame(partition, expr, view, frameLow, frameHigh)
+		if err != nil {
+			return nil, err
+		}
+		frameIndex = frame.index
+	case parser.GROUPS:
+		for _, framePosition := range []parser.WindowFramePosition{frameLow, frameHigh} {
+			if framePosition.OffsetValue != nil {
+				return nil, NewWindowFrameOffsetError(expr, framePosition, windowClause.Unit)
+			}
+		}
+		frame := newPeerGroups(partition, view)
+		frameIndex = frame.groupIndex
+	default:
+		for _, framePosition := range []parser.WindowFramePosition{frameLow, frameHigh} {
+			if framePosition.OffsetValue != nil {
+				return nil, NewWindowFrameOffsetError(expr, framePosition, windowClause.Unit)
+			}
+		}
+		frameIndex = func(current int, framePosition parser.WindowFramePosition, isHigh bool) int {
+			var idx int
+
+			switch framePosition.Direction {
+			case parser.CURRENT:
+				idx = current
+			case parser.PRECEDING:
+				if framePosition.Unbounded {
+					idx = 0
+				} else {
+					idx = current - framePosition.Offset
+				}
+			case parser.FOLLOWING:
+				if framePosition.Unbounded {
+					idx = length - 1
+				} else {
+					idx = current + framePosition.Offset
+				}
+			}
+
+			return idx
+		}
+	}
+
+	frameSet := make([]WindowFrame, 0, length)
+	for current := 0; current < length; current++ {
+		frameSet = append(frameSet, WindowFrame{
+			Low:     frameIndex(current, frameLow, false),
+			High:    frameIndex(current, frameHigh, true),
+			Records: []int{partition[current]},
+		})
+	}
+
+	return frameSet, nil
+}
+
+type peerGroups struct {
+	groupOf []int
+	starts  []int
+	ends    []int
+}
+
+func newPeerGroups(partition Partition, view *View) *peerGroups {
+	groups := &peerGroups{
+		groupOf: make([]int, len(partition)),
+		starts:  make([]int, 0),
+		ends:    make([]int, 0),
+	}
+
+	start := 0
+	for i, group := range perseCumulativeGroups(partition, view) {
+		groups.starts = append(groups.starts, start)
+		groups.ends = append(groups.ends, start+len(group)-1)
+		for j := range group {
+			groups.groupOf[start+j] = i
+		}
+		start += len(group)
+	}
+	return groups
+}
+
+func (groups *peerGroups) groupIndex(current int, framePosition parser.WindowFramePosition, isHigh bool) int {
+	group := groups.groupOf[current]
+	last := len(groups.starts) - 1
+
+	switch framePosition.Direction {
+	case parser.PRECEDING:
+		if framePosition.Unbounded {
+			return 0
+		}
+		group = group - framePosition.Offset
+		if group < 0 {
+			if isHigh {
+				return -1
+			}
+			group = 0
+		}
+	case parser.FOLLOWING:
+		if framePosition.Unbounded {
+			return len(groups.groupOf) - 1
+		}
+		group = group + framePosition.Offset
+		if last < group {
+			if !isHigh {
+				return len(groups.groupOf)
+			}
+			group = last
+		}
+	}
+
+	if isHigh {
+		return groups.ends[group]
+	}
+	return groups.starts[group]
+}
+
+type rangeFrame struct {
+	*peerGroups
+	keys       []value.Primary
+	descending bool
+	offsets    [2]float64
+	intervals  [2]value.Interval
+	byInterval bool
+	location   *time.Location
+	nonNullLow int
+	nonNullLen int
+}
+
+func newRangeFrame(partition Partition, expr parser.AnalyticFunction, view *View, frameLow parser.WindowFramePosition, frameHigh parser.WindowFramePosition) (*rangeFrame, error) {
+	frame := &rangeFrame{
+		peerGroups: newPeerGroups(partition, view),
+		location:   view.Filter.flags().GetLocation(),
+	}
+
+	hasOffset := false
+	for _, framePosition := range []parser.WindowFramePosition{frameLow, frameHigh} {
+		if framePosition.Direction == parser.CURRENT || framePosition.Unbounded {
+			continue
+		}
+		hasOffset = true
+	}
+	if !hasOffset {
+		return frame, nil
+	}
+
+	orderItems := expr.AnalyticClause.OrderByClause.(parser.OrderByClause).Items
+	if len(orderItems) != 1 || view.sortValuesInEachRecord == nil {
+		return nil, NewWindowFrameOrderByItemsError(expr)
+	}
+	frame.descending = view.sortDirections[0] == parser.DESC
+
+	for _, framePosition := range []parser.WindowFramePosition{frameLow, frameHigh} {
+		if framePosition.OffsetValue != nil {
+			if _, ok := framePosition.OffsetValue.(parser.PrimitiveType).Value.(value.Interval); ok {
+				frame.byInterval = true
+			}
+		}
+	}
+
+	for i, framePosition := range []parser.WindowFramePosition{frameLow, frameHigh} {
+		if framePosition.Direction == parser.CURRENT || framePosition.Unbounded {
+			continue
+		}
+		if frame.byInterval {
+			var iv value.Primary = value.NewNull()
+			if framePosition.OffsetValue != nil {
+				iv = framePosition.OffsetValue.(parser.PrimitiveType).Value
+			}
+			if _, ok := iv.(value.Interval); !ok {
+				return nil, NewWindowFrameOffsetError(expr, framePosition, parser.Token{Token: parser.RANGE, Literal: "range"})
+			}
+			frame.intervals[i] = iv.(value.Interval)
+			continue
+		}
+
+		offset := float64(framePosition.Offset)
+		if framePosition.OffsetValue != nil {
+			f := value.ToFloat(framePosition.OffsetValue.(parser.PrimitiveType).Value)
+			if value.IsNull(f) {
+				return nil, NewWindowFrameOffsetError(expr, framePosition, parser.Token{Token: parser.RANGE, Literal: "range"})
+			}
+			offset = f.(value.Float).Raw()
+		}
+		frame.offsets[i] = offset
+	}
+
+	frame.keys = make([]value.Primary, len(partition))
+	frame.nonNullLow = -1
+	for i, idx := range partition {
+		sortValue := view.sortValuesInEachRecord[idx][0]
+		switch sortValue.Type {
+		case NullType:
+			frame.keys[i] = value.NewNull()
+			continue
+		case IntegerType, FloatType, DatetimeType:
+			if frame.byInterval {
+				if !sortValue.IsDatetime {
+					return nil, NewWindowFrameOrderByValueError(expr, orderItems[0].(parser.OrderItem).Value)
+				}
+				frame.keys[i] = value.NewInteger(sortValue.Datetime)
+			} else if sortValue.Type == DatetimeType {
+				frame.keys[i] = value.NewFloat(float64(sortValue.Datetime) / 1e9)
+			} else {
+				frame.keys[i] = value.NewFloat(sortValue.Float)
+			}
+		default:
+			return nil, NewWindowFrameOrderByValueError(expr, orderItems[0].(parser.OrderItem).Value)
+		}
+		if frame.nonNullLow < 0 {
+			frame.nonNullLow = i
+		}
+		frame.nonNullLen++
+	}
+
+	return frame, nil
+}
+
+func (frame *rangeFrame) index(current int, framePosition parser.WindowFramePosition, isHigh bool) int {
+	if framePosition.Direction == parser.CURRENT || framePosition.Unbounded {
+		return frame.groupIndex(current, framePosition, isHigh)
+	}
+	if value.IsNull(frame.keys[current]) {
+		return frame.groupIndex(current, parser.WindowFramePosition{Direction: parser.CURRENT}, isHigh)
+	}
+
+	bound := 0
+	if isHigh {
+		bound = 1
+	}
+	negative := (framePosition.Direction == parser.PRECEDING) != frame.descending
 
-	if windowClause.FrameHigh == nil {
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    current,
-				Records: []int{partition[current]},
-			})
+	var compare func(key value.Primary) int
+	if frame.byInterval {
+		iv := frame.intervals[bound]
+		if negative {
+			iv = iv.Neg()
+		}
+		target := iv.AddTo(time.Unix(0, frame.keys[current].(value.Integer).Raw()).In(frame.location)).UnixNano()
+		compare = func(key value.Primary) int {
+			k := key.(value.Integer).Raw()
+			switch {
+			case k < target:
+				return -1
+			case target < k:
+				return 1
+			}
+			return 0
 		}
 	} else {
-		frameHigh := windowClause.FrameHigh.(parser.WindowFramePosition)
-		if frameLow.Direction == parser.PRECEDING && frameLow.Unbounded && frameHigh.Direction == parser.FOLLOWING && frameHigh.Unbounded {
-			return singleFrameSet(partition)
+		offset := frame.offsets[bound]
+		if negative {
+			offset = -offset
+		}
+		target := frame.keys[current].(value.Float).Raw() + offset
+		compare = func(key value.Primary) int {
+			k := key.(value.Float).Raw()
+			switch {
+			case k < target:
+				return -1
+			case target < k:
+				return 1
+			}
+			return 0
 		}
+	}
 
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    frameIndex(current, length, frameHigh),
-				Records: []int{partition[current]},
-			})
+	var beyond = func(key value.Primary) bool {
+		c := compare(key)
+		if frame.descending {
+			if isHigh {
+				return c < 0
+			}
+			return c <= 0
+		}
+		if isHigh {
+			return 0 < c
 		}
+		return 0 <= c
 	}
 
-	return frameSet
+	pos := frame.nonNullLow + sort.Search(frame.nonNullLen, func(i int) bool {
+		return beyond(frame.keys[frame.nonNullLow+i])
+	})
+	if isHigh {
+		return pos - 1
+	}
+	return pos
 }
 
 func windowValues(frame WindowFrame, partition Partition, expr parser.AnalyticFunction, filter *Filter, valueCache map[int]value.Primary) ([]value.Primary, error) {
 	values := make([]value.Primary, 0, frame.High-frame.Low+1)
+	condition := aggregateFilterCondition(expr)
 
 	for i := frame.Low; i <= frame.High; i++ {
 		if i < 0 || len(partition) <= i {
@@ -312,25 +575,60 @@ func windowValues(frame WindowFrame, partition Partition, expr parser.AnalyticFu
 		}
 
 		recordIdx := partition[i]
-		if v, ok := valueCache[recordIdx]; ok {
-			values = append(values, v)
-		} else {
+		v, ok := valueCache[recordIdx]
+		if !ok {
 			filter.Records[0].RecordIndex = recordIdx
-			p, e := filter.Evaluate(expr.Args[0])
+			p, e := filteredValue(expr.Args[0], condition, filter)
 			if e != nil {
 				return nil, e
 			}
 			valueCache[recordIdx] = p
-			values = append(values, p)
+			v = p
+		}
+		if v != nil {
+			values = append(values, v)
 		}
 	}
 
 	if expr.IsDistinct() {
-		values = Distinguish(values)
+		values = Distinguish(values, filter.flags())
 	}
 	return values, nil
 }
 
+func partitionValues(partition Partition, expr parser.AnalyticFunction, listExpr parser.QueryExpression, filter *Filter) ([]value.Primary, error) {
+	condition := aggregateFilterCondition(expr)
+
+	values := make([]value.Primary, 0, len(partition))
+	for _, idx := range partition {
+		filter.Records[0].RecordIndex = idx
+		val, e := filteredValue(listExpr, condition, filter)
+		if e != nil {
+			return nil, e
+		}
+		if val != nil {
+			values = append(values, val)
+		}
+	}
+	if expr.IsDistinct() {
+		values = Distinguish(values, filter.flags())
+	}
+	return values, nil
+}
+
+func filteredValue(expr parser.QueryExpression, condition parser.QueryExpression, filter *Filter) (value.Primary, error) {
+	if condition != nil {
+		p, err := filter.Evaluate(condition)
+		if err != nil {
+			return nil, err
+		}
+		if p.Ternary() != ternary.TRUE {
+			return nil, nil
+		}
+	}
+	return filter.Evaluate(expr)
+}
+
 func CheckArgsLen(expr parser.AnalyticFunction, length []int) error {
 	if len(length) == 1 {
 		if len(expr.Args) != length[0] {
@@ -586,7 +884,10 @@ func (fn NthValue) Execute(partition Partition, expr parser.AnalyticFunction, fi
 }
 
 func setNthValue(partition Partition, expr parser.AnalyticFunction, filter *Filter, n int) (map[int]value.Primary, error) {
-	frameSet := WindowFrameSet(partition, expr.AnalyticClause)
+	frameSet, err := WindowFrameSet(partition, expr, filter.Records[0].View)
+	if err != nil {
+		return nil, err
+	}
 	list := make(map[int]value.Primary, len(partition))
 
 	valueCache := make(map[int]value.Primary, len(partition))
@@ -728,17 +1029,9 @@ func (fn AnalyticListAgg) Execute(partition Partition, expr parser.AnalyticFunct
 		separator = s.(value.String).Raw()
 	}
 
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
-		filter.Records[0].RecordIndex = idx
-		val, e := filter.Evaluate(expr.Args[0])
-		if e != nil {
-			return nil, e
-		}
-		values[i] = val
-	}
-	if expr.IsDistinct() {
-		values = Distinguish(values)
+	values, err := partitionValues(partition, expr, expr.Args[0], filter)
+	if err != nil {
+		return nil, err
 	}
 
 	val := ListAgg(values, separator)
@@ -761,17 +1054,9 @@ func (fn AnalyticJsonAgg) Execute(partition Partition, expr parser.AnalyticFunct
 	argsFilter := filter.CreateNode()
 	argsFilter.Records = nil
 
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
-		filter.Records[0].RecordIndex = idx
-		val, e := filter.Evaluate(expr.Args[0])
-		if e != nil {
-			return nil, e
-		}
-		values[i] = val
-	}
-	if expr.IsDistinct() {
-		values = Distinguish(values)
+	values, err := partitionValues(partition, expr, expr.Args[0], filter)
+	if err != nil {
+		return nil, err
 	}
 
 	val := JsonAgg(values)
@@ -783,3 +1068,48 @@ func (fn AnalyticJsonAgg) Execute(partition Partition, expr parser.AnalyticFunct
 
 	return list, nil
 }
+
+type AnalyticPercentileCont struct{}
+
+func (fn AnalyticPercentileCont) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileCont) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	return setPercentile(partition, expr, filter, PercentileCont)
+}
+
+type AnalyticPercentileDisc struct{}
+
+func (fn AnalyticPercentileDisc) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileDisc) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
+	return setPercentile(partition, expr, filter, PercentileDisc)
+}
+
+func setPercentile(partition Partition, expr parser.AnalyticFunction, filter *Filter, percentileFn func([]value.Primary, float64) value.Primary) (map[int]value.Primary, error) {
+	argsFilter := filter.CreateNode()
+	argsFilter.Records = nil
+
+	fraction, err := argsFilter.checkArgsForPercentile(expr, expr.Name, expr.Args, expr.AnalyticClause.OrderByClause)
+	if err != nil {
+		return nil, err
+	}
+	listExpr := expr.AnalyticClause.OrderByClause.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+
+	values, err := partitionValues(partition, expr, listExpr, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	val := percentileFn(values, fraction)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
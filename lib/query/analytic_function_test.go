@@ -55,13 +55,13 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachRecord: []SortValues{
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(2))},
-				{NewSortValue(value.NewInteger(2))},
-				{NewSortValue(value.NewInteger(3))},
-				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(3), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
 			},
 		},
 		Function: parser.AnalyticFunction{
@@ -123,22 +123,22 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
 			},
 			sortValuesInEachRecord: []SortValues{
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(1))},
-				{NewSortValue(value.NewInteger(2))},
-				{NewSortValue(value.NewInteger(2))},
-				{NewSortValue(value.NewInteger(3))},
-				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(1), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(3), cmd.GetFlags())},
+				{NewSortValue(value.NewInteger(2), cmd.GetFlags())},
 			},
 		},
 	},
@@ -312,11 +312,101 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction with Filter Clause",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Where: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: "=",
+					},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 		},
 	},
@@ -404,11 +494,11 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 		},
 	},
@@ -440,11 +530,11 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 		},
 		Function: parser.AnalyticFunction{
@@ -493,11 +583,11 @@ var analyzeTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 		},
 	},
@@ -699,11 +789,11 @@ var analyzeTests = []struct {
 				}),
 			},
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 			Filter: &Filter{
 				Functions: UserDefinedFunctionScopes{
@@ -924,11 +1014,11 @@ var analyzeTests = []struct {
 				}),
 			},
 			sortValuesInEachCell: [][]*SortValue{
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("a")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
-				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("a"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
+				{NewSortValue(value.NewString("b"), cmd.GetFlags()), nil},
 			},
 			Filter: &Filter{
 				Functions: UserDefinedFunctionScopes{
@@ -1445,11 +1535,11 @@ var rankExecuteTests = []analyticFunctionExecuteTests{
 		Name:  "Rank Execute",
 		Items: Partition{2, 4, 1, 3, 5},
 		SortValues: map[int]SortValues{
-			2: {NewSortValue(value.NewString("1"))},
-			4: {NewSortValue(value.NewString("1"))},
-			1: {NewSortValue(value.NewString("2"))},
-			3: {NewSortValue(value.NewString("2"))},
-			5: {NewSortValue(value.NewString("3"))},
+			2: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			4: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			1: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			3: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			5: {NewSortValue(value.NewString("3"), cmd.GetFlags())},
 		},
 		Function: parser.AnalyticFunction{
 			Name: "rank",
@@ -1490,11 +1580,11 @@ var denseRankExecuteTests = []analyticFunctionExecuteTests{
 		Name:  "DenseRank Execute",
 		Items: Partition{2, 4, 1, 3, 5},
 		SortValues: map[int]SortValues{
-			2: {NewSortValue(value.NewString("1"))},
-			4: {NewSortValue(value.NewString("1"))},
-			1: {NewSortValue(value.NewString("2"))},
-			3: {NewSortValue(value.NewString("2"))},
-			5: {NewSortValue(value.NewString("3"))},
+			2: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			4: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			1: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			3: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			5: {NewSortValue(value.NewString("3"), cmd.GetFlags())},
 		},
 		Function: parser.AnalyticFunction{
 			Name: "dense_rank",
@@ -1535,10 +1625,10 @@ var cumeDistExecuteTests = []analyticFunctionExecuteTests{
 		Name:  "CumeDist Execute",
 		Items: Partition{2, 4, 1, 3},
 		SortValues: map[int]SortValues{
-			2: {NewSortValue(value.NewString("1"))},
-			4: {NewSortValue(value.NewString("2"))},
-			1: {NewSortValue(value.NewString("2"))},
-			3: {NewSortValue(value.NewString("3"))},
+			2: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			4: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			1: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			3: {NewSortValue(value.NewString("3"), cmd.GetFlags())},
 		},
 		Function: parser.AnalyticFunction{
 			Name: "cume_dist",
@@ -1578,11 +1668,11 @@ var percentRankExecuteTests = []analyticFunctionExecuteTests{
 		Name:  "PercentRank Execute",
 		Items: Partition{2, 4, 1, 3, 5},
 		SortValues: map[int]SortValues{
-			2: {NewSortValue(value.NewString("1"))},
-			4: {NewSortValue(value.NewString("2"))},
-			1: {NewSortValue(value.NewString("2"))},
-			3: {NewSortValue(value.NewString("3"))},
-			5: {NewSortValue(value.NewString("4"))},
+			2: {NewSortValue(value.NewString("1"), cmd.GetFlags())},
+			4: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			1: {NewSortValue(value.NewString("2"), cmd.GetFlags())},
+			3: {NewSortValue(value.NewString("3"), cmd.GetFlags())},
+			5: {NewSortValue(value.NewString("4"), cmd.GetFlags())},
 		},
 		Function: parser.AnalyticFunction{
 			Name: "percent_rank",
@@ -2417,3 +2507,112 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticPercentileContExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileCont Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(175),
+			1: value.NewInteger(175),
+			2: value.NewInteger(175),
+			3: value.NewInteger(175),
+			4: value.NewInteger(175),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Order By Clause Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Error: "[L:- C:-] exactly one order item is required in the within group clause for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Argument Value Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(1.5),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Order Item Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestAnalyticPercentileCont_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileCont{}, analyticPercentileContExecuteTests)
+}
+
+var analyticPercentileDiscExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileDisc Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			1: value.NewInteger(100),
+			2: value.NewInteger(100),
+			3: value.NewInteger(100),
+			4: value.NewInteger(100),
+		},
+	},
+}
+
+func TestAnalyticPercentileDisc_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileDisc{}, analyticPercentileDiscExecuteTests)
+}
@@ -2,11 +2,53 @@ package query
 
 import (
 	"math"
+	"strings"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
+func isDecimalValue(p value.Primary) bool {
+	_, ok := p.(value.Decimal)
+	return ok
+}
+
+func isDecimalOperand(p value.Primary, flags *cmd.Flags) bool {
+	switch p.(type) {
+	case value.Decimal:
+		return true
+	case value.String:
+		return flags.NumberAsDecimal && !value.IsNull(value.ToDecimal(p))
+	}
+	return false
+}
+
+func isIntervalValue(p value.Primary) bool {
+	_, ok := p.(value.Interval)
+	return ok
+}
+
+func toDatetimeOperand(p value.Primary, flags *cmd.Flags) (value.Datetime, bool) {
+	switch p.(type) {
+	case value.Datetime:
+		return p.(value.Datetime), true
+	case value.String:
+		if t, err := value.StrToTime(strings.TrimSpace(p.(value.String).Raw()), flags.DatetimeFormat, flags.GetLocation()); err == nil {
+			return value.NewDatetime(t), true
+		}
+	}
+	return value.Datetime{}, false
+}
+
+func Calculate(p1 value.Primary, p2 value.Primary, operator int, flags *cmd.Flags) value.Primary {
+	if isIntervalValue(p1) || isIntervalValue(p2) {
+		return calculateInterval(p1, p2, operator, flags)
+	}
+
+	if isDecimalValue(p1) || isDecimalValue(p2) {
+		return calculateDecimal(p1, p2, operator)
+	}
+
 	if operator != '/' {
 		if pi1 := value.ToInteger(p1); !value.IsNull(pi1) {
 			if pi2 := value.ToInteger(p2); !value.IsNull(pi2) {
@@ -15,10 +57,21 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 		}
 	}
 
+	if isDecimalOperand(p1, flags) || isDecimalOperand(p2, flags) {
+		return calculateDecimal(p1, p2, operator)
+	}
+
 	pf1 := value.ToFloat(p1)
 	pf2 := value.ToFloat(p2)
 
 	if value.IsNull(pf1) || value.IsNull(pf2) {
+		if operator == '-' {
+			if dt1, ok := toDatetimeOperand(p1, flags); ok {
+				if dt2, ok := toDatetimeOperand(p2, flags); ok {
+					return value.IntervalBetween(dt1.Raw(), dt2.Raw())
+				}
+			}
+		}
 		return value.NewNull()
 	}
 
@@ -42,6 +95,14 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 	return value.ParseFloat64(result)
 }
 
+func negateDecimal(p value.Primary, operator int) value.Primary {
+	d := value.ToDecimal(p).(value.Decimal)
+	if operator == '-' {
+		return d.Neg()
+	}
+	return d
+}
+
 func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 	var result int64 = 0
 	switch operator {
@@ -57,3 +118,86 @@ func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 
 	return value.NewInteger(result)
 }
+
+func calculateDecimal(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
+	pd1 := value.ToDecimal(p1)
+	pd2 := value.ToDecimal(p2)
+
+	if value.IsNull(pd1) || value.IsNull(pd2) {
+		return value.NewNull()
+	}
+
+	d1 := pd1.(value.Decimal)
+	d2 := pd2.(value.Decimal)
+
+	var result value.Decimal
+	ok := true
+	switch operator {
+	case '+':
+		result = d1.Add(d2)
+	case '-':
+		result = d1.Sub(d2)
+	case '*':
+		result = d1.Mul(d2)
+	case '/':
+		result, ok = d1.Div(d2)
+	case '%':
+		result, ok = d1.Mod(d2)
+	}
+
+	if !ok {
+		return value.NewNull()
+	}
+	return result
+}
+
+func negateInterval(p value.Primary, operator int) value.Primary {
+	iv := p.(value.Interval)
+	if operator == '-' {
+		return iv.Neg()
+	}
+	return iv
+}
+
+func calculateInterval(p1 value.Primary, p2 value.Primary, operator int, flags *cmd.Flags) value.Primary {
+	switch operator {
+	case '+', '-':
+		if iv1 := value.ToInterval(p1); !value.IsNull(iv1) {
+			if iv2 := value.ToInterval(p2); !value.IsNull(iv2) {
+				if operator == '-' {
+					return iv1.(value.Interval).Sub(iv2.(value.Interval))
+				}
+				return iv1.(value.Interval).Add(iv2.(value.Interval))
+			}
+			if dt, ok := toDatetimeOperand(p2, flags); ok && operator == '+' {
+				return value.NewDatetime(iv1.(value.Interval).AddTo(dt.Raw()))
+			}
+			return value.NewNull()
+		}
+
+		if dt, ok := toDatetimeOperand(p1, flags); ok {
+			iv := p2.(value.Interval)
+			if operator == '-' {
+				iv = iv.Neg()
+			}
+			return value.NewDatetime(iv.AddTo(dt.Raw()))
+		}
+	case '*':
+		if iv, ok := p1.(value.Interval); ok {
+			if f := value.ToFloat(p2); !value.IsNull(f) {
+				return iv.Mul(f.(value.Float).Raw())
+			}
+		} else if f := value.ToFloat(p1); !value.IsNull(f) {
+			return p2.(value.Interval).Mul(f.(value.Float).Raw())
+		}
+	case '/':
+		if iv, ok := p1.(value.Interval); ok {
+			if f := value.ToFloat(p2); !value.IsNull(f) {
+				if result, ok := iv.Div(f.(value.Float).Raw()); ok {
+					return result
+				}
+			}
+		}
+	}
+	return value.NewNull()
+}
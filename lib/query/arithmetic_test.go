@@ -3,15 +3,18 @@ package query
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 var calculateTests = []struct {
-	LHS      value.Primary
-	RHS      value.Primary
-	Operator int
-	Result   value.Primary
+	LHS             value.Primary
+	RHS             value.Primary
+	Operator        int
+	NumberAsDecimal bool
+	Result          value.Primary
 }{
 	{
 		LHS:      value.NewString("9"),
@@ -73,13 +76,170 @@ var calculateTests = []struct {
 		Operator: '%',
 		Result:   value.NewFloat(0.5),
 	},
+	{
+		LHS:      value.NewDecimalFromString("0.10"),
+		RHS:      value.NewFloat(0.2),
+		Operator: '+',
+		Result:   value.NewDecimalFromString("0.30"),
+	},
+	{
+		LHS:      value.NewInteger(3),
+		RHS:      value.NewDecimalFromString("1.25"),
+		Operator: '-',
+		Result:   value.NewDecimalFromString("1.75"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewString("1.25"),
+		Operator: '*',
+		Result:   value.NewDecimalFromString("1.875"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("10.00"),
+		RHS:      value.NewInteger(4),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("2.50"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1"),
+		RHS:      value.NewInteger(3),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("0.3333333333333333"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("-2"),
+		RHS:      value.NewInteger(3),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("-0.6666666666666667"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewInteger(0),
+		Operator: '/',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDecimalFromString("-8.5"),
+		RHS:      value.NewInteger(3),
+		Operator: '%',
+		Result:   value.NewDecimalFromString("-2.5"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewString("abc"),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 1, 31, 10, 0, 0, 0, time.UTC)),
+		RHS:      value.NewIntervalFromString("1 month 2 hours"),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 2, 29, 12, 0, 0, 0, time.UTC)),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewDatetime(time.Date(2012, 1, 31, 10, 0, 0, 0, time.UTC)),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 2, 1, 10, 0, 0, 0, time.UTC)),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 1, 0, 0, 0, 0, time.UTC)),
+		RHS:      value.NewIntervalFromString("P1D"),
+		Operator: '-',
+		Result:   value.NewDatetime(time.Date(2012, 2, 29, 0, 0, 0, 0, time.UTC)),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewDatetime(time.Date(2012, 1, 31, 10, 0, 0, 0, time.UTC)),
+		Operator: '-',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewString("2 hours"),
+		Operator: '-',
+		Result:   value.NewInterval(0, 1, -int64(2*time.Hour)),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day 1 hour"),
+		RHS:      value.NewString("1.5"),
+		Operator: '*',
+		Result:   value.NewInterval(0, 1, int64(13*time.Hour+30*time.Minute)),
+	},
+	{
+		LHS:      value.NewInteger(2),
+		RHS:      value.NewIntervalFromString("1 month"),
+		Operator: '*',
+		Result:   value.NewInterval(2, 0, 0),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewInteger(4),
+		Operator: '/',
+		Result:   value.NewInterval(0, 0, int64(6*time.Hour)),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewInteger(0),
+		Operator: '/',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewIntervalFromString("1 day"),
+		RHS:      value.NewInteger(2),
+		Operator: '%',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 1, 3, 12, 30, 0, 0, time.UTC)),
+		RHS:      value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Operator: '-',
+		Result:   value.NewInterval(0, 2, int64(12*time.Hour+30*time.Minute)),
+	},
+	{
+		LHS:      value.NewString("2012-01-01 00:00:00"),
+		RHS:      value.NewString("2012-01-01 06:00:00"),
+		Operator: '-',
+		Result:   value.NewInterval(0, 0, -int64(6*time.Hour)),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 1, 3, 12, 30, 0, 0, time.UTC)),
+		RHS:      value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:             value.NewString("0.1"),
+		RHS:             value.NewString("0.2"),
+		Operator:        '+',
+		NumberAsDecimal: true,
+		Result:          value.NewDecimalFromString("0.3"),
+	},
+	{
+		LHS:             value.NewString("9"),
+		RHS:             value.NewString("2"),
+		Operator:        '+',
+		NumberAsDecimal: true,
+		Result:          value.NewInteger(11),
+	},
+	{
+		LHS:             value.NewString("9"),
+		RHS:             value.NewString("2"),
+		Operator:        '/',
+		NumberAsDecimal: true,
+		Result:          value.NewDecimalFromString("4.5"),
+	},
 }
 
 func TestCalculate(t *testing.T) {
+	flags := cmd.GetFlags()
+
 	for _, v := range calculateTests {
-		r := Calculate(v.LHS, v.RHS, v.Operator)
+		flags.NumberAsDecimal = v.NumberAsDecimal
+		r := Calculate(v.LHS, v.RHS, v.Operator, cmd.GetFlags())
 		if !reflect.DeepEqual(r, v.Result) {
 			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(v.Operator), v.RHS)
 		}
 	}
+	flags.NumberAsDecimal = false
 }
@@ -61,7 +61,7 @@ func Echo(expr parser.Echo, filter *Filter) (string, error) {
 		return "", err
 	}
 
-	return Formatter.Format("%s", []value.Primary{p})
+	return NewStringFormatter().Format("%s", []value.Primary{p})
 }
 
 func Print(expr parser.Print, filter *Filter) (string, error) {
@@ -92,7 +92,7 @@ func Printf(expr parser.Printf, filter *Filter) (string, error) {
 		args[i] = p
 	}
 
-	message, err := Formatter.Format(format, args)
+	message, err := NewStringFormatter().Format(format, args)
 	if err != nil {
 		return "", NewReplaceValueLengthError(expr, err.(AppError).ErrorMessage())
 	}
@@ -120,10 +120,10 @@ func Source(expr parser.Source, filter *Filter) ([]parser.Statement, error) {
 		return nil, NewSourceInvalidFilePathError(expr, expr.FilePath)
 	}
 
-	return LoadStatementsFromFile(expr, fpath)
+	return LoadStatementsFromFile(expr, fpath, filter.flags())
 }
 
-func LoadStatementsFromFile(expr parser.Source, fpath string) ([]parser.Statement, error) {
+func LoadStatementsFromFile(expr parser.Source, fpath string, flags *cmd.Flags) ([]parser.Statement, error) {
 	if !filepath.IsAbs(fpath) {
 		if abs, err := filepath.Abs(fpath); err == nil {
 			fpath = abs
@@ -134,7 +134,7 @@ func LoadStatementsFromFile(expr parser.Source, fpath string) ([]parser.Statemen
 		return nil, NewSourceFileNotExistError(expr, fpath)
 	}
 
-	h, err := file.NewHandlerForRead(fpath)
+	h, err := file.NewHandlerForRead(fpath, flags.WaitTimeout, flags.RetryInterval)
 	if err != nil {
 		return nil, NewReadFileError(expr, err.Error())
 	}
@@ -146,7 +146,7 @@ func LoadStatementsFromFile(expr parser.Source, fpath string) ([]parser.Statemen
 	}
 	input := string(buf)
 
-	statements, err := parser.Parse(input, fpath)
+	statements, err := parser.Parse(input, fpath, flags.DatetimeFormat, flags.GetLocation())
 	if err != nil {
 		err = NewSyntaxError(err.(*parser.SyntaxError))
 	}
@@ -173,11 +173,11 @@ func ParseExecuteStatements(expr parser.Execute, filter *Filter) ([]parser.State
 		args[i] = p
 	}
 
-	input, err = Formatter.Format(input, args)
+	input, err = NewStringFormatter().Format(input, args)
 	if err != nil {
 		return nil, NewReplaceValueLengthError(expr, err.(AppError).ErrorMessage())
 	}
-	statements, err := parser.Parse(input, fmt.Sprintf("(L:%d C:%d) EXECUTE", expr.Line(), expr.Char()))
+	statements, err := parser.Parse(input, fmt.Sprintf("(L:%d C:%d) EXECUTE", expr.Line(), expr.Char()), filter.flags().DatetimeFormat, filter.flags().GetLocation())
 	if err != nil {
 		err = NewSyntaxError(err.(*parser.SyntaxError))
 	}
@@ -199,14 +199,14 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
-		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape:
+		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape, cmd.CompressionFlag:
 		p = value.ToString(p)
-	case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+	case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.NumberAsDecimalFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 		p = value.ToBoolean(p)
 	case cmd.WaitTimeoutFlag:
 		p = value.ToFloat(p)
-	case cmd.CPUFlag:
+	case cmd.InferTypesFlag, cmd.CPUFlag, cmd.MemoryLimitFlag:
 		p = value.ToInteger(p)
 	default:
 		return NewInvalidFlagNameError(expr, expr.Name)
@@ -215,7 +215,7 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		return NewFlagValueNotAllowedFormatError(expr)
 	}
 
-	flags := cmd.GetFlags()
+	flags := filter.flags()
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.RepositoryFlag:
@@ -236,6 +236,10 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		flags.SetNoHeader(p.(value.Boolean).Raw())
 	case cmd.WithoutNullFlag:
 		flags.SetWithoutNull(p.(value.Boolean).Raw())
+	case cmd.NumberAsDecimalFlag:
+		flags.SetNumberAsDecimal(p.(value.Boolean).Raw())
+	case cmd.InferTypesFlag:
+		flags.SetInferTypes(int(p.(value.Integer).Raw()))
 	case cmd.FormatFlag:
 		err = flags.SetFormat(p.(value.String).Raw(), "")
 	case cmd.WriteEncodingFlag:
@@ -252,6 +256,8 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 		err = flags.SetJsonEscape(p.(value.String).Raw())
 	case cmd.PrettyPrintFlag:
 		flags.SetPrettyPrint(p.(value.Boolean).Raw())
+	case cmd.CompressionFlag:
+		err = flags.SetCompression(p.(value.String).Raw(), "")
 	case cmd.EastAsianEncodingFlag:
 		flags.SetEastAsianEncoding(p.(value.Boolean).Raw())
 	case cmd.CountDiacriticalSignFlag:
@@ -259,11 +265,17 @@ func SetFlag(expr parser.SetFlag, filter *Filter) error {
 	case cmd.CountFormatCodeFlag:
 		flags.SetCountFormatCode(p.(value.Boolean).Raw())
 	case cmd.ColorFlag:
-		flags.SetColor(p.(value.Boolean).Raw())
+		if filter.inSession() {
+			flags.Color = p.(value.Boolean).Raw()
+		} else {
+			flags.SetColor(p.(value.Boolean).Raw())
+		}
 	case cmd.QuietFlag:
 		flags.SetQuiet(p.(value.Boolean).Raw())
 	case cmd.CPUFlag:
 		flags.SetCPU(int(p.(value.Integer).Raw()))
+	case cmd.MemoryLimitFlag:
+		flags.SetMemoryLimit(int(p.(value.Integer).Raw()))
 	case cmd.StatsFlag:
 		flags.SetStats(p.(value.Boolean).Raw())
 	}
@@ -284,11 +296,11 @@ func AddFlagElement(expr parser.AddFlagElement, filter *Filter) error {
 		}
 		return SetFlag(e, filter)
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
-		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape, cmd.CompressionFlag,
+		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.NumberAsDecimalFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
-		cmd.CPUFlag:
+		cmd.InferTypesFlag, cmd.CPUFlag, cmd.MemoryLimitFlag:
 
 		return NewAddFlagNotSupportedNameError(expr)
 	default:
@@ -307,7 +319,7 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 
 	switch strings.ToUpper(expr.Name) {
 	case cmd.DatetimeFormatFlag:
-		flags := cmd.GetFlags()
+		flags := filter.flags()
 
 		if i := value.ToInteger(p); !value.IsNull(i) {
 			idx := int(i.(value.Integer).Raw())
@@ -328,11 +340,11 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DelimiterFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
-		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+		cmd.WriteEncodingFlag, cmd.FormatFlag, cmd.WriteDelimiterFlag, cmd.LineBreakFlag, cmd.JsonEscape, cmd.CompressionFlag,
+		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.NumberAsDecimalFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag,
 		cmd.WaitTimeoutFlag,
-		cmd.CPUFlag:
+		cmd.InferTypesFlag, cmd.CPUFlag, cmd.MemoryLimitFlag:
 
 		return NewRemoveFlagNotSupportedNameError(expr)
 	default:
@@ -342,21 +354,21 @@ func RemoveFlagElement(expr parser.RemoveFlagElement, filter *Filter) error {
 	return nil
 }
 
-func ShowFlag(expr parser.ShowFlag) (string, error) {
-	s, err := showFlag(expr.Name)
+func ShowFlag(expr parser.ShowFlag, filter *Filter) (string, error) {
+	s, err := showFlag(expr.Name, filter)
 	if err != nil {
 		return s, NewInvalidFlagNameError(expr, expr.Name)
 	}
 
-	palette, _ := cmd.GetPalette()
+	palette := filter.palette()
 	return palette.Render(cmd.LableEffect, cmd.FlagSymbol(strings.ToUpper(expr.Name)+":")) + " " + s, nil
 }
 
-func showFlag(flag string) (string, error) {
+func showFlag(flag string, filter *Filter) (string, error) {
 	var s string
 
-	flags := cmd.GetFlags()
-	palette, _ := cmd.GetPalette()
+	flags := filter.flags()
+	palette := filter.palette()
 
 	switch strings.ToUpper(flag) {
 	case cmd.RepositoryFlag:
@@ -405,19 +417,23 @@ func showFlag(flag string) (string, error) {
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+q)
 		}
 	case cmd.EncodingFlag:
-		s = palette.Render(cmd.StringEffect, flags.Encoding.String())
+		s = palette.Render(cmd.StringEffect, cmd.EncodingString(flags.Encoding))
 	case cmd.NoHeaderFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NoHeader))
 	case cmd.WithoutNullFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.WithoutNull))
+	case cmd.NumberAsDecimalFlag:
+		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.NumberAsDecimal))
+	case cmd.InferTypesFlag:
+		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.InferTypes))
 	case cmd.FormatFlag:
 		s = palette.Render(cmd.StringEffect, flags.Format.String())
 	case cmd.WriteEncodingFlag:
 		switch flags.Format {
 		case cmd.JSON:
-			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+flags.WriteEncoding.String())
+			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+cmd.EncodingString(flags.WriteEncoding))
 		default:
-			s = palette.Render(cmd.StringEffect, flags.WriteEncoding.String())
+			s = palette.Render(cmd.StringEffect, cmd.EncodingString(flags.WriteEncoding))
 		}
 	case cmd.WriteDelimiterFlag:
 		d := "'" + cmd.EscapeString(string(flags.WriteDelimiter)) + "'"
@@ -464,6 +480,8 @@ func showFlag(flag string) (string, error) {
 		default:
 			s = palette.Render(cmd.NullEffect, IgnoredFlagPrefix+s)
 		}
+	case cmd.CompressionFlag:
+		s = palette.Render(cmd.StringEffect, flags.Compression.String())
 	case cmd.EastAsianEncodingFlag:
 		s = strconv.FormatBool(flags.EastAsianEncoding)
 		switch flags.Format {
@@ -494,6 +512,8 @@ func showFlag(flag string) (string, error) {
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Quiet))
 	case cmd.CPUFlag:
 		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.CPU))
+	case cmd.MemoryLimitFlag:
+		s = palette.Render(cmd.NumberEffect, strconv.Itoa(flags.MemoryLimit))
 	case cmd.StatsFlag:
 		s = palette.Render(cmd.BooleanEffect, strconv.FormatBool(flags.Stats))
 	default:
@@ -506,20 +526,20 @@ func showFlag(flag string) (string, error) {
 func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	var s string
 
-	w := NewObjectWriter()
+	w := NewObjectWriter(filter)
 
 	switch strings.ToUpper(expr.Type.Literal) {
 	case ShowTables:
-		keys := ViewCache.SortedKeys()
+		keys := filter.viewCache().SortedKeys()
 
 		if len(keys) < 1 {
 			s = cmd.Warn("No table is loaded")
 		} else {
-			createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+			createdFiles, updatedFiles := filter.uncommittedViews().UncommittedFiles()
 
 			for _, key := range keys {
-				fields := ViewCache[key].Header.TableColumnNames()
-				info := ViewCache[key].FileInfo
+				fields := filter.viewCache()[key].Header.TableColumnNames()
+				info := filter.viewCache()[key].FileInfo
 				ufpath := strings.ToUpper(info.Path)
 
 				if _, ok := createdFiles[ufpath]; ok {
@@ -553,7 +573,7 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 		} else {
 			keys := views.SortedKeys()
 
-			updatedViews := UncommittedViews.UncommittedTempViews()
+			updatedViews := filter.uncommittedViews().UncommittedTempViews()
 
 			for _, key := range keys {
 				fields := views[key].Header.TableColumnNames()
@@ -630,7 +650,8 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 		}
 	case ShowFunctions:
 		scalas, aggs := filter.Functions.All()
-		if len(scalas) < 1 && len(aggs) < 1 {
+		procs := filter.Functions.Procedures()
+		if len(scalas) < 1 && len(aggs) < 1 && len(procs) < 1 {
 			s = cmd.Warn("No function is declared")
 		} else {
 			if 0 < len(scalas) {
@@ -643,15 +664,20 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 				w.Clear()
 				writeFunctions(w, aggs)
 				w.Title1 = "Aggregate Functions"
-				s += "\n" + w.String() + "\n"
-			} else {
-				s += "\n"
+				s += "\n" + w.String()
 			}
+			if 0 < len(procs) {
+				w.Clear()
+				writeFunctions(w, procs)
+				w.Title1 = "Procedures"
+				s += "\n" + w.String()
+			}
+			s += "\n"
 		}
 	case ShowFlags:
 		for _, flag := range cmd.FlagList {
 			symbol := cmd.FlagSymbol(flag)
-			s, _ := showFlag(flag)
+			s, _ := showFlag(flag, filter)
 			w.WriteSpaces(24 - len(symbol))
 			w.WriteColorWithoutLineBreak(symbol, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
@@ -695,16 +721,18 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	case ShowRuninfo:
 		for _, ri := range RuntimeInformatinList {
 			label := string(parser.VariableSign) + string(parser.RuntimeInformationSign) + ri
-			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri})
+			p, _ := GetRuntimeInformation(parser.RuntimeInformation{Name: ri}, filter)
 
 			w.WriteSpaces(19 - len(label))
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
-			switch ri {
-			case WorkingDirectory, VersionInformation:
+			switch {
+			case value.IsNull(p):
+				w.WriteColorWithoutLineBreak(p.String(), cmd.NullEffect)
+			case ri == WorkingDirectory || ri == VersionInformation || ri == ErrorMessageInformation:
 				w.WriteColorWithoutLineBreak(p.(value.String).Raw(), cmd.StringEffect)
-			case UncommittedInformation:
+			case ri == UncommittedInformation:
 				w.WriteColorWithoutLineBreak(p.(value.Boolean).String(), cmd.BooleanEffect)
 			default:
 				w.WriteColorWithoutLineBreak(p.(value.Integer).String(), cmd.NumberEffect)
@@ -724,7 +752,7 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 	w.WriteColor("Format: ", cmd.LableEffect)
 	w.WriteWithoutLineBreak(info.Format.String())
 
-	w.WriteSpaces(8 - cmd.TextWidth(info.Format.String()))
+	w.WriteSpaces(8 - w.textWidth(info.Format.String()))
 	switch info.Format {
 	case cmd.CSV:
 		w.WriteColorWithoutLineBreak("Delimiter: ", cmd.LableEffect)
@@ -752,11 +780,19 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Sheet)
+		if 0 < len(info.CellRange) {
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("Range: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(info.CellRange)
+		}
 	}
 
 	switch info.Format {
 	case cmd.CSV, cmd.TSV:
-		w.WriteSpaces(4 - (cmd.TextWidth(cmd.EscapeString(string(info.Delimiter)))))
+		w.WriteSpaces(4 - (w.textWidth(cmd.EscapeString(string(info.Delimiter)))))
 		w.WriteColorWithoutLineBreak("Enclose All: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.EncloseAll))
 	}
@@ -765,26 +801,36 @@ func writeTableAttribute(w *ObjectWriter, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.XLSX:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
-		w.WriteWithoutLineBreak(info.Encoding.String())
+		w.WriteWithoutLineBreak(cmd.EncodingString(info.Encoding))
 	}
 
-	w.WriteSpaces(6 - (cmd.TextWidth(info.Encoding.String())))
+	spaces := 6 - (w.textWidth(cmd.EncodingString(info.Encoding)))
+	if spaces < 2 {
+		spaces = 2
+	}
+	w.WriteSpaces(spaces)
 	w.WriteColorWithoutLineBreak("LineBreak: ", cmd.LableEffect)
 	w.WriteWithoutLineBreak(info.LineBreak.String())
 
 	switch info.Format {
 	case cmd.JSON:
-		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String())))
+		w.WriteSpaces(6 - (w.textWidth(info.LineBreak.String())))
 		w.WriteColorWithoutLineBreak("Pretty Print: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.PrettyPrint))
-	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
-		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String())))
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.XLSX:
+		w.WriteSpaces(6 - (w.textWidth(info.LineBreak.String())))
 		w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 	}
+
+	if info.Compression != cmd.NoCompression {
+		w.NewLine()
+		w.WriteColor("Compression: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Compression.String())
+	}
 }
 
 func writeFields(w *ObjectWriter, fields []string) {
@@ -826,6 +872,9 @@ func writeFunctions(w *ObjectWriter, funcs UserDefinedFunctionMap) {
 			if 0 < i {
 				w.WriteWithoutLineBreak(", ")
 			}
+			if fn.IsOutParameter(i) {
+				w.WriteWithoutLineBreak("OUT ")
+			}
 			if def, ok := fn.Defaults[p.Name]; ok {
 				w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
 				w.WriteWithoutLineBreak(" = ")
@@ -855,14 +904,14 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 	}
 
 	if view.FileInfo.IsTemporary {
-		updatedViews := UncommittedViews.UncommittedTempViews()
+		updatedViews := filter.uncommittedViews().UncommittedTempViews()
 		ufpath := strings.ToUpper(view.FileInfo.Path)
 
 		if _, ok := updatedViews[ufpath]; ok {
 			status = ObjectUpdated
 		}
 	} else {
-		createdViews, updatedView := UncommittedViews.UncommittedFiles()
+		createdViews, updatedView := filter.uncommittedViews().UncommittedFiles()
 		ufpath := strings.ToUpper(view.FileInfo.Path)
 
 		if _, ok := createdViews[ufpath]; ok {
@@ -872,7 +921,7 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 		}
 	}
 
-	w := NewObjectWriter()
+	w := NewObjectWriter(filter)
 	w.WriteColorWithoutLineBreak("Type: ", cmd.LableEffect)
 	if view.FileInfo.IsTemporary {
 		w.WriteWithoutLineBreak("View")
@@ -897,7 +946,12 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 	}
 
 	w.NewLine()
-	writeFieldList(w, view.Header.TableColumnNames())
+	fields := view.Header.TableColumnNames()
+	types := make([]string, len(fields))
+	for i := range fields {
+		types[i] = view.FileInfo.Schema.ColumnType(fields[i])
+	}
+	writeFieldList(w, fields, types)
 
 	w.Title1 = "Fields in"
 	w.Title2 = expr.Table.Literal
@@ -905,7 +959,7 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 	return "\n" + w.String() + "\n", nil
 }
 
-func writeFieldList(w *ObjectWriter, fields []string) {
+func writeFieldList(w *ObjectWriter, fields []string, types []string) {
 	l := len(fields)
 	digits := len(strconv.Itoa(l))
 	fieldNumbers := make([]string, 0, l)
@@ -923,6 +977,10 @@ func writeFieldList(w *ObjectWriter, fields []string) {
 		w.Write(".")
 		w.WriteSpaces(1)
 		w.WriteColorWithoutLineBreak(fields[i], cmd.AttributeEffect)
+		if 0 < len(types[i]) {
+			w.WriteSpaces(1)
+			w.WriteColorWithoutLineBreak(types[i], cmd.ValueEffect)
+		}
 		w.NewLine()
 	}
 }
@@ -987,7 +1045,7 @@ func Pwd(expr parser.Pwd) (string, error) {
 	return dirpath, err
 }
 
-func Reload(expr parser.Reload) error {
+func Reload(expr parser.Reload, filter *Filter) error {
 	switch strings.ToUpper(expr.Type.Literal) {
 	case ReloadConfig:
 		if err := cmd.LoadEnvironment(); err != nil {
@@ -996,7 +1054,7 @@ func Reload(expr parser.Reload) error {
 
 		env, _ := cmd.GetEnvironment()
 
-		flags := cmd.GetFlags()
+		flags := filter.flags()
 		for _, v := range env.DatetimeFormat {
 			flags.DatetimeFormat = cmd.AppendStrIfNotExist(flags.DatetimeFormat, v)
 		}
@@ -1008,8 +1066,8 @@ func Reload(expr parser.Reload) error {
 		oldPalette, _ := cmd.GetPalette()
 		oldPalette.Merge(palette)
 
-		if Terminal != nil {
-			if err := Terminal.ReloadConfig(); err != nil {
+		if t := filter.terminal(); t != nil {
+			if err := t.ReloadConfig(); err != nil {
 				return NewLoadConfigurationError(expr, err.Error())
 			}
 		}
@@ -1049,11 +1107,11 @@ func Syntax(expr parser.Syntax, filter *Filter) string {
 	exps := store.Search(keys)
 
 	var p *color.Palette
-	if cmd.GetFlags().Color {
-		p, _ = cmd.GetPalette()
+	if filter.flags().Color {
+		p = filter.palette()
 	}
 
-	w := NewObjectWriter()
+	w := NewObjectWriter(filter)
 
 	for _, exp := range exps {
 		w.WriteColor(exp.Label, cmd.LableEffect)
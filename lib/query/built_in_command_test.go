@@ -441,6 +441,20 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set NumberAsDecimal",
+		Expr: parser.SetFlag{
+			Name:  "number_as_decimal",
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set InferTypes",
+		Expr: parser.SetFlag{
+			Name:  "infer_types",
+			Value: parser.NewIntegerValue(100),
+		},
+	},
 	{
 		Name: "Set Format",
 		Expr: parser.SetFlag{
@@ -539,6 +553,13 @@ var setFlagTests = []struct {
 			Value: parser.NewIntegerValue(int64(runtime.NumCPU())),
 		},
 	},
+	{
+		Name: "Set Memory Limit",
+		Expr: parser.SetFlag{
+			Name:  "memory_limit",
+			Value: parser.NewIntegerValue(0),
+		},
+	},
 	{
 		Name: "Set Stats",
 		Expr: parser.SetFlag{
@@ -995,6 +1016,32 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@WITHOUT_NULL:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show NumberAsDecimal",
+		Expr: parser.ShowFlag{
+			Name: "number_as_decimal",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "number_as_decimal",
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@NUMBER_AS_DECIMAL:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show InferTypes",
+		Expr: parser.ShowFlag{
+			Name: "infer_types",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "infer_types",
+				Value: parser.NewIntegerValue(100),
+			},
+		},
+		Result: "\033[34;1m@@INFER_TYPES:\033[0m \033[35m100\033[0m",
+	},
 	{
 		Name: "Show Format",
 		Expr: parser.ShowFlag{
@@ -1375,6 +1422,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@CPU:\033[0m \033[35m1\033[0m",
 	},
+	{
+		Name: "Show Memory Limit",
+		Expr: parser.ShowFlag{
+			Name: "memory_limit",
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Name:  "memory_limit",
+				Value: parser.NewIntegerValue(100),
+			},
+		},
+		Result: "\033[34;1m@@MEMORY_LIMIT:\033[0m \033[35m100\033[0m",
+	},
 	{
 		Name: "Show Stats",
 		Expr: parser.ShowFlag{
@@ -1406,7 +1466,7 @@ func TestShowFlag(t *testing.T) {
 		for _, expr := range v.SetExprs {
 			SetFlag(expr, filter)
 		}
-		result, err := ShowFlag(v.Expr)
+		result, err := ShowFlag(v.Expr, NewEmptyFilter())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1492,6 +1552,17 @@ var showObjectsTests = []struct {
 					PrettyPrint: false,
 				},
 			},
+			"TABLE2.CSV": &View{
+				Header: NewHeader("table2", []string{"col1", "col2"}),
+				FileInfo: &FileInfo{
+					Path:      "table2.csv",
+					Delimiter: ',',
+					Format:    cmd.CSV,
+					Encoding:  cmd.UTF16LEM,
+					LineBreak: text.CRLF,
+					NoHeader:  false,
+				},
+			},
 			"TABLE1.TXT": &View{
 				Header: NewHeader("table1", []string{"col1", "col2"}),
 				FileInfo: &FileInfo{
@@ -1523,6 +1594,10 @@ var showObjectsTests = []struct {
 			"     Fields: col1, col2\n" +
 			"     Format: FIXED   Delimiter Positions: [3, 12]\n" +
 			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
+			" table2.csv\n" +
+			"     Fields: col1, col2\n" +
+			"     Format: CSV     Delimiter: ','   Enclose All: false\n" +
+			"     Encoding: UTF16LEM  LineBreak: CRLF  Header: true\n" +
 			" table2.json\n" +
 			"     Fields: col1, col2\n" +
 			"     Format: JSON    Escape: HEX      Query: (empty)\n" +
@@ -1862,6 +1937,8 @@ var showObjectsTests = []struct {
 			"               @@ENCODING: UTF8\n" +
 			"              @@NO_HEADER: false\n" +
 			"           @@WITHOUT_NULL: false\n" +
+			"      @@NUMBER_AS_DECIMAL: false\n" +
+			"            @@INFER_TYPES: 0\n" +
 			"                 @@FORMAT: CSV\n" +
 			"         @@WRITE_ENCODING: UTF8\n" +
 			"        @@WRITE_DELIMITER: ',' | SPACES\n" +
@@ -1870,12 +1947,14 @@ var showObjectsTests = []struct {
 			"            @@ENCLOSE_ALL: false\n" +
 			"            @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"           @@PRETTY_PRINT: (ignored) false\n" +
+			"            @@COMPRESSION: NONE\n" +
 			"    @@EAST_ASIAN_ENCODING: (ignored) false\n" +
 			" @@COUNT_DIACRITICAL_SIGN: (ignored) false\n" +
 			"      @@COUNT_FORMAT_CODE: (ignored) false\n" +
 			"                  @@COLOR: false\n" +
 			"                  @@QUIET: false\n" +
 			"                    @@CPU: " + strconv.Itoa(cmd.GetFlags().CPU) + "\n" +
+			"           @@MEMORY_LIMIT: 0\n" +
 			"                  @@STATS: false\n" +
 			"\n",
 	},
@@ -1893,6 +1972,8 @@ var showObjectsTests = []struct {
 			"     @#LOADED_TABLES: 0\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
+			"        @#ERROR_CODE: NULL\n" +
+			"     @#ERROR_MESSAGE: NULL\n" +
 			"\n",
 	},
 	{
@@ -1993,6 +2074,38 @@ var showFieldsTests = []struct {
 			"   2. column2\n" +
 			"\n",
 	},
+	{
+		Name: "ShowFields Temporary Table with Column Types",
+		Expr: parser.ShowFields{
+			Type:  parser.Identifier{Literal: "fields"},
+			Table: parser.Identifier{Literal: "view1"},
+		},
+		Filter: &Filter{
+			TempViews: TemporaryViewScopes{
+				ViewMap{
+					"VIEW1": &View{
+						Header: NewHeader("view1", []string{"column1", "column2"}),
+						FileInfo: &FileInfo{
+							Path:        "view1",
+							IsTemporary: true,
+							Schema: &TableSchema{
+								Columns: []ColumnSchema{{Name: "column2", Type: "DATETIME"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Expect: "\n" +
+			"    Fields in view1\n" +
+			"-----------------------\n" +
+			" Type: View\n" +
+			" Status: Fixed\n" +
+			" Fields:\n" +
+			"   1. column1\n" +
+			"   2. column2 DATETIME\n" +
+			"\n",
+	},
 	{
 		Name: "ShowFields Updated Temporary Table",
 		Expr: parser.ShowFields{
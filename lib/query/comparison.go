@@ -2,6 +2,7 @@ package query
 
 import (
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -78,6 +79,23 @@ func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
 	return ternary.TRUE
 }
 
+func Regexp(p1 value.Primary, p2 value.Primary, regExps *RegExpCache) (ternary.Value, error) {
+	s := value.ToString(p1)
+	if value.IsNull(s) {
+		return ternary.UNKNOWN, nil
+	}
+	pattern := value.ToString(p2)
+	if value.IsNull(pattern) {
+		return ternary.UNKNOWN, nil
+	}
+
+	re, err := regExps.Get(pattern.(value.String).Raw(), "")
+	if err != nil {
+		return ternary.FALSE, err
+	}
+	return ternary.ConvertFromBool(re.MatchString(s.(value.String).Raw())), nil
+}
+
 func stringPattern(pattern []rune, position int) (int, int, string, int) {
 	anyRunesMinLen := 0
 	anyRunesMaxLen := 0
@@ -126,11 +144,11 @@ func stringPattern(pattern []rune, position int) (int, int, string, int) {
 	return anyRunesMinLen, anyRunesMaxLen, string(search), returnPostion
 }
 
-func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType int, operator string) (ternary.Value, error) {
+func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType int, operator string, datetimeFormat []string, location *time.Location) (ternary.Value, error) {
 	results := make([]ternary.Value, len(list))
 
 	for i, v := range list {
-		t, err := value.CompareRowValues(rowValue, v, operator)
+		t, err := value.CompareRowValues(rowValue, v, operator, datetimeFormat, location)
 		if err != nil {
 			return ternary.FALSE, NewRowValueLengthInListError(i)
 		}
@@ -156,10 +174,10 @@ func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType in
 	}
 }
 
-func Any(rowValue value.RowValue, list []value.RowValue, operator string) (ternary.Value, error) {
-	return InRowValueList(rowValue, list, parser.ANY, operator)
+func Any(rowValue value.RowValue, list []value.RowValue, operator string, datetimeFormat []string, location *time.Location) (ternary.Value, error) {
+	return InRowValueList(rowValue, list, parser.ANY, operator, datetimeFormat, location)
 }
 
-func All(rowValue value.RowValue, list []value.RowValue, operator string) (ternary.Value, error) {
-	return InRowValueList(rowValue, list, parser.ALL, operator)
+func All(rowValue value.RowValue, list []value.RowValue, operator string, datetimeFormat []string, location *time.Location) (ternary.Value, error) {
+	return InRowValueList(rowValue, list, parser.ALL, operator, datetimeFormat, location)
 }
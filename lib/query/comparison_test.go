@@ -2,6 +2,7 @@ package query
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -141,6 +142,66 @@ func TestLike(t *testing.T) {
 	}
 }
 
+var regexpTests = []struct {
+	LHS     value.Primary
+	Pattern value.Primary
+	Result  ternary.Value
+	Error   string
+}{
+	{
+		LHS:     value.NewString("str"),
+		Pattern: value.NewNull(),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewNull(),
+		Pattern: value.NewString("str"),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewString("abcdefg"),
+		Pattern: value.NewString("c.e"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abcdefg"),
+		Pattern: value.NewString("^C.E"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewInteger(12345),
+		Pattern: value.NewString("^[0-9]+$"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abcdefg"),
+		Pattern: value.NewString("a(bc"),
+		Error:   "error parsing regexp: missing closing ): `a(bc`",
+	},
+}
+
+func TestRegexp(t *testing.T) {
+	regExps := NewRegExpCache()
+	for _, v := range regexpTests {
+		r, err := Regexp(v.LHS, v.Pattern, regExps)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%s regexp %s)", err, v.LHS, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%s regexp %s)", err.Error(), v.Error, v.LHS, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%s regexp %s)", v.Error, v.LHS, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s regexp %s)", r, v.Result, v.LHS, v.Pattern)
+		}
+	}
+}
+
 var inRowValueListTests = []struct {
 	LHS      value.RowValue
 	List     []value.RowValue
@@ -218,7 +279,7 @@ var inRowValueListTests = []struct {
 
 func TestInRowValueList(t *testing.T) {
 	for _, v := range inRowValueListTests {
-		r, err := InRowValueList(v.LHS, v.List, v.Type, v.Operator)
+		r, err := InRowValueList(v.LHS, v.List, v.Type, v.Operator, nil, time.Local)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("unexpected error %q for (%s %s %s %s)", err, v.LHS, v.Operator, parser.TokenLiteral(v.Type), v.List)
@@ -44,6 +44,7 @@ var statementPrefix = []string{
 	"CHDIR",
 	"EXECUTE",
 	"SHOW",
+	"EXPLAIN",
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
@@ -78,6 +79,7 @@ var tableObjectCandidates = []string{
 	"FIXED()",
 	"JSON()",
 	"LTSV()",
+	"XLSX()",
 	"JSON_TABLE()",
 }
 var tableObjects = []string{
@@ -85,6 +87,7 @@ var tableObjects = []string{
 	cmd.FIXED.String(),
 	cmd.JSON.String(),
 	cmd.LTSV.String(),
+	cmd.XLSX.String(),
 }
 
 type ReadlineListener struct {
@@ -147,14 +150,17 @@ func NewCompleter(filter *Filter) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(Functions)+2)
+	completer.funcs = make([]string, 0, len(Functions)+len(RegExpFunctions)+2)
 	for k := range Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
+	for k := range RegExpFunctions {
+		completer.funcs = append(completer.funcs, k)
+	}
 	completer.funcs = append(completer.funcs, "NOW")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+4)
 	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
@@ -162,6 +168,8 @@ func NewCompleter(filter *Filter) *Completer {
 	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_CONT")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_DISC")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -348,6 +356,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return c.UsingArgs(line, origLine, index)
 	case parser.SHOW:
 		return c.ShowArgs(line, origLine, index)
+	case parser.EXPLAIN:
+		return c.ExplainArgs(line, origLine, index)
 	case parser.SOURCE:
 		return c.SearchExecutableFiles(line, origLine, index)
 	case parser.RELOAD:
@@ -401,6 +411,17 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 	var cands readline.CandidateList
 
 	switch strings.ToUpper(c.tokens[0].Literal) {
+	case "XLSX":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 3, 4:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+			}
+		}
 	case "LTSV":
 		switch commaCnt {
 		case 0:
@@ -554,7 +575,7 @@ func (c *Completer) FunctionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(!InStrSliceWithCaseInsensitive(funcName, []string{"LISTAGG", "JSON_AGG", "PERCENTILE_CONT", "PERCENTILE_DISC"}) && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -1231,9 +1252,13 @@ func (c *Completer) CreateArgs(line string, origLine string, index int) readline
 					i == c.lastIdx-1 {
 					return []string{"AS", "SELECT"}, nil, true
 				}
+			case parser.INDEX:
+				if i == c.lastIdx-1 {
+					return []string{"ON"}, nil, true
+				}
 			case parser.CREATE:
 				if i == c.lastIdx {
-					return []string{"TABLE"}, nil, true
+					return []string{"INDEX", "TABLE"}, nil, true
 				}
 			}
 			return nil, nil, false
@@ -1339,11 +1364,13 @@ func (c *Completer) AlterArgs(line string, origLine string, index int) readline.
 					case TableDelimiter:
 						return nil, c.candidateList(delimiterCandidates, false), true
 					case TableEncoding:
-						return nil, c.candidateList(c.encodingList(), false), true
+						return nil, c.candidateList(c.writeEncodingList(), false), true
 					case TableLineBreak:
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case TableJsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case TableCompression:
+						return nil, c.candidateList(c.compressionList(), false), true
 					case TableHeader, TableEncloseAll, TablePrettyPrint:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					}
@@ -1384,7 +1411,7 @@ func (c *Completer) DeclareArgs(line string, origLine string, index int) readlin
 				if i == c.lastIdx {
 					return []string{"FOR"}, nil, true
 				}
-			case parser.AGGREGATE, parser.FUNCTION, parser.VIEW, parser.VAR:
+			case parser.AGGREGATE, parser.FUNCTION, parser.PROCEDURE, parser.VIEW, parser.VAR:
 			case parser.DECLARE:
 				if i == c.lastIdx-1 && c.tokens[c.lastIdx].Token != parser.VARIABLE {
 					obj := []string{
@@ -1392,6 +1419,7 @@ func (c *Completer) DeclareArgs(line string, origLine string, index int) readlin
 						"VIEW",
 						"FUNCTION",
 						"AGGREGATE",
+						"PROCEDURE",
 					}
 					return obj, nil, true
 				}
@@ -1475,9 +1503,11 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList([]string{"Local", "UTC"}, false), true
 					case cmd.DelimiterFlag, cmd.WriteDelimiterFlag:
 						return nil, c.candidateList(delimiterCandidates, false), true
-					case cmd.EncodingFlag, cmd.WriteEncodingFlag:
+					case cmd.EncodingFlag:
 						return nil, c.candidateList(c.encodingList(), false), true
-					case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
+					case cmd.WriteEncodingFlag:
+						return nil, c.candidateList(c.writeEncodingList(), false), true
+					case cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.NumberAsDecimalFlag, cmd.WithoutHeaderFlag, cmd.EncloseAll, cmd.PrettyPrintFlag,
 						cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
 						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
@@ -1487,6 +1517,8 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.CompressionFlag:
+						return nil, c.candidateList(c.compressionList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -1649,6 +1681,29 @@ func (c *Completer) ShowArgs(line string, origLine string, index int) readline.C
 	)
 }
 
+func (c *Completer) ExplainArgs(line string, origLine string, index int) readline.CandidateList {
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.EXPLAIN:
+				if i == c.lastIdx {
+					return []string{"ANALYZE", "SELECT", "WITH"}, nil, true
+				}
+			case parser.ANALYZE:
+				if i == c.lastIdx {
+					return []string{"SELECT", "WITH"}, nil, true
+				}
+			default:
+				return nil, nil, false
+			}
+			return nil, nil, true
+		},
+	)
+}
+
 func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index int) readline.CandidateList {
 	cands := c.SearchAllTables(line, origLine, index)
 	for i := range cands {
@@ -1659,7 +1714,7 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 
 func (c *Completer) SearchAllTables(line string, origLine string, index int) readline.CandidateList {
 	tableKeys := ViewCache.SortedKeys()
-	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.FixedExt, cmd.JsonExt, cmd.LtsvExt}, cmd.GetFlags().Repository)
+	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.FixedExt, cmd.JsonExt, cmd.LtsvExt, cmd.XlsxExt}, cmd.GetFlags().Repository)
 
 	defaultDir := cmd.GetFlags().Repository
 	if len(defaultDir) < 1 {
@@ -2073,7 +2128,7 @@ func (c *Completer) completeArgs(
 func (c *Completer) UpdateTokens(line string, origLine string) {
 	c.tokens = c.tokens[:0]
 	s := new(parser.Scanner)
-	s.Init(origLine, "")
+	s.Init(origLine, "", cmd.GetFlags().DatetimeFormat, cmd.GetFlags().GetLocation())
 	for {
 		t, _ := s.Scan()
 		if t.Token == parser.EOF {
@@ -2084,6 +2139,7 @@ func (c *Completer) UpdateTokens(line string, origLine string) {
 
 	if 0 < len(c.tokens) {
 		c.tokens = c.tokens[c.searchStartIndex():]
+		c.tokens = c.tokens[c.explainedStatementIndex(line):]
 	}
 
 	c.combineSubqueryTokens()
@@ -2100,6 +2156,26 @@ func (c *Completer) SetLastIndex(line string) {
 	}
 }
 
+func (c *Completer) explainedStatementIndex(line string) int {
+	if c.tokens[0].Token != parser.EXPLAIN {
+		return 0
+	}
+
+	idx := 1
+	if 1 < len(c.tokens) && c.tokens[1].Token == parser.ANALYZE {
+		idx++
+	}
+
+	completed := len(c.tokens)
+	if 0 < len(line) {
+		completed--
+	}
+	if completed <= idx {
+		return 0
+	}
+	return idx
+}
+
 func (c *Completer) searchStartIndex() int {
 	idx := 0
 	blockLevel := 0
@@ -2247,6 +2323,9 @@ func (c *Completer) isFunction(token parser.Token) bool {
 		if _, ok := Functions[strings.ToUpper(token.Literal)]; ok {
 			return true
 		}
+		if _, ok := RegExpFunctions[strings.ToUpper(token.Literal)]; ok {
+			return true
+		}
 		return InStrSliceWithCaseInsensitive(token.Literal, c.userFuncList)
 	}
 
@@ -2357,14 +2436,24 @@ func (c *Completer) tableFormatList() []string {
 }
 
 func (c *Completer) encodingList() []string {
-	list := make([]string, 0, len(text.EncodingLiteral))
-	for _, v := range text.EncodingLiteral {
+	list := make([]string, 0, len(cmd.EncodingLiteral))
+	for _, v := range cmd.EncodingLiteral {
 		list = append(list, v)
 	}
 	sort.Strings(list)
 	return list
 }
 
+func (c *Completer) writeEncodingList() []string {
+	list := make([]string, 0, len(cmd.EncodingLiteral))
+	for _, v := range c.encodingList() {
+		if v != cmd.EncodingString(cmd.AUTO) && v != cmd.EncodingString(cmd.UTF16) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
 func (c *Completer) lineBreakList() []string {
 	list := make([]string, 0, len(text.LineBreakLiteral))
 	for _, v := range text.LineBreakLiteral {
@@ -2382,3 +2471,12 @@ func (c *Completer) jsonEscapeTypeList() []string {
 	sort.Strings(list)
 	return list
 }
+
+func (c *Completer) compressionList() []string {
+	list := make([]string, 0, len(cmd.CompressionLiteral))
+	for _, v := range cmd.CompressionLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
@@ -98,10 +98,10 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], cmd.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(Functions)+2 {
+	if len(c.funcs) != len(Functions)+len(RegExpFunctions)+2 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+4 {
 		t.Error("aggregate functions are not set correctly")
 	}
 	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
@@ -118,10 +118,10 @@ func TestCompleter_Update(t *testing.T) {
 	if !reflect.DeepEqual(c.userFuncList, []string{"aggfunc", "scalafunc"}) {
 		t.Error("user defined functions are not set correctly")
 	}
-	if len(c.funcList) != len(Functions)+2+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(Functions)+len(RegExpFunctions)+2+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list are not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+4+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list are not set correctly")
 	}
 	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
@@ -220,6 +220,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
@@ -258,6 +259,36 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("FROM"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "Statements EXPLAIN",
+		Line:     "",
+		OrigLine: "explain ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANALYZE"), AppendSpace: true},
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN ANALYZE",
+		Line:     "",
+		OrigLine: "explain analyze ",
+		Index:    16,
+		Expect: readline.CandidateList{
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN SELECT",
+		Line:     "fro",
+		OrigLine: "explain analyze select 1 fro",
+		Index:    28,
+		Expect: readline.CandidateList{
+			{Name: []rune("FROM"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "Statements INSERT",
 		Line:     "",
@@ -291,6 +322,7 @@ var completerStatementsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
@@ -312,6 +344,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -606,8 +639,20 @@ var completerTableObjectArgsTests = []completerTest{
 		OrigLine: "csv(',', filepath, ",
 		Index:    19,
 		Expect: readline.CandidateList{
+			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("CP1252")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("GBK")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16BEM")},
+			{Name: []rune("UTF16LE")},
+			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8M")},
 		},
 	},
 	{
@@ -663,8 +708,20 @@ var completerTableObjectArgsTests = []completerTest{
 		OrigLine: "ltsv(filepath, ",
 		Index:    15,
 		Expect: readline.CandidateList{
+			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("CP1252")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("GBK")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16BEM")},
+			{Name: []rune("UTF16LE")},
+			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8M")},
 		},
 	},
 	{
@@ -1135,6 +1192,7 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1190,6 +1248,7 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1659,6 +1718,7 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1733,6 +1793,7 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1818,6 +1879,7 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1847,6 +1909,7 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1897,9 +1960,19 @@ var completerCreateArgsTests = []completerTest{
 		OrigLine: "create ",
 		Index:    7,
 		Expect: readline.CandidateList{
+			{Name: []rune("INDEX"), AppendSpace: true},
 			{Name: []rune("TABLE"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "CreateArgs After Index Name",
+		Line:     "",
+		OrigLine: "create index idx ",
+		Index:    17,
+		Expect: readline.CandidateList{
+			{Name: []rune("ON"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "CreateArgs After Table Name",
 		Line:     "",
@@ -1974,6 +2047,7 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSON_TABLE()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2115,6 +2189,7 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set ",
 		Index:    31,
 		Expect: readline.CandidateList{
+			{Name: []rune("COMPRESSION"), AppendSpace: true},
 			{Name: []rune("DELIMITER"), AppendSpace: true},
 			{Name: []rune("ENCLOSE_ALL"), AppendSpace: true},
 			{Name: []rune("ENCODING"), AppendSpace: true},
@@ -2160,6 +2235,7 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("ORG")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -2168,8 +2244,18 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set encoding to ",
 		Index:    42,
 		Expect: readline.CandidateList{
+			{Name: []rune("BIG5")},
+			{Name: []rune("CP1252")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("GBK")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16BEM")},
+			{Name: []rune("UTF16LE")},
+			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8M")},
 		},
 	},
 	{
@@ -2254,6 +2340,7 @@ var completerDeclareArgsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -2453,8 +2540,20 @@ var completerSetArgsTests = []completerTest{
 		OrigLine: "set @@encoding to ",
 		Index:    18,
 		Expect: readline.CandidateList{
+			{Name: []rune("AUTO")},
+			{Name: []rune("BIG5")},
+			{Name: []rune("CP1252")},
+			{Name: []rune("EUCJP")},
+			{Name: []rune("GBK")},
+			{Name: []rune("LATIN1")},
 			{Name: []rune("SJIS")},
+			{Name: []rune("UTF16")},
+			{Name: []rune("UTF16BE")},
+			{Name: []rune("UTF16BEM")},
+			{Name: []rune("UTF16LE")},
+			{Name: []rune("UTF16LEM")},
 			{Name: []rune("UTF8")},
+			{Name: []rune("UTF8M")},
 		},
 	},
 	{
@@ -2481,6 +2580,7 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("ORG")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	bzip2w "github.com/dsnet/compress/bzip2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (w nopWriteCloser) Close() error {
+	return nil
+}
+
+// NewDecompressionReader returns a reader that decompresses r. The returned reader must be closed by the caller.
+func NewDecompressionReader(r io.Reader, compression cmd.Compression) (io.ReadCloser, error) {
+	switch compression {
+	case cmd.NoCompression:
+		return ioutil.NopCloser(r), nil
+	case cmd.GZIP:
+		return gzip.NewReader(r)
+	case cmd.BZIP2:
+		return ioutil.NopCloser(bzip2.NewReader(r)), nil
+	case cmd.ZSTD:
+		d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case cmd.XZ:
+		xr, err := xz.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(xr), nil
+	}
+	return nil, errors.New(fmt.Sprintf("%s compression is not supported", compression))
+}
+
+func NewCompressionWriter(w io.Writer, compression cmd.Compression) (io.WriteCloser, error) {
+	switch compression {
+	case cmd.NoCompression:
+		return nopWriteCloser{w}, nil
+	case cmd.GZIP:
+		return gzip.NewWriter(w), nil
+	case cmd.BZIP2:
+		return bzip2w.NewWriter(w, nil)
+	case cmd.ZSTD:
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	case cmd.XZ:
+		return xz.NewWriter(w)
+	}
+	return nil, errors.New(fmt.Sprintf("%s compression is not supported", compression))
+}
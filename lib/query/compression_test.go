@@ -0,0 +1,164 @@
+package query
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+func gzipTestData(s string) []byte {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	w.Write([]byte(s))
+	w.Close()
+	return buf.Bytes()
+}
+
+var bzip2TestData = []byte{
+	0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xe8, 0x8e, 0x69, 0x4b, 0x00, 0x00,
+	0x03, 0xd9, 0x80, 0x00, 0x10, 0x00, 0x04, 0x30, 0x00, 0x08, 0x00, 0x1c, 0x00, 0x20, 0x00, 0x31,
+	0x0c, 0x08, 0x21, 0xea, 0x32, 0x69, 0xad, 0x53, 0x40, 0xe1, 0x3c, 0x5d, 0xc9, 0x14, 0xe1, 0x42,
+	0x43, 0xa2, 0x39, 0xa5, 0x2c,
+}
+
+var newDecompressionReaderTests = []struct {
+	Name        string
+	Compression cmd.Compression
+	Data        []byte
+	Result      string
+	Error       string
+}{
+	{
+		Name:        "No Compression",
+		Compression: cmd.NoCompression,
+		Data:        []byte("c1,c2\n1,str\n"),
+		Result:      "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "GZIP",
+		Compression: cmd.GZIP,
+		Data:        gzipTestData("c1,c2\n1,str\n"),
+		Result:      "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "BZIP2",
+		Compression: cmd.BZIP2,
+		Data:        bzip2TestData,
+		Result:      "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "GZIP Invalid Header Error",
+		Compression: cmd.GZIP,
+		Data:        []byte("c1,c2\n1,str\n"),
+		Error:       "gzip: invalid header",
+	},
+	{
+		Name:        "ZSTD Invalid Header Error",
+		Compression: cmd.ZSTD,
+		Data:        []byte("c1,c2\n1,str\n"),
+		Error:       "invalid input: magic number mismatch",
+	},
+	{
+		Name:        "XZ Invalid Header Error",
+		Compression: cmd.XZ,
+		Data:        []byte("c1,c2\n1,str\n"),
+		Error:       "xz: invalid header magic bytes",
+	},
+}
+
+func TestNewDecompressionReader(t *testing.T) {
+	for _, v := range newDecompressionReaderTests {
+		r, err := NewDecompressionReader(bytes.NewReader(v.Data), v.Compression)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+
+		result, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(result) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(result), v.Result)
+		}
+	}
+}
+
+var newCompressionWriterTests = []struct {
+	Name        string
+	Compression cmd.Compression
+	Data        string
+	Error       string
+}{
+	{
+		Name:        "No Compression",
+		Compression: cmd.NoCompression,
+		Data:        "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "GZIP",
+		Compression: cmd.GZIP,
+		Data:        "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "BZIP2",
+		Compression: cmd.BZIP2,
+		Data:        "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "ZSTD",
+		Compression: cmd.ZSTD,
+		Data:        "c1,c2\n1,str\n",
+	},
+	{
+		Name:        "XZ",
+		Compression: cmd.XZ,
+		Data:        "c1,c2\n1,str\n",
+	},
+}
+
+func TestNewCompressionWriter(t *testing.T) {
+	for _, v := range newCompressionWriterTests {
+		buf := new(bytes.Buffer)
+		w, err := NewCompressionWriter(buf, v.Compression)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		w.Write([]byte(v.Data))
+		w.Close()
+
+		r, _ := NewDecompressionReader(bytes.NewReader(buf.Bytes()), v.Compression)
+		result, _ := ioutil.ReadAll(r)
+		r.Close()
+		if string(result) != v.Data {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(result), v.Data)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package query
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	PrimaryKeyConstraint = "PRIMARY KEY"
+	UniqueConstraint     = "UNIQUE"
+	NotNullConstraint    = "NOT NULL"
+	CheckConstraint      = "CHECK"
+)
+
+var ConstraintTypeList = []string{
+	PrimaryKeyConstraint,
+	UniqueConstraint,
+	NotNullConstraint,
+	CheckConstraint,
+}
+
+var constraintNameSuffixes = map[string]string{
+	PrimaryKeyConstraint: "pkey",
+	UniqueConstraint:     "key",
+	NotNullConstraint:    "not_null",
+	CheckConstraint:      "check",
+}
+
+type ConstraintSchema struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Columns []string `json:"columns,omitempty"`
+	Check   string   `json:"check,omitempty"`
+}
+
+func (c ConstraintSchema) String() string {
+	if c.Type == CheckConstraint {
+		return c.Type + " (" + c.Check + ")"
+	}
+	return c.Type + " (" + strings.Join(c.Columns, ", ") + ")"
+}
+
+// References returns the names of the columns that the constraint refers to.
+func (c ConstraintSchema) References() []string {
+	if c.Type != CheckConstraint {
+		return c.Columns
+	}
+	return checkReferences(c.Check)
+}
+
+func (c *ConstraintSchema) validate() error {
+	if len(c.Name) < 1 {
+		return errors.New("constraint name is empty")
+	}
+
+	c.Type = strings.ToUpper(c.Type)
+	if !InStrSliceWithCaseInsensitive(c.Type, ConstraintTypeList) {
+		return errors.New(fmt.Sprintf("%s is an invalid constraint type", c.Type))
+	}
+
+	if c.Type == CheckConstraint {
+		if _, err := parseCheckExpression(c.Check, nil, time.Local); err != nil {
+			return errors.New(fmt.Sprintf("constraint %s: %s", c.Name, err.Error()))
+		}
+	} else if len(c.Columns) < 1 {
+		return errors.New(fmt.Sprintf("constraint %s has no columns", c.Name))
+	}
+	return nil
+}
+
+func parseCheckExpression(check string, datetimeFormat []string, location *time.Location) (parser.QueryExpression, error) {
+	statements, err := parser.Parse(check, "", datetimeFormat, location)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 1 {
+		if expr, ok := statements[0].(parser.QueryExpression); ok {
+			return expr, nil
+		}
+	}
+	return nil, errors.New(fmt.Sprintf("%q cannot be evaluated as a condition", check))
+}
+
+// checkReferences returns the identifiers in the check expression except function names.
+func checkReferences(check string) []string {
+	scanner := new(parser.Scanner).Init(check, "", nil, time.Local)
+	refs := make([]string, 0, 4)
+
+	var ident string
+	for {
+		token, err := scanner.Scan()
+		if err != nil || token.Token == parser.EOF {
+			break
+		}
+
+		if 0 < len(ident) && token.Token != '(' {
+			refs = append(refs, ident)
+		}
+		ident = ""
+
+		switch token.Token {
+		case parser.IDENTIFIER, parser.TIES, parser.NULLS, parser.ROWS, parser.GROUPS, parser.KEY:
+			ident = token.Literal
+		}
+	}
+	if 0 < len(ident) {
+		refs = append(refs, ident)
+	}
+	return refs
+}
+
+func constraintType(token int) string {
+	switch token {
+	case parser.PRIMARY:
+		return PrimaryKeyConstraint
+	case parser.UNIQUE:
+		return UniqueConstraint
+	case parser.NOT:
+		return NotNullConstraint
+	default:
+		return CheckConstraint
+	}
+}
+
+// AddConstraint appends the constraint declared by expr to the schema.
+// The constraint is applied to column if expr is declared in a column definition.
+// An unnamed constraint is given a name made from the table name, the column names and the type.
+func (s *TableSchema) AddConstraint(expr parser.TableConstraint, column parser.Identifier, table string, columns []string) (ConstraintSchema, error) {
+	c := ConstraintSchema{
+		Name: expr.Name.Literal,
+		Type: constraintType(expr.Type.Token),
+	}
+
+	if c.Type == CheckConstraint {
+		c.Check = expr.Check.String()
+	} else if 0 < len(column.Literal) {
+		c.Columns = []string{column.Literal}
+	} else {
+		c.Columns = make([]string, 0, len(expr.Columns))
+		for _, v := range expr.Columns {
+			ident := v.(parser.Identifier)
+			if InStrSliceWithCaseInsensitive(ident.Literal, c.Columns) {
+				return c, NewDuplicateFieldNameError(ident)
+			}
+			if !InStrSliceWithCaseInsensitive(ident.Literal, columns) {
+				return c, NewFieldNotExistError(ident)
+			}
+			c.Columns = append(c.Columns, ident.Literal)
+		}
+	}
+
+	if c.Type == PrimaryKeyConstraint {
+		for _, v := range s.Constraints {
+			if v.Type == PrimaryKeyConstraint {
+				return c, NewMultiplePrimaryKeysError(expr, table)
+			}
+		}
+	}
+
+	if 0 < len(c.Name) {
+		if _, ok := s.Constraint(c.Name); ok {
+			return c, NewDuplicateConstraintNameError(expr.Name)
+		}
+	} else {
+		c.Name = s.constraintName(table, c)
+	}
+
+	s.Constraints = append(s.Constraints, c)
+	return c, nil
+}
+
+func (s *TableSchema) constraintName(table string, c ConstraintSchema) string {
+	items := []string{table}
+	if c.Type != PrimaryKeyConstraint {
+		if c.Type == CheckConstraint {
+			items = append(items, c.References()...)
+		} else {
+			items = append(items, c.Columns...)
+		}
+	}
+	items = append(items, constraintNameSuffixes[c.Type])
+	name := strings.Join(items, "_")
+
+	if _, ok := s.Constraint(name); !ok {
+		return name
+	}
+	for i := 1; ; i++ {
+		if _, ok := s.Constraint(name + strconv.Itoa(i)); !ok {
+			return name + strconv.Itoa(i)
+		}
+	}
+}
+
+func (s *TableSchema) DropConstraint(name string) bool {
+	idx, ok := s.Constraint(name)
+	if !ok {
+		return false
+	}
+	s.Constraints = append(s.Constraints[:idx], s.Constraints[idx+1:]...)
+	return true
+}
+
+// ValidateConstraints checks all the records in the view against the constraints of the table.
+func (view *View) ValidateConstraints(expr parser.Expression, filter *Filter) error {
+	if view.FileInfo == nil || view.FileInfo.Schema == nil {
+		return nil
+	}
+	for _, c := range view.FileInfo.Schema.Constraints {
+		if err := view.validateConstraint(expr, c, filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (view *View) validateConstraint(expr parser.Expression, c ConstraintSchema, filter *Filter) error {
+	table := filepath.Base(view.FileInfo.Path)
+
+	if c.Type == CheckConstraint {
+		check, err := parseCheckExpression(c.Check, filter.flags().DatetimeFormat, filter.flags().GetLocation())
+		if err != nil {
+			return err
+		}
+
+		filterForLoop := NewFilterForSequentialEvaluation(view, filter)
+		for i := range view.RecordSet {
+			filterForLoop.Records[0].RecordIndex = i
+			p, err := filterForLoop.Evaluate(check)
+			if err != nil {
+				return err
+			}
+			if p.Ternary() == ternary.FALSE {
+				return NewConstraintViolationError(expr, c.Name, i+1, table, fmt.Sprintf("check (%s) is not satisfied", c.Check))
+			}
+		}
+		return nil
+	}
+
+	indices := make([]int, len(c.Columns))
+	for i, column := range c.Columns {
+		idx, err := view.FieldIndex(parser.FieldReference{Column: parser.Identifier{Literal: column}})
+		if err != nil {
+			return err
+		}
+		indices[i] = idx
+	}
+
+	keys := make(map[string]bool, view.RecordLen())
+	values := make([]value.Primary, len(indices))
+	buf := &bytes.Buffer{}
+
+RecordLoop:
+	for i, record := range view.RecordSet {
+		for j, idx := range indices {
+			values[j] = record[idx].Value()
+			if value.IsNull(values[j]) {
+				if c.Type == UniqueConstraint {
+					continue RecordLoop
+				}
+				return NewConstraintViolationError(expr, c.Name, i+1, table, fmt.Sprintf("field %s is null", c.Columns[j]))
+			}
+		}
+
+		if c.Type == NotNullConstraint {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, values, filter.flags())
+		key := buf.String()
+		if keys[key] {
+			list := make([]string, len(values))
+			for j := range values {
+				list[j] = values[j].String()
+			}
+			return NewConstraintViolationError(expr, c.Name, i+1, table, fmt.Sprintf("key (%s) = (%s) already exists", strings.Join(c.Columns, ", "), strings.Join(list, ", ")))
+		}
+		keys[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func TestCheckReferences(t *testing.T) {
+	result := checkReferences("column1 > 0 AND trim(column2) <> '' AND `key` IS NOT NULL")
+	expect := []string{"column1", "column2", "key"}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+var tableSchemaAddConstraintTests = []struct {
+	Name       string
+	Constraint parser.TableConstraint
+	Column     parser.Identifier
+	Result     ConstraintSchema
+	Error      string
+}{
+	{
+		Name: "Primary Key",
+		Constraint: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.PRIMARY, Literal: "PRIMARY KEY"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+		},
+		Result: ConstraintSchema{Name: "table1_pkey", Type: "PRIMARY KEY", Columns: []string{"column1"}},
+	},
+	{
+		Name: "Not Null on Column",
+		Constraint: parser.TableConstraint{
+			Type: parser.Token{Token: parser.NOT, Literal: "NOT NULL"},
+		},
+		Column: parser.Identifier{Literal: "column2"},
+		Result: ConstraintSchema{Name: "table1_column2_not_null", Type: "NOT NULL", Columns: []string{"column2"}},
+	},
+	{
+		Name: "Check with Generated Name",
+		Constraint: parser.TableConstraint{
+			Type: parser.Token{Token: parser.CHECK, Literal: "CHECK"},
+			Check: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Operator: ">",
+				RHS:      parser.NewIntegerValueFromString("0"),
+			},
+		},
+		Result: ConstraintSchema{Name: "table1_column1_check1", Type: "CHECK", Check: "column1 > 0"},
+	},
+	{
+		Name: "Multiple Primary Keys Error",
+		Constraint: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.PRIMARY, Literal: "PRIMARY KEY"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+		},
+		Error: "[L:- C:-] table table1 cannot have more than one primary key",
+	},
+	{
+		Name: "Duplicate Name Error",
+		Constraint: parser.TableConstraint{
+			Constraint: "CONSTRAINT",
+			Name:       parser.Identifier{Literal: "TABLE1_PKEY"},
+			Type:       parser.Token{Token: parser.UNIQUE, Literal: "UNIQUE"},
+			Columns:    []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+		},
+		Error: "[L:- C:-] constraint TABLE1_PKEY already exists",
+	},
+	{
+		Name: "Duplicate Field Error",
+		Constraint: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.UNIQUE, Literal: "UNIQUE"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "column2"}, parser.Identifier{Literal: "COLUMN2"}},
+		},
+		Error: "[L:- C:-] field name COLUMN2 is a duplicate",
+	},
+	{
+		Name: "Field Not Exist Error",
+		Constraint: parser.TableConstraint{
+			Type:    parser.Token{Token: parser.UNIQUE, Literal: "UNIQUE"},
+			Columns: []parser.QueryExpression{parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestTableSchema_AddConstraint(t *testing.T) {
+	schema := &TableSchema{
+		Constraints: []ConstraintSchema{
+			{Name: "table1_column1_check", Type: "CHECK", Check: "column1 <> 0"},
+		},
+	}
+
+	for _, v := range tableSchemaAddConstraintTests {
+		result, err := schema.AddConstraint(v.Constraint, v.Column, "table1", []string{"column1", "column2"})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var viewValidateConstraintsTests = []struct {
+	Name        string
+	Constraints []ConstraintSchema
+	Error       string
+}{
+	{
+		Name: "Valid Records",
+		Constraints: []ConstraintSchema{
+			{Name: "pk", Type: "PRIMARY KEY", Columns: []string{"column1"}},
+			{Name: "uq", Type: "UNIQUE", Columns: []string{"column1", "column3"}},
+			{Name: "ck", Type: "CHECK", Check: "column1 > 0 AND column3 <> 'x'"},
+		},
+	},
+	{
+		Name: "Primary Key Violation",
+		Constraints: []ConstraintSchema{
+			{Name: "pk", Type: "PRIMARY KEY", Columns: []string{"column2"}},
+		},
+		Error: "[L:- C:-] constraint pk is violated by record 3 in table1.csv: key (column2) = (\"a\") already exists",
+	},
+	{
+		Name: "Not Null Violation",
+		Constraints: []ConstraintSchema{
+			{Name: "nn", Type: "NOT NULL", Columns: []string{"column1", "column3"}},
+		},
+		Error: "[L:- C:-] constraint nn is violated by record 2 in table1.csv: field column3 is null",
+	},
+	{
+		Name: "Multiple Columns Unique Violation",
+		Constraints: []ConstraintSchema{
+			{Name: "uq", Type: "UNIQUE", Columns: []string{"column2", "column3"}},
+		},
+		Error: "[L:- C:-] constraint uq is violated by record 3 in table1.csv: key (column2, column3) = (\"a\", \"B \") already exists",
+	},
+	{
+		Name: "Check Violation",
+		Constraints: []ConstraintSchema{
+			{Name: "ck", Type: "CHECK", Check: "column1 < 3"},
+		},
+		Error: "[L:- C:-] constraint ck is violated by record 3 in table1.csv: check (column1 < 3) is not satisfied",
+	},
+	{
+		Name: "Field Not Exist Error",
+		Constraints: []ConstraintSchema{
+			{Name: "uq", Type: "UNIQUE", Columns: []string{"notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestView_ValidateConstraints(t *testing.T) {
+	for _, v := range viewValidateConstraintsTests {
+		view := &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewString("b")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewInteger(3), value.NewString("a"), value.NewString("B ")}),
+			},
+			FileInfo: &FileInfo{
+				Path:   GetTestFilePath("table1.csv"),
+				Schema: &TableSchema{Constraints: v.Constraints},
+			},
+		}
+
+		err := view.ValidateConstraints(parser.Identifier{Literal: "table1"}, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
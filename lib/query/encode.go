@@ -12,6 +12,7 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
@@ -32,22 +33,55 @@ func NewEmptyResultSetError() *EmptyResultSetError {
 	return &EmptyResultSetError{}
 }
 
-func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo) error {
+func EncodeView(fp io.Writer, view *View, fileInfo *FileInfo, filter *Filter) error {
+	if fileInfo.Compression == cmd.NoCompression {
+		return encodeView(fp, view, fileInfo, filter)
+	}
+
+	w, err := NewCompressionWriter(fp, fileInfo.Compression)
+	if err != nil {
+		return err
+	}
+	if err = encodeView(w, view, fileInfo, filter); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func encodeView(fp io.Writer, view *View, fileInfo *FileInfo, filter *Filter) error {
 	switch fileInfo.Format {
-	case cmd.FIXED:
-		return encodeFixedLengthFormat(fp, view, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
 	case cmd.JSON:
-		return encodeJson(fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint)
+		return encodeJson(fp, view, fileInfo.LineBreak, fileInfo.JsonEscape, fileInfo.PrettyPrint, filter)
+	case cmd.XLSX:
+		return encodeXlsx(fp, view, fileInfo.Sheet, fileInfo.NoHeader)
+	case cmd.FIXED:
+		if !cmd.IsFixedLengthEncoding(fileInfo.Encoding) {
+			return errors.New(fmt.Sprintf("%s encoding is not supported in fixed-length format", cmd.EncodingString(fileInfo.Encoding)))
+		}
+	}
+
+	w := cmd.NewEncodingWriter(fp, fileInfo.Encoding)
+	encoding := cmd.TextEncoding(fileInfo.Encoding)
+
+	var err error
+	switch fileInfo.Format {
+	case cmd.FIXED:
+		err = encodeFixedLengthFormat(w, view, fileInfo.DelimiterPositions, fileInfo.LineBreak, fileInfo.NoHeader, encoding)
 	case cmd.LTSV:
-		return encodeLTSV(fp, view, fileInfo.LineBreak, fileInfo.Encoding)
+		err = encodeLTSV(w, view, fileInfo.LineBreak, encoding)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
-		return encodeText(fp, view, fileInfo.Format, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding)
+		err = encodeText(w, view, fileInfo.Format, fileInfo.LineBreak, fileInfo.NoHeader, encoding, filter)
 	case cmd.TSV:
 		fileInfo.Delimiter = '\t'
 		fallthrough
 	default: // cmd.CSV
-		return encodeCSV(fp, view, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, fileInfo.Encoding, fileInfo.EncloseAll)
+		err = encodeCSV(w, view, fileInfo.Delimiter, fileInfo.LineBreak, fileInfo.NoHeader, encoding, fileInfo.EncloseAll)
+	}
+	if err != nil {
+		return err
 	}
+	return w.Close()
 }
 
 func bareValues(view *View) ([]string, [][]value.Primary) {
@@ -162,7 +196,7 @@ func encodeFixedLengthFormat(fp io.Writer, view *View, positions []int, lineBrea
 	return nil
 }
 
-func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool) error {
+func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType txjson.EscapeType, prettyPrint bool, filter *Filter) error {
 	header, records := bareValues(view)
 
 	data, err := json.ConvertTableValueToJsonStructure(header, records)
@@ -174,8 +208,8 @@ func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType t
 	e.EscapeType = escapeType
 	e.LineBreak = lineBreak
 	e.PrettyPrint = prettyPrint
-	if prettyPrint && cmd.GetFlags().Color {
-		e.Palette, _ = cmd.GetPalette()
+	if prettyPrint && filter.flags().Color {
+		e.Palette = filter.palette()
 	}
 
 	s := e.Encode(data)
@@ -190,7 +224,7 @@ func encodeJson(fp io.Writer, view *View, lineBreak text.LineBreak, escapeType t
 	return w.Flush()
 }
 
-func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding) error {
+func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.LineBreak, withoutHeader bool, encoding text.Encoding, filter *Filter) error {
 	header, records := bareValues(view)
 
 	isPlainTable := false
@@ -203,11 +237,11 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 		tableFormat = table.OrgTable
 	default:
 		if len(header) < 1 {
-			LogWarn("Empty Fields", cmd.GetFlags().Quiet)
+			filter.logWarn("Empty Fields", filter.flags().Quiet)
 			return NewEmptyResultSetError()
 		}
 		if len(records) < 1 {
-			LogWarn("Empty RecordSet", cmd.GetFlags().Quiet)
+			filter.logWarn("Empty RecordSet", filter.flags().Quiet)
 			return NewEmptyResultSetError()
 		}
 		isPlainTable = true
@@ -215,13 +249,14 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 
 	e := table.NewEncoder(tableFormat, len(records))
 	e.LineBreak = lineBreak
-	e.EastAsianEncoding = cmd.GetFlags().EastAsianEncoding
-	e.CountDiacriticalSign = cmd.GetFlags().CountDiacriticalSign
-	e.CountFormatCode = cmd.GetFlags().CountFormatCode
+	flags := filter.flags()
+	e.EastAsianEncoding = flags.EastAsianEncoding
+	e.CountDiacriticalSign = flags.CountDiacriticalSign
+	e.CountFormatCode = flags.CountFormatCode
 	e.WithoutHeader = withoutHeader
 	e.Encoding = encoding
 
-	palette, _ := cmd.GetPalette()
+	palette := filter.palette()
 
 	if !withoutHeader {
 		hfields := make([]table.Field, 0, len(header))
@@ -298,6 +333,18 @@ func encodeText(fp io.Writer, view *View, format cmd.Format, lineBreak text.Line
 	return w.Flush()
 }
 
+func encodeXlsx(fp io.Writer, view *View, sheet string, withoutHeader bool) error {
+	header, records := bareValues(view)
+	if withoutHeader {
+		header = nil
+	}
+
+	if err := xlsx.Write(fp, sheet, header, records); err != nil {
+		return errors.New(fmt.Sprintf("encoding to xlsx failed: %s", err.Error()))
+	}
+	return nil
+}
+
 func encodeLTSV(fp io.Writer, view *View, lineBreak text.LineBreak, encoding text.Encoding) error {
 	header, records := bareValues(view)
 	w, err := ltsv.NewWriter(fp, header, lineBreak, encoding)
@@ -335,6 +382,10 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 		s = val.(value.Float).String()
 		effect = cmd.NumberEffect
 		align = text.RightAligned
+	case value.Decimal:
+		s = val.(value.Decimal).String()
+		effect = cmd.NumberEffect
+		align = text.RightAligned
 	case value.Boolean:
 		s = val.(value.Boolean).String()
 		effect = cmd.BooleanEffect
@@ -353,6 +404,9 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 	case value.Datetime:
 		s = val.(value.Datetime).Format(time.RFC3339Nano)
 		effect = cmd.DatetimeEffect
+	case value.Interval:
+		s = val.(value.Interval).ISO8601()
+		effect = cmd.DatetimeEffect
 	case value.Null:
 		if forTextTable {
 			s = "NULL"
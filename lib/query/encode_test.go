@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
@@ -55,7 +56,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk日本語あアｱＡ（\n"), value.NewNull()}),
 			},
 		},
@@ -97,7 +98,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(false)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 			},
 		},
 		Format:                  cmd.FIXED,
@@ -113,7 +114,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(false)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 			},
 		},
 		Format:                  cmd.FIXED,
@@ -128,7 +129,7 @@ var encodeViewTests = []struct {
 		View: &View{
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" ab|cdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk日本語あアｱＡ（\n"), value.NewNull()}),
 			},
 		},
@@ -145,7 +146,7 @@ var encodeViewTests = []struct {
 		View: &View{
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" ab|cdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk日本語あアｱＡ（\n"), value.NewNull()}),
 			},
 		},
@@ -163,7 +164,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk\n"), value.NewNull()}),
 			},
 		},
@@ -181,7 +182,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk\n"), value.NewNull()}),
 			},
 		},
@@ -198,7 +199,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2\nsecond line", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"jk\n"), value.NewNull()}),
 			},
 		},
@@ -217,7 +218,7 @@ var encodeViewTests = []struct {
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" abc\\defghi/jk\rlmn\topqrstuvwxyzabcdefg\nhi\"jk\n"), value.NewNull()}),
 			},
 		},
@@ -324,7 +325,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 			},
 		},
 		Format: cmd.LTSV,
@@ -337,7 +338,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(false)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 			},
 		},
 		Format:                  cmd.FIXED,
@@ -364,7 +365,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1:", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 			},
 		},
 		Format: cmd.LTSV,
@@ -376,7 +377,7 @@ var encodeViewTests = []struct {
 			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abc\tdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abc\tdef")}),
 			},
 		},
 		Format: cmd.LTSV,
@@ -389,7 +390,7 @@ var encodeViewTests = []struct {
 			RecordSet: []Record{
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
 				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewBoolean(true)}),
-				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("abcdef")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil, time.Local), value.NewString("abcdef")}),
 				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString(" 日本語ghijklmnopqrstuvwxyzabcdefg\nhi\"jk\n"), value.NewNull()}),
 			},
 		},
@@ -402,6 +403,44 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" " + string([]byte{0x93, 0xfa, 0x96, 0x7b, 0x8c, 0xea}) + "ghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Encode with BOM",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("日本語")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.UTF8M,
+		Result: "\xef\xbb\xbfc1,c2\n" +
+			"1,日本語",
+	},
+	{
+		Name: "LTSV Encode Latin-1",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("café")}),
+			},
+		},
+		Format:        cmd.LTSV,
+		WriteEncoding: cmd.LATIN1,
+		Result:        "c1:1\tc2:caf\xe9",
+	},
+	{
+		Name: "Fixed-Length Format Unsupported Encoding",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("café")}),
+			},
+		},
+		Format:                  cmd.FIXED,
+		WriteDelimiterPositions: []int{2, 8},
+		WriteEncoding:           cmd.LATIN1,
+		Error:                   "LATIN1 encoding is not supported in fixed-length format",
+	},
 }
 
 func TestEncodeView(t *testing.T) {
@@ -432,7 +471,7 @@ func TestEncodeView(t *testing.T) {
 		}
 
 		buf.Reset()
-		err := EncodeView(buf, v.View, fileInfo)
+		err := EncodeView(buf, v.View, fileInfo, NewEmptyFilter())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3,6 +3,7 @@ package query
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -33,9 +34,17 @@ const (
 	ErrorFunctionInvalidArgument              = "%s for function %s"
 	ErrorUnpermittedStatementFunction         = "function %s cannot be used as a statement"
 	ErrorNestedAggregateFunctions             = "aggregate functions are nested at %s"
+	ErrorWindowNotExist                       = "window %s does not exist"
+	ErrorWindowRedeclared                     = "window %s is redeclared"
+	ErrorWindowFrameOffset                    = "invalid offset %s for %s frame"
+	ErrorWindowFrameOrderByItems              = "function %s requires exactly one order by item for range frame offsets"
+	ErrorWindowFrameOrderByValue              = "order by item %s of function %s is not a number or a datetime for range frame offsets"
 	ErrorFunctionRedeclared                   = "function %s is redeclared"
 	ErrorBuiltInFunctionDeclared              = "function %s is a built-in function"
 	ErrorDuplicateParameter                   = "parameter %s is a duplicate"
+	ErrorProcedureNotExist                    = "procedure %s does not exist"
+	ErrorProcedureRedeclared                  = "procedure %s is redeclared"
+	ErrorProcedureOutArgument                 = "%s for out parameter %s of procedure %s is not a variable"
 	ErrorSubqueryTooManyRecords               = "subquery returns too many records, should return only one record"
 	ErrorSubqueryTooManyFields                = "subquery returns too many fields, should return only one field"
 	ErrorJsonQueryTooManyRecords              = "json query returns too many records, should return only one record"
@@ -49,6 +58,8 @@ const (
 	ErrorTableObjectArgumentsLength           = "table object %s takes at most %d arguments"
 	ErrorTableObjectJsonArgumentsLength       = "table object %s takes exactly %d arguments"
 	ErrorTableObjectInvalidArgument           = "invalid argument for %s: %s"
+	ErrorLateralJoinDirection                 = "lateral table %s cannot be joined by %s outer join"
+	ErrorLateralTableFieldsNotMatch           = "fields of lateral table %s do not match between records"
 	ErrorCursorRedeclared                     = "cursor %s is redeclared"
 	ErrorUndeclaredCursor                     = "cursor %s is undeclared"
 	ErrorCursorClosed                         = "cursor %s is closed"
@@ -61,6 +72,7 @@ const (
 	ErrorInlineTableFieldLength               = "select query should return exactly %s for inline table %s"
 	ErrorFileNotExist                         = "file %s does not exist"
 	ErrorFileAlreadyExist                     = "file %s already exists"
+	ErrorTableIsTemporary                     = "%s is a temporary table"
 	ErrorFileUnableToRead                     = "file %s is unable to be read"
 	ErrorFileLockTimeout                      = "file %s: lock wait timeout period exceeded"
 	ErrorFileNameAmbiguous                    = "filename %s is ambiguous"
@@ -83,6 +95,7 @@ const (
 	ErrorUpdateFieldNotExist                  = "field %s does not exist in the tables to update"
 	ErrorUpdateValueAmbiguous                 = "value %s to set in the field %s is ambiguous"
 	ErrorDeleteTableNotSpecified              = "tables to delete records are not specified"
+	ErrorMergeTargetRecordAmbiguous           = "a record in %s is matched by more than one record in the source table"
 	ErrorShowInvalidObjectType                = "object type %s is invalid"
 	ErrorReplaceValueLength                   = "%s"
 	ErrorSourceInvalidFilePath                = "%s is a invalid file path"
@@ -109,6 +122,17 @@ const (
 	ErrorExternalCommand                      = "external command: %s"
 	ErrorInvalidReloadType                    = "%s is an unknown reload type"
 	ErrorLoadConfiguration                    = "configuration loading error: %s"
+	ErrorInvalidRegExp                        = "invalid regular expression: %s"
+	ErrorInvalidIndexName                     = "%s is an invalid index name"
+	ErrorIndexAlreadyExist                    = "index %s already exists on %s"
+	ErrorCreateIndex                          = "failed to create index %s: %s"
+	ErrorInvalidColumnType                    = "%s is an invalid column type"
+	ErrorFieldValueType                       = "value %s cannot be converted to %s for field %s"
+	ErrorConstraintViolation                  = "constraint %s is violated by record %d in %s: %s"
+	ErrorDuplicateConstraintName              = "constraint %s already exists"
+	ErrorMultiplePrimaryKeys                  = "table %s cannot have more than one primary key"
+	ErrorConstraintNotExist                   = "constraint %s does not exist"
+	ErrorFieldReferencedByConstraint          = "field %s is referenced by check constraint %s"
 )
 
 type ForcedExit struct {
@@ -277,7 +301,9 @@ type CommitError struct {
 
 func NewCommitError(expr parser.Expression, message string) error {
 	if expr == nil {
-		NewBaseErrorWithPrefix("Auto Commit", fmt.Sprintf(ErrorCommit, message), 1)
+		return &CommitError{
+			NewBaseErrorWithPrefix("Auto Commit", fmt.Sprintf(ErrorCommit, message), 1),
+		}
 	}
 	return &CommitError{
 		NewBaseError(expr, fmt.Sprintf(ErrorCommit, message)),
@@ -290,7 +316,9 @@ type RollbackError struct {
 
 func NewRollbackError(expr parser.Expression, message string) error {
 	if expr == nil {
-		NewBaseErrorWithPrefix("Auto Rollback", fmt.Sprintf(ErrorRollback, message), 1)
+		return &RollbackError{
+			NewBaseErrorWithPrefix("Auto Rollback", fmt.Sprintf(ErrorRollback, message), 1),
+		}
 	}
 	return &RollbackError{
 		NewBaseError(expr, fmt.Sprintf(ErrorRollback, message)),
@@ -439,6 +467,56 @@ func NewNestedAggregateFunctionsError(expr parser.QueryExpression) error {
 	}
 }
 
+type WindowNotExistError struct {
+	*BaseError
+}
+
+func NewWindowNotExistError(name parser.Identifier) error {
+	return &WindowNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrorWindowNotExist, name.Literal)),
+	}
+}
+
+type WindowRedeclaredError struct {
+	*BaseError
+}
+
+func NewWindowRedeclaredError(name parser.Identifier) error {
+	return &WindowRedeclaredError{
+		NewBaseError(name, fmt.Sprintf(ErrorWindowRedeclared, name.Literal)),
+	}
+}
+
+type WindowFrameOffsetError struct {
+	*BaseError
+}
+
+func NewWindowFrameOffsetError(expr parser.AnalyticFunction, position parser.WindowFramePosition, unit parser.Token) error {
+	return &WindowFrameOffsetError{
+		NewBaseError(expr, fmt.Sprintf(ErrorWindowFrameOffset, position.Literal, strings.ToUpper(unit.Literal))),
+	}
+}
+
+type WindowFrameOrderByItemsError struct {
+	*BaseError
+}
+
+func NewWindowFrameOrderByItemsError(expr parser.AnalyticFunction) error {
+	return &WindowFrameOrderByItemsError{
+		NewBaseError(expr, fmt.Sprintf(ErrorWindowFrameOrderByItems, expr.Name)),
+	}
+}
+
+type WindowFrameOrderByValueError struct {
+	*BaseError
+}
+
+func NewWindowFrameOrderByValueError(expr parser.AnalyticFunction, item parser.QueryExpression) error {
+	return &WindowFrameOrderByValueError{
+		NewBaseError(expr, fmt.Sprintf(ErrorWindowFrameOrderByValue, item, expr.Name)),
+	}
+}
+
 type FunctionRedeclaredError struct {
 	*BaseError
 }
@@ -469,6 +547,36 @@ func NewDuplicateParameterError(expr parser.Variable) error {
 	}
 }
 
+type ProcedureNotExistError struct {
+	*BaseError
+}
+
+func NewProcedureNotExistError(expr parser.Identifier) error {
+	return &ProcedureNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ErrorProcedureNotExist, expr.Literal)),
+	}
+}
+
+type ProcedureRedeclaredError struct {
+	*BaseError
+}
+
+func NewProcedureRedeclaredError(expr parser.Identifier) error {
+	return &ProcedureRedeclaredError{
+		NewBaseError(expr, fmt.Sprintf(ErrorProcedureRedeclared, expr.Literal)),
+	}
+}
+
+type ProcedureOutArgumentError struct {
+	*BaseError
+}
+
+func NewProcedureOutArgumentError(expr parser.CallProcedure, arg parser.QueryExpression, parameter parser.Variable) error {
+	return &ProcedureOutArgumentError{
+		NewBaseError(expr, fmt.Sprintf(ErrorProcedureOutArgument, arg.String(), parameter.String(), expr.Name.Literal)),
+	}
+}
+
 type SubqueryTooManyRecordsError struct {
 	*BaseError
 }
@@ -599,6 +707,27 @@ func NewTableObjectInvalidArgumentError(expr parser.TableObject, message string)
 	}
 }
 
+type LateralJoinDirectionError struct {
+	*BaseError
+}
+
+func NewLateralJoinDirectionError(join parser.Join) error {
+	table := join.JoinTable.(parser.Table)
+	return &LateralJoinDirectionError{
+		NewBaseError(table.Name(), fmt.Sprintf(ErrorLateralJoinDirection, table.Name(), join.Direction.Literal)),
+	}
+}
+
+type LateralTableFieldsNotMatchError struct {
+	*BaseError
+}
+
+func NewLateralTableFieldsNotMatchError(table parser.Table) error {
+	return &LateralTableFieldsNotMatchError{
+		NewBaseError(table.Name(), fmt.Sprintf(ErrorLateralTableFieldsNotMatch, table.Name())),
+	}
+}
+
 type CursorRedeclaredError struct {
 	*BaseError
 }
@@ -721,6 +850,16 @@ func NewFileAlreadyExistError(file parser.Identifier) error {
 	}
 }
 
+type TableIsTemporaryError struct {
+	*BaseError
+}
+
+func NewTableIsTemporaryError(table parser.QueryExpression) error {
+	return &TableIsTemporaryError{
+		NewBaseError(table, fmt.Sprintf(ErrorTableIsTemporary, table)),
+	}
+}
+
 type FileUnableToReadError struct {
 	*BaseError
 }
@@ -949,6 +1088,16 @@ func NewDeleteTableNotSpecifiedError(query parser.DeleteQuery) error {
 	}
 }
 
+type MergeTargetRecordAmbiguousError struct {
+	*BaseError
+}
+
+func NewMergeTargetRecordAmbiguousError(when parser.MergeWhen, table parser.Identifier) error {
+	return &MergeTargetRecordAmbiguousError{
+		NewBaseError(when, fmt.Sprintf(ErrorMergeTargetRecordAmbiguous, table)),
+	}
+}
+
 type ShowInvalidObjectTypeError struct {
 	*BaseError
 }
@@ -1211,6 +1360,120 @@ func NewLoadConfigurationError(expr parser.Reload, message string) error {
 	}
 }
 
+type InvalidRegExpError struct {
+	*BaseError
+}
+
+func NewInvalidRegExpError(expr parser.QueryExpression, message string) error {
+	return &InvalidRegExpError{
+		NewBaseError(expr, fmt.Sprintf(ErrorInvalidRegExp, message)),
+	}
+}
+
+type InvalidIndexNameError struct {
+	*BaseError
+}
+
+func NewInvalidIndexNameError(name parser.Identifier) error {
+	return &InvalidIndexNameError{
+		NewBaseError(name, fmt.Sprintf(ErrorInvalidIndexName, name)),
+	}
+}
+
+type IndexAlreadyExistError struct {
+	*BaseError
+}
+
+func NewIndexAlreadyExistError(name parser.Identifier, path string) error {
+	return &IndexAlreadyExistError{
+		NewBaseError(name, fmt.Sprintf(ErrorIndexAlreadyExist, name, path)),
+	}
+}
+
+type CreateIndexError struct {
+	*BaseError
+}
+
+func NewCreateIndexError(name parser.Identifier, message string) error {
+	return &CreateIndexError{
+		NewBaseError(name, fmt.Sprintf(ErrorCreateIndex, name, message)),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, fmt.Sprintf(ErrorInvalidColumnType, columnType)),
+	}
+}
+
+type FieldValueTypeError struct {
+	*BaseError
+}
+
+func NewFieldValueTypeError(expr parser.QueryExpression, val value.Primary, columnType string, field string) error {
+	return &FieldValueTypeError{
+		NewBaseError(expr, fmt.Sprintf(ErrorFieldValueType, val, columnType, field)),
+	}
+}
+
+type ConstraintViolationError struct {
+	*BaseError
+	Constraint   string
+	RecordNumber int
+}
+
+func NewConstraintViolationError(expr parser.Expression, constraint string, recordNumber int, table string, detail string) error {
+	return &ConstraintViolationError{
+		BaseError:    NewBaseError(expr, fmt.Sprintf(ErrorConstraintViolation, constraint, recordNumber, table, detail)),
+		Constraint:   constraint,
+		RecordNumber: recordNumber,
+	}
+}
+
+type DuplicateConstraintNameError struct {
+	*BaseError
+}
+
+func NewDuplicateConstraintNameError(name parser.Identifier) error {
+	return &DuplicateConstraintNameError{
+		NewBaseError(name, fmt.Sprintf(ErrorDuplicateConstraintName, name)),
+	}
+}
+
+type MultiplePrimaryKeysError struct {
+	*BaseError
+}
+
+func NewMultiplePrimaryKeysError(expr parser.TableConstraint, table string) error {
+	return &MultiplePrimaryKeysError{
+		NewBaseError(expr, fmt.Sprintf(ErrorMultiplePrimaryKeys, table)),
+	}
+}
+
+type ConstraintNotExistError struct {
+	*BaseError
+}
+
+func NewConstraintNotExistError(name parser.Identifier) error {
+	return &ConstraintNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrorConstraintNotExist, name)),
+	}
+}
+
+type FieldReferencedByConstraintError struct {
+	*BaseError
+}
+
+func NewFieldReferencedByConstraintError(field parser.QueryExpression, constraint string) error {
+	return &FieldReferencedByConstraintError{
+		NewBaseError(field, fmt.Sprintf(ErrorFieldReferencedByConstraint, field, constraint)),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
@@ -0,0 +1,1250 @@
+package query
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const UnknownRecordLen = -1
+
+const (
+	equalitySelectivity = 0.005
+	defaultSelectivity  = 1.0 / 3
+	groupReductionRatio = 10
+	recordSampleSize    = 64 * 1024
+)
+
+type PlanNode struct {
+	Operator  string
+	Detail    string
+	Estimated int
+	Workers   int
+
+	Analyzed bool
+	Actual   int
+	Time     time.Duration
+	Loops    int
+
+	Children []*PlanNode
+
+	estimate func(inputs []int) int
+	workers  func(inputs []int, cpu int) int
+}
+
+func finishPlanNode(node *PlanNode, cpu int, children ...*PlanNode) *PlanNode {
+	node.Children = children
+
+	if node.estimate != nil {
+		node.Estimated = node.estimate(planNodeInputs(children, false))
+	}
+	if node.workers != nil {
+		if inputs := planNodeInputs(children, node.Analyzed); inputs != nil {
+			node.Workers = node.workers(inputs, cpu)
+		}
+	}
+	return node
+}
+
+func planNodeInputs(children []*PlanNode, analyzed bool) []int {
+	inputs := make([]int, 0, len(children))
+	for _, child := range children {
+		n := child.Estimated
+		if analyzed && child.Analyzed {
+			n = child.Actual
+		}
+		if n == UnknownRecordLen {
+			return nil
+		}
+		inputs = append(inputs, n)
+	}
+	return inputs
+}
+
+func plannedRoutines(recordLen int, minimumRequiredPerCore int, cpu int) int {
+	if minimumRequiredPerCore < 1 {
+		minimumRequiredPerCore = GetGoroutineManager().MinimumRequiredPerCore
+	}
+
+	number := recordLen / minimumRequiredPerCore
+	if number < 1 {
+		number = 1
+	}
+	if cpu < number {
+		number = cpu
+	}
+	return number
+}
+
+func passThroughEstimate(inputs []int) int {
+	if inputs == nil {
+		return UnknownRecordLen
+	}
+	return inputs[len(inputs)-1]
+}
+
+func sequentialWorkers(inputs []int, cpu int) int {
+	return plannedRoutines(inputs[len(inputs)-1], -1, cpu)
+}
+
+func newLoadPlanNode(detail string, recordLen int) *PlanNode {
+	return &PlanNode{
+		Operator:  "Load",
+		Detail:    detail,
+		Estimated: recordLen,
+		Workers:   1,
+	}
+}
+
+func newStreamPlanNode(stream *selectStream) *PlanNode {
+	detail := "file: " + stream.fileInfo.Path
+	if stream.query.OrderByClause != nil || stream.entity.GroupByClause != nil {
+		detail = detail + fmt.Sprintf(", spill over %d MB", stream.memoryLimit/1024/1024)
+	}
+
+	return &PlanNode{
+		Operator:  "Stream",
+		Detail:    detail,
+		Estimated: estimateRecordLen(stream.fileInfo, stream.fileInfo.NoHeader),
+		Workers:   1,
+	}
+}
+
+func newIndexScanPlanNode(path string, indexName string, recordLen int) *PlanNode {
+	return &PlanNode{
+		Operator:  "Index Scan",
+		Detail:    fmt.Sprintf("%s using %s", path, indexName),
+		Estimated: recordLen,
+		Workers:   1,
+	}
+}
+
+func newCrossJoinPlanNode(detail string) *PlanNode {
+	return &PlanNode{
+		Operator: "Cross Join",
+		Detail:   detail,
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			return inputs[0] * inputs[1]
+		},
+	}
+}
+
+func newJoinPlanNode(join parser.Join, strategy JoinStrategy, cross bool) *PlanNode {
+	joinType := resolveJoinType(join)
+	direction := join.Direction.Token
+	if direction == parser.TokenUndefined {
+		direction = parser.LEFT
+	}
+
+	var detail string
+	switch joinType {
+	case parser.CROSS:
+		detail = "CROSS"
+	case parser.OUTER:
+		detail = strings.ToUpper(parser.TokenLiteral(direction)) + " OUTER"
+	default:
+		detail = "INNER"
+	}
+	if !join.Natural.IsEmpty() {
+		detail = "NATURAL " + detail
+	}
+	if isLateralTable(join.JoinTable) {
+		detail = detail + " LATERAL"
+	}
+	if join.Condition != nil {
+		detail = detail + " " + join.Condition.String()
+	}
+
+	if cross {
+		return newCrossJoinPlanNode(detail)
+	}
+
+	node := &PlanNode{
+		Operator: strategy.String(),
+		Detail:   detail,
+		Workers:  1,
+	}
+
+	node.estimate = func(inputs []int) int {
+		if inputs == nil {
+			return UnknownRecordLen
+		}
+		l, r := inputs[0], inputs[1]
+
+		n := l * r / 3
+		if strategy != NestedLoopJoin {
+			n = maxInt(l, r)
+		}
+		if joinType == parser.OUTER {
+			switch direction {
+			case parser.RIGHT:
+				n = maxInt(n, r)
+			case parser.FULL:
+				n = maxInt(n, l+r)
+			default:
+				n = maxInt(n, l)
+			}
+		}
+		return n
+	}
+
+	node.workers = func(inputs []int, cpu int) int {
+		l, r := inputs[0], inputs[1]
+		if strategy != NestedLoopJoin {
+			return plannedRoutines(maxInt(l, r), -1, cpu)
+		}
+		if joinType == parser.OUTER && direction == parser.RIGHT {
+			l, r = r, l
+		}
+		return plannedRoutines(l, CalcMinimumRequired(l, r, MinimumRequiredPerCPUCore), cpu)
+	}
+	return node
+}
+
+func newFilterPlanNode(condition parser.QueryExpression) *PlanNode {
+	selectivity := conditionSelectivity(condition)
+
+	return &PlanNode{
+		Operator: "Filter",
+		Detail:   condition.String(),
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			return int(math.Ceil(float64(inputs[0]) * selectivity))
+		},
+		workers: sequentialWorkers,
+	}
+}
+
+func newGroupPlanNode(clause parser.GroupByClause) *PlanNode {
+	return &PlanNode{
+		Operator: "Group",
+		Detail:   listExpressions(clause.Items),
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			return int(math.Ceil(float64(inputs[0]) / groupReductionRatio))
+		},
+		workers: sequentialWorkers,
+	}
+}
+
+func newHavingPlanNode(clause parser.HavingClause) *PlanNode {
+	node := newFilterPlanNode(clause.Filter)
+	node.Operator = "Having"
+	return node
+}
+
+func newAnalyticPlanNode(clause parser.SelectClause) *PlanNode {
+	functions := make([]string, 0)
+	searchExpressions(clause, func(expr parser.QueryExpression) bool {
+		if _, ok := expr.(parser.AnalyticFunction); ok {
+			functions = append(functions, expr.String())
+		}
+		return false
+	})
+
+	return &PlanNode{
+		Operator: "Analytic",
+		Detail:   strings.Join(functions, ", "),
+		Workers:  1,
+		estimate: passThroughEstimate,
+		workers:  sequentialWorkers,
+	}
+}
+
+func newSelectPlanNode(clause parser.SelectClause, aggregated bool) *PlanNode {
+	detail := listExpressions(clause.Fields)
+	if clause.IsDistinct() {
+		detail = clause.Distinct.Literal + " " + detail
+	}
+
+	return &PlanNode{
+		Operator: "Select",
+		Detail:   detail,
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			if aggregated {
+				return 1
+			}
+			return inputs[0]
+		},
+		workers: sequentialWorkers,
+	}
+}
+
+func newSortPlanNode(clause parser.OrderByClause) *PlanNode {
+	return &PlanNode{
+		Operator: "Sort",
+		Detail:   listExpressions(clause.Items),
+		Workers:  1,
+		estimate: passThroughEstimate,
+		workers:  sequentialWorkers,
+	}
+}
+
+func newOffsetPlanNode(clause parser.OffsetClause) *PlanNode {
+	offset, ok := literalInteger(clause.Value)
+
+	return &PlanNode{
+		Operator: "Offset",
+		Detail:   clause.Value.String(),
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			if !ok {
+				return inputs[0]
+			}
+			return maxInt(inputs[0]-offset, 0)
+		},
+	}
+}
+
+func newLimitPlanNode(clause parser.LimitClause) *PlanNode {
+	limit, ok := literalInteger(clause.Value)
+	if clause.IsPercentage() || clause.IsWithTies() {
+		ok = false
+	}
+
+	detail := clause.Value.String()
+	if clause.IsPercentage() {
+		detail = detail + " " + clause.Percent
+	}
+	if clause.With != nil {
+		detail = detail + " " + clause.With.String()
+	}
+
+	return &PlanNode{
+		Operator: "Limit",
+		Detail:   detail,
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil {
+				return UnknownRecordLen
+			}
+			if !ok {
+				return inputs[0]
+			}
+			return minInt(inputs[0], maxInt(limit, 0))
+		},
+	}
+}
+
+func newSetPlanNode(set parser.SelectSet, recursive bool) *PlanNode {
+	operator := set.Operator.Token
+	all := !set.All.IsEmpty()
+
+	node := &PlanNode{
+		Operator: "Union",
+		Workers:  1,
+	}
+	switch operator {
+	case parser.EXCEPT:
+		node.Operator = "Except"
+	case parser.INTERSECT:
+		node.Operator = "Intersect"
+	}
+	if all {
+		node.Detail = strings.ToUpper(set.All.Literal)
+	}
+	if recursive {
+		node.Detail = strings.TrimSpace(node.Detail + " RECURSIVE")
+	}
+
+	node.estimate = func(inputs []int) int {
+		if inputs == nil || recursive {
+			return UnknownRecordLen
+		}
+		switch operator {
+		case parser.EXCEPT:
+			return inputs[0]
+		case parser.INTERSECT:
+			return minInt(inputs[0], inputs[1])
+		default:
+			return inputs[0] + inputs[1]
+		}
+	}
+	if !all {
+		node.workers = func(inputs []int, cpu int) int {
+			return plannedRoutines(inputs[0]+inputs[len(inputs)-1], -1, cpu)
+		}
+	}
+	return node
+}
+
+func newSubqueryPlanNode(alias parser.Identifier) *PlanNode {
+	return &PlanNode{
+		Operator: "Subquery",
+		Detail:   alias.Literal,
+		Workers:  1,
+		estimate: passThroughEstimate,
+	}
+}
+
+func newPivotPlanNode(expr parser.QueryExpression) *PlanNode {
+	if pivot, ok := expr.(parser.Pivot); ok {
+		return &PlanNode{
+			Operator: "Pivot",
+			Detail:   pivot.Aggregate.String() + " " + strings.ToUpper(pivot.For) + " " + pivot.Field.String(),
+			Workers:  1,
+			estimate: passThroughEstimate,
+		}
+	}
+
+	unpivot := expr.(parser.Unpivot)
+	return &PlanNode{
+		Operator: "Unpivot",
+		Detail:   unpivot.ValueColumn.String() + " " + strings.ToUpper(unpivot.For) + " " + unpivot.NameColumn.String(),
+		Workers:  1,
+		estimate: func(inputs []int) int {
+			if inputs == nil || inputs[0] < 0 {
+				return UnknownRecordLen
+			}
+			return inputs[0] * len(unpivot.Fields)
+		},
+	}
+}
+
+func newInlineTablePlanNode(inlineTable parser.InlineTable) *PlanNode {
+	detail := inlineTable.Name.Literal
+	if inlineTable.IsRecursive() {
+		detail = "RECURSIVE " + detail
+	}
+
+	return &PlanNode{
+		Operator: "Inline Table",
+		Detail:   detail,
+		Workers:  1,
+		estimate: passThroughEstimate,
+	}
+}
+
+func newWithPlanNode(clause parser.QueryExpression) *PlanNode {
+	if clause == nil {
+		return nil
+	}
+
+	return &PlanNode{
+		Operator: "With",
+		Workers:  1,
+		estimate: passThroughEstimate,
+	}
+}
+
+func resolveJoinType(join parser.Join) int {
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			return parser.INNER
+		}
+		return parser.OUTER
+	}
+	return join.JoinType.Token
+}
+
+func conditionSelectivity(expr parser.QueryExpression) float64 {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return conditionSelectivity(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		lhs := conditionSelectivity(logic.LHS)
+		rhs := conditionSelectivity(logic.RHS)
+		if logic.Operator.Token == parser.AND {
+			return lhs * rhs
+		}
+		return lhs + rhs - lhs*rhs
+	case parser.UnaryLogic:
+		unary := expr.(parser.UnaryLogic)
+		if unary.Operator.Token == parser.NOT {
+			return 1 - conditionSelectivity(unary.Operand)
+		}
+	case parser.Comparison:
+		if expr.(parser.Comparison).Operator == "=" {
+			return equalitySelectivity
+		}
+	}
+	return defaultSelectivity
+}
+
+func literalInteger(expr parser.QueryExpression) (int, bool) {
+	pt, ok := expr.(parser.PrimitiveType)
+	if !ok {
+		return 0, false
+	}
+	i := value.ToInteger(pt.Value)
+	if value.IsNull(i) {
+		return 0, false
+	}
+	return int(i.(value.Integer).Raw()), true
+}
+
+func listExpressions(exprs []parser.QueryExpression) string {
+	list := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		list = append(list, expr.String())
+	}
+	return strings.Join(list, ", ")
+}
+
+func searchExpressions(expr interface{}, fn func(parser.QueryExpression) bool) bool {
+	return searchExpressionValue(reflect.ValueOf(expr), fn)
+}
+
+func searchExpressionValue(v reflect.Value, fn func(parser.QueryExpression) bool) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return false
+		}
+		return searchExpressionValue(v.Elem(), fn)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if searchExpressionValue(v.Index(i), fn) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		if v.CanInterface() {
+			if expr, ok := v.Interface().(parser.QueryExpression); ok {
+				if _, ok := expr.(parser.Subquery); ok {
+					return false
+				}
+				if fn(expr) {
+					return true
+				}
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanInterface() && searchExpressionValue(v.Field(i), fn) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasAnalyticFunction(clause parser.SelectClause) bool {
+	return searchExpressions(clause, func(expr parser.QueryExpression) bool {
+		_, ok := expr.(parser.AnalyticFunction)
+		return ok
+	})
+}
+
+func isAggregatedEntity(entity parser.SelectEntity) bool {
+	if entity.GroupByClause != nil {
+		return false
+	}
+	if entity.HavingClause != nil {
+		return true
+	}
+	return searchExpressions(entity.SelectClause, func(expr parser.QueryExpression) bool {
+		switch expr.(type) {
+		case parser.AggregateFunction, parser.ListFunction:
+			return true
+		}
+		return false
+	})
+}
+
+func planStoredTable(tableIdentifier parser.Identifier, filter *Filter) (*PlanNode, error) {
+	if view := temporaryView(filter.TempViews, tableIdentifier.Literal); view != nil {
+		return newLoadPlanNode("temporary table: "+tableIdentifier.Literal, view.RecordLen()), nil
+	}
+
+	flags := filter.flags()
+
+	filePath, err := CreateFilePath(tableIdentifier, flags.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if !filter.viewCache().Exists(filePath) {
+		fileInfo, err := NewFileInfo(tableIdentifier, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+		if err != nil {
+			return nil, err
+		}
+		filePath = fileInfo.Path
+
+		if !filter.viewCache().Exists(filePath) {
+			return newLoadPlanNode("file: "+filePath, estimateRecordLen(fileInfo, flags.NoHeader)), nil
+		}
+	}
+	return newLoadPlanNode("cache: "+filePath, filter.viewCache()[strings.ToUpper(filePath)].RecordLen()), nil
+}
+
+func temporaryView(scopes TemporaryViewScopes, name string) *View {
+	uname := strings.ToUpper(name)
+	for _, m := range scopes {
+		if view, ok := m[uname]; ok {
+			return view
+		}
+	}
+	return nil
+}
+
+func estimateRecordLen(fileInfo *FileInfo, noHeader bool) int {
+	if fileInfo.Compression != cmd.NoCompression {
+		return UnknownRecordLen
+	}
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.LTSV:
+	default:
+		return UnknownRecordLen
+	}
+
+	fp, err := os.Open(fileInfo.Path)
+	if err != nil {
+		return UnknownRecordLen
+	}
+	defer fp.Close()
+
+	stat, err := fp.Stat()
+	if err != nil {
+		return UnknownRecordLen
+	}
+
+	buf := make([]byte, recordSampleSize)
+	n, err := io.ReadFull(fp, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return UnknownRecordLen
+	}
+	if n < 1 {
+		return 0
+	}
+	buf = buf[:n]
+
+	lines := 0
+	for _, b := range buf {
+		if b == '\n' {
+			lines++
+		}
+	}
+
+	if int64(n) < stat.Size() {
+		lines = int(float64(lines) * float64(stat.Size()) / float64(n))
+	} else if buf[n-1] != '\n' {
+		lines++
+	}
+
+	if !noHeader && fileInfo.Format != cmd.LTSV {
+		lines--
+	}
+	return maxInt(lines, 0)
+}
+
+func maxInt(i1 int, i2 int) int {
+	if i1 < i2 {
+		return i2
+	}
+	return i1
+}
+
+func minInt(i1 int, i2 int) int {
+	if i1 < i2 {
+		return i1
+	}
+	return i2
+}
+
+type QueryPlanner struct {
+	filter       *Filter
+	inlineTables []map[string]*PlanNode
+	recursive    *parser.InlineTable
+	stream       *selectStream
+}
+
+func NewQueryPlanner(filter *Filter) *QueryPlanner {
+	return &QueryPlanner{
+		filter: filter,
+	}
+}
+
+func (p *QueryPlanner) finish(node *PlanNode, children ...*PlanNode) *PlanNode {
+	return finishPlanNode(node, p.filter.flags().CPU, children...)
+}
+
+// PlanStatement plans a select query executed as a statement.
+// Queries that are read as streams under the memory limit are planned with a stream in place of loading the table.
+func (p *QueryPlanner) PlanStatement(query parser.SelectQuery) (*PlanNode, error) {
+	p.stream = newSelectStream(query, p.filter)
+	defer func() {
+		p.stream = nil
+	}()
+	return p.Plan(query)
+}
+
+func (p *QueryPlanner) Plan(query parser.SelectQuery) (*PlanNode, error) {
+	var inlineTables []*PlanNode
+
+	if query.WithClause != nil {
+		p.inlineTables = append([]map[string]*PlanNode{{}}, p.inlineTables...)
+		defer func() {
+			p.inlineTables = p.inlineTables[1:]
+		}()
+
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			inlineTable := v.(parser.InlineTable)
+			node, err := p.planInlineTable(inlineTable)
+			if err != nil {
+				return nil, err
+			}
+			p.inlineTables[0][strings.ToUpper(inlineTable.Name.Literal)] = node
+			inlineTables = append(inlineTables, node)
+		}
+	}
+
+	node, err := p.planQueryExpression(query.SelectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.OrderByClause != nil {
+		node = p.finish(newSortPlanNode(query.OrderByClause.(parser.OrderByClause)), node)
+	}
+	if query.OffsetClause != nil {
+		node = p.finish(newOffsetPlanNode(query.OffsetClause.(parser.OffsetClause)), node)
+	}
+	if query.LimitClause != nil {
+		node = p.finish(newLimitPlanNode(query.LimitClause.(parser.LimitClause)), node)
+	}
+
+	if query.WithClause != nil {
+		node = p.finish(newWithPlanNode(query.WithClause), append(inlineTables, node)...)
+	}
+	return node, nil
+}
+
+func (p *QueryPlanner) planInlineTable(inlineTable parser.InlineTable) (*PlanNode, error) {
+	recursive := p.recursive
+	if inlineTable.IsRecursive() {
+		p.recursive = &inlineTable
+	}
+	defer func() {
+		p.recursive = recursive
+	}()
+
+	node, err := p.Plan(inlineTable.Query)
+	if err != nil {
+		return nil, err
+	}
+	return p.finish(newInlineTablePlanNode(inlineTable), node), nil
+}
+
+func (p *QueryPlanner) planQueryExpression(expr parser.QueryExpression) (*PlanNode, error) {
+	switch expr.(type) {
+	case parser.Subquery:
+		return p.Plan(expr.(parser.Subquery).Query)
+	case parser.SelectSet:
+		return p.planSelectSet(expr.(parser.SelectSet))
+	}
+	return p.planSelectEntity(expr.(parser.SelectEntity))
+}
+
+func (p *QueryPlanner) planSelectSet(set parser.SelectSet) (*PlanNode, error) {
+	lhs, err := p.planQueryExpression(set.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := p.planQueryExpression(set.RHS)
+	if err != nil {
+		return nil, err
+	}
+	return p.finish(newSetPlanNode(set, p.recursive != nil), lhs, rhs), nil
+}
+
+func (p *QueryPlanner) planSelectEntity(entity parser.SelectEntity) (*PlanNode, error) {
+	var node *PlanNode
+
+	if entity.FromClause == nil {
+		var obj parser.QueryExpression
+		if cmd.IsReadableFromPipeOrRedirection() {
+			obj = parser.Stdin{Stdin: "stdin"}
+		} else {
+			obj = parser.Dual{}
+		}
+		entity.FromClause = parser.FromClause{Tables: []parser.QueryExpression{parser.Table{Object: obj}}}
+	}
+	tables := entity.FromClause.(parser.FromClause).Tables
+
+	if p.stream != nil {
+		node = newStreamPlanNode(p.stream)
+	} else if entity.WhereClause != nil && len(tables) == 1 && p.recursive == nil {
+		if table, ok := tables[0].(parser.Table); ok && !p.isInlineTable(table.Object) {
+			fileInfo, idx, offsets, err := searchIndexByCondition(table, entity.WhereClause.(parser.WhereClause).Filter, p.filter)
+			if err != nil {
+				return nil, err
+			}
+			if idx != nil {
+				node = newIndexScanPlanNode(fileInfo.Path, idx.Name, len(offsets))
+			}
+		}
+	}
+
+	if node == nil {
+		for _, table := range tables {
+			tableNode, err := p.planTable(table)
+			if err != nil {
+				return nil, err
+			}
+			if node == nil {
+				node = tableNode
+			} else if isLateralTable(table) {
+				node = p.finish(newCrossJoinPlanNode("LATERAL"), node, tableNode)
+			} else {
+				node = p.finish(newCrossJoinPlanNode(""), node, tableNode)
+			}
+		}
+	}
+
+	if entity.WhereClause != nil {
+		node = p.finish(newFilterPlanNode(entity.WhereClause.(parser.WhereClause).Filter), node)
+	}
+	if entity.GroupByClause != nil {
+		node = p.finish(newGroupPlanNode(entity.GroupByClause.(parser.GroupByClause)), node)
+	}
+	if entity.HavingClause != nil {
+		node = p.finish(newHavingPlanNode(entity.HavingClause.(parser.HavingClause)), node)
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if hasAnalyticFunction(selectClause) {
+		node = p.finish(newAnalyticPlanNode(selectClause), node)
+	}
+	return p.finish(newSelectPlanNode(selectClause, isAggregatedEntity(entity)), node), nil
+}
+
+func (p *QueryPlanner) isInlineTable(obj parser.QueryExpression) bool {
+	ident, ok := obj.(parser.Identifier)
+	if !ok {
+		return false
+	}
+	_, ok = p.inlineTable(ident)
+	return ok
+}
+
+func (p *QueryPlanner) inlineTable(ident parser.Identifier) (*PlanNode, bool) {
+	uname := strings.ToUpper(ident.Literal)
+	for _, m := range p.inlineTables {
+		if node, ok := m[uname]; ok {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+func (p *QueryPlanner) planTable(expr parser.QueryExpression) (*PlanNode, error) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return p.planTable(parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+
+	switch table.Object.(type) {
+	case parser.Subquery:
+		node, err := p.Plan(table.Object.(parser.Subquery).Query)
+		if err != nil {
+			return nil, err
+		}
+		return p.finish(newSubqueryPlanNode(table.Name()), node), nil
+	case parser.Join:
+		join := table.Object.(parser.Join)
+		lhs, err := p.planTable(join.Table)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := p.planTable(join.JoinTable)
+		if err != nil {
+			return nil, err
+		}
+		cross := resolveJoinType(join) == parser.CROSS || (join.Natural.IsEmpty() && join.Condition == nil)
+		return p.finish(newJoinPlanNode(join, predictJoinStrategy(join, p.filter.flags()), cross), lhs, rhs), nil
+	case parser.Pivot:
+		node, err := p.planTable(table.Object.(parser.Pivot).Table)
+		if err != nil {
+			return nil, err
+		}
+		return p.finish(newPivotPlanNode(table.Object), node), nil
+	case parser.Unpivot:
+		node, err := p.planTable(table.Object.(parser.Unpivot).Table)
+		if err != nil {
+			return nil, err
+		}
+		return p.finish(newPivotPlanNode(table.Object), node), nil
+	case parser.Identifier:
+		ident := table.Object.(parser.Identifier)
+		if p.recursive != nil && strings.EqualFold(ident.Literal, p.recursive.Name.Literal) {
+			return newLoadPlanNode("recursive table: "+ident.Literal, UnknownRecordLen), nil
+		}
+		if node, ok := p.inlineTable(ident); ok {
+			return newLoadPlanNode("inline table: "+ident.Literal, node.Estimated), nil
+		}
+	}
+	return planTableObject(table, p.filter)
+}
+
+func predictJoinStrategy(join parser.Join, flags *cmd.Flags) JoinStrategy {
+	if isLateralTable(join.JoinTable) {
+		return NestedLoopJoin
+	}
+	if !join.Natural.IsEmpty() {
+		return EquiJoinStrategy(flags)
+	}
+	condition, ok := join.Condition.(parser.JoinCondition)
+	if !ok {
+		return NestedLoopJoin
+	}
+	if condition.On == nil {
+		return EquiJoinStrategy(flags)
+	}
+
+	for _, c := range splitConjunction(condition.On) {
+		comparison, ok := c.(parser.Comparison)
+		if !ok || comparison.Operator != "=" {
+			continue
+		}
+		lhs, lok := comparison.LHS.(parser.FieldReference)
+		rhs, rok := comparison.RHS.(parser.FieldReference)
+		if !lok || !rok {
+			continue
+		}
+		if 0 < len(lhs.View.Literal) && strings.EqualFold(lhs.View.Literal, rhs.View.Literal) {
+			continue
+		}
+		return EquiJoinStrategy(flags)
+	}
+	return NestedLoopJoin
+}
+
+func planTableObject(table parser.Table, filter *Filter) (*PlanNode, error) {
+	switch table.Object.(type) {
+	case parser.Dual:
+		return newLoadPlanNode("dual", 1), nil
+	case parser.Stdin:
+		if view := temporaryView(filter.TempViews, table.Object.String()); view != nil {
+			return newLoadPlanNode("stdin", view.RecordLen()), nil
+		}
+		return newLoadPlanNode("stdin", UnknownRecordLen), nil
+	case parser.JsonQuery:
+		return newLoadPlanNode("json table", UnknownRecordLen), nil
+	case parser.TableFunction:
+		return newLoadPlanNode("table function: "+table.Object.(parser.TableFunction).Name, UnknownRecordLen), nil
+	case parser.TableObject:
+		return planStoredTable(table.Object.(parser.TableObject).Path, filter)
+	case parser.Identifier:
+		ident := table.Object.(parser.Identifier)
+		if filter.RecursiveTable != nil && strings.EqualFold(ident.Literal, filter.RecursiveTable.Name.Literal) && filter.RecursiveTmpView != nil {
+			return newLoadPlanNode("recursive table: "+ident.Literal, filter.RecursiveTmpView.RecordLen()), nil
+		}
+		if view, err := filter.InlineTables.Get(ident); err == nil {
+			return newLoadPlanNode("inline table: "+ident.Literal, view.RecordLen()), nil
+		}
+		return planStoredTable(ident, filter)
+	}
+	return nil, nil
+}
+
+type planTracer struct {
+	flags        *cmd.Flags
+	frames       [][]*PlanNode
+	analyticTime time.Duration
+}
+
+func newPlanTracer(flags *cmd.Flags) *planTracer {
+	return &planTracer{
+		flags:  flags,
+		frames: [][]*PlanNode{{}},
+	}
+}
+
+func (t *planTracer) Root() *PlanNode {
+	if len(t.frames) < 1 || len(t.frames[0]) < 1 {
+		return nil
+	}
+	return t.frames[0][len(t.frames[0])-1]
+}
+
+func (t *planTracer) current() []*PlanNode {
+	return t.frames[len(t.frames)-1]
+}
+
+func (t *planTracer) size() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.current())
+}
+
+func (t *planTracer) setCurrent(nodes []*PlanNode) {
+	t.frames[len(t.frames)-1] = nodes
+}
+
+func (t *planTracer) analyze(node *PlanNode, children []*PlanNode, actual int, elapsed time.Duration) *PlanNode {
+	node.Analyzed = true
+	node.Actual = actual
+	node.Time = elapsed
+	node.Loops = 1
+	return finishPlanNode(node, t.flags.CPU, children...)
+}
+
+func (t *planTracer) begin() {
+	if t == nil {
+		return
+	}
+	t.frames = append(t.frames, []*PlanNode{})
+}
+
+func (t *planTracer) end(node *PlanNode, actual int, start time.Time) {
+	if t == nil {
+		return
+	}
+
+	nodes := t.current()
+	t.frames = t.frames[:len(t.frames)-1]
+	if node != nil {
+		nodes = []*PlanNode{t.analyze(node, nodes, actual, time.Since(start))}
+	}
+	t.setCurrent(append(t.current(), nodes...))
+}
+
+func (t *planTracer) add(node *PlanNode, actual int, elapsed time.Duration) {
+	if t == nil {
+		return
+	}
+	t.setCurrent(append(t.current(), t.analyze(node, nil, actual, elapsed)))
+}
+
+func (t *planTracer) wrap(node *PlanNode, actual int, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.setCurrent([]*PlanNode{t.analyze(node, t.current(), actual, time.Since(start))})
+}
+
+func (t *planTracer) combine(node *PlanNode, actual int, elapsed time.Duration) {
+	if t == nil {
+		return
+	}
+
+	nodes := t.current()
+	children := []*PlanNode{nodes[0], nodes[1]}
+	for _, child := range children {
+		elapsed += child.Time
+	}
+	t.setCurrent(append([]*PlanNode{t.analyze(node, children, actual, elapsed)}, nodes[2:]...))
+}
+
+func (t *planTracer) mergeLoops(from int) {
+	if t == nil {
+		return
+	}
+
+	nodes := t.current()
+	if len(nodes) <= from+1 {
+		return
+	}
+	for _, node := range nodes[from+1:] {
+		accumulatePlanNode(nodes[from], node)
+	}
+	t.setCurrent(nodes[:from+1])
+}
+
+func accumulatePlanNode(dst *PlanNode, src *PlanNode) {
+	dst.Actual += src.Actual
+	dst.Time += src.Time
+	dst.Loops += src.Loops
+	if len(dst.Children) == len(src.Children) {
+		for i := range dst.Children {
+			accumulatePlanNode(dst.Children[i], src.Children[i])
+		}
+	}
+}
+
+func (t *planTracer) addAnalyticTime(elapsed time.Duration) {
+	if t == nil {
+		return
+	}
+	t.analyticTime += elapsed
+}
+
+func (t *planTracer) resetAnalyticTime() {
+	if t == nil {
+		return
+	}
+	t.analyticTime = 0
+}
+
+func (t *planTracer) wrapAnalytic(node *PlanNode, actual int) {
+	if t == nil {
+		return
+	}
+
+	elapsed := t.analyticTime
+	for _, child := range t.current() {
+		elapsed += child.Time
+	}
+	t.setCurrent([]*PlanNode{t.analyze(node, t.current(), actual, elapsed)})
+	t.analyticTime = 0
+}
+
+func (t *planTracer) tableNode(table parser.Table, filter *Filter) *PlanNode {
+	if t == nil {
+		return nil
+	}
+
+	switch table.Object.(type) {
+	case parser.Join:
+		return nil
+	case parser.Pivot, parser.Unpivot:
+		return newPivotPlanNode(table.Object)
+	case parser.Subquery:
+		return newSubqueryPlanNode(table.Name())
+	}
+	node, _ := planTableObject(table, filter)
+	return node
+}
+
+func (t *planTracer) joinNode(join parser.Join, condition parser.QueryExpression, view *View, joinView *View) *PlanNode {
+	if t == nil {
+		return nil
+	}
+
+	joinType := resolveJoinType(join)
+	if joinType == parser.CROSS || condition == nil {
+		return newJoinPlanNode(join, NestedLoopJoin, joinType != parser.OUTER)
+	}
+
+	strategy := NestedLoopJoin
+	if keys, _ := EquiJoinKeys(condition, MergeHeader(view.Header, joinView.Header), view.FieldLen()); 0 < len(keys) {
+		strategy = EquiJoinStrategy(t.flags)
+	}
+	return newJoinPlanNode(join, strategy, false)
+}
+
+func (t *planTracer) lateralJoinNode(join parser.Join) *PlanNode {
+	if t == nil {
+		return nil
+	}
+	return newJoinPlanNode(join, NestedLoopJoin, resolveJoinType(join) == parser.CROSS)
+}
+
+func analyzeStream(stream *selectStream, filter *Filter) (*PlanNode, error) {
+	planner := NewQueryPlanner(filter)
+	planner.stream = stream
+	node, err := planner.Plan(stream.query)
+	if err != nil {
+		return nil, err
+	}
+
+	stream.filter = filter.CreateNode()
+	start := time.Now()
+	if err = stream.run(discardStreamEncoder{}); err != nil {
+		return nil, err
+	}
+	elapsed := time.Since(start)
+
+	leaf := node
+	for 0 < len(leaf.Children) {
+		leaf = leaf.Children[0]
+	}
+	leaf.Analyzed = true
+	leaf.Actual = stream.read
+	leaf.Time = stream.readTime
+	leaf.Loops = 1
+
+	node.Analyzed = true
+	node.Actual = stream.emitted
+	node.Time = elapsed
+	node.Loops = 1
+	return node, nil
+}
+
+func Explain(expr parser.Explain, filter *Filter) (string, error) {
+	var node *PlanNode
+	var err error
+
+	if expr.Analyze {
+		if stream := newSelectStream(expr.Query, filter); stream != nil {
+			if node, err = analyzeStream(stream, filter); err != nil {
+				return "", err
+			}
+		} else {
+			f := filter.CreateNode()
+			f.plan = newPlanTracer(filter.flags())
+			if _, err = Select(expr.Query, f); err != nil {
+				return "", err
+			}
+			node = f.plan.Root()
+		}
+	} else if node, err = NewQueryPlanner(filter).PlanStatement(expr.Query); err != nil {
+		return "", err
+	}
+
+	w := NewObjectWriter(filter)
+	writePlanNode(w, node)
+	w.Title1 = "Query Plan"
+	if expr.Analyze {
+		w.Title2 = "(Analyzed)"
+		w.Title2Effect = cmd.EmphasisEffect
+	}
+	return "\n" + w.String() + "\n", nil
+}
+
+func writePlanNode(w *ObjectWriter, node *PlanNode) {
+	w.WriteColorWithoutLineBreak(node.Operator, cmd.ObjectEffect)
+	if 0 < len(node.Detail) {
+		w.WriteSpaces(1)
+		w.WriteColor(node.Detail, cmd.IdentifierEffect)
+	}
+	w.NewLine()
+
+	w.BeginBlock()
+	w.WriteColorWithoutLineBreak("Estimated: ", cmd.LableEffect)
+	writePlanRecordLen(w, node.Estimated)
+	w.WriteColorWithoutLineBreak("  Workers: ", cmd.LableEffect)
+	w.WriteColorWithoutLineBreak(cmd.FormatInt(node.Workers, ","), cmd.NumberEffect)
+	if node.Analyzed {
+		w.WriteColorWithoutLineBreak("  Actual: ", cmd.LableEffect)
+		writePlanRecordLen(w, node.Actual)
+		if 1 < node.Loops {
+			w.WriteColorWithoutLineBreak("  Loops: ", cmd.LableEffect)
+			w.WriteColorWithoutLineBreak(cmd.FormatInt(node.Loops, ","), cmd.NumberEffect)
+		}
+		w.WriteColorWithoutLineBreak("  Time: ", cmd.LableEffect)
+		w.WriteColorWithoutLineBreak(cmd.FormatNumber(node.Time.Seconds(), 6, ".", ",", ""), cmd.NumberEffect)
+		w.WriteWithoutLineBreak(" seconds")
+	}
+	w.NewLine()
+
+	for _, child := range node.Children {
+		writePlanNode(w, child)
+	}
+	w.EndBlock()
+}
+
+func writePlanRecordLen(w *ObjectWriter, n int) {
+	if n == UnknownRecordLen {
+		w.WriteWithoutLineBreak("unknown")
+		return
+	}
+	w.WriteColorWithoutLineBreak(cmd.FormatInt(n, ","), cmd.NumberEffect)
+}
@@ -0,0 +1,358 @@
+package query
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func planTreeString(node *PlanNode) string {
+	buf := new(strings.Builder)
+	writePlanTree(buf, node, 0)
+	return buf.String()
+}
+
+func writePlanTree(buf *strings.Builder, node *PlanNode, depth int) {
+	buf.WriteString(strings.Repeat("  ", depth))
+	buf.WriteString(node.Operator)
+	if 0 < len(node.Detail) {
+		buf.WriteString(" " + node.Detail)
+	}
+	buf.WriteString(fmt.Sprintf(" est=%d", node.Estimated))
+	if node.Analyzed {
+		buf.WriteString(fmt.Sprintf(" act=%d", node.Actual))
+		if 1 < node.Loops {
+			buf.WriteString(fmt.Sprintf(" loops=%d", node.Loops))
+		}
+	}
+	buf.WriteString("\n")
+
+	for _, child := range node.Children {
+		writePlanTree(buf, child, depth+1)
+	}
+}
+
+var queryPlannerPlanTests = []struct {
+	Name   string
+	Query  string
+	Result string
+	Error  string
+}{
+	{
+		Name:  "Sort and Limit",
+		Query: "select column1 from table1 where column1 = 2 order by column1 limit 1",
+		Result: "Limit 1 est=1\n" +
+			"  Sort column1 est=1\n" +
+			"    Select column1 est=1\n" +
+			"      Filter column1 = 2 est=1\n" +
+			"        Load file: " + GetTestFilePath("table1.csv") + " est=3\n",
+	},
+	{
+		Name:  "Hash Join",
+		Query: "select * from table1 t1 left join table2 t2 on t1.column1 = t2.column3",
+		Result: "Select * est=3\n" +
+			"  Hash Join LEFT OUTER on t1.column1 = t2.column3 est=3\n" +
+			"    Load file: " + GetTestFilePath("table1.csv") + " est=3\n" +
+			"    Load file: " + GetTestFilePath("table2.csv") + " est=3\n",
+	},
+	{
+		Name:  "Nested Loop Join",
+		Query: "select * from table1 t1 join table2 t2 on t1.column1 < t2.column3",
+		Result: "Select * est=3\n" +
+			"  Nested Loop Join INNER on t1.column1 < t2.column3 est=3\n" +
+			"    Load file: " + GetTestFilePath("table1.csv") + " est=3\n" +
+			"    Load file: " + GetTestFilePath("table2.csv") + " est=3\n",
+	},
+	{
+		Name:  "Multiple Tables",
+		Query: "select * from table1, table2 where column1 < column3 or column2 = 'str1'",
+		Result: "Select * est=4\n" +
+			"  Filter column1 < column3 or column2 = 'str1' est=4\n" +
+			"    Cross Join est=9\n" +
+			"      Load file: " + GetTestFilePath("table1.csv") + " est=3\n" +
+			"      Load file: " + GetTestFilePath("table2.csv") + " est=3\n",
+	},
+	{
+		Name:  "Grouping",
+		Query: "select column1, count(*) from group_table group by column1 having count(*) > 1",
+		Result: "Select column1, count(*) est=1\n" +
+			"  Having count(*) > 1 est=1\n" +
+			"    Group column1 est=1\n" +
+			"      Load file: " + GetTestFilePath("group_table.csv") + " est=5\n",
+	},
+	{
+		Name:  "Aggregation Without Grouping",
+		Query: "select count(*) from table1",
+		Result: "Select count(*) est=1\n" +
+			"  Load file: " + GetTestFilePath("table1.csv") + " est=3\n",
+	},
+	{
+		Name:  "Analytic Function",
+		Query: "select column1, rank() over (order by column2) from table1",
+		Result: "Select column1, rank() over (order by column2) est=3\n" +
+			"  Analytic rank() over (order by column2) est=3\n" +
+			"    Load file: " + GetTestFilePath("table1.csv") + " est=3\n",
+	},
+	{
+		Name:  "Pivot and Unpivot",
+		Query: "select * from table1 unpivot (val for col in (column1, column2)) u pivot (count(*) for col in ('column1'))",
+		Result: "Select * est=6\n" +
+			"  Pivot count(*) FOR col est=6\n" +
+			"    Unpivot val FOR col est=6\n" +
+			"      Load file: " + GetTestFilePath("table1.csv") + " est=3\n",
+	},
+	{
+		Name:  "Inline Table, Set Operation and Subquery",
+		Query: "with it as (select 1 as n) select n from it union all select column1 from (select column1 from table1) s offset 1",
+		Result: "With est=3\n" +
+			"  Inline Table it est=1\n" +
+			"    Select 1 as n est=1\n" +
+			"      Load dual est=1\n" +
+			"  Offset 1 est=3\n" +
+			"    Union ALL est=4\n" +
+			"      Select n est=1\n" +
+			"        Load inline table: it est=1\n" +
+			"      Select column1 est=3\n" +
+			"        Subquery s est=3\n" +
+			"          Select column1 est=3\n" +
+			"            Load file: " + GetTestFilePath("table1.csv") + " est=3\n",
+	},
+	{
+		Name:  "Recursive Inline Table",
+		Query: "with recursive it (n) as (select 1 union select n + 1 from it where n < 3) select n from it",
+		Result: "With est=-1\n" +
+			"  Inline Table RECURSIVE it est=-1\n" +
+			"    Union RECURSIVE est=-1\n" +
+			"      Select 1 est=1\n" +
+			"        Load dual est=1\n" +
+			"      Select n + 1 est=-1\n" +
+			"        Filter n < 3 est=-1\n" +
+			"          Load recursive table: it est=-1\n" +
+			"  Select n est=-1\n" +
+			"    Load inline table: it est=-1\n",
+	},
+	{
+		Name:  "File Does Not Exist Error",
+		Query: "select * from notexist",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+}
+
+func TestPredictJoinStrategy(t *testing.T) {
+	tf := cmd.GetFlags()
+	defer func() {
+		tf.MemoryLimit = 0
+	}()
+
+	statements, _ := parser.Parse("select * from table1 t1 join table2 t2 on t1.column1 = t2.column3", "", nil, GetTestLocation())
+	join := statements[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).FromClause.(parser.FromClause).Tables[0].(parser.Table).Object.(parser.Join)
+
+	for limit, expect := range map[int]JoinStrategy{0: HashJoin, 1: SortMergeJoin} {
+		tf.MemoryLimit = limit
+		if strategy := predictJoinStrategy(join, cmd.GetFlags()); strategy != expect {
+			t.Errorf("strategy = %s, want %s for memory limit %d", strategy, expect, limit)
+		}
+	}
+}
+
+func TestQueryPlanner_Plan(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range queryPlannerPlanTests {
+		ViewCache.Clean()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		node, err := NewQueryPlanner(filter).Plan(statements[0].(parser.SelectQuery))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result := planTreeString(node); result != v.Result {
+			t.Errorf("%s: plan = \n%s\nwant\n%s", v.Name, result, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
+
+var explainAnalyzeTests = []struct {
+	Name   string
+	Query  string
+	Result string
+}{
+	{
+		Name:  "Load From File",
+		Query: "select column1, row_number() over (order by column1) from table1 where column1 > 1",
+		Result: "Select column1, row_number() over (order by column1) est=1 act=2\n" +
+			"  Analytic row_number() over (order by column1) est=1 act=2\n" +
+			"    Filter column1 > 1 est=1 act=2\n" +
+			"      Load file: " + GetTestFilePath("table1.csv") + " est=3 act=3\n",
+	},
+	{
+		Name:  "Load From Cache",
+		Query: "select * from table1 t1 join table2 t2 on t1.column1 = t2.column3 order by column1 limit 1",
+		Result: "Limit 1 est=1 act=1\n" +
+			"  Sort column1 est=3 act=2\n" +
+			"    Select * est=3 act=2\n" +
+			"      Hash Join INNER on t1.column1 = t2.column3 est=3 act=2\n" +
+			"        Load cache: " + GetTestFilePath("table1.csv") + " est=3 act=3\n" +
+			"        Load file: " + GetTestFilePath("table2.csv") + " est=3 act=3\n",
+	},
+	{
+		Name:  "Recursion",
+		Query: "with recursive it (n) as (select 1 union all select n + 1 from it where n < 3) select n from it",
+		Result: "With est=-1 act=3\n" +
+			"  Inline Table RECURSIVE it est=-1 act=3\n" +
+			"    Union ALL RECURSIVE est=-1 act=3\n" +
+			"      Select 1 est=1 act=1\n" +
+			"        Load dual est=1 act=1\n" +
+			"      Select n + 1 est=1 act=2 loops=3\n" +
+			"        Filter n < 3 est=1 act=2 loops=3\n" +
+			"          Load recursive table: it est=1 act=3 loops=3\n" +
+			"  Select n est=3 act=3\n" +
+			"    Load inline table: it est=3 act=3\n",
+	},
+}
+
+func TestExplain_Analyze(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+	ViewCache.Clean()
+
+	for _, v := range explainAnalyzeTests {
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		f := filter.CreateNode()
+		f.plan = newPlanTracer(cmd.GetFlags())
+		if _, err := Select(statements[0].(parser.SelectQuery), f); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result := planTreeString(f.plan.Root()); result != v.Result {
+			t.Errorf("%s: plan = \n%s\nwant\n%s", v.Name, result, v.Result)
+		}
+	}
+	ViewCache.Clean()
+
+	statements, _ := parser.Parse("explain analyze select 1", "", nil, GetTestLocation())
+	s, err := Explain(statements[0].(parser.Explain), filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	for _, expect := range []string{"Query Plan (Analyzed)", "Select 1", "Load dual", "Estimated: 1", "Actual: 1"} {
+		if !strings.Contains(s, expect) {
+			t.Errorf("output %q does not contain %q", s, expect)
+		}
+	}
+}
+
+func TestExplain_Stream(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.MemoryLimit = 1
+	defer func() {
+		tf.MemoryLimit = 0
+	}()
+
+	filter := NewEmptyFilter()
+	ViewCache.Clean()
+
+	statements, _ := parser.Parse("select column2 from group_table where column1 > 1 order by column2 limit 2", "", nil, GetTestLocation())
+	query := statements[0].(parser.SelectQuery)
+
+	node, err := NewQueryPlanner(filter).PlanStatement(query)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := "Limit 2 est=2\n" +
+		"  Sort column2 est=2\n" +
+		"    Select column2 est=2\n" +
+		"      Filter column1 > 1 est=2\n" +
+		"        Stream file: " + GetTestFilePath("group_table.csv") + ", spill over 1 MB est=5\n"
+	if result := planTreeString(node); result != expect {
+		t.Errorf("plan = \n%s\nwant\n%s", result, expect)
+	}
+
+	node, err = analyzeStream(newSelectStream(query, filter), filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect = "Limit 2 est=2 act=2\n" +
+		"  Sort column2 est=2\n" +
+		"    Select column2 est=2\n" +
+		"      Filter column1 > 1 est=2\n" +
+		"        Stream file: " + GetTestFilePath("group_table.csv") + ", spill over 1 MB est=5 act=5\n"
+	if result := planTreeString(node); result != expect {
+		t.Errorf("analyzed plan = \n%s\nwant\n%s", result, expect)
+	}
+	if 0 < len(ViewCache) {
+		t.Errorf("streamed table is loaded into the view cache")
+	}
+
+	tf.MemoryLimit = 0
+	node, err = NewQueryPlanner(filter).PlanStatement(query)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if leaf := node.Children[0].Children[0].Children[0].Children[0]; leaf.Operator != "Load" {
+		t.Errorf("operator = %q, want %q without memory limit", leaf.Operator, "Load")
+	}
+	ViewCache.Clean()
+}
+
+func TestEstimateRecordLen(t *testing.T) {
+	fpath := filepath.Join(TestDir, "estimate.csv")
+	ioutil.WriteFile(fpath, []byte("c1\n"+strings.Repeat("1234567\n", 20000)), 0644)
+
+	n := estimateRecordLen(&FileInfo{Path: fpath, Format: cmd.CSV}, false)
+	if n < 19000 || 21000 < n {
+		t.Errorf("estimated records = %d, want approximately %d", n, 20000)
+	}
+
+	n = estimateRecordLen(&FileInfo{Path: fpath, Format: cmd.JSON}, false)
+	if n != UnknownRecordLen {
+		t.Errorf("estimated records = %d, want %d", n, UnknownRecordLen)
+	}
+}
+
+func TestPlannedRoutines(t *testing.T) {
+	cpu := 4
+
+	for _, v := range []struct {
+		RecordLen int
+		Minimum   int
+		Result    int
+	}{
+		{RecordLen: 10, Minimum: -1, Result: 1},
+		{RecordLen: 200, Minimum: -1, Result: 2},
+		{RecordLen: 10000, Minimum: -1, Result: 4},
+		{RecordLen: 100, Minimum: CalcMinimumRequired(100, 100, MinimumRequiredPerCPUCore), Result: 4},
+	} {
+		if n := plannedRoutines(v.RecordLen, v.Minimum, cpu); n != v.Result {
+			t.Errorf("routines = %d, want %d for %d records and minimum %d", n, v.Result, v.RecordLen, v.Minimum)
+		}
+	}
+}
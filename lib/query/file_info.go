@@ -26,6 +26,7 @@ const (
 	TableEncloseAll  = "ENCLOSE_ALL"
 	TableJsonEscape  = "JSON_ESCAPE"
 	TablePrettyPrint = "PRETTY_PRINT"
+	TableCompression = "COMPRESSION"
 )
 
 var FileAttributeList = []string{
@@ -37,6 +38,7 @@ var FileAttributeList = []string{
 	TableEncloseAll,
 	TableJsonEscape,
 	TablePrettyPrint,
+	TableCompression,
 }
 
 type TableAttributeUnchangedError struct {
@@ -68,12 +70,19 @@ type FileInfo struct {
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        cmd.Compression
+	Sheet              string
+	CellRange          string
+	Schema             *TableSchema
 
 	Handler *file.Handler
 
 	IsTemporary      bool
 	InitialHeader    Header
 	InitialRecordSet RecordSet
+
+	// RenamedFrom is the path of the file that has been renamed to this file in the current transaction.
+	RenamedFrom string
 }
 
 func NewFileInfo(
@@ -82,8 +91,9 @@ func NewFileInfo(
 	format cmd.Format,
 	delimiter rune,
 	encoding text.Encoding,
+	flags *cmd.Flags,
 ) (*FileInfo, error) {
-	fpath, format, err := SearchFilePath(filename, repository, format)
+	fpath, format, err := SearchFilePath(filename, repository, format, flags)
 	if err != nil {
 		return nil, err
 	}
@@ -91,15 +101,18 @@ func NewFileInfo(
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
+	_, compression := cmd.SplitCompressionExt(fpath)
+
 	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
+		Path:        fpath,
+		Format:      format,
+		Delimiter:   delimiter,
+		Encoding:    encoding,
+		Compression: compression,
 	}, nil
 }
 
@@ -149,7 +162,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
@@ -168,7 +181,7 @@ func (f *FileInfo) SetFormat(s string) error {
 }
 
 func (f *FileInfo) SetEncoding(s string) error {
-	encoding, err := cmd.ParseEncoding(s)
+	encoding, err := cmd.ParseWriteEncoding(s)
 	if err != nil {
 		return err
 	}
@@ -178,6 +191,10 @@ func (f *FileInfo) SetEncoding(s string) error {
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
+	case cmd.XLSX:
+		if encoding != text.UTF8 {
+			return errors.New("xlsx format is supported only UTF8")
+		}
 	}
 
 	if f.Encoding == encoding {
@@ -240,6 +257,19 @@ func (f *FileInfo) SetPrettyPrint(b bool) error {
 	return nil
 }
 
+func (f *FileInfo) SetCompression(s string) error {
+	compression, err := cmd.ParseCompression(s)
+	if err != nil {
+		return err
+	}
+
+	if compression == f.Compression {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+	f.Compression = compression
+	return nil
+}
+
 func (f *FileInfo) Close() error {
 	if f.Handler == nil {
 		return nil
@@ -261,7 +291,14 @@ func (f *FileInfo) Commit() error {
 	return f.Handler.Commit()
 }
 
-func SearchFilePath(filename parser.Identifier, repository string, format cmd.Format) (string, cmd.Format, error) {
+func (f *FileInfo) Remove() error {
+	if f.Handler == nil {
+		return nil
+	}
+	return f.Handler.Remove()
+}
+
+func SearchFilePath(filename parser.Identifier, repository string, format cmd.Format, flags *cmd.Flags) (string, cmd.Format, error) {
 	var fpath string
 	var err error
 
@@ -274,9 +311,12 @@ func SearchFilePath(filename parser.Identifier, repository string, format cmd.Fo
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.XLSX:
+		fpath, err = SearchXlsxFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
+			fname, _ := cmd.SplitCompressionExt(fpath)
+			switch strings.ToLower(filepath.Ext(fname)) {
 			case cmd.CsvExt:
 				format = cmd.CSV
 			case cmd.TsvExt:
@@ -287,8 +327,10 @@ func SearchFilePath(filename parser.Identifier, repository string, format cmd.Fo
 				format = cmd.JSON
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.XlsxExt:
+				format = cmd.XLSX
 			default:
-				format = cmd.GetFlags().SelectImportFormat()
+				format = flags.SelectImportFormat()
 			}
 		}
 	}
@@ -312,8 +354,12 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt})
 }
 
+func SearchXlsxFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XlsxExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.FixedExt, cmd.LtsvExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.FixedExt, cmd.LtsvExt, cmd.XlsxExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -336,6 +382,12 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 				pathes = append(pathes, fpath+ext)
 				infoList = append(infoList, i)
 			}
+			for _, cext := range cmd.CompressionExtList {
+				if i, err := os.Stat(fpath + ext + cext); err == nil {
+					pathes = append(pathes, fpath+ext+cext)
+					infoList = append(infoList, i)
+				}
+			}
 		}
 		switch {
 		case len(pathes) < 1:
@@ -365,8 +417,10 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		return nil, NewWriteFileError(filename, err.Error())
 	}
 
+	fname, compression := cmd.SplitCompressionExt(fpath)
+
 	var format cmd.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(fname)) {
 	case cmd.TsvExt:
 		delimiter = '\t'
 		format = cmd.TSV
@@ -381,18 +435,37 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		format = cmd.GFM
 	case cmd.OrgExt:
 		format = cmd.ORG
+	case cmd.XlsxExt:
+		encoding = text.UTF8
+		format = cmd.XLSX
 	default:
 		format = cmd.CSV
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: compression,
 	}, nil
 }
 
+func NewFileInfoForOutput(flags *cmd.Flags) *FileInfo {
+	return &FileInfo{
+		Format:             flags.Format,
+		Delimiter:          flags.WriteDelimiter,
+		DelimiterPositions: flags.WriteDelimiterPositions,
+		Encoding:           flags.WriteEncoding,
+		LineBreak:          flags.LineBreak,
+		NoHeader:           flags.WithoutHeader,
+		EncloseAll:         flags.EncloseAll,
+		JsonEscape:         flags.JsonEscape,
+		PrettyPrint:        flags.PrettyPrint,
+		Compression:        flags.Compression,
+	}
+}
+
 func CreateFilePath(filename parser.Identifier, repository string) (string, error) {
 	fpath := filename.Literal
 	if !filepath.IsAbs(fpath) {
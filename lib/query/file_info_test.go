@@ -134,6 +134,50 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "XLSX with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table7.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Compressed CSV with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table8.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: cmd.GZIP,
+		},
+	},
+	{
+		Name:       "Compressed CSV",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.CSV,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table8.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: cmd.GZIP,
+		},
+	},
 	{
 		Name:       "Fixed-Length",
 		FilePath:   parser.Identifier{Literal: "fixed_length.txt"},
@@ -193,7 +237,7 @@ var fileInfoTests = []struct {
 
 func TestNewFileInfo(t *testing.T) {
 	for _, v := range fileInfoTests {
-		fileInfo, err := NewFileInfo(v.FilePath, v.Repository, v.Format, v.Delimiter, v.Encoding)
+		fileInfo, err := NewFileInfo(v.FilePath, v.Repository, v.Format, v.Delimiter, v.Encoding, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -217,6 +261,9 @@ func TestNewFileInfo(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
@@ -313,6 +360,19 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "Compressed TSV",
+		FilePath:  parser.Identifier{Literal: "table1.tsv.gz"},
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1.tsv.gz",
+			Delimiter:   '\t',
+			Format:      cmd.TSV,
+			Encoding:    text.UTF8,
+			Compression: cmd.GZIP,
+		},
+	},
 }
 
 func TestNewFileInfoForCreate(t *testing.T) {
@@ -347,6 +407,9 @@ func TestNewFileInfoForCreate(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
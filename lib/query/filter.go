@@ -2,11 +2,11 @@ package query
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/excmd"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -39,7 +39,13 @@ type Filter struct {
 
 	checkAvailableParallelRoutine bool
 
-	Now time.Time
+	Now     time.Time
+	RegExps *RegExpCache
+
+	plan        *planTracer
+	ctx         context.Context
+	session     *sessionState
+	caughtError error
 }
 
 type ContainsSubstitusion struct{}
@@ -103,15 +109,23 @@ func (f *Filter) Merge(filter *Filter) {
 	f.InlineTables = filter.InlineTables
 	f.Aliases = filter.Aliases
 	f.Now = filter.Now
+	f.RegExps = filter.RegExps
+	f.ctx = filter.ctx
+	f.session = filter.session
+	f.caughtError = filter.caughtError
 }
 
 func (f *Filter) CreateChildScope() *Filter {
-	return NewFilter(
+	child := NewFilter(
 		append(VariableScopes{NewVariableMap()}, f.Variables...),
 		append(TemporaryViewScopes{{}}, f.TempViews...),
 		append(CursorScopes{{}}, f.Cursors...),
 		append(UserDefinedFunctionScopes{{}}, f.Functions...),
 	)
+	child.ctx = f.ctx
+	child.session = f.session
+	child.caughtError = f.caughtError
+	return child
 }
 
 func (f *Filter) ResetCurrentScope() {
@@ -142,15 +156,31 @@ func (f *Filter) CreateNode() *Filter {
 		RecursiveTable:   f.RecursiveTable,
 		RecursiveTmpView: f.RecursiveTmpView,
 		Now:              f.Now,
+		RegExps:          f.RegExps,
+		plan:             f.plan,
+		ctx:              f.ctx,
+		session:          f.session,
+		caughtError:      f.caughtError,
 	}
 
 	if filter.Now.IsZero() {
-		filter.Now = cmd.Now()
+		filter.Now = filter.flags().CurrentTime()
+	}
+	if filter.RegExps == nil {
+		filter.RegExps = NewRegExpCache()
 	}
 
 	return filter
 }
 
+// Err returns the error of the context that the filter is evaluated in, if the context is done.
+func (f *Filter) Err() error {
+	if f.ctx == nil {
+		return nil
+	}
+	return f.ctx.Err()
+}
+
 func (f *Filter) LoadInlineTable(clause parser.WithClause) error {
 	return f.InlineTables.Load(clause, f)
 }
@@ -184,6 +214,8 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val, err = f.evalBetween(expr.(parser.Between))
 	case parser.Like:
 		val, err = f.evalLike(expr.(parser.Like))
+	case parser.Regexp:
+		val, err = f.evalRegexp(expr.(parser.Regexp))
 	case parser.In:
 		val, err = f.evalIn(expr.(parser.In))
 	case parser.Any:
@@ -211,7 +243,7 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 	case parser.EnvironmentVariable:
 		val = value.NewString(os.Getenv(expr.(parser.EnvironmentVariable).Name))
 	case parser.RuntimeInformation:
-		val, err = GetRuntimeInformation(expr.(parser.RuntimeInformation))
+		val, err = GetRuntimeInformation(expr.(parser.RuntimeInformation), f)
 	case parser.VariableSubstitution:
 		if f.checkAvailableParallelRoutine {
 			err = &ContainsSubstitusion{}
@@ -231,21 +263,24 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 
 func (f *Filter) EvaluateSequentially(fn func(*Filter, int) error, expr interface{}) error {
 	if expr == nil || f.CanUseMultithreading(expr) {
-		header := f.Records[0].View.Header
-		recordSet := f.Records[0].View.RecordSet
-		isGrouped := f.Records[0].View.isGrouped
+		view := f.Records[0].View
+		header := view.Header
+		recordSet := view.RecordSet
 		f.Records = f.Records[1:]
 
-		gm := NewGoroutineTaskManager(len(recordSet), -1)
+		gm := NewGoroutineTaskManager(len(recordSet), -1, f.flags().CPU)
 		for i := 0; i < gm.Number; i++ {
 			gm.Add()
 			go func(thIdx int) {
 				start, end := gm.RecordRange(thIdx)
 				filter := NewFilterForSequentialEvaluation(
 					&View{
-						Header:    header,
-						RecordSet: recordSet[start:end],
-						isGrouped: isGrouped,
+						Header:            header,
+						RecordSet:         recordSet[start:end],
+						isGrouped:         view.isGrouped,
+						groupingKeys:      view.groupingKeys,
+						groupingFieldBits: view.groupingFieldBits,
+						groupingIdIndex:   view.groupingIdIndex,
 					},
 					f,
 				)
@@ -336,7 +371,7 @@ func (f *Filter) evalFieldReference(expr parser.QueryExpression) (value.Primary,
 	for _, v := range f.Records {
 		if v.fieldReferenceIndices != nil {
 			if idx, ok := v.fieldReferenceIndices[exprStr]; ok {
-				p = v.View.RecordSet[v.RecordIndex][idx].Value()
+				p = v.View.fieldValue(v.RecordIndex, idx)
 				break
 			}
 		}
@@ -346,7 +381,7 @@ func (f *Filter) evalFieldReference(expr parser.QueryExpression) (value.Primary,
 			if v.View.isGrouped && v.View.Header[idx].IsFromTable && !v.View.Header[idx].IsGroupKey {
 				return nil, NewFieldNotGroupKeyError(expr)
 			}
-			p = v.View.RecordSet[v.RecordIndex][idx].Value()
+			p = v.View.fieldValue(v.RecordIndex, idx)
 			if v.fieldReferenceIndices != nil {
 				v.fieldReferenceIndices[exprStr] = idx
 			}
@@ -377,7 +412,7 @@ func (f *Filter) evalArithmetic(expr parser.Arithmetic) (value.Primary, error) {
 		return nil, err
 	}
 
-	return Calculate(lhs, rhs, expr.Operator), nil
+	return Calculate(lhs, rhs, expr.Operator, f.flags()), nil
 }
 
 func (f *Filter) evalUnaryArithmetic(expr parser.UnaryArithmetic) (value.Primary, error) {
@@ -386,6 +421,14 @@ func (f *Filter) evalUnaryArithmetic(expr parser.UnaryArithmetic) (value.Primary
 		return nil, err
 	}
 
+	if isDecimalValue(ope) {
+		return negateDecimal(ope, expr.Operator.Token), nil
+	}
+
+	if isIntervalValue(ope) {
+		return negateInterval(ope, expr.Operator.Token), nil
+	}
+
 	if pi := value.ToInteger(ope); !value.IsNull(pi) {
 		val := pi.(value.Integer).Raw()
 		switch expr.Operator.Token {
@@ -395,6 +438,10 @@ func (f *Filter) evalUnaryArithmetic(expr parser.UnaryArithmetic) (value.Primary
 		return value.NewInteger(val), nil
 	}
 
+	if isDecimalOperand(ope, f.flags()) {
+		return negateDecimal(ope, expr.Operator.Token), nil
+	}
+
 	pf := value.ToFloat(ope)
 	if value.IsNull(pf) {
 		return value.NewNull(), nil
@@ -449,14 +496,14 @@ func (f *Filter) evalComparison(expr parser.Comparison) (value.Primary, error) {
 			return nil, err
 		}
 
-		t = value.Compare(lhsVal, rhs, expr.Operator)
+		t = value.Compare(lhsVal, rhs, expr.Operator, f.flags().DatetimeFormat, f.flags().GetLocation())
 	} else {
 		rhs, err := f.evalRowValue(expr.RHS.(parser.RowValue))
 		if err != nil {
 			return nil, err
 		}
 
-		t, err = value.CompareRowValues(lhs, rhs, expr.Operator)
+		t, err = value.CompareRowValues(lhs, rhs, expr.Operator, f.flags().DatetimeFormat, f.flags().GetLocation())
 		if err != nil {
 			return nil, NewRowValueLengthInComparisonError(expr.RHS.(parser.RowValue), len(lhs))
 		}
@@ -505,7 +552,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 			return nil, err
 		}
 
-		lowResult := value.GreaterOrEqual(lhsVal, low)
+		lowResult := value.GreaterOrEqual(lhsVal, low, f.flags().DatetimeFormat, f.flags().GetLocation())
 		if lowResult == ternary.FALSE {
 			t = ternary.FALSE
 		} else {
@@ -514,7 +561,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 				return nil, err
 			}
 
-			highResult := value.LessOrEqual(lhsVal, high)
+			highResult := value.LessOrEqual(lhsVal, high, f.flags().DatetimeFormat, f.flags().GetLocation())
 			t = ternary.And(lowResult, highResult)
 		}
 	} else {
@@ -522,7 +569,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 		if err != nil {
 			return nil, err
 		}
-		lowResult, err := value.CompareRowValues(lhs, low, ">=")
+		lowResult, err := value.CompareRowValues(lhs, low, ">=", f.flags().DatetimeFormat, f.flags().GetLocation())
 		if err != nil {
 			return nil, NewRowValueLengthInComparisonError(expr.Low.(parser.RowValue), len(lhs))
 		}
@@ -535,7 +582,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 				return nil, err
 			}
 
-			highResult, err := value.CompareRowValues(lhs, high, "<=")
+			highResult, err := value.CompareRowValues(lhs, high, "<=", f.flags().DatetimeFormat, f.flags().GetLocation())
 			if err != nil {
 				return nil, NewRowValueLengthInComparisonError(expr.High.(parser.RowValue), len(lhs))
 			}
@@ -575,7 +622,7 @@ func (f *Filter) evalIn(expr parser.In) (value.Primary, error) {
 		return nil, err
 	}
 
-	t, err := Any(val, list, "=")
+	t, err := Any(val, list, "=", f.flags().DatetimeFormat, f.flags().GetLocation())
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -600,7 +647,7 @@ func (f *Filter) evalAny(expr parser.Any) (value.Primary, error) {
 		return nil, err
 	}
 
-	t, err := Any(val, list, expr.Operator)
+	t, err := Any(val, list, expr.Operator, f.flags().DatetimeFormat, f.flags().GetLocation())
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -621,7 +668,7 @@ func (f *Filter) evalAll(expr parser.All) (value.Primary, error) {
 		return nil, err
 	}
 
-	t, err := All(val, list, expr.Operator)
+	t, err := All(val, list, expr.Operator, f.flags().DatetimeFormat, f.flags().GetLocation())
 	if err != nil {
 		if subquery, ok := expr.Values.(parser.Subquery); ok {
 			return nil, NewSelectFieldLengthInComparisonError(subquery, len(val))
@@ -653,6 +700,26 @@ func (f *Filter) evalLike(expr parser.Like) (value.Primary, error) {
 	return value.NewTernary(t), nil
 }
 
+func (f *Filter) evalRegexp(expr parser.Regexp) (value.Primary, error) {
+	lhs, err := f.Evaluate(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := f.Evaluate(expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := Regexp(lhs, pattern, f.RegExps)
+	if err != nil {
+		return nil, NewInvalidRegExpError(expr, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
 	view, err := Select(expr.Query.Query, f)
 	if err != nil {
@@ -688,9 +755,9 @@ func (f *Filter) evalSubqueryForValue(expr parser.Subquery) (value.Primary, erro
 func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	name := strings.ToUpper(expr.Name)
 
-	if _, ok := Functions[name]; !ok && name != "NOW" && name != "JSON_OBJECT" {
+	if !isBuiltInFunction(name) {
 		udfn, err := f.Functions.Get(expr, name)
-		if err != nil {
+		if err != nil || udfn.IsProcedure {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
 		}
 		if udfn.IsAggregate {
@@ -710,6 +777,9 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	if name == "JSON_OBJECT" {
 		return JsonObject(expr, f)
 	}
+	if name == "GROUPING" {
+		return Grouping(expr, f)
+	}
 
 	args := make([]value.Primary, len(expr.Args))
 	for i, v := range expr.Args {
@@ -725,7 +795,10 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	}
 
 	if fn, ok := Functions[name]; ok {
-		return fn(expr, args)
+		return fn(expr, args, f.flags())
+	}
+	if fn, ok := RegExpFunctions[name]; ok {
+		return fn(expr, args, f.RegExps)
 	}
 
 	udfn, _ := f.Functions.Get(expr, name)
@@ -733,7 +806,7 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 }
 
 func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Primary, error) {
-	var aggfn func([]value.Primary) value.Primary
+	var aggfn AggregateFunction
 	var udfn *UserDefinedFunction
 	var useUserDefined bool
 	var err error
@@ -771,7 +844,7 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		listExpr = parser.NewIntegerValue(1)
 	}
 
-	if uname == "COUNT" {
+	if uname == "COUNT" && expr.Filter == nil {
 		if _, ok := listExpr.(parser.PrimitiveType); ok {
 			return value.NewInteger(int64(f.Records[0].View.RecordSet[f.Records[0].RecordIndex].GroupLen())), nil
 		}
@@ -796,16 +869,20 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		return udfn.ExecuteAggregate(list, args, f)
 	}
 
-	return aggfn(list), nil
+	return aggfn(list, f.flags()), nil
 }
 
 func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var fraction float64
 	var err error
 
-	switch strings.ToUpper(expr.Name) {
+	uname := strings.ToUpper(expr.Name)
+	switch uname {
 	case "JSON_AGG":
 		err = f.checkArgsForJsonAgg(expr)
+	case "PERCENTILE_CONT", "PERCENTILE_DISC":
+		fraction, err = f.checkArgsForPercentile(expr, expr.Name, expr.Args, expr.OrderBy)
 	default: // LISTAGG
 		separator, err = f.checkArgsForListFunction(expr)
 	}
@@ -830,14 +907,24 @@ func (f *Filter) evalListFunction(expr parser.ListFunction) (value.Primary, erro
 		}
 	}
 
-	list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[0], expr.IsDistinct(), f)
+	listExpr := expr.Args[0]
+	switch uname {
+	case "PERCENTILE_CONT", "PERCENTILE_DISC":
+		listExpr = expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+	}
+
+	list, err := view.ListValuesForAggregateFunctions(expr, listExpr, expr.IsDistinct(), f)
 	if err != nil {
 		return nil, err
 	}
 
-	switch strings.ToUpper(expr.Name) {
+	switch uname {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "PERCENTILE_CONT":
+		return PercentileCont(list, fraction), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -870,6 +957,26 @@ func (f *Filter) checkArgsForJsonAgg(expr parser.ListFunction) error {
 	return nil
 }
 
+func (f *Filter) checkArgsForPercentile(expr parser.QueryExpression, name string, args []parser.QueryExpression, orderBy parser.QueryExpression) (float64, error) {
+	if 1 != len(args) {
+		return 0, NewFunctionArgumentLengthError(expr, name, []int{1})
+	}
+
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "exactly one order item is required in the within group clause")
+	}
+
+	p, err := f.Evaluate(args[0])
+	if err != nil {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	fraction := value.ToFloat(p)
+	if value.IsNull(fraction) || fraction.(value.Float).Raw() < 0 || 1 < fraction.(value.Float).Raw() {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	return fraction.(value.Float).Raw(), nil
+}
+
 func (f *Filter) evalCaseExpr(expr parser.CaseExpr) (value.Primary, error) {
 	var val value.Primary
 	var err error
@@ -892,7 +999,7 @@ func (f *Filter) evalCaseExpr(expr parser.CaseExpr) (value.Primary, error) {
 		if val == nil {
 			t = cond.Ternary()
 		} else {
-			t = value.Equal(val, cond)
+			t = value.Equal(val, cond, f.flags().DatetimeFormat, f.flags().GetLocation())
 		}
 
 		if t == ternary.TRUE {
@@ -1278,7 +1385,7 @@ func (f *Filter) EvaluateEmbeddedString(embedded string) (string, error) {
 		case excmd.CsvqExpression:
 			expr := scanner.Text()
 			if 0 < len(expr) {
-				statements, err := parser.Parse(expr, "")
+				statements, err := parser.Parse(expr, "", f.flags().DatetimeFormat, f.flags().GetLocation())
 				if err != nil {
 					if syntaxErr, ok := err.(*parser.SyntaxError); ok {
 						err = NewSyntaxError(syntaxErr)
@@ -1313,7 +1420,7 @@ func (f *Filter) writeEmbeddedExpression(buf *bytes.Buffer, expr parser.QueryExp
 	if err != nil {
 		return err
 	}
-	s, _ := Formatter.Format("%s", []value.Primary{p})
+	s, _ := NewStringFormatter().Format("%s", []value.Primary{p})
 	buf.WriteString(s)
 	return nil
 }
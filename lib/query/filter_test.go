@@ -303,6 +303,14 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewFloat(-1.234),
 	},
+	{
+		Name: "UnaryArithmetic Interval",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewIntervalValueFromString("1 day"),
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		Result: value.NewInterval(0, -1, 0),
+	},
 	{
 		Name: "UnaryArithmetic Operand Error",
 		Expr: parser.UnaryArithmetic{
@@ -2268,6 +2276,47 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "Regexp",
+		Expr: parser.Regexp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("^a.c"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Not Regexp",
+		Expr: parser.Regexp{
+			LHS:      parser.NewStringValue("abcdefg"),
+			Pattern:  parser.NewStringValue("^a.c"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Regexp LHS Error",
+		Expr: parser.Regexp{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("^a.c"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Regexp Pattern Error",
+		Expr: parser.Regexp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Regexp Invalid Pattern Error",
+		Expr: parser.Regexp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("a(bc"),
+		},
+		Error: "[L:- C:-] invalid regular expression: error parsing regexp: missing closing ): `a(bc`",
+	},
 	{
 		Name: "Exists",
 		Filter: &Filter{
@@ -2529,6 +2578,18 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewDatetime(NowForTest),
 	},
+	{
+		Name: "Function RegExp",
+		Expr: parser.Function{
+			Name: "regexp_replace",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("abc-123"),
+				parser.NewStringValue("[0-9]+"),
+				parser.NewStringValue("N"),
+			},
+		},
+		Result: value.NewString("abc-N"),
+	},
 	{
 		Name: "User Defined Function",
 		Filter: &Filter{
@@ -2779,6 +2840,94 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewInteger(3),
 	},
+	{
+		Name: "Aggregate Function Count With Filter Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str1"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Where: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewStringValue("str1"),
+						Operator: "=",
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function Sum With Filter Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str1"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Filter: parser.FilterClause{
+				Where: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewStringValue("str1"),
+						Operator: "=",
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(4),
+	},
 	{
 		Name:   "Aggregate Function As a Statement Error",
 		Filter: &Filter{},
@@ -2910,6 +3059,133 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewInteger(3),
 	},
+	{
+		Name: "Aggregate Function User Defined With Filter Clause",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeader("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str3"),
+									value.NewString("str4"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+			Functions: UserDefinedFunctionScopes{
+				{
+					"USERAGGFUNC": &UserDefinedFunction{
+						Name:        parser.Identifier{Literal: "useraggfunc"},
+						IsAggregate: true,
+						Cursor:      parser.Identifier{Literal: "column1"},
+						Parameters: []parser.Variable{
+							{Name: "default"},
+						},
+						RequiredArgs: 1,
+						Statements: []parser.Statement{
+							parser.VariableDeclaration{
+								Assignments: []parser.VariableAssignment{
+									{
+										Variable: parser.Variable{Name: "value"},
+									},
+									{
+										Variable: parser.Variable{Name: "fetch"},
+									},
+								},
+							},
+							parser.WhileInCursor{
+								Variables: []parser.Variable{
+									{Name: "fetch"},
+								},
+								Cursor: parser.Identifier{Literal: "column1"},
+								Statements: []parser.Statement{
+									parser.If{
+										Condition: parser.Is{
+											LHS: parser.Variable{Name: "fetch"},
+											RHS: parser.NewNullValue(),
+										},
+										Statements: []parser.Statement{
+											parser.FlowControl{Token: parser.CONTINUE},
+										},
+									},
+									parser.If{
+										Condition: parser.Is{
+											LHS: parser.Variable{Name: "value"},
+											RHS: parser.NewNullValue(),
+										},
+										Statements: []parser.Statement{
+											parser.VariableSubstitution{
+												Variable: parser.Variable{Name: "value"},
+												Value:    parser.Variable{Name: "fetch"},
+											},
+											parser.FlowControl{Token: parser.CONTINUE},
+										},
+									},
+									parser.VariableSubstitution{
+										Variable: parser.Variable{Name: "value"},
+										Value: parser.Arithmetic{
+											LHS:      parser.Variable{Name: "value"},
+											RHS:      parser.Variable{Name: "fetch"},
+											Operator: '*',
+										},
+									},
+								},
+							},
+
+							parser.If{
+								Condition: parser.Is{
+									LHS: parser.Variable{Name: "value"},
+									RHS: parser.NewNullValue(),
+								},
+								Statements: []parser.Statement{
+									parser.VariableSubstitution{
+										Variable: parser.Variable{Name: "value"},
+										Value:    parser.Variable{Name: "default"},
+									},
+								},
+							},
+
+							parser.Return{
+								Value: parser.Variable{Name: "value"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "useraggfunc",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewIntegerValue(0),
+			},
+			Filter: parser.FilterClause{
+				Where: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewStringValue("str4"),
+						Operator: "<>",
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
 	{
 		Name: "Aggregate Function User Defined Argument Length Error",
 		Filter: &Filter{
@@ -3266,6 +3542,201 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewString("str1,str2"),
 	},
+	{
+		Name: "PercentileCont Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(4),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "PercentileDisc Function",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(4),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "PercentileCont Function Arguments Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(4),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "[L:- C:-] function percentile_cont takes exactly 1 argument",
+	},
+	{
+		Name: "PercentileCont Function Within Group Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(2),
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(4),
+								}),
+							},
+						},
+						Filter:    NewEmptyFilter(),
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Error: "[L:- C:-] exactly one order item is required in the within group clause for function percentile_cont",
+	},
 	{
 		Name: "ListAgg Function Null",
 		Filter: &Filter{
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -19,9 +20,9 @@ type functionTest struct {
 	Error    string
 }
 
-func testFunction(t *testing.T, f func(parser.Function, []value.Primary) (value.Primary, error), tests []functionTest) {
+func testFunction(t *testing.T, f func(parser.Function, []value.Primary, *cmd.Flags) (value.Primary, error), tests []functionTest) {
 	for _, v := range tests {
-		result, err := f(v.Function, v.Args)
+		result, err := f(v.Function, v.Args, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -207,6 +208,16 @@ var ceilTests = []functionTest{
 		},
 		Result: value.NewInteger(3),
 	},
+	{
+		Name: "Ceil Decimal",
+		Function: parser.Function{
+			Name: "ceil",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("2.345"),
+		},
+		Result: value.NewDecimalFromString("3"),
+	},
 	{
 		Name: "Ceil Null",
 		Function: parser.Function{
@@ -254,6 +265,17 @@ var floorTests = []functionTest{
 		},
 		Result: value.NewFloat(2.3),
 	},
+	{
+		Name: "Floor Decimal",
+		Function: parser.Function{
+			Name: "floor",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("-2.345"),
+			value.NewInteger(1),
+		},
+		Result: value.NewDecimalFromString("-2.4"),
+	},
 	{
 		Name: "Floor Null",
 		Function: parser.Function{
@@ -301,6 +323,17 @@ var roundTests = []functionTest{
 		},
 		Result: value.NewFloat(-2.46),
 	},
+	{
+		Name: "Round Decimal",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("-2.345"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("-2.35"),
+	},
 	{
 		Name: "Round Null",
 		Function: parser.Function{
@@ -336,6 +369,16 @@ var absTests = []functionTest{
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		Name: "Abs Decimal",
+		Function: parser.Function{
+			Name: "abs",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("-1.50"),
+		},
+		Result: value.NewDecimalFromString("1.50"),
+	},
 	{
 		Name: "Abs Null",
 		Function: parser.Function{
@@ -1047,7 +1090,7 @@ var randTests = []struct {
 
 func TestRand(t *testing.T) {
 	for _, v := range randTests {
-		result, err := Rand(v.Function, v.Args)
+		result, err := Rand(v.Function, v.Args, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1365,6 +1408,17 @@ var byteLenTests = []functionTest{
 		},
 		Result: value.NewInteger(9),
 	},
+	{
+		Name: "ByteLen UTF16LE",
+		Function: parser.Function{
+			Name: "byte_len",
+		},
+		Args: []value.Primary{
+			value.NewString("abc日本語"),
+			value.NewString("utf16le"),
+		},
+		Result: value.NewInteger(12),
+	},
 	{
 		Name: "ByteLen Null",
 		Function: parser.Function{
@@ -1392,7 +1446,18 @@ var byteLenTests = []functionTest{
 			value.NewString("abc日本語"),
 			value.NewString("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS for function byte_len",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5 for function byte_len",
+	},
+	{
+		Name: "ByteLen AUTO Encoding Error",
+		Function: parser.Function{
+			Name: "byte_len",
+		},
+		Args: []value.Primary{
+			value.NewString("abc日本語"),
+			value.NewString("auto"),
+		},
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5 for function byte_len",
 	},
 }
 
@@ -1491,7 +1556,7 @@ var lpadTests = []functionTest{
 			value.NewString("byte"),
 			value.NewString("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS for function lpad",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5 for function lpad",
 	},
 	{
 		Name: "Lpad by Width",
@@ -1895,6 +1960,326 @@ func TestReplace(t *testing.T) {
 	testFunction(t, Replace, replaceTests)
 }
 
+func testRegExpFunction(t *testing.T, f func(parser.Function, []value.Primary, *RegExpCache) (value.Primary, error), tests []functionTest) {
+	regExps := NewRegExpCache()
+	testFunction(t, func(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+		return f(fn, args, regExps)
+	}, tests)
+}
+
+var regExpMatchTests = []functionTest{
+	{
+		Name: "RegExpMatch",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+			value.NewString("^a.c"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch Not Matched",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+			value.NewString("^A.C"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExpMatch with Case-Insensitive Flag",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+			value.NewString("^A.C"),
+			value.NewString("i"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch with Multi-Line Flag",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc\ndef"),
+			value.NewString("^def$"),
+			value.NewString("m"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch String is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("^a.c"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+		},
+		Error: "[L:- C:-] function regexp_match takes 2 or 3 arguments",
+	},
+	{
+		Name: "RegExpMatch Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+			value.NewString("a(bc"),
+		},
+		Error: "[L:- C:-] error parsing regexp: missing closing ): `a(bc` for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Invalid Flag Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg"),
+			value.NewString("^a.c"),
+			value.NewString("ix"),
+		},
+		Error: "[L:- C:-] \"x\" is an unknown flag for function regexp_match",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	testRegExpFunction(t, RegExpMatch, regExpMatchTests)
+}
+
+var regExpFindTests = []functionTest{
+	{
+		Name: "RegExpFind",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("ERROR 2018-10-01 code:123"),
+			value.NewString("[0-9]{4}-[0-9]{2}-[0-9]{2}"),
+		},
+		Result: value.NewString("2018-10-01"),
+	},
+	{
+		Name: "RegExpFind Not Found",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("ERROR code:123"),
+			value.NewString("[0-9]{4}-[0-9]{2}-[0-9]{2}"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("ERROR code:123"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFind(t *testing.T) {
+	testRegExpFunction(t, RegExpFind, regExpFindTests)
+}
+
+var regExpFindSubmatchesTests = []functionTest{
+	{
+		Name: "RegExpFindSubmatches",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewString("user=alice id=12"),
+			value.NewString("(\\w+)=(\\w+)( ok)?"),
+		},
+		Result: value.NewString("[\"user=alice\",\"user\",\"alice\",null]"),
+	},
+	{
+		Name: "RegExpFindSubmatches Not Found",
+		Function: parser.Function{
+			Name: "regexp_find_submatches",
+		},
+		Args: []value.Primary{
+			value.NewString("user"),
+			value.NewString("(\\w+)=(\\w+)"),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFindSubmatches(t *testing.T) {
+	testRegExpFunction(t, RegExpFindSubmatches, regExpFindSubmatchesTests)
+}
+
+var regExpFindAllTests = []functionTest{
+	{
+		Name: "RegExpFindAll",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("user=alice ID=12"),
+			value.NewString("([a-z]+)=(\\w+)"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("[[\"user=alice\",\"user\",\"alice\"],[\"ID=12\",\"ID\",\"12\"]]"),
+	},
+	{
+		Name: "RegExpFindAll Not Found",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("user"),
+			value.NewString("(\\w+)=(\\w+)"),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFindAll(t *testing.T) {
+	testRegExpFunction(t, RegExpFindAll, regExpFindAllTests)
+}
+
+var regExpReplaceTests = []functionTest{
+	{
+		Name: "RegExpReplace",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("2018-10-01 2018-11-02"),
+			value.NewString("([0-9]{4})-([0-9]{2})-([0-9]{2})"),
+			value.NewString("${3}/${2}/${1}"),
+		},
+		Result: value.NewString("01/10/2018 02/11/2018"),
+	},
+	{
+		Name: "RegExpReplace with Flags",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("Abc abc"),
+			value.NewString("abc"),
+			value.NewString("x"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("x x"),
+	},
+	{
+		Name: "RegExpReplace Replacement is Null",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("abc"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpReplace Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_replace takes 3 or 4 arguments",
+	},
+}
+
+func TestRegExpReplace(t *testing.T) {
+	testRegExpFunction(t, RegExpReplace, regExpReplaceTests)
+}
+
+var regExpSplitTests = []functionTest{
+	{
+		Name: "RegExpSplit",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("a, b;c"),
+			value.NewString("[,;]\\s*"),
+		},
+		Result: value.NewString("[\"a\",\"b\",\"c\"]"),
+	},
+	{
+		Name: "RegExpSplit with Flags",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("1x2X3"),
+			value.NewString("x"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("[\"1\",\"2\",\"3\"]"),
+	},
+	{
+		Name: "RegExpSplit Not Found",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString(","),
+		},
+		Result: value.NewString("[\"abc\"]"),
+	},
+	{
+		Name: "RegExpSplit Null",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(","),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpSplit Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] function regexp_split takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpSplit(t *testing.T) {
+	testRegExpFunction(t, RegExpSplit, regExpSplitTests)
+}
+
 var formatTests = []functionTest{
 	{
 		Name: "Format",
@@ -3178,6 +3563,95 @@ func TestFloat(t *testing.T) {
 	testFunction(t, Float, floatTests)
 }
 
+var decimalTests = []functionTest{
+	{
+		Name: "Decimal from String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString(" 12.30 "),
+		},
+		Result: value.NewDecimalFromString("12.30"),
+	},
+	{
+		Name: "Decimal from Float",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewFloat(0.1),
+		},
+		Result: value.NewDecimalFromString("0.1"),
+	},
+	{
+		Name: "Decimal from Datetime",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+		},
+		Result: value.NewDecimalFromString("1328260695.12345"),
+	},
+	{
+		Name: "Decimal with Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("2.5"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("2.50"),
+	},
+	{
+		Name: "Decimal with Scale Rounding",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("2.345"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("2.35"),
+	},
+	{
+		Name: "Decimal from Invalid String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Decimal Scale Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("2.5"),
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] the second argument must be an integer for function decimal",
+	},
+	{
+		Name: "Decimal Arguments Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function decimal takes 1 or 2 arguments",
+	},
+}
+
+func TestDecimal(t *testing.T) {
+	testFunction(t, Decimal, decimalTests)
+}
+
 var booleanTests = []functionTest{
 	{
 		Name: "Boolean from String",
@@ -3253,6 +3727,51 @@ func TestDatetime(t *testing.T) {
 	testFunction(t, Datetime, datetimeTests)
 }
 
+var intervalTests = []functionTest{
+	{
+		Name: "Interval from String",
+		Function: parser.Function{
+			Name: "interval",
+		},
+		Args: []value.Primary{
+			value.NewString("1 day 2 hours"),
+		},
+		Result: value.NewInterval(0, 1, int64(2*time.Hour)),
+	},
+	{
+		Name: "Interval from Number",
+		Function: parser.Function{
+			Name: "interval",
+		},
+		Args: []value.Primary{
+			value.NewFloat(1.5),
+		},
+		Result: value.NewInterval(0, 0, int64(1500*time.Millisecond)),
+	},
+	{
+		Name: "Interval from Invalid String",
+		Function: parser.Function{
+			Name: "interval",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Interval Arguments Error",
+		Function: parser.Function{
+			Name: "interval",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function interval takes exactly 1 argument",
+	},
+}
+
+func TestInterval(t *testing.T) {
+	testFunction(t, Interval, intervalTests)
+}
+
 var callTests = []functionTest{
 	{
 		Name: "Call Argument Error",
@@ -7,7 +7,11 @@ import (
 	"github.com/mithrandie/csvq/lib/parser"
 )
 
-const InternalIdColumn = "@__internal_id"
+const (
+	InternalIdColumn        = "@__internal_id"
+	GroupingIdColumn        = "@__grouping_id"
+	GroupingKeyColumnPrefix = "@__grouping_key_"
+)
 
 type HeaderField struct {
 	View         string
@@ -240,6 +244,18 @@ func (h Header) Update(reference string, fields []parser.QueryExpression) error
 	return nil
 }
 
+func (h Header) equalColumns(h2 Header) bool {
+	if len(h) != len(h2) {
+		return false
+	}
+	for i := range h {
+		if !strings.EqualFold(h[i].Column, h2[i].Column) {
+			return false
+		}
+	}
+	return true
+}
+
 func (h Header) Copy() Header {
 	header := make(Header, h.Len())
 	copy(header, h)
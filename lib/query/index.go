@@ -0,0 +1,1174 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+	"github.com/mithrandie/ternary"
+)
+
+const IndexFileExt = ".csvqidx"
+
+const indexSkipThreshold = 64 * 1024
+
+type IndexEntry struct {
+	Values  []string
+	Nulls   []bool
+	Offsets []int64
+}
+
+func (e IndexEntry) Value(i int) value.Primary {
+	if e.Nulls[i] {
+		return value.NewNull()
+	}
+	return value.NewString(e.Values[i])
+}
+
+type Index struct {
+	Name      string
+	Columns   []string
+	Positions []int
+	Header    []string
+
+	FileSize    int64
+	ModTime     int64
+	Delimiter   rune
+	Encoding    text.Encoding
+	NoHeader    bool
+	WithoutNull bool
+
+	Entries []IndexEntry
+
+	orders      []*indexOrder
+	keyMap      map[string][]int
+	keyFormat   []string
+	keyLocation *time.Location
+	orderMtx    sync.Mutex
+}
+
+// indexOrder holds the entries sorted by the values of a column in the order of comparison.
+type indexOrder struct {
+	numbers []float64
+	numeric []int
+	others  []int
+
+	keys  []string
+	texts []int
+}
+
+type indexFile struct {
+	index   *Index
+	size    int64
+	modTime int64
+}
+
+var loadedIndexes = struct {
+	files map[string]indexFile
+	mtx   sync.Mutex
+}{
+	files: make(map[string]indexFile),
+}
+
+func IndexFilePath(fpath string, name string) string {
+	return filepath.Join(filepath.Dir(fpath), "."+filepath.Base(fpath)+"."+name+IndexFileExt)
+}
+
+func IndexFilePaths(fpath string) []string {
+	list, err := ioutil.ReadDir(filepath.Dir(fpath))
+	if err != nil {
+		return nil
+	}
+
+	prefix := "." + filepath.Base(fpath) + "."
+	paths := make([]string, 0)
+	for _, f := range list {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), prefix) || !strings.HasSuffix(f.Name(), IndexFileExt) {
+			continue
+		}
+		paths = append(paths, filepath.Join(filepath.Dir(fpath), f.Name()))
+	}
+	return paths
+}
+
+func IsValidIndexName(name string) bool {
+	if len(name) < 1 {
+		return false
+	}
+	for _, c := range name {
+		if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func CheckIndexable(fileInfo *FileInfo) error {
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return errors.New(fmt.Sprintf("%s format is not supported", fileInfo.Format))
+	}
+	if fileInfo.Compression != cmd.NoCompression {
+		return errors.New("compressed files are not supported")
+	}
+
+	limit := rune(0x80)
+	if fileInfo.Encoding == text.SJIS {
+		limit = 0x40
+	} else if fileInfo.Encoding != text.UTF8 {
+		return errors.New(fmt.Sprintf("%s encoding is not supported", cmd.EncodingString(fileInfo.Encoding)))
+	}
+	if limit <= fileInfo.Delimiter || fileInfo.Delimiter == '"' || fileInfo.Delimiter == '\r' || fileInfo.Delimiter == '\n' {
+		return errors.New(fmt.Sprintf("delimiter %q is not supported", fileInfo.Delimiter))
+	}
+	return nil
+}
+
+func BuildIndex(fileInfo *FileInfo, name string, columns []string, withoutNull bool, flags *cmd.Flags) (*Index, error) {
+	if err := CheckIndexable(fileInfo); err != nil {
+		return nil, err
+	}
+
+	h, err := file.NewHandlerForRead(fileInfo.Path, flags.WaitTimeout, flags.RetryInterval)
+	if err != nil {
+		return nil, err
+	}
+	defer h.Close()
+
+	fp := h.FileForRead()
+	stat, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &Index{
+		Name:        name,
+		Columns:     columns,
+		FileSize:    stat.Size(),
+		ModTime:     stat.ModTime().UnixNano(),
+		Delimiter:   fileInfo.Delimiter,
+		Encoding:    fileInfo.Encoding,
+		NoHeader:    fileInfo.NoHeader,
+		WithoutNull: withoutNull,
+	}
+
+	entryMap := make(map[string]int)
+	scanner := newRecordScanner(fp, byte(idx.Delimiter), 0)
+	for {
+		offset, b, err := scanner.Scan()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if idx.Header == nil && !idx.NoHeader {
+			header, err := idx.parseHeader(b)
+			if err == io.EOF {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err = idx.setHeader(header); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		record, err := idx.parseRecord(b)
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("offset %d: %s", offset, err.Error()))
+		}
+
+		if idx.Header == nil {
+			header := make([]string, len(record))
+			for i := range header {
+				header[i] = "c" + strconv.Itoa(i+1)
+			}
+			if err = idx.setHeader(header); err != nil {
+				return nil, err
+			}
+		}
+
+		key := idx.entryKey(record)
+		if i, ok := entryMap[key]; ok {
+			idx.Entries[i].Offsets = append(idx.Entries[i].Offsets, offset)
+			continue
+		}
+
+		entry := IndexEntry{
+			Values:  make([]string, len(idx.Positions)),
+			Nulls:   make([]bool, len(idx.Positions)),
+			Offsets: []int64{offset},
+		}
+		for i, pos := range idx.Positions {
+			if value.IsNull(record[pos]) {
+				entry.Nulls[i] = true
+			} else {
+				entry.Values[i] = record[pos].(value.String).Raw()
+			}
+		}
+		entryMap[key] = len(idx.Entries)
+		idx.Entries = append(idx.Entries, entry)
+	}
+
+	if idx.Header == nil {
+		return nil, errors.New("table is empty")
+	}
+
+	sort.Slice(idx.Entries, func(i, j int) bool {
+		for k := range idx.Positions {
+			if idx.Entries[i].Nulls[k] != idx.Entries[j].Nulls[k] {
+				return idx.Entries[i].Nulls[k]
+			}
+			if idx.Entries[i].Values[k] != idx.Entries[j].Values[k] {
+				return idx.Entries[i].Values[k] < idx.Entries[j].Values[k]
+			}
+		}
+		return false
+	})
+	return idx, nil
+}
+
+func LoadIndex(ipath string) (*Index, error) {
+	fp, err := os.Open(ipath)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	stat, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	loadedIndexes.mtx.Lock()
+	loaded, ok := loadedIndexes.files[ipath]
+	loadedIndexes.mtx.Unlock()
+	if ok && loaded.size == stat.Size() && loaded.modTime == stat.ModTime().UnixNano() {
+		return loaded.index, nil
+	}
+
+	idx := &Index{}
+	if err = gob.NewDecoder(bufio.NewReader(fp)).Decode(idx); err != nil {
+		return nil, err
+	}
+
+	loadedIndexes.mtx.Lock()
+	loadedIndexes.files[ipath] = indexFile{
+		index:   idx,
+		size:    stat.Size(),
+		modTime: stat.ModTime().UnixNano(),
+	}
+	loadedIndexes.mtx.Unlock()
+	return idx, nil
+}
+
+func unloadIndex(ipath string) {
+	loadedIndexes.mtx.Lock()
+	delete(loadedIndexes.files, ipath)
+	loadedIndexes.mtx.Unlock()
+}
+
+func (idx *Index) Save(ipath string) error {
+	unloadIndex(ipath)
+
+	tmp := ipath + ".tmp"
+	fp, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(fp)
+	err = gob.NewEncoder(w).Encode(idx)
+	if err == nil {
+		err = w.Flush()
+	}
+	if e := fp.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, ipath)
+}
+
+func (idx *Index) IsAvailable(fileInfo *FileInfo, withoutNull bool, stat os.FileInfo) bool {
+	return idx.FileSize == stat.Size() &&
+		idx.ModTime == stat.ModTime().UnixNano() &&
+		idx.Delimiter == fileInfo.Delimiter &&
+		idx.Encoding == fileInfo.Encoding &&
+		idx.NoHeader == fileInfo.NoHeader &&
+		idx.WithoutNull == withoutNull
+}
+
+func (idx *Index) setHeader(header []string) error {
+	idx.Header = header
+	idx.Positions = make([]int, len(idx.Columns))
+	for i, c := range idx.Columns {
+		idx.Positions[i] = -1
+		for j, h := range header {
+			if strings.EqualFold(c, h) {
+				idx.Positions[i] = j
+				break
+			}
+		}
+		if idx.Positions[i] < 0 {
+			return errors.New(fmt.Sprintf(ErrorFieldNotExist, c))
+		}
+		idx.Columns[i] = header[idx.Positions[i]]
+	}
+	return nil
+}
+
+func (idx *Index) newReader(b []byte) *csv.Reader {
+	reader := csv.NewReader(bytes.NewReader(b), idx.Encoding)
+	reader.Delimiter = idx.Delimiter
+	reader.WithoutNull = idx.WithoutNull
+	reader.FieldsPerRecord = len(idx.Header)
+	return reader
+}
+
+func (idx *Index) parseHeader(b []byte) ([]string, error) {
+	return idx.newReader(b).ReadHeader()
+}
+
+func (idx *Index) parseRecord(b []byte) ([]value.Primary, error) {
+	row, err := idx.newReader(b).Read()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]value.Primary, len(row))
+	for i, v := range row {
+		if v == nil {
+			fields[i] = value.NewNull()
+		} else {
+			fields[i] = value.NewString(string(v))
+		}
+	}
+	return fields, nil
+}
+
+func (idx *Index) entryKey(record []value.Primary) string {
+	buf := &bytes.Buffer{}
+	for _, pos := range idx.Positions {
+		if value.IsNull(record[pos]) {
+			buf.WriteByte('-')
+			continue
+		}
+		s := record[pos].(value.String).Raw()
+		buf.WriteString(strconv.Itoa(len(s)))
+		buf.WriteByte(':')
+		buf.WriteString(s)
+	}
+	return buf.String()
+}
+
+func (idx *Index) ReadRecords(fp io.ReadSeeker, offsets []int64) (RecordSet, error) {
+	records := make(RecordSet, 0, len(offsets))
+
+	var scanner *recordScanner
+	for _, offset := range offsets {
+		if scanner == nil || offset < scanner.offset || scanner.offset+indexSkipThreshold < offset {
+			if _, err := fp.Seek(offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+			scanner = newRecordScanner(fp, byte(idx.Delimiter), offset)
+		} else if err := scanner.Skip(offset - scanner.offset); err != nil {
+			return nil, err
+		}
+
+		_, b, err := scanner.Scan()
+		if err != nil {
+			if err == io.EOF {
+				err = errors.New(fmt.Sprintf("offset %d: record does not exist", offset))
+			}
+			return nil, err
+		}
+
+		record, err := idx.parseRecord(b)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("offset %d: %s", offset, err.Error()))
+		}
+		records = append(records, NewRecord(record))
+	}
+	return records, nil
+}
+
+func (idx *Index) SearchByCondition(header Header, conditions []parser.QueryExpression, filter *Filter) ([]int64, error) {
+	var candidates []int
+	for _, condition := range conditions {
+		list, ok, err := idx.searchCandidates(header, condition, filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok && (candidates == nil || len(list) < len(candidates)) {
+			candidates = list
+		}
+	}
+	if candidates == nil {
+		candidates = make([]int, len(idx.Entries))
+		for i := range candidates {
+			candidates[i] = i
+		}
+	}
+
+	view := NewView()
+	view.Header = header
+	view.RecordSet = make(RecordSet, len(candidates))
+	for i, eIdx := range candidates {
+		fields := make([]value.Primary, len(idx.Positions))
+		for j := range fields {
+			fields[j] = idx.Entries[eIdx].Value(j)
+		}
+		view.RecordSet[i] = NewRecord(fields)
+	}
+
+	results := make([]bool, len(candidates))
+	err := NewFilterForSequentialEvaluation(view, filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		for _, condition := range conditions {
+			p, e := f.Evaluate(condition)
+			if e != nil {
+				return e
+			}
+			if p.Ternary() != ternary.TRUE {
+				return nil
+			}
+		}
+		results[rIdx] = true
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make([]int64, 0)
+	for i, ok := range results {
+		if ok {
+			offsets = append(offsets, idx.Entries[candidates[i]].Offsets...)
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets, nil
+}
+
+// searchCandidates returns the positions of the entries that can satisfy the condition by binary search.
+// The second return value is false if the condition cannot narrow down the entries.
+func (idx *Index) searchCandidates(header Header, condition parser.QueryExpression, filter *Filter) ([]int, bool, error) {
+	column := func(expr parser.QueryExpression) int {
+		switch expr.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			if i, err := header.ContainsObject(expr); err == nil {
+				return i
+			}
+		}
+		return -1
+	}
+	operand := func(expr parser.QueryExpression) (value.Primary, bool, error) {
+		switch expr.(type) {
+		case parser.PrimitiveType, parser.Variable:
+			p, err := filter.Evaluate(expr)
+			return p, err == nil, err
+		}
+		return nil, false, nil
+	}
+
+	switch e := condition.(type) {
+	case parser.Parentheses:
+		return idx.searchCandidates(header, e.Expr, filter)
+	case parser.Comparison:
+		col, operandExpr, operator := column(e.LHS), e.RHS, e.Operator
+		if col < 0 {
+			col, operandExpr = column(e.RHS), e.LHS
+			switch operator {
+			case "<":
+				operator = ">"
+			case "<=":
+				operator = ">="
+			case ">":
+				operator = "<"
+			case ">=":
+				operator = "<="
+			}
+		}
+		if col < 0 {
+			return nil, false, nil
+		}
+
+		p, ok, err := operand(operandExpr)
+		if !ok {
+			return nil, false, err
+		}
+
+		switch operator {
+		case "=":
+			return idx.order(col).search(p, p, filter.flags())
+		case "<", "<=":
+			return idx.order(col).search(nil, p, filter.flags())
+		case ">", ">=":
+			return idx.order(col).search(p, nil, filter.flags())
+		}
+	case parser.Between:
+		col := column(e.LHS)
+		if col < 0 || !e.Negation.IsEmpty() {
+			return nil, false, nil
+		}
+
+		low, ok, err := operand(e.Low)
+		if !ok {
+			return nil, false, err
+		}
+		high, ok, err := operand(e.High)
+		if !ok {
+			return nil, false, err
+		}
+		return idx.order(col).search(low, high, filter.flags())
+	case parser.In:
+		col := column(e.LHS)
+		if col < 0 || !e.Negation.IsEmpty() {
+			return nil, false, nil
+		}
+		rowValue, ok := e.Values.(parser.RowValue)
+		if !ok {
+			return nil, false, nil
+		}
+		list, ok := rowValue.Value.(parser.ValueList)
+		if !ok {
+			return nil, false, nil
+		}
+
+		found := make([]bool, len(idx.Entries))
+		for _, v := range list.Values {
+			p, ok, err := operand(v)
+			if !ok {
+				return nil, false, err
+			}
+			positions, ok, _ := idx.order(col).search(p, p, filter.flags())
+			if !ok {
+				return nil, false, nil
+			}
+			for _, i := range positions {
+				found[i] = true
+			}
+		}
+
+		candidates := make([]int, 0)
+		for i, ok := range found {
+			if ok {
+				candidates = append(candidates, i)
+			}
+		}
+		return candidates, true, nil
+	}
+	return nil, false, nil
+}
+
+func (idx *Index) order(col int) *indexOrder {
+	idx.orderMtx.Lock()
+	defer idx.orderMtx.Unlock()
+
+	if idx.orders == nil {
+		idx.orders = make([]*indexOrder, len(idx.Positions))
+	}
+	if idx.orders[col] == nil {
+		idx.orders[col] = newIndexOrder(idx.Entries, col)
+	}
+	return idx.orders[col]
+}
+
+func newIndexOrder(entries []IndexEntry, col int) *indexOrder {
+	o := &indexOrder{
+		numbers: make([]float64, 0, len(entries)),
+		numeric: make([]int, 0, len(entries)),
+		others:  make([]int, 0),
+		keys:    make([]string, 0, len(entries)),
+		texts:   make([]int, 0, len(entries)),
+	}
+
+	for i, entry := range entries {
+		if entry.Nulls[col] {
+			continue
+		}
+
+		p := entry.Value(col)
+		if f := value.ToFloat(p); !value.IsNull(f) && !math.IsNaN(f.(value.Float).Raw()) {
+			o.numeric = append(o.numeric, i)
+		} else {
+			o.others = append(o.others, i)
+		}
+		o.texts = append(o.texts, i)
+	}
+
+	numbers := make([]float64, len(entries))
+	for _, i := range o.numeric {
+		numbers[i] = value.ToFloat(entries[i].Value(col)).(value.Float).Raw()
+	}
+	sort.SliceStable(o.numeric, func(i, j int) bool { return numbers[o.numeric[i]] < numbers[o.numeric[j]] })
+	for _, i := range o.numeric {
+		o.numbers = append(o.numbers, numbers[i])
+	}
+
+	keys := make([]string, len(entries))
+	for _, i := range o.texts {
+		keys[i] = strings.ToUpper(strings.TrimSpace(entries[i].Values[col]))
+	}
+	sort.SliceStable(o.texts, func(i, j int) bool { return keys[o.texts[i]] < keys[o.texts[j]] })
+	for _, i := range o.texts {
+		o.keys = append(o.keys, keys[i])
+	}
+	return o
+}
+
+// search returns the positions of the entries that can be between low and high inclusive.
+// Nil bounds are unlimited. The second return value is false if the bounds cannot be searched.
+//
+// A number is compared with numeric entries as a number, and with other entries in various ways, so all of the other entries are included.
+// A string that cannot be converted to a number, a datetime or a boolean is compared with all entries as a string.
+func (o *indexOrder) search(low value.Primary, high value.Primary, flags *cmd.Flags) ([]int, bool, error) {
+	if (low != nil && value.IsNull(low)) || (high != nil && value.IsNull(high)) {
+		return []int{}, true, nil
+	}
+
+	isNumber := func(p value.Primary) bool {
+		switch p.(type) {
+		case value.Integer, value.Float, value.String:
+			f := value.ToFloat(p)
+			return !value.IsNull(f) && !math.IsNaN(f.(value.Float).Raw())
+		}
+		return false
+	}
+	isText := func(p value.Primary) bool {
+		_, ok := p.(value.String)
+		return ok && !isNumber(p) && value.IsNull(value.ToDatetime(p, flags.DatetimeFormat, flags.GetLocation())) && value.IsNull(value.ToBoolean(p))
+	}
+	bounds := func(fn func(value.Primary) bool) bool {
+		return (low == nil || fn(low)) && (high == nil || fn(high))
+	}
+
+	switch {
+	case bounds(isNumber):
+		start, end := 0, len(o.numbers)
+		if low != nil {
+			start = sort.SearchFloat64s(o.numbers, value.ToFloat(low).(value.Float).Raw())
+		}
+		if high != nil {
+			f := value.ToFloat(high).(value.Float).Raw()
+			end = sort.Search(len(o.numbers), func(i int) bool { return f < o.numbers[i] })
+		}
+		if end < start {
+			end = start
+		}
+
+		candidates := make([]int, 0, end-start+len(o.others))
+		candidates = append(candidates, o.numeric[start:end]...)
+		return append(candidates, o.others...), true, nil
+	case bounds(isText):
+		start, end := 0, len(o.keys)
+		if low != nil {
+			start = sort.SearchStrings(o.keys, strings.ToUpper(strings.TrimSpace(low.(value.String).Raw())))
+		}
+		if high != nil {
+			s := strings.ToUpper(strings.TrimSpace(high.(value.String).Raw()))
+			end = sort.Search(len(o.keys), func(i int) bool { return s < o.keys[i] })
+		}
+		if end < start {
+			end = start
+		}
+
+		candidates := make([]int, end-start)
+		copy(candidates, o.texts[start:end])
+		return candidates, true, nil
+	}
+	return nil, false, nil
+}
+
+// keys returns the positions of the entries grouped by the equality key tokens of the first column.
+// The tokens depend on the datetime format and the time zone, so they are regenerated when the flags differ.
+func (idx *Index) keys(flags *cmd.Flags) map[string][]int {
+	idx.orderMtx.Lock()
+	defer idx.orderMtx.Unlock()
+
+	location := flags.GetLocation()
+	if idx.keyMap == nil || idx.keyLocation != location || !reflect.DeepEqual(idx.keyFormat, flags.DatetimeFormat) {
+		idx.keyMap = make(map[string][]int, len(idx.Entries))
+		for i, entry := range idx.Entries {
+			for _, t := range equalityKeyTokens(entry.Value(0), flags) {
+				idx.keyMap[t] = append(idx.keyMap[t], i)
+			}
+		}
+		idx.keyFormat = flags.DatetimeFormat
+		idx.keyLocation = location
+	}
+	return idx.keyMap
+}
+
+func (idx *Index) SearchByValues(values []value.Primary, flags *cmd.Flags) []int64 {
+	keys := idx.keys(flags)
+
+	searched := make(map[string]bool)
+	found := make(map[int]bool)
+	offsets := make([]int64, 0)
+	for _, v := range values {
+		for _, t := range equalityKeyTokens(v, flags) {
+			if searched[t] {
+				continue
+			}
+			searched[t] = true
+
+			for _, i := range keys[t] {
+				if !found[i] {
+					found[i] = true
+					offsets = append(offsets, idx.Entries[i].Offsets...)
+				}
+			}
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets
+}
+
+func equalityKeyTokens(p value.Primary, flags *cmd.Flags) []string {
+	if value.IsNull(p) {
+		return nil
+	}
+
+	tokens := make([]string, 0, 6)
+	if i := value.ToInteger(p); !value.IsNull(i) {
+		tokens = append(tokens, "I"+strconv.FormatInt(i.(value.Integer).Raw(), 10))
+	}
+	if f := value.ToFloat(p); !value.IsNull(f) {
+		fv := f.(value.Float).Raw()
+		if fv == 0 {
+			fv = 0
+		}
+		tokens = append(tokens, "F"+strconv.FormatFloat(fv, 'g', -1, 64))
+	}
+	if d := value.ToDatetime(p, flags.DatetimeFormat, flags.GetLocation()); !value.IsNull(d) {
+		tokens = append(tokens, "D"+strconv.FormatInt(d.(value.Datetime).Raw().UnixNano(), 10))
+	}
+	if iv := value.ToInterval(p); !value.IsNull(iv) {
+		tokens = append(tokens, "V"+iv.(value.Interval).TotalNanoseconds().String())
+	}
+	if b := value.ToBoolean(p); !value.IsNull(b) {
+		tokens = append(tokens, "B"+strconv.FormatBool(b.(value.Boolean).Raw()))
+	}
+	if s, ok := p.(value.String); ok {
+		tokens = append(tokens, "S"+strings.ToUpper(strings.TrimSpace(s.Raw())))
+	}
+	return tokens
+}
+
+func RebuildIndexes(fileInfo *FileInfo, filter *Filter) {
+	flags := filter.flags()
+
+	for _, ipath := range IndexFilePaths(fileInfo.Path) {
+		old, err := LoadIndex(ipath)
+		if err != nil {
+			os.Remove(ipath)
+			continue
+		}
+
+		idx, err := BuildIndex(fileInfo, old.Name, append([]string{}, old.Columns...), old.WithoutNull, flags)
+		if err == nil {
+			err = idx.Save(ipath)
+		}
+		if err != nil {
+			os.Remove(ipath)
+			filter.logWarn(fmt.Sprintf("Commit: index %s on %q is removed: %s", old.Name, fileInfo.Path, err.Error()), flags.Quiet)
+			continue
+		}
+		filter.logNotice(fmt.Sprintf("Commit: index %s on %q is rebuilt.", idx.Name, fileInfo.Path), flags.Quiet)
+	}
+}
+
+// RenameIndexes moves the index files of the table renamed from oldPath to the path of fileInfo.
+func RenameIndexes(oldPath string, fileInfo *FileInfo, filter *Filter) {
+	flags := filter.flags()
+
+	prefix := "." + filepath.Base(oldPath) + "."
+	for _, ipath := range IndexFilePaths(oldPath) {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(ipath), prefix), IndexFileExt)
+		npath := IndexFilePath(fileInfo.Path, name)
+
+		unloadIndex(ipath)
+		if err := os.Rename(ipath, npath); err != nil {
+			os.Remove(ipath)
+			filter.logWarn(fmt.Sprintf("Commit: index %s on %q is removed: %s", name, oldPath, err.Error()), flags.Quiet)
+			continue
+		}
+		filter.logNotice(fmt.Sprintf("Commit: index %s on %q is moved to %q.", name, oldPath, fileInfo.Path), flags.Quiet)
+	}
+}
+
+func RemoveIndexes(fileInfo *FileInfo, filter *Filter) {
+	for _, ipath := range IndexFilePaths(fileInfo.Path) {
+		if idx, err := LoadIndex(ipath); err == nil {
+			filter.logNotice(fmt.Sprintf("Commit: index %s on %q is removed.", idx.Name, fileInfo.Path), filter.flags().Quiet)
+		}
+		unloadIndex(ipath)
+		os.Remove(ipath)
+	}
+}
+
+func loadViewByIndex(table parser.Table, condition parser.QueryExpression, filter *Filter) (*View, error) {
+	fileInfo, idx, offsets, err := searchIndexByCondition(table, condition, filter)
+	if err != nil || idx == nil {
+		return nil, err
+	}
+	return loadViewFromIndexedFile(table.Object.(parser.Identifier), table.Name(), fileInfo, idx, offsets, filter)
+}
+
+func searchIndexByCondition(table parser.Table, condition parser.QueryExpression, filter *Filter) (*FileInfo, *Index, []int64, error) {
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil, nil, nil, nil
+	}
+
+	fileInfo, idx, err := searchIndexedFile(tableIdentifier, filter)
+	if err != nil || fileInfo == nil {
+		return nil, nil, nil, err
+	}
+
+	conditions := splitConjunction(condition)
+
+	for _, i := range idx {
+		fullHeader := NewHeader(table.Name().Literal, i.Header)
+		applicable := make([]parser.QueryExpression, 0, len(conditions))
+		for _, c := range conditions {
+			if isIndexableCondition(c, fullHeader, i.Positions) {
+				applicable = append(applicable, c)
+			}
+		}
+		if len(applicable) < 1 {
+			continue
+		}
+
+		header := NewHeader(table.Name().Literal, i.Columns)
+		for j, pos := range i.Positions {
+			header[j].Number = pos + 1
+		}
+		offsets, err := i.SearchByCondition(header, applicable, filter)
+		if err != nil {
+			return nil, nil, nil, nil
+		}
+		return fileInfo, i, offsets, nil
+	}
+	return nil, nil, nil, nil
+}
+
+func loadJoinTableByIndex(join parser.Join, view *View, filter *Filter) (*View, error) {
+	table, ok := join.JoinTable.(parser.Table)
+	if !ok {
+		return nil, nil
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil, nil
+	}
+
+	condition, ok := join.Condition.(parser.JoinCondition)
+	if !ok || condition.On == nil {
+		return nil, nil
+	}
+	comparison, ok := condition.On.(parser.Comparison)
+	if !ok || comparison.Operator != "=" {
+		return nil, nil
+	}
+
+	var leftIdx int
+	var rightRef parser.FieldReference
+	if lref, ok := comparison.LHS.(parser.FieldReference); ok {
+		if rref, ok := comparison.RHS.(parser.FieldReference); ok {
+			if i, err := view.Header.Contains(lref); err == nil {
+				leftIdx, rightRef = i, rref
+			} else if i, err := view.Header.Contains(rref); err == nil {
+				leftIdx, rightRef = i, lref
+			} else {
+				return nil, nil
+			}
+		} else {
+			return nil, nil
+		}
+	} else {
+		return nil, nil
+	}
+	if _, err := view.Header.Contains(rightRef); err == nil {
+		return nil, nil
+	}
+	if 0 < len(rightRef.View.Literal) && !strings.EqualFold(rightRef.View.Literal, table.Name().Literal) {
+		return nil, nil
+	}
+
+	fileInfo, idx, err := searchIndexedFile(tableIdentifier, filter)
+	if err != nil || fileInfo == nil {
+		return nil, err
+	}
+
+	for _, i := range idx {
+		if !strings.EqualFold(i.Columns[0], rightRef.Column.Literal) {
+			continue
+		}
+
+		values := make([]value.Primary, 0, view.RecordLen())
+		for _, record := range view.RecordSet {
+			values = append(values, record[leftIdx].Value())
+		}
+		return loadViewFromIndexedFile(tableIdentifier, table.Name(), fileInfo, i, i.SearchByValues(values, filter.flags()), filter)
+	}
+	return nil, nil
+}
+
+func searchIndexedFile(tableIdentifier parser.Identifier, filter *Filter) (*FileInfo, []*Index, error) {
+	if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) {
+		return nil, nil, nil
+	}
+	if _, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+		return nil, nil, nil
+	}
+	if filter.TempViews.Exists(tableIdentifier.Literal) {
+		return nil, nil, nil
+	}
+
+	flags := filter.flags()
+	if fpath, err := CreateFilePath(tableIdentifier, flags.Repository); err != nil || filter.viewCache().Exists(fpath) {
+		return nil, nil, nil
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+	if err != nil || filter.viewCache().Exists(fileInfo.Path) || filter.uncommittedViews().IsDropped(fileInfo.Path) {
+		return nil, nil, nil
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.LineBreak = flags.LineBreak
+	if CheckIndexable(fileInfo) != nil {
+		return nil, nil, nil
+	}
+
+	ipaths := IndexFilePaths(fileInfo.Path)
+	if len(ipaths) < 1 {
+		return nil, nil, nil
+	}
+
+	stat, err := os.Stat(fileInfo.Path)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	list := make([]*Index, 0, len(ipaths))
+	for _, ipath := range ipaths {
+		idx, err := LoadIndex(ipath)
+		if err != nil || !idx.IsAvailable(fileInfo, flags.WithoutNull, stat) {
+			continue
+		}
+		list = append(list, idx)
+	}
+	if len(list) < 1 {
+		return nil, nil, nil
+	}
+	return fileInfo, list, nil
+}
+
+func loadViewFromIndexedFile(tableIdentifier parser.Identifier, tableName parser.Identifier, fileInfo *FileInfo, idx *Index, offsets []int64, filter *Filter) (*View, error) {
+	start := time.Now()
+
+	h, err := file.NewHandlerForRead(fileInfo.Path, filter.flags().WaitTimeout, filter.flags().RetryInterval)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+		}
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+	defer h.Close()
+
+	fp := h.FileForRead()
+	if stat, err := fp.Stat(); err != nil || !idx.IsAvailable(fileInfo, idx.WithoutNull, stat) {
+		return nil, nil
+	}
+
+	records, err := idx.ReadRecords(fp, offsets)
+	if err != nil {
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), idx.Header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+
+	if err = applyTableSchema(view, -1, filter.flags()); err != nil {
+		return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+
+	if err = filter.Aliases.Add(tableName, fileInfo.Path); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(parser.FormatTableName(fileInfo.Path), tableName.Literal) {
+		view.Header.Update(tableName.Literal, nil)
+	}
+
+	filter.plan.add(newIndexScanPlanNode(fileInfo.Path, idx.Name, len(offsets)), view.RecordLen(), time.Since(start))
+	return view, nil
+}
+
+func splitConjunction(expr parser.QueryExpression) []parser.QueryExpression {
+	switch e := expr.(type) {
+	case parser.Parentheses:
+		return splitConjunction(e.Expr)
+	case parser.Logic:
+		if e.Operator.Token == parser.AND {
+			return append(splitConjunction(e.LHS), splitConjunction(e.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+func isIndexableCondition(expr parser.QueryExpression, header Header, positions []int) bool {
+	all := func(list ...parser.QueryExpression) bool {
+		for _, e := range list {
+			if !isIndexableCondition(e, header, positions) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch e := expr.(type) {
+	case parser.PrimitiveType, parser.Variable:
+		return true
+	case parser.FieldReference, parser.ColumnNumber:
+		idx, err := header.ContainsObject(expr)
+		return err == nil && InIntSlice(idx, positions)
+	case parser.Parentheses:
+		return all(e.Expr)
+	case parser.RowValue:
+		return all(e.Value)
+	case parser.ValueList:
+		return all(e.Values...)
+	case parser.RowValueList:
+		return all(e.RowValues...)
+	case parser.Comparison:
+		return all(e.LHS, e.RHS)
+	case parser.Is:
+		return all(e.LHS, e.RHS)
+	case parser.Between:
+		return all(e.LHS, e.Low, e.High)
+	case parser.In:
+		return all(e.LHS, e.Values)
+	case parser.Like:
+		return all(e.LHS, e.Pattern)
+	case parser.Regexp:
+		return all(e.LHS, e.Pattern)
+	case parser.Arithmetic:
+		return all(e.LHS, e.RHS)
+	case parser.UnaryArithmetic:
+		return all(e.Operand)
+	case parser.Logic:
+		return all(e.LHS, e.RHS)
+	case parser.UnaryLogic:
+		return all(e.Operand)
+	case parser.Concat:
+		return all(e.Items...)
+	}
+	return false
+}
+
+type recordScanner struct {
+	reader    *bufio.Reader
+	delimiter byte
+	offset    int64
+	buf       bytes.Buffer
+}
+
+func newRecordScanner(r io.Reader, delimiter byte, offset int64) *recordScanner {
+	return &recordScanner{
+		reader:    bufio.NewReaderSize(r, indexSkipThreshold),
+		delimiter: delimiter,
+		offset:    offset,
+	}
+}
+
+func (s *recordScanner) Skip(n int64) error {
+	if n < 1 {
+		return nil
+	}
+	discarded, err := s.reader.Discard(int(n))
+	s.offset += int64(discarded)
+	return err
+}
+
+func (s *recordScanner) Scan() (int64, []byte, error) {
+	s.buf.Reset()
+	start := s.offset
+	fieldStart := true
+	quoted := false
+	read := false
+
+	for {
+		c, err := s.reader.ReadByte()
+		if err != nil {
+			if err == io.EOF && read {
+				return start, s.buf.Bytes(), nil
+			}
+			return start, nil, err
+		}
+		s.offset++
+		read = true
+
+		if quoted {
+			s.buf.WriteByte(c)
+			if c == '"' {
+				if next, err := s.reader.Peek(1); err == nil && next[0] == '"' {
+					s.reader.ReadByte()
+					s.offset++
+					s.buf.WriteByte('"')
+				} else {
+					quoted = false
+				}
+			}
+			continue
+		}
+
+		switch c {
+		case '\r':
+			if next, err := s.reader.Peek(1); err == nil && next[0] == '\n' {
+				s.reader.ReadByte()
+				s.offset++
+			}
+			return start, s.buf.Bytes(), nil
+		case '\n':
+			return start, s.buf.Bytes(), nil
+		case '"':
+			if fieldStart {
+				quoted = true
+			}
+		}
+		fieldStart = c == s.delimiter
+		s.buf.WriteByte(c)
+	}
+}
@@ -0,0 +1,450 @@
+package query
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var recordScannerScanTests = []struct {
+	Name      string
+	Input     string
+	Delimiter byte
+	Offsets   []int64
+	Records   []string
+}{
+	{
+		Name:      "Line Breaks",
+		Input:     "a,b\nc,d\r\ne,f\rg,h",
+		Delimiter: ',',
+		Offsets:   []int64{0, 4, 9, 13},
+		Records:   []string{"a,b", "c,d", "e,f", "g,h"},
+	},
+	{
+		Name:      "Quoted Fields",
+		Input:     "\"a\nb\",\"c\"\"\n\"\n\"d,\"\"e\"\n",
+		Delimiter: ',',
+		Offsets:   []int64{0, 13},
+		Records:   []string{"\"a\nb\",\"c\"\"\n\"", "\"d,\"\"e\""},
+	},
+	{
+		Name:      "Quotation Mark in Unquoted Field",
+		Input:     "a\"b\nc\td\"\n\"e\"\n",
+		Delimiter: '\t',
+		Offsets:   []int64{0, 4, 9},
+		Records:   []string{"a\"b", "c\td\"", "\"e\""},
+	},
+	{
+		Name:      "Empty Lines",
+		Input:     "a\n\nb\n",
+		Delimiter: ',',
+		Offsets:   []int64{0, 2, 3},
+		Records:   []string{"a", "", "b"},
+	},
+}
+
+func TestRecordScanner_Scan(t *testing.T) {
+	for _, v := range recordScannerScanTests {
+		s := newRecordScanner(strings.NewReader(v.Input), v.Delimiter, 0)
+
+		offsets := make([]int64, 0)
+		records := make([]string, 0)
+		for {
+			offset, b, err := s.Scan()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				break
+			}
+			offsets = append(offsets, offset)
+			records = append(records, string(b))
+		}
+
+		if !reflect.DeepEqual(offsets, v.Offsets) {
+			t.Errorf("%s: offsets = %v, want %v", v.Name, offsets, v.Offsets)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %q, want %q", v.Name, records, v.Records)
+		}
+	}
+}
+
+var indexTestDir = filepath.Join(TestDir, "index")
+
+var createIndexTests = []struct {
+	Name  string
+	Query string
+	Error string
+}{
+	{
+		Name:  "Create Index",
+		Query: "create index idx_id on indexed (id)",
+	},
+	{
+		Name:  "Create Multi-Column Index",
+		Query: "create index idx_grp on indexed (GRP, name)",
+	},
+	{
+		Name:  "Create Index on Joined Table",
+		Query: "create index idx_code on codes (code)",
+	},
+	{
+		Name:  "Create Index on Column To Be Dropped",
+		Query: "create index idx_label on codes (label)",
+	},
+	{
+		Name:  "Index Already Exists Error",
+		Query: "create index idx_id on indexed (name)",
+		Error: "[L:1 C:14] index idx_id already exists on " + filepath.Join(indexTestDir, "indexed.csv"),
+	},
+	{
+		Name:  "Invalid Index Name Error",
+		Query: "create index `idx.id` on indexed (id)",
+		Error: "[L:1 C:14] `idx.id` is an invalid index name",
+	},
+	{
+		Name:  "Duplicate Column Error",
+		Query: "create index idx_dup on indexed (id, ID)",
+		Error: "[L:1 C:38] field name ID is a duplicate",
+	},
+	{
+		Name:  "Column Not Exist Error",
+		Query: "create index idx_notexist on indexed (notexist)",
+		Error: "[L:1 C:14] failed to create index idx_notexist: field notexist does not exist",
+	},
+	{
+		Name:  "Unsupported Format Error",
+		Query: "create index idx_json on `jsondata.json` (id)",
+		Error: "[L:1 C:14] failed to create index idx_json: JSON format is not supported",
+	},
+}
+
+var indexedSelectTests = []struct {
+	Name        string
+	Query       string
+	LoadedCount int
+	Result      [][]value.Primary
+}{
+	{
+		Name:        "Equality",
+		Query:       "select id, name from indexed where id = 3",
+		LoadedCount: 1,
+		Result: [][]value.Primary{
+			{value.NewString("3"), value.NewString("q\"uote")},
+		},
+	},
+	{
+		Name:        "Range and Non-Indexed Condition",
+		Query:       "select id from indexed i where i.id between 2 and 4 and name is not null",
+		LoadedCount: 3,
+		Result: [][]value.Primary{
+			{value.NewString("2")},
+			{value.NewString("3")},
+		},
+	},
+	{
+		Name:        "Multi-Column Index",
+		Query:       "select id from indexed where grp = 'X' and name like 'a%'",
+		LoadedCount: 1,
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+		},
+	},
+	{
+		Name:        "Null",
+		Query:       "select id from indexed where name is null",
+		LoadedCount: 1,
+		Result: [][]value.Primary{
+			{value.NewString("4")},
+		},
+	},
+	{
+		Name:        "Not Indexable Condition",
+		Query:       "select id from indexed where id = 1 or name = 'str2'",
+		LoadedCount: -1,
+		Result: [][]value.Primary{
+			{value.NewString("1")},
+			{value.NewString("2")},
+		},
+	},
+	{
+		Name:        "Join",
+		Query:       "select i.id, c.label from indexed i join codes c on i.grp = c.code",
+		LoadedCount: -1,
+		Result: [][]value.Primary{
+			{value.NewString("1"), value.NewString("Code X")},
+			{value.NewString("2"), value.NewString("Code Y")},
+			{value.NewString("3"), value.NewString("Code X")},
+			{value.NewString("4"), value.NewString("Code Y")},
+		},
+	},
+}
+
+func TestIndex(t *testing.T) {
+	tf := cmd.GetFlags()
+	oldRepository := tf.Repository
+	tf.Repository = indexTestDir
+	defer func() {
+		tf.Repository = oldRepository
+		os.RemoveAll(indexTestDir)
+	}()
+
+	os.Mkdir(indexTestDir, 0755)
+	ioutil.WriteFile(filepath.Join(indexTestDir, "indexed.csv"), []byte("id,name,grp\r\n1,\"a\r\nb\",x\r\n2,str2,y\r\n\r\n3,\"q\"\"uote\",x\r\n4,,y\r\n"), 0644)
+	ioutil.WriteFile(filepath.Join(indexTestDir, "codes.csv"), []byte("code,label\nx,Code X\ny,Code Y\nz,Code Z\n"), 0644)
+	ioutil.WriteFile(filepath.Join(indexTestDir, "jsondata.json"), []byte("[{\"id\":1}]"), 0644)
+
+	filter := NewEmptyFilter()
+
+	for _, v := range createIndexTests {
+		ViewCache.Clean()
+		statements, _ := parser.Parse(v.Query, "", nil, GetTestLocation())
+		_, err := CreateIndex(statements[0].(parser.CreateIndex), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+
+	for _, v := range indexedSelectTests {
+		ViewCache.Clean()
+		statements, _ := parser.Parse(v.Query, "", nil, GetTestLocation())
+		query := statements[0].(parser.SelectQuery)
+		entity := query.SelectEntity.(parser.SelectEntity)
+
+		if 0 <= v.LoadedCount {
+			loaded, err := loadViewByIndex(entity.FromClause.(parser.FromClause).Tables[0].(parser.Table), entity.WhereClause.(parser.WhereClause).Filter, filter.CreateNode())
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+			if loaded == nil {
+				t.Errorf("%s: index is not used", v.Name)
+			} else if loaded.RecordLen() != v.LoadedCount {
+				t.Errorf("%s: loaded records = %d, want %d", v.Name, loaded.RecordLen(), v.LoadedCount)
+			}
+		}
+
+		result, err := Select(query, filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		values := make([][]value.Primary, 0, result.RecordLen())
+		for _, record := range result.RecordSet {
+			row := make([]value.Primary, 0, len(record))
+			for _, cell := range record {
+				row = append(row, cell.Value())
+			}
+			values = append(values, row)
+		}
+		if !reflect.DeepEqual(values, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, values, v.Result)
+		}
+	}
+
+	ViewCache.Clean()
+	statements, _ := parser.Parse("insert into codes values ('w', 'Code W'); commit;", "", nil, GetTestLocation())
+	proc := NewProcedure()
+	if _, err := proc.Execute(statements); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	idx, err := LoadIndex(IndexFilePath(filepath.Join(indexTestDir, "codes.csv"), "idx_code"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if len(idx.Entries) != 4 {
+		t.Errorf("index entries after commit = %d, want %d", len(idx.Entries), 4)
+	}
+	if loaded, _ := LoadIndex(IndexFilePath(filepath.Join(indexTestDir, "codes.csv"), "idx_code")); loaded != idx {
+		t.Errorf("unchanged index is decoded again")
+	}
+
+	ViewCache.Clean()
+	statements, _ = parser.Parse("alter table codes drop label; commit;", "", nil, GetTestLocation())
+	if _, err := proc.Execute(statements); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err := os.Stat(IndexFilePath(filepath.Join(indexTestDir, "codes.csv"), "idx_label")); !os.IsNotExist(err) {
+		t.Errorf("index including dropped column is not removed")
+	}
+	if _, err := os.Stat(IndexFilePath(filepath.Join(indexTestDir, "codes.csv"), "idx_code")); err != nil {
+		t.Errorf("index is not rebuilt: %s", err)
+	}
+
+	ViewCache.Clean()
+	statements, _ = parser.Parse("alter table codes rename to `renamed.csv`; commit;", "", nil, GetTestLocation())
+	if _, err := proc.Execute(statements); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err := os.Stat(IndexFilePath(filepath.Join(indexTestDir, "codes.csv"), "idx_code")); !os.IsNotExist(err) {
+		t.Errorf("index of renamed table is not moved")
+	}
+	if idx, err := LoadIndex(IndexFilePath(filepath.Join(indexTestDir, "renamed.csv"), "idx_code")); err != nil {
+		t.Errorf("index of renamed table is not moved: %s", err)
+	} else if len(idx.Entries) != 4 {
+		t.Errorf("index entries after rename = %d, want %d", len(idx.Entries), 4)
+	}
+	ViewCache.Clean()
+}
+
+var indexSearchByConditionTests = []struct {
+	Name       string
+	Condition  string
+	Candidates []int
+	Offsets    []int64
+}{
+	{
+		Name:       "Equal to Number",
+		Condition:  "c = 2",
+		Candidates: []int{4, 1, 5, 6},
+		Offsets:    []int64{40},
+	},
+	{
+		Name:       "Less than Number",
+		Condition:  "3 > c",
+		Candidates: []int{2, 4, 1, 5, 6},
+		Offsets:    []int64{20, 40},
+	},
+	{
+		Name:       "Between",
+		Condition:  "c between 2 and 10",
+		Candidates: []int{4, 3, 1, 5, 6},
+		Offsets:    []int64{10, 30, 40},
+	},
+	{
+		Name:       "In",
+		Condition:  "c in (1, 10)",
+		Candidates: []int{1, 2, 3, 5, 6},
+		Offsets:    []int64{20, 30},
+	},
+	{
+		Name:       "Equal to String",
+		Condition:  "c = 'abc'",
+		Candidates: []int{5},
+		Offsets:    []int64{50},
+	},
+	{
+		Name:       "Equal to Null",
+		Condition:  "c = null",
+		Candidates: []int{},
+		Offsets:    []int64{},
+	},
+	{
+		Name:      "Not Equal",
+		Condition: "c <> 2",
+		Offsets:   []int64{10, 20, 30},
+	},
+}
+
+func TestIndex_SearchByCondition(t *testing.T) {
+	idx := &Index{
+		Columns:   []string{"c"},
+		Positions: []int{0},
+		Entries: []IndexEntry{
+			{Values: []string{""}, Nulls: []bool{true}, Offsets: []int64{0}},
+			{Values: []string{" 3"}, Nulls: []bool{false}, Offsets: []int64{10}},
+			{Values: []string{"1"}, Nulls: []bool{false}, Offsets: []int64{20}},
+			{Values: []string{"10"}, Nulls: []bool{false}, Offsets: []int64{30}},
+			{Values: []string{"2"}, Nulls: []bool{false}, Offsets: []int64{40}},
+			{Values: []string{"ABC"}, Nulls: []bool{false}, Offsets: []int64{50}},
+			{Values: []string{"xyz"}, Nulls: []bool{false}, Offsets: []int64{60}},
+		},
+	}
+	header := NewHeader("t", idx.Columns)
+	header[0].Number = 1
+	filter := NewEmptyFilter()
+
+	for _, v := range indexSearchByConditionTests {
+		statements, _ := parser.Parse("select c from t where "+v.Condition, "", nil, GetTestLocation())
+		condition := statements[0].(parser.SelectQuery).SelectEntity.(parser.SelectEntity).WhereClause.(parser.WhereClause).Filter
+
+		candidates, ok, err := idx.searchCandidates(header, condition, filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !ok {
+			candidates = nil
+		}
+		if !reflect.DeepEqual(candidates, v.Candidates) {
+			t.Errorf("%s: candidates = %v, want %v", v.Name, candidates, v.Candidates)
+		}
+
+		offsets, err := idx.SearchByCondition(header, []parser.QueryExpression{condition}, filter)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(offsets, v.Offsets) {
+			t.Errorf("%s: offsets = %v, want %v", v.Name, offsets, v.Offsets)
+		}
+	}
+}
+
+func TestIndex_SearchByValues(t *testing.T) {
+	idx := &Index{
+		Columns:   []string{"c"},
+		Positions: []int{0},
+		Entries: []IndexEntry{
+			{Values: []string{""}, Nulls: []bool{true}, Offsets: []int64{0}},
+			{Values: []string{"1"}, Nulls: []bool{false}, Offsets: []int64{10, 70}},
+			{Values: []string{"abc"}, Nulls: []bool{false}, Offsets: []int64{20}},
+			{Values: []string{" 2"}, Nulls: []bool{false}, Offsets: []int64{30}},
+			{Values: []string{"xyz"}, Nulls: []bool{false}, Offsets: []int64{40}},
+		},
+	}
+
+	values := []value.Primary{
+		value.NewString("ABC"),
+		value.NewInteger(1),
+		value.NewFloat(1),
+		value.NewNull(),
+		value.NewInteger(2),
+		value.NewString("notexist"),
+	}
+	expect := []int64{10, 20, 30, 70}
+
+	offsets := idx.SearchByValues(values, cmd.GetFlags())
+	if !reflect.DeepEqual(offsets, expect) {
+		t.Errorf("offsets = %v, want %v", offsets, expect)
+	}
+}
+
+var equalityKeyTokensTests = []struct {
+	Value  value.Primary
+	Result []string
+}{
+	{Value: value.NewNull(), Result: nil},
+	{Value: value.NewString(" 1"), Result: []string{"I1", "D1000000000", "B" + "true", "S1"}},
+	{Value: value.NewFloat(-0), Result: []string{"I0", "F0", "D0", "Bfalse"}},
+	{Value: value.NewString("abc"), Result: []string{"SABC"}},
+	{Value: value.NewInterval(1, 0, 0), Result: []string{"V2592000000000000"}},
+	{Value: value.NewString("30 days"), Result: []string{"V2592000000000000", "S30 DAYS"}},
+}
+
+func TestEqualityKeyTokens(t *testing.T) {
+	for _, v := range equalityKeyTokensTests {
+		result := equalityKeyTokens(v.Value, cmd.GetFlags())
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("tokens = %q, want %q for %s", result, v.Result, v.Value)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/parser"
 )
@@ -45,10 +46,14 @@ func (it InlineTableMap) Set(inlineTable parser.InlineTable, parentFilter *Filte
 	if inlineTable.IsRecursive() {
 		filter.RecursiveTable = &inlineTable
 	}
+
+	filter.plan.begin()
+	start := time.Now()
 	view, err := Select(inlineTable.Query, filter)
 	if err != nil {
 		return err
 	}
+	filter.plan.end(newInlineTablePlanNode(inlineTable), view.RecordLen(), start)
 
 	err = view.Header.Update(inlineTable.Name.Literal, inlineTable.Fields)
 	if err != nil {
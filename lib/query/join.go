@@ -1,12 +1,88 @@
 package query
 
 import (
+	"fmt"
 	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/ternary"
 )
 
+type JoinStrategy int
+
+const (
+	NestedLoopJoin JoinStrategy = iota
+	HashJoin
+	SortMergeJoin
+)
+
+func (s JoinStrategy) String() string {
+	switch s {
+	case HashJoin:
+		return "Hash Join"
+	case SortMergeJoin:
+		return "Sort-Merge Join"
+	}
+	return "Nested Loop Join"
+}
+
+// EquiJoinStrategy returns the strategy used for joins with equality conditions.
+// When a memory limit is set, the sort-merge join is used because sorted lists of join keys take less memory than a hash table.
+func EquiJoinStrategy(flags *cmd.Flags) JoinStrategy {
+	if 0 < flags.MemoryLimit {
+		return SortMergeJoin
+	}
+	return HashJoin
+}
+
+type JoinStatistics struct {
+	Strategy       JoinStrategy
+	RecordLen      int
+	JoinRecordLen  int
+	BuildRecordLen int
+}
+
+func (s JoinStatistics) String() string {
+	if s.Strategy == HashJoin {
+		return fmt.Sprintf("%s (%d x %d records, hash table of %d records)", s.Strategy, s.RecordLen, s.JoinRecordLen, s.BuildRecordLen)
+	}
+	return fmt.Sprintf("%s (%d x %d records)", s.Strategy, s.RecordLen, s.JoinRecordLen)
+}
+
+type JoinLog struct {
+	mtx   *sync.Mutex
+	stats []JoinStatistics
+}
+
+func NewJoinLog() *JoinLog {
+	return &JoinLog{
+		mtx: &sync.Mutex{},
+	}
+}
+
+func (l *JoinLog) Add(stats JoinStatistics) {
+	l.mtx.Lock()
+	l.stats = append(l.stats, stats)
+	l.mtx.Unlock()
+}
+
+func (l *JoinLog) List() []JoinStatistics {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return append([]JoinStatistics(nil), l.stats...)
+}
+
+func (l *JoinLog) Clear() {
+	l.mtx.Lock()
+	l.stats = nil
+	l.mtx.Unlock()
+}
+
 func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.QueryExpression, []parser.FieldReference, []parser.FieldReference, error) {
 	if join.Natural.IsEmpty() && join.Condition == nil {
 		return nil, nil, nil, nil
@@ -101,14 +177,97 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 	return logic, includeFields, excludeFields, nil
 }
 
-func CrossJoin(view *View, joinView *View) {
+func isLateralTable(expr parser.QueryExpression) bool {
+	table, ok := expr.(parser.Table)
+	return ok && table.IsLateral()
+}
+
+func joinViews(join parser.Join, view *View, joinView *View, condition parser.QueryExpression, filter *Filter) error {
+	switch resolveJoinType(join) {
+	case parser.CROSS:
+		CrossJoin(view, joinView, filter.flags())
+	case parser.INNER:
+		return InnerJoin(view, joinView, condition, filter)
+	case parser.OUTER:
+		return OuterJoin(view, joinView, condition, join.Direction.Token, filter)
+	}
+	return nil
+}
+
+// LateralJoin loads the lateral table for each record of the view and joins them with joinFn.
+// If the view has no records, the table is loaded once with a record of nulls to determine the header.
+func LateralJoin(view *View, table parser.QueryExpression, filter *Filter, joinFn func(*View, *View) error) (time.Duration, error) {
+	lateral := table.(parser.Table)
+
+	records := view.RecordSet
+	if view.RecordLen() < 1 {
+		records = RecordSet{NewEmptyRecord(view.FieldLen())}
+	}
+
+	recordViews := make([]*View, len(records))
+	joinViews := make([]*View, len(records))
+	var joinHeader Header
+
+	loopsFrom := filter.plan.size()
+	for i, record := range records {
+		recordViews[i] = &View{
+			Header:    view.Header.Copy(),
+			RecordSet: RecordSet{record},
+		}
+
+		start := time.Now()
+		joinView, err := loadView(lateral, NewFilterForRecord(recordViews[i], 0, filter).CreateNode(), false, false)
+		if err != nil {
+			return 0, err
+		}
+		filter.plan.add(filter.plan.tableNode(lateral, filter), joinView.RecordLen(), time.Since(start))
+
+		if joinHeader == nil && (0 < joinView.FieldLen() || i == len(records)-1) {
+			joinHeader = joinView.Header
+		}
+		joinViews[i] = joinView
+	}
+	filter.plan.mergeLoops(loopsFrom)
+
+	for _, joinView := range joinViews {
+		if joinView.RecordLen() < 1 {
+			joinView.Header = joinHeader.Copy()
+			continue
+		}
+		if !joinView.Header.equalColumns(joinHeader) {
+			return 0, NewLateralTableFieldsNotMatchError(lateral)
+		}
+	}
+
+	if err := filter.Aliases.Add(lateral.Name(), ""); err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	recordSet := make(RecordSet, 0, view.RecordLen())
+	for i := range recordViews {
+		if err := joinFn(recordViews[i], joinViews[i]); err != nil {
+			return 0, err
+		}
+		if 0 < view.RecordLen() {
+			recordSet = append(recordSet, recordViews[i].RecordSet...)
+		}
+	}
+
+	view.Header = recordViews[len(recordViews)-1].Header
+	view.RecordSet = recordSet
+	view.FileInfo = nil
+	return time.Since(start), nil
+}
+
+func CrossJoin(view *View, joinView *View, flags *cmd.Flags) {
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore)).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), flags.CPU).Run(func(index int) {
 		start := index * joinView.RecordLen()
 		for i := 0; i < joinView.RecordLen(); i++ {
-			records[start+i] = append(view.RecordSet[index], joinView.RecordSet[i]...)
+			records[start+i] = mergeRecord(view.RecordSet[index], joinView.RecordSet[i])
 		}
 	})
 
@@ -119,13 +278,38 @@ func CrossJoin(view *View, joinView *View) {
 
 func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, parentFilter *Filter) error {
 	if condition == nil {
-		CrossJoin(view, joinView)
+		CrossJoin(view, joinView, parentFilter.flags())
 		return nil
 	}
 
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	if keys, joinKeys := EquiJoinKeys(condition, mergedHeader, view.FieldLen()); 0 < len(keys) {
+		matchesList, err := equiJoin(view, joinView, keys, joinKeys, mergedHeader, condition, parentFilter, func(r1 Record, r2 Record) Record {
+			return mergeRecord(r1, r2)
+		})
+		if err != nil {
+			return err
+		}
+
+		records := make(RecordSet, 0, view.RecordLen())
+		for _, matches := range matchesList {
+			for _, m := range matches {
+				records = append(records, m.record)
+			}
+		}
+
+		view.Header = mergedHeader
+		view.RecordSet = records
+		view.FileInfo = nil
+		return nil
+	}
+
+	if parentFilter.flags().Stats {
+		parentFilter.executedJoins().Add(JoinStatistics{Strategy: NestedLoopJoin, RecordLen: view.RecordLen(), JoinRecordLen: joinView.RecordLen()})
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.flags().CPU)
 	recordsList := make([]RecordSet, gm.Number)
 	for i := 0; i < gm.Number; i++ {
 		gm.Add()
@@ -148,7 +332,7 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 						break InnerJoinLoop
 					}
 
-					mergedRecord := append(view.RecordSet[i], joinView.RecordSet[j]...)
+					mergedRecord := mergeRecord(view.RecordSet[i], joinView.RecordSet[j])
 					filter.Records[0].View.RecordSet[0] = mergedRecord
 
 					primary, e := filter.Evaluate(condition)
@@ -192,7 +376,64 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	viewEmptyRecord := NewEmptyRecord(view.FieldLen())
 	joinViewEmptyRecord := NewEmptyRecord(joinView.FieldLen())
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore))
+	leftFieldLen := view.FieldLen()
+	if direction == parser.RIGHT {
+		leftFieldLen = joinView.FieldLen()
+	}
+	if keys, joinKeys := EquiJoinKeys(condition, mergedHeader, leftFieldLen); 0 < len(keys) {
+		if direction == parser.RIGHT {
+			keys, joinKeys = joinKeys, keys
+		}
+
+		merge := func(r1 Record, r2 Record) Record {
+			return mergeRecord(r1, r2)
+		}
+		if direction == parser.RIGHT {
+			merge = func(r1 Record, r2 Record) Record {
+				return mergeRecord(r2, r1)
+			}
+		}
+
+		matchesList, err := equiJoin(view, joinView, keys, joinKeys, mergedHeader, condition, parentFilter, merge)
+		if err != nil {
+			return err
+		}
+
+		records := make(RecordSet, 0, view.RecordLen())
+		joinViewMatches := make([]bool, joinView.RecordLen())
+		for i, matches := range matchesList {
+			if len(matches) < 1 {
+				records = append(records, merge(view.RecordSet[i], joinViewEmptyRecord))
+				continue
+			}
+			for _, m := range matches {
+				records = append(records, m.record)
+				joinViewMatches[m.index] = true
+			}
+		}
+		if direction == parser.FULL {
+			for i := 0; i < joinView.RecordLen(); i++ {
+				if !joinViewMatches[i] {
+					records = append(records, mergeRecord(viewEmptyRecord, joinView.RecordSet[i]))
+				}
+			}
+		}
+
+		if direction == parser.RIGHT {
+			view, joinView = joinView, view
+		}
+
+		view.Header = mergedHeader
+		view.RecordSet = records
+		view.FileInfo = nil
+		return nil
+	}
+
+	if parentFilter.flags().Stats {
+		parentFilter.executedJoins().Add(JoinStatistics{Strategy: NestedLoopJoin, RecordLen: view.RecordLen(), JoinRecordLen: joinView.RecordLen()})
+	}
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), parentFilter.flags().CPU)
 
 	recordsList := make([]RecordSet, gm.Number)
 	joinViewMatchesList := make([][]bool, gm.Number)
@@ -224,9 +465,9 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 					var mergedRecord Record
 					switch direction {
 					case parser.RIGHT:
-						mergedRecord = append(joinView.RecordSet[j], view.RecordSet[i]...)
+						mergedRecord = mergeRecord(joinView.RecordSet[j], view.RecordSet[i])
 					default:
-						mergedRecord = append(view.RecordSet[i], joinView.RecordSet[j]...)
+						mergedRecord = mergeRecord(view.RecordSet[i], joinView.RecordSet[j])
 					}
 					filter.Records[0].View.RecordSet[0] = mergedRecord
 
@@ -248,9 +489,9 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 					var record Record
 					switch direction {
 					case parser.RIGHT:
-						record = append(joinViewEmptyRecord, view.RecordSet[i]...)
+						record = mergeRecord(joinViewEmptyRecord, view.RecordSet[i])
 					default:
-						record = append(view.RecordSet[i], joinViewEmptyRecord...)
+						record = mergeRecord(view.RecordSet[i], joinViewEmptyRecord)
 					}
 					records = append(records, record)
 
@@ -278,7 +519,7 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 				}
 			}
 			if !match {
-				record := append(viewEmptyRecord, joinView.RecordSet[i]...)
+				record := mergeRecord(viewEmptyRecord, joinView.RecordSet[i])
 				recordsList[len(recordsList)-1] = append(recordsList[len(recordsList)-1], record)
 			}
 		}
@@ -294,6 +535,319 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	return nil
 }
 
+func mergeRecord(r1 Record, r2 Record) Record {
+	record := make(Record, len(r1)+len(r2))
+	copy(record, r1)
+	copy(record[len(r1):], r2)
+	return record
+}
+
+func EquiJoinKeys(condition parser.QueryExpression, mergedHeader Header, leftFieldLen int) ([]int, []int) {
+	var fieldIndex = func(expr parser.QueryExpression) (int, bool) {
+		var idx int
+		var err error
+
+		switch e := expr.(type) {
+		case parser.FieldReference:
+			idx, err = mergedHeader.Contains(e)
+		case parser.ColumnNumber:
+			idx, err = mergedHeader.ContainsNumber(e)
+		default:
+			return -1, false
+		}
+		return idx, err == nil
+	}
+
+	var keys []int
+	var joinKeys []int
+
+	for _, expr := range splitConjunction(condition) {
+		comp, ok := expr.(parser.Comparison)
+		if !ok || comp.Operator != "=" {
+			continue
+		}
+
+		lidx, ok := fieldIndex(comp.LHS)
+		if !ok {
+			continue
+		}
+		ridx, ok := fieldIndex(comp.RHS)
+		if !ok {
+			continue
+		}
+		if leftFieldLen <= lidx {
+			lidx, ridx = ridx, lidx
+		}
+		if leftFieldLen <= lidx || ridx < leftFieldLen {
+			continue
+		}
+
+		keys = append(keys, lidx)
+		joinKeys = append(joinKeys, ridx-leftFieldLen)
+	}
+	return keys, joinKeys
+}
+
+type joinMatch struct {
+	index  int
+	record Record
+}
+
+type joinPair struct {
+	index     int
+	joinIndex int
+	record    Record
+}
+
+func equiJoin(view *View, joinView *View, keys []int, joinKeys []int, mergedHeader Header, condition parser.QueryExpression, parentFilter *Filter, merge func(Record, Record) Record) ([][]joinMatch, error) {
+	if view.RecordLen() < 1 || joinView.RecordLen() < 1 {
+		return make([][]joinMatch, view.RecordLen()), nil
+	}
+
+	filter := newJoinFilter(mergedHeader, parentFilter)
+	filter.Records[0].View.RecordSet[0] = merge(view.RecordSet[0], joinView.RecordSet[0])
+	if _, err := filter.Evaluate(condition); err != nil {
+		return nil, err
+	}
+
+	if EquiJoinStrategy(parentFilter.flags()) == SortMergeJoin {
+		return sortMergeJoin(view, joinView, keys, joinKeys, mergedHeader, condition, parentFilter, merge)
+	}
+	return hashJoin(view, joinView, keys, joinKeys, mergedHeader, condition, parentFilter, merge)
+}
+
+func newJoinFilter(mergedHeader Header, parentFilter *Filter) *Filter {
+	return NewFilterForRecord(
+		&View{
+			Header:    mergedHeader,
+			RecordSet: make(RecordSet, 1),
+		},
+		0,
+		parentFilter,
+	)
+}
+
+func hashJoin(view *View, joinView *View, keys []int, joinKeys []int, mergedHeader Header, condition parser.QueryExpression, parentFilter *Filter, merge func(Record, Record) Record) ([][]joinMatch, error) {
+	matchesList := make([][]joinMatch, view.RecordLen())
+
+	buildView, buildKeys, probeView, probeKeys := joinView, joinKeys, view, keys
+	probeIsView := true
+	if view.RecordLen() < joinView.RecordLen() {
+		buildView, buildKeys, probeView, probeKeys = view, keys, joinView, joinKeys
+		probeIsView = false
+	}
+
+	if parentFilter.flags().Stats {
+		parentFilter.executedJoins().Add(JoinStatistics{Strategy: HashJoin, RecordLen: view.RecordLen(), JoinRecordLen: joinView.RecordLen(), BuildRecordLen: buildView.RecordLen()})
+	}
+
+	buildHashKeys := make([][]string, buildView.RecordLen())
+	NewGoroutineTaskManager(buildView.RecordLen(), -1, parentFilter.flags().CPU).Run(func(index int) {
+		buildHashKeys[index] = joinKeyTokens(buildView.RecordSet[index], buildKeys, parentFilter.flags())
+	})
+
+	hashTable := make(map[string][]int, buildView.RecordLen())
+	for i, hashKeys := range buildHashKeys {
+		for _, k := range hashKeys {
+			hashTable[k] = append(hashTable[k], i)
+		}
+	}
+	buildHashKeys = nil
+
+	gm := NewGoroutineTaskManager(probeView.RecordLen(), -1, parentFilter.flags().CPU)
+	pairsList := make([][]joinPair, gm.Number)
+	for i := 0; i < gm.Number; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			pairs := make([]joinPair, 0, end-start)
+			filter := newJoinFilter(mergedHeader, parentFilter)
+
+		HashJoinLoop:
+			for i := start; i < end; i++ {
+				var candidates []int
+				for _, k := range joinKeyTokens(probeView.RecordSet[i], probeKeys, parentFilter.flags()) {
+					candidates = append(candidates, hashTable[k]...)
+				}
+				if len(candidates) < 1 {
+					continue
+				}
+				sort.Ints(candidates)
+
+				for j, c := range candidates {
+					if gm.HasError() {
+						break HashJoinLoop
+					}
+					if 0 < j && candidates[j-1] == c {
+						continue
+					}
+
+					pair := joinPair{index: i, joinIndex: c}
+					if !probeIsView {
+						pair.index, pair.joinIndex = c, i
+					}
+					pair.record = merge(view.RecordSet[pair.index], joinView.RecordSet[pair.joinIndex])
+					filter.Records[0].View.RecordSet[0] = pair.record
+
+					primary, e := filter.Evaluate(condition)
+					if e != nil {
+						gm.SetError(e)
+						break HashJoinLoop
+					}
+					if primary.Ternary() == ternary.TRUE {
+						pairs = append(pairs, pair)
+					}
+				}
+			}
+
+			pairsList[thIdx] = pairs
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, gm.Err()
+	}
+
+	for _, pairs := range pairsList {
+		for _, p := range pairs {
+			matchesList[p.index] = append(matchesList[p.index], joinMatch{index: p.joinIndex, record: p.record})
+		}
+	}
+	return matchesList, nil
+}
+
+type sortedJoinKey struct {
+	token string
+	index int
+}
+
+func sortJoinKeys(view *View, indices []int, flags *cmd.Flags) []sortedJoinKey {
+	tokensList := make([][]string, view.RecordLen())
+	NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(func(index int) {
+		tokensList[index] = joinKeyTokens(view.RecordSet[index], indices, flags)
+	})
+
+	list := make([]sortedJoinKey, 0, view.RecordLen())
+	for i, tokens := range tokensList {
+		for _, t := range tokens {
+			list = append(list, sortedJoinKey{token: t, index: i})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].token == list[j].token {
+			return list[i].index < list[j].index
+		}
+		return list[i].token < list[j].token
+	})
+	return list
+}
+
+func sortMergeJoin(view *View, joinView *View, keys []int, joinKeys []int, mergedHeader Header, condition parser.QueryExpression, parentFilter *Filter, merge func(Record, Record) Record) ([][]joinMatch, error) {
+	if parentFilter.flags().Stats {
+		parentFilter.executedJoins().Add(JoinStatistics{Strategy: SortMergeJoin, RecordLen: view.RecordLen(), JoinRecordLen: joinView.RecordLen()})
+	}
+
+	sortedKeys := sortJoinKeys(view, keys, parentFilter.flags())
+	sortedJoinKeys := sortJoinKeys(joinView, joinKeys, parentFilter.flags())
+
+	candidatesList := make([][]int, view.RecordLen())
+	i, j := 0, 0
+	for i < len(sortedKeys) && j < len(sortedJoinKeys) {
+		switch {
+		case sortedKeys[i].token < sortedJoinKeys[j].token:
+			i++
+		case sortedJoinKeys[j].token < sortedKeys[i].token:
+			j++
+		default:
+			token := sortedKeys[i].token
+			iEnd := i + 1
+			for iEnd < len(sortedKeys) && sortedKeys[iEnd].token == token {
+				iEnd++
+			}
+			jEnd := j + 1
+			for jEnd < len(sortedJoinKeys) && sortedJoinKeys[jEnd].token == token {
+				jEnd++
+			}
+
+			for _, k := range sortedKeys[i:iEnd] {
+				for _, jk := range sortedJoinKeys[j:jEnd] {
+					candidatesList[k.index] = append(candidatesList[k.index], jk.index)
+				}
+			}
+			i, j = iEnd, jEnd
+		}
+	}
+	sortedKeys = nil
+	sortedJoinKeys = nil
+
+	matchesList := make([][]joinMatch, view.RecordLen())
+
+	gm := NewGoroutineTaskManager(view.RecordLen(), -1, parentFilter.flags().CPU)
+	for i := 0; i < gm.Number; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			filter := newJoinFilter(mergedHeader, parentFilter)
+
+		SortMergeJoinLoop:
+			for i := start; i < end; i++ {
+				candidates := candidatesList[i]
+				sort.Ints(candidates)
+
+				for j, c := range candidates {
+					if gm.HasError() {
+						break SortMergeJoinLoop
+					}
+					if 0 < j && candidates[j-1] == c {
+						continue
+					}
+
+					record := merge(view.RecordSet[i], joinView.RecordSet[c])
+					filter.Records[0].View.RecordSet[0] = record
+
+					primary, e := filter.Evaluate(condition)
+					if e != nil {
+						gm.SetError(e)
+						break SortMergeJoinLoop
+					}
+					if primary.Ternary() == ternary.TRUE {
+						matchesList[i] = append(matchesList[i], joinMatch{index: c, record: record})
+					}
+				}
+			}
+
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, gm.Err()
+	}
+	return matchesList, nil
+}
+
+func joinKeyTokens(record Record, indices []int, flags *cmd.Flags) []string {
+	keys := []string{""}
+	for _, idx := range indices {
+		tokens := equalityKeyTokens(record[idx].Value(), flags)
+		if len(tokens) < 1 {
+			return nil
+		}
+
+		combined := make([]string, 0, len(keys)*len(tokens))
+		for _, k := range keys {
+			for _, t := range tokens {
+				combined = append(combined, k+strconv.Itoa(len(t))+":"+t)
+			}
+		}
+		keys = combined
+	}
+	return keys
+}
+
 func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	if i1 < 1 || i2 < 1 {
 		return defaultMinimumRequired
@@ -7,6 +7,8 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func naturalJoinTestFieldReference(view string, column string) parser.FieldReference {
@@ -300,7 +302,7 @@ func TestCrossJoin(t *testing.T) {
 		},
 	}
 
-	CrossJoin(view, joinView)
+	CrossJoin(view, joinView, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("Cross Join: result = %v, want %v", view, expect)
 	}
@@ -523,6 +525,166 @@ var innerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Inner Join Using Hash Table With Mixed Types",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString(" ABC"),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("abc"),
+					value.NewInteger(1),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewFloat(1),
+					value.NewInteger(2),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(4),
+				}),
+				NewRecordWithId(5, []value.Primary{
+					value.NewBoolean(true),
+					value.NewInteger(5),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				RHS:      parser.NewIntegerValue(4),
+				Operator: "<>",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewFloat(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(5),
+					value.NewBoolean(true),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString(" ABC"),
+					value.NewString("str3"),
+					value.NewInteger(1),
+					value.NewString("abc"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Inner Join Using Hash Table With Intervals",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInterval(1, 0, 0),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInterval(0, 2, 0),
+					value.NewString("str2"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInterval(0, 30, 0),
+					value.NewInteger(1),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2 days"),
+					value.NewInteger(2),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInterval(0, 3, 0),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: "=",
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInterval(1, 0, 0),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewInterval(0, 30, 0),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInterval(0, 2, 0),
+					value.NewString("str2"),
+					value.NewInteger(2),
+					value.NewString("2 days"),
+					value.NewInteger(2),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Inner Join Filter Error",
 		View: &View{
@@ -566,6 +728,9 @@ var innerJoinTests = []struct {
 
 func TestInnerJoin(t *testing.T) {
 	flags := cmd.GetFlags()
+	defer func() {
+		flags.MemoryLimit = 0
+	}()
 
 	for _, v := range innerJoinTests {
 		flags.CPU = 1
@@ -577,21 +742,27 @@ func TestInnerJoin(t *testing.T) {
 			v.Filter = NewEmptyFilter()
 		}
 
-		err := InnerJoin(v.View, v.JoinView, v.Condition, v.Filter)
-		if err != nil {
-			if len(v.Error) < 1 {
-				t.Errorf("%s: unexpected error %q", v.Name, err)
-			} else if err.Error() != v.Error {
-				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+		for _, memoryLimit := range []int{0, 1} {
+			flags.MemoryLimit = memoryLimit
+			strategy := EquiJoinStrategy(flags)
+
+			view := v.View.Copy()
+			err := InnerJoin(view, v.JoinView.Copy(), v.Condition, v.Filter)
+			if err != nil {
+				if len(v.Error) < 1 {
+					t.Errorf("%s: unexpected error %q with %s", v.Name, err, strategy)
+				} else if err.Error() != v.Error {
+					t.Errorf("%s: error %q, want error %q with %s", v.Name, err.Error(), v.Error, strategy)
+				}
+				continue
+			}
+			if 0 < len(v.Error) {
+				t.Errorf("%s: no error, want error %q with %s", v.Name, v.Error, strategy)
+				continue
+			}
+			if !reflect.DeepEqual(view, v.Result) {
+				t.Errorf("%s: result = %v, want %v with %s", v.Name, view, v.Result, strategy)
 			}
-			continue
-		}
-		if 0 < len(v.Error) {
-			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
-			continue
-		}
-		if !reflect.DeepEqual(v.View, v.Result) {
-			t.Errorf("%s: result = %v, want %v", v.Name, v.View, v.Result)
 		}
 	}
 }
@@ -974,35 +1145,253 @@ var outerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Full Outer Join Using Hash Table",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str2"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewNull(),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str33"),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: "=",
+		},
+		Direction: parser.FULL,
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewString("1"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewString("str2"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(1),
+					value.NewNull(),
+					value.NewString("str11"),
+				}),
+			},
+		},
+	},
 }
 
 func TestOuterJoin(t *testing.T) {
+	flags := cmd.GetFlags()
+	defer func() {
+		flags.MemoryLimit = 0
+	}()
+
 	for _, v := range outerJoinTests {
 		if v.Filter == nil {
 			v.Filter = NewEmptyFilter()
 		}
 
-		err := OuterJoin(v.View, v.JoinView, v.Condition, v.Direction, v.Filter)
-		if err != nil {
-			if len(v.Error) < 1 {
-				t.Errorf("%s: unexpected error %q", v.Name, err)
-			} else if err.Error() != v.Error {
-				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+		for _, memoryLimit := range []int{0, 1} {
+			flags.MemoryLimit = memoryLimit
+			strategy := EquiJoinStrategy(flags)
+
+			view := v.View.Copy()
+			err := OuterJoin(view, v.JoinView.Copy(), v.Condition, v.Direction, v.Filter)
+			if err != nil {
+				if len(v.Error) < 1 {
+					t.Errorf("%s: unexpected error %q with %s", v.Name, err, strategy)
+				} else if err.Error() != v.Error {
+					t.Errorf("%s: error %q, want error %q with %s", v.Name, err.Error(), v.Error, strategy)
+				}
+				continue
+			}
+			if 0 < len(v.Error) {
+				t.Errorf("%s: no error, want error %q with %s", v.Name, v.Error, strategy)
+				continue
+			}
+			if !reflect.DeepEqual(view, v.Result) {
+				t.Errorf("%s: result = %v, want %v with %s", v.Name, view, v.Result, strategy)
+				t.Log(view.RecordSet)
+				t.Log(v.Result.RecordSet)
 			}
-			continue
-		}
-		if 0 < len(v.Error) {
-			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
-			continue
 		}
-		if !reflect.DeepEqual(v.View, v.Result) {
-			t.Errorf("%s: result = %v, want %v", v.Name, v.View, v.Result)
-			t.Log(v.View.RecordSet)
-			t.Log(v.Result.RecordSet)
+	}
+}
+
+var equiJoinKeysTests = []struct {
+	Name      string
+	Condition parser.QueryExpression
+	Keys      []int
+	JoinKeys  []int
+}{
+	{
+		Name: "Equality Conditions",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				Operator: "=",
+			},
+			RHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(1)},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+					Operator: "=",
+				},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+		},
+		Keys:     []int{2, 1},
+		JoinKeys: []int{2, 1},
+	},
+	{
+		Name: "Ignore Non-Equality Conditions",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "<",
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				Operator: "=",
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+		},
+	},
+	{
+		Name: "Ignore Disjunction",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: "=",
+			},
+			RHS:      parser.NewTernaryValue(ternary.FALSE),
+			Operator: parser.Token{Token: parser.OR, Literal: "OR"},
+		},
+	},
+	{
+		Name: "Ignore Ambiguous Field",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: "=",
+		},
+	},
+}
+
+func TestEquiJoinKeys(t *testing.T) {
+	header := MergeHeader(NewHeaderWithId("table1", []string{"column1", "column2"}), NewHeaderWithId("table2", []string{"column1", "column3"}))
+
+	for _, v := range equiJoinKeysTests {
+		keys, joinKeys := EquiJoinKeys(v.Condition, header, 3)
+		if !reflect.DeepEqual(keys, v.Keys) || !reflect.DeepEqual(joinKeys, v.JoinKeys) {
+			t.Errorf("%s: keys = %v, %v, want %v, %v", v.Name, keys, joinKeys, v.Keys, v.JoinKeys)
 		}
 	}
 }
 
+func TestExecutedJoins(t *testing.T) {
+	flags := cmd.GetFlags()
+	flags.Stats = true
+	defer func() {
+		flags.Stats = false
+		ExecutedJoins.Clear()
+	}()
+
+	view := GenerateBenchView("t1", 3)
+	joinView := GenerateBenchView("t2", 2)
+	InnerJoin(view, joinView, parser.Comparison{
+		LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "c1"}},
+		RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "c1"}},
+		Operator: "=",
+	}, NewEmptyFilter())
+
+	view = GenerateBenchView("t1", 3)
+	joinView = GenerateBenchView("t2", 2)
+	OuterJoin(view, joinView, parser.Comparison{
+		LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "c1"}},
+		RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "c1"}},
+		Operator: "<",
+	}, parser.LEFT, NewEmptyFilter())
+
+	flags.MemoryLimit = 1
+	view = GenerateBenchView("t1", 3)
+	joinView = GenerateBenchView("t2", 2)
+	InnerJoin(view, joinView, parser.Comparison{
+		LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "c1"}},
+		RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "c1"}},
+		Operator: "=",
+	}, NewEmptyFilter())
+	flags.MemoryLimit = 0
+
+	expect := []string{
+		"Hash Join (3 x 2 records, hash table of 2 records)",
+		"Nested Loop Join (3 x 2 records)",
+		"Sort-Merge Join (3 x 2 records)",
+	}
+	list := ExecutedJoins.List()
+	result := make([]string, 0, len(list))
+	for _, s := range list {
+		result = append(result, s.String())
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("executed joins = %q, want %q", result, expect)
+	}
+}
+
 var calcMinimumRequiredTests = []struct {
 	Int1    int
 	Int2    int
@@ -1055,7 +1444,7 @@ func BenchmarkCrossJoin(b *testing.B) {
 		view := GenerateBenchView("t1", 100)
 		joinView := GenerateBenchView("t2", 100)
 
-		CrossJoin(view, joinView)
+		CrossJoin(view, joinView, cmd.GetFlags())
 	}
 }
 
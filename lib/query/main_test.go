@@ -68,6 +68,7 @@ func setup() {
 	}
 
 	copyfile(filepath.Join(TestDir, "table_sjis.csv"), filepath.Join(TestDataDir, "table_sjis.csv"))
+	copyfile(filepath.Join(TestDir, "table_utf16.csv"), filepath.Join(TestDataDir, "table_utf16.csv"))
 	copyfile(filepath.Join(TestDir, "table_noheader.csv"), filepath.Join(TestDataDir, "table_noheader.csv"))
 	copyfile(filepath.Join(TestDir, "table_broken.csv"), filepath.Join(TestDataDir, "table_broken.csv"))
 	copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
@@ -83,6 +84,7 @@ func setup() {
 	copyfile(filepath.Join(TestDir, "drop_columns.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "rename_column.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "updated_file_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	copyfile(filepath.Join(TestDir, "drop_table.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "dup_name.csv"), filepath.Join(TestDataDir, "dup_name.csv"))
 
 	copyfile(filepath.Join(TestDir, "table3.tsv"), filepath.Join(TestDataDir, "table3.tsv"))
@@ -94,6 +96,11 @@ func setup() {
 
 	copyfile(filepath.Join(TestDir, "table6.ltsv"), filepath.Join(TestDataDir, "table6.ltsv"))
 
+	copyfile(filepath.Join(TestDir, "table7.xlsx"), filepath.Join(TestDataDir, "table7.xlsx"))
+
+	copyfile(filepath.Join(TestDir, "table8.csv.gz"), filepath.Join(TestDataDir, "table8.csv.gz"))
+	copyfile(filepath.Join(TestDir, "table9.csv.bz2"), filepath.Join(TestDataDir, "table9.csv.bz2"))
+
 	copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 
 	copyfile(filepath.Join(TestDir, "autoselect"), filepath.Join(TestDataDir, "autoselect"))
@@ -138,7 +145,7 @@ func initFlag(flags *cmd.Flags) {
 	}
 
 	flags.Repository = "."
-	flags.Location = TestLocation
+	flags.SetLocation(TestLocation)
 	flags.DatetimeFormat = []string{}
 	flags.WaitTimeout = 15
 	flags.Delimiter = ','
@@ -146,6 +153,8 @@ func initFlag(flags *cmd.Flags) {
 	flags.Encoding = text.UTF8
 	flags.NoHeader = false
 	flags.WithoutNull = false
+	flags.NumberAsDecimal = false
+	flags.InferTypes = 0
 	flags.Format = cmd.TEXT
 	flags.WriteEncoding = text.UTF8
 	flags.WriteDelimiter = ','
@@ -160,6 +169,7 @@ func initFlag(flags *cmd.Flags) {
 	flags.Color = false
 	flags.Quiet = false
 	flags.CPU = cpu
+	flags.MemoryLimit = 0
 	flags.Stats = false
 	flags.DelimitAutomatically = false
 	flags.DelimiterPositions = nil
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -29,26 +30,27 @@ type ObjectWriter struct {
 	Title2       string
 	Title2Effect string
 
-	buf bytes.Buffer
+	buf   bytes.Buffer
+	flags *cmd.Flags
 
 	subBlock  int
 	lineWidth int
 	column    int
 }
 
-func NewObjectWriter() *ObjectWriter {
+func NewObjectWriter(filter *Filter) *ObjectWriter {
 	maxWidth := DefaultLineWidth
-	if Terminal != nil {
-		if termw, _, err := Terminal.GetSize(); err == nil {
+	if t := filter.terminal(); t != nil {
+		if termw, _, err := t.GetSize(); err == nil {
 			maxWidth = termw
 		}
-	} else {
+	} else if !filter.inSession() {
 		if w, _, err := terminal.GetSize(int(ScreenFd)); err == nil {
 			maxWidth = w
 		}
 	}
 
-	palette, _ := cmd.GetPalette()
+	palette := filter.palette()
 
 	return &ObjectWriter{
 		MaxWidth:    maxWidth,
@@ -59,9 +61,14 @@ func NewObjectWriter() *ObjectWriter {
 		lineWidth:   0,
 		column:      0,
 		subBlock:    0,
+		flags:       filter.flags(),
 	}
 }
 
+func (w *ObjectWriter) textWidth(s string) int {
+	return text.Width(s, w.flags.EastAsianEncoding, w.flags.CountDiacriticalSign, w.flags.CountFormatCode)
+}
+
 func (w *ObjectWriter) Clear() {
 	w.Title1 = ""
 	w.Title1Effect = ""
@@ -99,7 +106,7 @@ func (w *ObjectWriter) write(s string, effect string, withoutLineBreak bool) {
 		} else {
 			w.writeToBuf(w.Palette.Render(effect, s))
 		}
-		w.column = w.column + cmd.TextWidth(s)
+		w.column = w.column + w.textWidth(s)
 	}
 }
 
@@ -112,7 +119,7 @@ func (w *ObjectWriter) leadingSpacesWidth() int {
 }
 
 func (w *ObjectWriter) FitInLine(s string) bool {
-	if w.MaxWidth-w.Padding < w.column+cmd.TextWidth(s) {
+	if w.MaxWidth-w.Padding < w.column+w.textWidth(s) {
 		return false
 	}
 	return true
@@ -211,7 +218,7 @@ func (w *ObjectWriter) ClearBlock() {
 func (w *ObjectWriter) String() string {
 	var header bytes.Buffer
 	if 0 < len(w.Title1) || 0 < len(w.Title2) {
-		tw := cmd.TextWidth(w.Title1) + cmd.TextWidth(w.Title2)
+		tw := w.textWidth(w.Title1) + w.textWidth(w.Title2)
 		if 0 < len(w.Title1) && 0 < len(w.Title2) {
 			tw++
 		}
@@ -7,7 +7,7 @@ import (
 )
 
 func TestObjectWriter_String(t *testing.T) {
-	w := NewObjectWriter()
+	w := NewObjectWriter(NewEmptyFilter())
 	w.MaxWidth = 20
 
 	w.Write("aaa")
@@ -70,7 +70,7 @@ func TestObjectWriter_String(t *testing.T) {
 		t.Errorf("result = %q, want %q", result, expect)
 	}
 
-	w = NewObjectWriter()
+	w = NewObjectWriter(NewEmptyFilter())
 	w.MaxWidth = 20
 
 	w.Title1 = "title"
@@ -109,7 +109,7 @@ func TestObjectWriter_String(t *testing.T) {
 		t.Errorf("result = %s, want %s", result, expect)
 	}
 
-	w = NewObjectWriter()
+	w = NewObjectWriter(NewEmptyFilter())
 	w.MaxWidth = 20
 
 	w.Title1 = "title"
@@ -127,7 +127,7 @@ func TestObjectWriter_String(t *testing.T) {
 	}
 
 	cmd.GetFlags().SetColor(true)
-	w = NewObjectWriter()
+	w = NewObjectWriter(NewEmptyFilter())
 	w.MaxWidth = 20
 
 	w.Title1 = "title1"
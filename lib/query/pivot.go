@@ -0,0 +1,232 @@
+package query
+
+import (
+	"bytes"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func loadPivotView(pivot parser.Pivot, filter *Filter) (*View, error) {
+	view, err := loadView(pivot.Table, filter, false, false)
+	if err != nil {
+		return nil, err
+	}
+	view.Filter = filter
+
+	pivotIdx, err := view.Header.ContainsObject(pivot.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := map[int]bool{pivotIdx: true}
+	searchExpressions(pivot.Aggregate, func(expr parser.QueryExpression) bool {
+		switch expr.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			if idx, e := view.Header.ContainsObject(expr); e == nil {
+				excluded[idx] = true
+			}
+		}
+		return false
+	})
+
+	groupIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if !excluded[i] {
+			groupIndices = append(groupIndices, i)
+		}
+	}
+
+	keyBuf := new(bytes.Buffer)
+
+	pivotKeys := make(map[string]int, len(pivot.Values))
+	for i, v := range pivot.Values {
+		p, err := filter.Evaluate(v.(parser.Field).Object)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsNull(p) {
+			continue
+		}
+		keyBuf.Reset()
+		SerializeKey(keyBuf, p, filter.flags())
+		if _, ok := pivotKeys[keyBuf.String()]; !ok {
+			pivotKeys[keyBuf.String()] = i
+		}
+	}
+
+	groups := make(map[string]int)
+	groupRecords := make([]int, 0)
+	members := make([][][]int, 0)
+	groupValues := make([]value.Primary, len(groupIndices))
+
+	for i, record := range view.RecordSet {
+		keyBuf.Reset()
+		SerializeKey(keyBuf, record[pivotIdx].Value(), filter.flags())
+		valueIdx, ok := pivotKeys[keyBuf.String()]
+		if !ok {
+			continue
+		}
+
+		for j, idx := range groupIndices {
+			groupValues[j] = record[idx].Value()
+		}
+		keyBuf.Reset()
+		SerializeComparisonKeys(keyBuf, groupValues, filter.flags())
+		key := keyBuf.String()
+
+		groupIdx, ok := groups[key]
+		if !ok {
+			groupIdx = len(groupRecords)
+			groups[key] = groupIdx
+			groupRecords = append(groupRecords, i)
+			members = append(members, make([][]int, len(pivot.Values)))
+		}
+		members[groupIdx][valueIdx] = append(members[groupIdx][valueIdx], i)
+	}
+
+	fieldLen := view.FieldLen()
+	aggregateView := &View{
+		Header:    view.Header,
+		RecordSet: make(RecordSet, 0, len(groupRecords)*len(pivot.Values)),
+		Filter:    filter,
+		isGrouped: true,
+	}
+	for _, valueMembers := range members {
+		for _, indices := range valueMembers {
+			record := make(Record, fieldLen)
+			for j := 0; j < fieldLen; j++ {
+				primaries := make([]value.Primary, len(indices))
+				for k, idx := range indices {
+					primaries[k] = view.RecordSet[idx][j].Value()
+				}
+				record[j] = NewGroupCell(primaries)
+			}
+			aggregateView.RecordSet = append(aggregateView.RecordSet, record)
+		}
+	}
+
+	aggregates := make([]value.Primary, aggregateView.RecordLen())
+	err = NewFilterForSequentialEvaluation(aggregateView, filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		p, e := f.Evaluate(pivot.Aggregate)
+		if e != nil {
+			return e
+		}
+		aggregates[rIdx] = p
+		return nil
+	}, pivot.Aggregate)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(Header, 0, len(groupIndices)+len(pivot.Values))
+	for _, idx := range groupIndices {
+		f := view.Header[idx]
+		f.IsGroupKey = false
+		header = append(header, f)
+	}
+	pivotViewName := view.Header[pivotIdx].View
+	for _, v := range pivot.Values {
+		header = append(header, HeaderField{
+			View:        pivotViewName,
+			Column:      v.(parser.Field).Name(),
+			IsFromTable: true,
+		})
+	}
+
+	renumberHeader(header)
+
+	records := make(RecordSet, len(groupRecords))
+	for i, recordIdx := range groupRecords {
+		record := make(Record, 0, len(header))
+		for _, idx := range groupIndices {
+			record = append(record, NewCell(view.RecordSet[recordIdx][idx].Value()))
+		}
+		for j := range pivot.Values {
+			record = append(record, NewCell(aggregates[i*len(pivot.Values)+j]))
+		}
+		records[i] = record
+	}
+
+	return &View{
+		Header:    header,
+		RecordSet: records,
+	}, nil
+}
+
+func loadUnpivotView(unpivot parser.Unpivot, filter *Filter) (*View, error) {
+	view, err := loadView(unpivot.Table, filter, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, len(unpivot.Fields))
+	labels := make([]value.Primary, len(unpivot.Fields))
+	unpivoted := make(map[int]bool, len(unpivot.Fields))
+	for i, v := range unpivot.Fields {
+		field := v.(parser.Field)
+		idx, err := view.Header.ContainsObject(field.Object)
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = idx
+		unpivoted[idx] = true
+		if field.Alias != nil {
+			labels[i] = value.NewString(field.Alias.(parser.Identifier).Literal)
+		} else {
+			labels[i] = value.NewString(view.Header[idx].Column)
+		}
+	}
+
+	keptIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if !unpivoted[i] {
+			keptIndices = append(keptIndices, i)
+		}
+	}
+
+	header := make(Header, 0, len(keptIndices)+2)
+	for _, idx := range keptIndices {
+		header = append(header, view.Header[idx])
+	}
+	unpivotViewName := view.Header[indices[0]].View
+	for _, column := range []parser.Identifier{unpivot.NameColumn, unpivot.ValueColumn} {
+		header = append(header, HeaderField{
+			View:        unpivotViewName,
+			Column:      column.Literal,
+			IsFromTable: true,
+		})
+	}
+
+	renumberHeader(header)
+
+	records := make(RecordSet, 0, view.RecordLen()*len(indices))
+	for _, src := range view.RecordSet {
+		for i, idx := range indices {
+			val := src[idx].Value()
+			if value.IsNull(val) {
+				continue
+			}
+
+			record := make(Record, 0, len(header))
+			for _, kept := range keptIndices {
+				record = append(record, src[kept])
+			}
+			record = append(record, NewCell(labels[i]), NewCell(val))
+			records = append(records, record)
+		}
+	}
+
+	return &View{
+		Header:    header,
+		RecordSet: records,
+	}, nil
+}
+
+func renumberHeader(header Header) {
+	numbers := make(map[string]int)
+	for i := range header {
+		numbers[header[i].View]++
+		header[i].Number = numbers[header[i].View]
+	}
+}
@@ -30,25 +30,35 @@ const (
 var Version string
 var ViewCache = make(ViewMap, 10)
 var UncommittedViews = NewUncommittedViewMap()
+var ExecutedJoins = NewJoinLog()
+var FileContainer = file.NewContainer()
 
 var Formatter = NewStringFormatter()
 
 func ReleaseResources() error {
-	if err := ViewCache.Clean(); err != nil {
+	return releaseResources(ViewCache, FileContainer)
+}
+
+func ReleaseResourcesWithErrors() error {
+	return releaseResourcesWithErrors(ViewCache, FileContainer)
+}
+
+func releaseResources(viewCache ViewMap, container file.Container) error {
+	if err := viewCache.Clean(); err != nil {
 		return err
 	}
-	if err := file.UnlockAll(); err != nil {
+	if err := container.UnlockAll(); err != nil {
 		return err
 	}
 	return nil
 }
 
-func ReleaseResourcesWithErrors() error {
+func releaseResourcesWithErrors(viewCache ViewMap, container file.Container) error {
 	var errs []error
-	if err := ViewCache.CleanWithErrors(); err != nil {
+	if err := viewCache.CleanWithErrors(); err != nil {
 		errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
 	}
-	if err := file.UnlockAllWithErrors(); err != nil {
+	if err := container.UnlockAllWithErrors(); err != nil {
 		errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
 	}
 
@@ -62,20 +72,36 @@ type Procedure struct {
 	Filter           *Filter
 	ReturnVal        value.Primary
 	MeasurementStart time.Time
+
+	// Output is used to write the results of select queries in place of the attributes derived from the flags if it is not nil.
+	Output *FileInfo
+
+	affectedRows *int
 }
 
 func NewProcedure() *Procedure {
 	return &Procedure{
-		Filter: NewEmptyFilter(),
+		Filter:       NewEmptyFilter(),
+		affectedRows: new(int),
 	}
 }
 
 func (proc *Procedure) NewChildProcedure() *Procedure {
 	return &Procedure{
-		Filter: proc.Filter.CreateChildScope(),
+		Filter:       proc.Filter.CreateChildScope(),
+		Output:       proc.Output,
+		affectedRows: proc.affectedRows,
 	}
 }
 
+func (proc *Procedure) AffectedRows() int {
+	return *proc.affectedRows
+}
+
+func (proc *Procedure) ResetAffectedRows() {
+	*proc.affectedRows = 0
+}
+
 func (proc *Procedure) ExecuteChild(statements []parser.Statement) (StatementFlow, error) {
 	child := proc.NewChildProcedure()
 	return child.Execute(statements)
@@ -85,6 +111,10 @@ func (proc *Procedure) Execute(statements []parser.Statement) (StatementFlow, er
 	flow := Terminate
 
 	for _, stmt := range statements {
+		if err := proc.Filter.Err(); err != nil {
+			return Error, err
+		}
+
 		f, err := proc.ExecuteStatement(stmt)
 		if err != nil {
 			return f, err
@@ -98,7 +128,7 @@ func (proc *Procedure) Execute(statements []parser.Statement) (StatementFlow, er
 }
 
 func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, error) {
-	flags := cmd.GetFlags()
+	flags := proc.Filter.flags()
 	flow := Terminate
 
 	var err error
@@ -113,8 +143,8 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	case parser.RemoveFlagElement:
 		err = RemoveFlagElement(stmt.(parser.RemoveFlagElement), proc.Filter)
 	case parser.ShowFlag:
-		if printstr, err = ShowFlag(stmt.(parser.ShowFlag)); err == nil {
-			Log(printstr, false)
+		if printstr, err = ShowFlag(stmt.(parser.ShowFlag), proc.Filter); err == nil {
+			proc.Filter.log(printstr, false)
 		}
 	case parser.VariableDeclaration:
 		err = proc.Filter.Variables.Declare(stmt.(parser.VariableDeclaration), proc.Filter)
@@ -147,36 +177,33 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		err = proc.Filter.Functions.Dispose(stmt.(parser.DisposeFunction).Name)
 	case parser.AggregateDeclaration:
 		err = proc.Filter.Functions.DeclareAggregate(stmt.(parser.AggregateDeclaration))
+	case parser.ProcedureDeclaration:
+		err = proc.Filter.Functions.DeclareProcedure(stmt.(parser.ProcedureDeclaration))
+	case parser.DisposeProcedure:
+		err = proc.Filter.Functions.DisposeProcedure(stmt.(parser.DisposeProcedure).Name)
+	case parser.CallProcedure:
+		flow, err = proc.Call(stmt.(parser.CallProcedure))
 	case parser.SelectQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
+			proc.Filter.executedJoins().Clear()
 		}
 
-		view, e := Select(stmt.(parser.SelectQuery), proc.Filter)
-		if e == nil {
-			fileInfo := &FileInfo{
-				Format:             flags.Format,
-				Delimiter:          flags.WriteDelimiter,
-				DelimiterPositions: flags.WriteDelimiterPositions,
-				Encoding:           flags.WriteEncoding,
-				LineBreak:          flags.LineBreak,
-				NoHeader:           flags.WithoutHeader,
-				EncloseAll:         flags.EncloseAll,
-				PrettyPrint:        flags.PrettyPrint,
-			}
+		selectQuery := stmt.(parser.SelectQuery)
+		fileInfo := proc.Output
+		if fileInfo == nil {
+			fileInfo = NewFileInfoForOutput(flags)
+		}
 
-			var writer io.Writer
-			if OutFile != nil {
-				writer = OutFile
-			} else {
-				writer = Stdout
-			}
-			err = EncodeView(writer, view, fileInfo)
-			if err == nil {
-				writer.Write([]byte(cmd.GetFlags().LineBreak.Value()))
-			} else if _, ok := err.(*EmptyResultSetError); ok {
-				err = nil
-			}
+		if stream := newSelectStream(selectQuery, proc.Filter); stream != nil {
+			stream.filter = proc.Filter.CreateNode()
+			err = proc.writeSelectResult(fileInfo, func(w io.Writer) error {
+				return stream.run(NewStreamEncoder(w, fileInfo, proc.Filter))
+			})
+		} else if view, e := Select(selectQuery, proc.Filter); e == nil {
+			err = proc.writeSelectResult(fileInfo, func(w io.Writer) error {
+				return encodeView(w, view, fileInfo, proc.Filter)
+			})
 		} else {
 			err = e
 		}
@@ -187,14 +214,16 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	case parser.InsertQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
+			proc.Filter.executedJoins().Clear()
 		}
 
 		fileInfo, cnt, e := Insert(stmt.(parser.InsertQuery), proc.Filter)
 		if e == nil {
+			*proc.affectedRows += cnt
 			if 0 < cnt {
-				UncommittedViews.SetForUpdatedView(fileInfo)
+				proc.Filter.uncommittedViews().SetForUpdatedView(fileInfo)
 			}
-			Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
+			proc.Filter.log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -205,15 +234,17 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	case parser.UpdateQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
+			proc.Filter.executedJoins().Clear()
 		}
 
 		infos, cnts, e := Update(stmt.(parser.UpdateQuery), proc.Filter)
 		if e == nil {
 			for i, info := range infos {
+				*proc.affectedRows += cnts[i]
 				if 0 < cnts[i] {
-					UncommittedViews.SetForUpdatedView(info)
+					proc.Filter.uncommittedViews().SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				proc.Filter.log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
@@ -225,52 +256,127 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	case parser.DeleteQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
+			proc.Filter.executedJoins().Clear()
 		}
 
 		infos, cnts, e := Delete(stmt.(parser.DeleteQuery), proc.Filter)
 		if e == nil {
 			for i, info := range infos {
+				*proc.affectedRows += cnts[i]
 				if 0 < cnts[i] {
-					UncommittedViews.SetForUpdatedView(info)
+					proc.Filter.uncommittedViews().SetForUpdatedView(info)
 				}
-				Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
+				proc.Filter.log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), flags.Quiet)
 			}
 		} else {
 			err = e
 		}
 
+		if flags.Stats {
+			proc.showExecutionTime()
+		}
+	case parser.MergeQuery:
+		if flags.Stats {
+			proc.MeasurementStart = time.Now()
+			proc.Filter.executedJoins().Clear()
+		}
+
+		info, inserted, updated, deleted, e := Merge(stmt.(parser.MergeQuery), proc.Filter)
+		if e == nil {
+			*proc.affectedRows += inserted + updated + deleted
+			if 0 < inserted+updated+deleted {
+				proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			}
+			proc.Filter.log(fmt.Sprintf("%s inserted, %s updated and %s deleted on %q.", FormatCount(inserted, "record"), FormatCount(updated, "record"), FormatCount(deleted, "record"), info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+
 		if flags.Stats {
 			proc.showExecutionTime()
 		}
 	case parser.CreateTable:
 		info, e := CreateTable(stmt.(parser.CreateTable), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForCreatedView(info)
-			Log(fmt.Sprintf("file %q is created.", info.Path), flags.Quiet)
+			proc.Filter.uncommittedViews().SetForCreatedView(info)
+			proc.Filter.log(fmt.Sprintf("file %q is created.", info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.CreateIndex:
+		fpath, e := CreateIndex(stmt.(parser.CreateIndex), proc.Filter)
+		if e == nil {
+			proc.Filter.log(fmt.Sprintf("index %s is created on %q.", stmt.(parser.CreateIndex).Name.Literal, fpath), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.AddColumns:
 		info, cnt, e := AddColumns(stmt.(parser.AddColumns), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s added on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(fmt.Sprintf("%s added on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.DropColumns:
 		info, cnt, e := DropColumns(stmt.(parser.DropColumns), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s dropped on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(fmt.Sprintf("%s dropped on %q.", FormatCount(cnt, "field"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
 	case parser.RenameColumn:
 		info, e := RenameColumn(stmt.(parser.RenameColumn), proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(fmt.Sprintf("%s renamed on %q.", FormatCount(1, "field"), info.Path), flags.Quiet)
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(fmt.Sprintf("%s renamed on %q.", FormatCount(1, "field"), info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.AddConstraint:
+		info, name, e := AddConstraint(stmt.(parser.AddConstraint), proc.Filter)
+		if e == nil {
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(fmt.Sprintf("constraint %s is added on %q.", name, info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.DropConstraint:
+		info, e := DropConstraint(stmt.(parser.DropConstraint), proc.Filter)
+		if e == nil {
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(fmt.Sprintf("constraint %s is dropped on %q.", stmt.(parser.DropConstraint).Name.Literal, info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.RenameTable:
+		oldInfo, info, e := RenameTable(stmt.(parser.RenameTable), proc.Filter)
+		if e == nil {
+			proc.Filter.uncommittedViews().SetForDroppedView(oldInfo)
+			proc.Filter.uncommittedViews().SetForCreatedView(info)
+			proc.Filter.log(fmt.Sprintf("file %q is renamed to %q.", oldInfo.Path, info.Path), flags.Quiet)
+		} else {
+			err = e
+		}
+	case parser.DropTable:
+		info, e := DropTable(stmt.(parser.DropTable), proc.Filter)
+		if e == nil {
+			if info != nil {
+				proc.Filter.uncommittedViews().SetForDroppedView(info)
+				proc.Filter.log(fmt.Sprintf("file %q is dropped.", info.Path), flags.Quiet)
+			}
+		} else {
+			err = e
+		}
+	case parser.TruncateTable:
+		info, cnt, e := TruncateTable(stmt.(parser.TruncateTable), proc.Filter)
+		if e == nil {
+			*proc.affectedRows += cnt
+			if 0 < cnt {
+				proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			}
+			proc.Filter.log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnt, "record"), info.Path), flags.Quiet)
 		} else {
 			err = e
 		}
@@ -278,11 +384,11 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		expr := stmt.(parser.SetTableAttribute)
 		info, log, e := SetTableAttribute(expr, proc.Filter)
 		if e == nil {
-			UncommittedViews.SetForUpdatedView(info)
-			Log(log, flags.Quiet)
+			proc.Filter.uncommittedViews().SetForUpdatedView(info)
+			proc.Filter.log(log, flags.Quiet)
 		} else {
 			if unchanged, ok := e.(*TableAttributeUnchangedError); ok {
-				Log(fmt.Sprintf("Table attributes of %s remain unchanged.", unchanged.Path), flags.Quiet)
+				proc.Filter.log(fmt.Sprintf("Table attributes of %s remain unchanged.", unchanged.Path), flags.Quiet)
 			} else {
 				err = e
 			}
@@ -327,17 +433,19 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		flow, err = proc.While(stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(stmt.(parser.WhileInCursor))
+	case parser.Try:
+		flow, err = proc.Try(stmt.(parser.Try))
 	case parser.Echo:
 		if printstr, err = Echo(stmt.(parser.Echo), proc.Filter); err == nil {
-			Log(printstr, false)
+			proc.Filter.log(printstr, false)
 		}
 	case parser.Print:
 		if printstr, err = Print(stmt.(parser.Print), proc.Filter); err == nil {
-			Log(printstr, false)
+			proc.Filter.log(printstr, false)
 		}
 	case parser.Printf:
 		if printstr, err = Printf(stmt.(parser.Printf), proc.Filter); err == nil {
-			Log(printstr, false)
+			proc.Filter.log(printstr, false)
 		}
 	case parser.Source:
 		var externalStatements []parser.Statement
@@ -355,25 +463,34 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		var dirpath string
 		dirpath, err = Pwd(stmt.(parser.Pwd))
 		if err == nil {
-			Log(dirpath, false)
+			proc.Filter.log(dirpath, false)
 		}
 	case parser.Reload:
-		err = Reload(stmt.(parser.Reload))
+		err = Reload(stmt.(parser.Reload), proc.Filter)
 	case parser.ShowObjects:
 		if printstr, err = ShowObjects(stmt.(parser.ShowObjects), proc.Filter); err == nil {
-			Log(printstr, false)
+			proc.Filter.log(printstr, false)
 		}
 	case parser.ShowFields:
 		if printstr, err = ShowFields(stmt.(parser.ShowFields), proc.Filter); err == nil {
-			Log(printstr, false)
+			proc.Filter.log(printstr, false)
+		}
+	case parser.Explain:
+		if printstr, err = Explain(stmt.(parser.Explain), proc.Filter); err == nil {
+			proc.Filter.log(printstr, false)
 		}
 	case parser.Syntax:
 		printstr = Syntax(stmt.(parser.Syntax), proc.Filter)
-		Log(printstr, false)
+		proc.Filter.log(printstr, false)
 	case parser.Trigger:
 		trigger := stmt.(parser.Trigger)
 		switch strings.ToUpper(trigger.Event.Literal) {
 		case "ERROR":
+			if trigger.Message == nil && trigger.Code == nil && proc.Filter.caughtError != nil {
+				err = proc.Filter.caughtError
+				break
+			}
+
 			var message string
 			if trigger.Message != nil {
 				if pt, ok := trigger.Message.(parser.PrimitiveType); ok && trigger.Code == nil && pt.IsInteger() {
@@ -402,6 +519,11 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	}
 
 	if err != nil {
+		if _, ok := err.(*ConstraintViolationError); ok {
+			if e := Rollback(nil, proc.Filter); e != nil {
+				proc.Filter.logError(e.Error())
+			}
+		}
 		flow = Error
 	}
 	return flow, err
@@ -454,7 +576,7 @@ func (proc *Procedure) Case(stmt parser.Case) (StatementFlow, error) {
 		if val == nil {
 			t = cond.Ternary()
 		} else {
-			t = value.Equal(val, cond)
+			t = value.Equal(val, cond, proc.Filter.flags().DatetimeFormat, proc.Filter.flags().GetLocation())
 		}
 
 		if t == ternary.TRUE {
@@ -537,6 +659,63 @@ func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow,
 	return Terminate, nil
 }
 
+func (proc *Procedure) Call(stmt parser.CallProcedure) (StatementFlow, error) {
+	udproc, err := proc.Filter.Functions.GetProcedure(stmt.Name)
+	if err != nil {
+		return Error, err
+	}
+	if err = udproc.CheckArgsLen(stmt.Name, stmt.Name.Literal, len(stmt.Args)); err != nil {
+		return Error, err
+	}
+
+	args := make([]value.Primary, len(stmt.Args))
+	for i, v := range stmt.Args {
+		if udproc.IsOutParameter(i) {
+			variable, ok := v.(parser.Variable)
+			if !ok {
+				return Error, NewProcedureOutArgumentError(stmt, v, udproc.Parameters[i])
+			}
+			if _, err = proc.Filter.Variables.Get(variable); err != nil {
+				return Error, err
+			}
+			args[i] = value.NewNull()
+			continue
+		}
+
+		if args[i], err = proc.Filter.Evaluate(v); err != nil {
+			return Error, err
+		}
+	}
+
+	outValues, flow, err := udproc.ExecuteProcedure(args, proc)
+	if err != nil {
+		return flow, err
+	}
+
+	for i, v := range stmt.Args {
+		if udproc.IsOutParameter(i) {
+			if _, err = proc.Filter.Variables.SubstituteDirectly(v.(parser.Variable), outValues[i]); err != nil {
+				return Error, err
+			}
+		}
+	}
+	return flow, nil
+}
+
+func (proc *Procedure) Try(stmt parser.Try) (StatementFlow, error) {
+	flow, err := proc.ExecuteChild(stmt.Statements)
+	if err == nil {
+		return flow, nil
+	}
+	if _, ok := err.(*ForcedExit); ok {
+		return flow, err
+	}
+
+	child := proc.NewChildProcedure()
+	child.Filter.caughtError = err
+	return child.Execute(stmt.CatchStatements)
+}
+
 func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
 	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
 	var argStrs = make([]string, 0, 8)
@@ -567,9 +746,11 @@ func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
 	}
 
 	c := exec.Command(args[0], args[1:]...)
-	c.Stdin = Stdin
-	c.Stdout = Stdout
-	c.Stderr = Stderr
+	if proc.Filter.session == nil {
+		c.Stdin = Stdin
+	}
+	c.Stdout = proc.Filter.stdout()
+	c.Stderr = proc.Filter.stderr()
 
 	err = c.Run()
 	if err != nil {
@@ -578,9 +759,41 @@ func (proc *Procedure) ExecExternalCommand(stmt parser.ExternalCommand) error {
 	return err
 }
 
+func (proc *Procedure) writeSelectResult(fileInfo *FileInfo, encode func(io.Writer) error) error {
+	var writer io.Writer
+	if outFile := proc.Filter.outFile(); outFile != nil {
+		writer = outFile
+	} else {
+		writer = proc.Filter.stdout()
+	}
+
+	cw, err := NewCompressionWriter(writer, fileInfo.Compression)
+	if err != nil {
+		return err
+	}
+
+	err = encode(cw)
+	if err == nil {
+		if fileInfo.Format != cmd.XLSX {
+			cw.Write([]byte(fileInfo.LineBreak.Value()))
+		}
+	} else if _, ok := err.(*EmptyResultSetError); ok {
+		err = nil
+	}
+	if e := cw.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
 func (proc *Procedure) showExecutionTime() {
-	palette, _ := cmd.GetPalette()
+	palette := proc.Filter.palette()
 	exectime := cmd.FormatNumber(time.Since(proc.MeasurementStart).Seconds(), 6, ".", ",", "")
 	stats := fmt.Sprintf(palette.Render(cmd.LableEffect, "Query Execution Time: ")+"%s seconds", exectime)
-	Log(stats, false)
+	proc.Filter.log(stats, false)
+
+	for _, join := range proc.Filter.executedJoins().List() {
+		proc.Filter.log(palette.Render(cmd.LableEffect, "Join Strategy: ")+join.String(), false)
+	}
+	proc.Filter.executedJoins().Clear()
 }
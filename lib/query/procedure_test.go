@@ -1,6 +1,7 @@
 package query
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -481,6 +482,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("2 records inserted on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -514,6 +516,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("1 record updated on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -545,6 +548,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("1 record deleted on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -567,6 +571,7 @@ var procedureExecuteStatementTests = []struct {
 				},
 			},
 			Updated: map[string]*FileInfo{},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("file %q is created.\n", GetTestFilePath("newtable.csv")),
 	},
@@ -590,6 +595,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("1 field added on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -611,6 +617,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("1 field dropped on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -631,6 +638,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: text.LF,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: fmt.Sprintf("1 field renamed on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -660,6 +668,7 @@ var procedureExecuteStatementTests = []struct {
 					Format:    cmd.TSV,
 				},
 			},
+			Dropped: map[string]*FileInfo{},
 		},
 		Logs: "\n" +
 			strings.Repeat(" ", (calcShowFieldsWidth("table1.csv", "table1.csv", 22)-(22+len("table1.csv")))/2) + "Attributes Updated in table1.csv\n" +
@@ -884,6 +893,48 @@ func TestProcedure_ExecuteStatement(t *testing.T) {
 	OutFile = nil
 }
 
+func TestProcedure_ExecuteStatement_CompressedOutput(t *testing.T) {
+	initCmdFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	proc := NewProcedure()
+	proc.Output = &FileInfo{
+		Format:      cmd.CSV,
+		Delimiter:   ',',
+		Encoding:    text.UTF8,
+		LineBreak:   text.LF,
+		Compression: cmd.GZIP,
+	}
+
+	buf := new(bytes.Buffer)
+	OutFile = buf
+	defer func() {
+		OutFile = nil
+	}()
+
+	stmt := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "a"}},
+				},
+			},
+		},
+	}
+	if _, err := proc.ExecuteStatement(stmt); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	r, _ := NewDecompressionReader(buf, cmd.GZIP)
+	result, _ := ioutil.ReadAll(r)
+	r.Close()
+	expect := "a\n1\n"
+	if string(result) != expect {
+		t.Errorf("result = %q, want %q", string(result), expect)
+	}
+}
+
 var procedureIfStmtTests = []struct {
 	Name       string
 	Stmt       parser.If
@@ -1015,7 +1066,7 @@ func TestProcedure_IfStmt(t *testing.T) {
 			continue
 		}
 		if flow != v.ResultFlow {
-			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
 		}
 		if string(log) != v.Result {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
@@ -1189,7 +1240,7 @@ func TestProcedure_Case(t *testing.T) {
 			continue
 		}
 		if flow != v.ResultFlow {
-			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
 		}
 		if string(log) != v.Result {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
@@ -1442,7 +1493,339 @@ func TestProcedure_While(t *testing.T) {
 			continue
 		}
 		if flow != v.ResultFlow {
-			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+}
+
+var procedureCallTests = []struct {
+	Name   string
+	Stmt   parser.CallProcedure
+	Result string
+	OutVal value.Primary
+	Error  string
+}{
+	{
+		Name: "Call Procedure",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("2"),
+				parser.Variable{Name: "out"},
+			},
+		},
+		Result: "2\n",
+		OutVal: value.NewInteger(3),
+	},
+	{
+		Name: "Call Procedure Return",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("-1"),
+				parser.Variable{Name: "out"},
+				parser.NewIntegerValueFromString("10"),
+			},
+		},
+		Result: "-1\n",
+		OutVal: value.NewNull(),
+	},
+	{
+		Name: "Call Procedure Not Exist Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "[L:- C:-] procedure notexist does not exist",
+	},
+	{
+		Name: "Call Procedure Arguments Length Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("2"),
+			},
+		},
+		Error: "[L:- C:-] function userproc takes at least 2 arguments",
+	},
+	{
+		Name: "Call Procedure Out Argument Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("2"),
+				parser.NewIntegerValueFromString("3"),
+			},
+		},
+		Error: "[L:- C:-] 3 for out parameter @ret of procedure userproc is not a variable",
+	},
+	{
+		Name: "Call Procedure Undeclared Variable Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValueFromString("2"),
+				parser.Variable{Name: "undeclared"},
+			},
+		},
+		Error: "[L:- C:-] variable @undeclared is undeclared",
+	},
+	{
+		Name: "Call Procedure Execution Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				parser.Variable{Name: "out"},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+}
+
+func TestProcedure_Call(t *testing.T) {
+	cmd.GetFlags().SetQuiet(true)
+	proc := NewProcedure()
+	proc.Filter.Variables[0].Add(parser.Variable{Name: "out"}, value.NewNull())
+	proc.Filter.Functions.DeclareProcedure(parser.ProcedureDeclaration{
+		Name: parser.Identifier{Literal: "userproc"},
+		Parameters: []parser.ProcedureParameter{
+			{Variable: parser.Variable{Name: "arg"}},
+			{Variable: parser.Variable{Name: "ret"}, Out: true},
+			{Variable: parser.Variable{Name: "opt"}, Value: parser.NewIntegerValueFromString("1")},
+		},
+		Statements: []parser.Statement{
+			parser.Print{Value: parser.Variable{Name: "arg"}},
+			parser.If{
+				Condition: parser.Comparison{
+					LHS:      parser.Variable{Name: "arg"},
+					RHS:      parser.NewIntegerValueFromString("0"),
+					Operator: "<",
+				},
+				Statements: []parser.Statement{
+					parser.Return{Value: parser.NewNullValue()},
+				},
+			},
+			parser.VariableSubstitution{
+				Variable: parser.Variable{Name: "ret"},
+				Value: parser.Arithmetic{
+					LHS:      parser.Variable{Name: "arg"},
+					RHS:      parser.Variable{Name: "opt"},
+					Operator: '+',
+				},
+			},
+		},
+	})
+
+	for _, v := range procedureCallTests {
+		proc.Filter.Variables[0].Set(parser.Variable{Name: "out"}, value.NewInteger(0))
+
+		oldStdout := Stdout
+
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		_, err := proc.Call(v.Stmt)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+		if val, _ := proc.Filter.Variables[0].Get(parser.Variable{Name: "out"}); !reflect.DeepEqual(val, v.OutVal) {
+			t.Errorf("%s: out value = %s, want %s", v.Name, val, v.OutVal)
+		}
+	}
+}
+
+var procedureTryTests = []struct {
+	Name       string
+	Stmt       parser.Try
+	ResultFlow StatementFlow
+	Result     string
+	Error      string
+}{
+	{
+		Name: "Try Statement",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValueFromString("1")},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValueFromString("2")},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n",
+	},
+	{
+		Name: "Try Statement Catch Error",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValueFromString("1")},
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				parser.Print{Value: parser.NewIntegerValueFromString("2")},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_CODE"}},
+				parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "1\n1\n\"field notexist does not exist\"\n",
+	},
+	{
+		Name: "Try Statement Catch User Triggered Error",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+					Code:    value.NewInteger(200),
+				},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_CODE"}},
+				parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "200\n\"user error\"\n",
+	},
+	{
+		Name: "Try Statement Nested",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("outer error"),
+				},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Try{
+					Statements: []parser.Statement{
+						parser.Trigger{
+							Event:   parser.Identifier{Literal: "error"},
+							Message: parser.NewStringValue("inner error"),
+						},
+					},
+					CatchStatements: []parser.Statement{
+						parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+					},
+				},
+				parser.Print{Value: parser.RuntimeInformation{Name: "ERROR_MESSAGE"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "\"inner error\"\n\"outer error\"\n",
+	},
+	{
+		Name: "Try Statement Flow Control",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.FlowControl{Token: parser.BREAK},
+				parser.Print{Value: parser.NewIntegerValueFromString("1")},
+			},
+		},
+		ResultFlow: Break,
+		Result:     "",
+	},
+	{
+		Name: "Try Statement Rethrow Error",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValueFromString("1")},
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Try Statement Error in Catch Block",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist2"}}},
+			},
+		},
+		Error: "[L:- C:-] field notexist2 does not exist",
+	},
+	{
+		Name: "Try Statement Forced Exit",
+		Stmt: parser.Try{
+			Statements: []parser.Statement{
+				parser.Exit{Code: value.NewInteger(2)},
+			},
+			CatchStatements: []parser.Statement{
+				parser.Print{Value: parser.NewIntegerValueFromString("1")},
+			},
+		},
+		ResultFlow: Error,
+		Result:     "",
+	},
+}
+
+func TestProcedure_Try(t *testing.T) {
+	cmd.GetFlags().SetQuiet(true)
+	proc := NewProcedure()
+
+	for _, v := range procedureTryTests {
+		oldStdout := Stdout
+
+		r, w, _ := os.Pipe()
+		Stdout = w
+
+		flow, err := proc.Try(v.Stmt)
+
+		w.Close()
+		Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if proc.Filter.caughtError != nil {
+			t.Errorf("%s: caught error is not cleared", v.Name)
+			proc.Filter.caughtError = nil
+		}
+
+		if _, ok := err.(*ForcedExit); ok {
+			err = nil
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
 		}
 		if string(log) != v.Result {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
@@ -1653,7 +2036,7 @@ func TestProcedure_WhileInCursor(t *testing.T) {
 			continue
 		}
 		if flow != v.ResultFlow {
-			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+			t.Errorf("%s: result flow = %d, want %d", v.Name, flow, v.ResultFlow)
 		}
 		if string(log) != v.Result {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable, filter *Filter) (bool, error) {
@@ -100,12 +103,18 @@ func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
 
+	filter.plan.begin()
+	start := time.Now()
+
 	if query.WithClause != nil {
 		if err := filter.LoadInlineTable(query.WithClause.(parser.WithClause)); err != nil {
 			return nil, err
 		}
 	}
 
+	filter.plan.begin()
+	entityStart := time.Now()
+
 	view, err := selectEntity(query.SelectEntity, filter)
 	if err != nil {
 		return nil, err
@@ -115,21 +124,27 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 		if err := view.OrderBy(query.OrderByClause.(parser.OrderByClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newSortPlanNode(query.OrderByClause.(parser.OrderByClause)), view.RecordLen(), entityStart)
 	}
 
 	if query.OffsetClause != nil {
 		if err := view.Offset(query.OffsetClause.(parser.OffsetClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newOffsetPlanNode(query.OffsetClause.(parser.OffsetClause)), view.RecordLen(), entityStart)
 	}
 
 	if query.LimitClause != nil {
 		if err := view.Limit(query.LimitClause.(parser.LimitClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newLimitPlanNode(query.LimitClause.(parser.LimitClause)), view.RecordLen(), entityStart)
 	}
 
-	view.Fix()
+	view.Fix(filter.flags())
+
+	filter.plan.end(nil, view.RecordLen(), entityStart)
+	filter.plan.end(newWithPlanNode(query.WithClause), view.RecordLen(), start)
 
 	return view, nil
 }
@@ -143,51 +158,86 @@ func selectEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
 	if entity.FromClause == nil {
 		entity.FromClause = parser.FromClause{}
 	}
+	var condition parser.QueryExpression
+	if entity.WhereClause != nil {
+		condition = entity.WhereClause.(parser.WhereClause).Filter
+	}
+
+	start := time.Now()
+
 	view := NewView()
-	err := view.Load(entity.FromClause.(parser.FromClause), filter)
+	err := view.LoadWithCondition(entity.FromClause.(parser.FromClause), condition, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	if entity.WindowClause != nil {
+		if err := view.Window(entity.WindowClause.(parser.WindowClause)); err != nil {
+			return nil, err
+		}
+	}
+
 	if entity.WhereClause != nil {
 		if err := view.Where(entity.WhereClause.(parser.WhereClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newFilterPlanNode(condition), view.RecordLen(), start)
 	}
 
 	if entity.GroupByClause != nil {
 		if err := view.GroupBy(entity.GroupByClause.(parser.GroupByClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newGroupPlanNode(entity.GroupByClause.(parser.GroupByClause)), view.RecordLen(), start)
 	}
 
 	if entity.HavingClause != nil {
 		if err := view.Having(entity.HavingClause.(parser.HavingClause)); err != nil {
 			return nil, err
 		}
+		filter.plan.wrap(newHavingPlanNode(entity.HavingClause.(parser.HavingClause)), view.RecordLen(), start)
 	}
 
-	if err := view.Select(entity.SelectClause.(parser.SelectClause)); err != nil {
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	filter.plan.resetAnalyticTime()
+	if err := view.Select(selectClause); err != nil {
 		return nil, err
 	}
+	if filter.plan != nil && hasAnalyticFunction(selectClause) {
+		filter.plan.wrapAnalytic(newAnalyticPlanNode(selectClause), view.RecordLen())
+	}
+	filter.plan.wrap(newSelectPlanNode(selectClause, isAggregatedEntity(entity)), view.RecordLen(), start)
 
 	return view, nil
 }
 
 func selectSetEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
+	filter.plan.begin()
+	start := time.Now()
+
+	var view *View
+	var err error
+
 	if subquery, ok := expr.(parser.Subquery); ok {
-		return Select(subquery.Query, filter)
+		view, err = Select(subquery.Query, filter)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		view, err = selectEntity(expr, filter)
+		if err != nil {
+			return nil, err
+		}
+		view.Fix(filter.flags())
 	}
 
-	view, err := selectEntity(expr, filter)
-	if err != nil {
-		return nil, err
-	}
-	view.Fix()
+	filter.plan.end(nil, view.RecordLen(), start)
 	return view, nil
 }
 
 func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
+	start := time.Now()
+
 	lview, err := selectSetEntity(set.LHS, filter)
 	if err != nil {
 		return nil, err
@@ -199,6 +249,7 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 		if err != nil {
 			return nil, err
 		}
+		filter.plan.mergeLoops(1)
 	} else {
 		rview, err := selectSetEntity(set.RHS, filter)
 		if err != nil {
@@ -211,15 +262,16 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 
 		switch set.Operator.Token {
 		case parser.UNION:
-			lview.Union(rview, !set.All.IsEmpty())
+			lview.Union(rview, !set.All.IsEmpty(), filter.flags())
 		case parser.EXCEPT:
-			lview.Except(rview, !set.All.IsEmpty())
+			lview.Except(rview, !set.All.IsEmpty(), filter.flags())
 		case parser.INTERSECT:
-			lview.Intersect(rview, !set.All.IsEmpty())
+			lview.Intersect(rview, !set.All.IsEmpty(), filter.flags())
 		}
 	}
 
 	lview.SelectAllColumns()
+	filter.plan.wrap(newSetPlanNode(set, filter.RecursiveTable != nil), lview.RecordLen(), start)
 
 	return lview, nil
 }
@@ -251,11 +303,11 @@ func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter) err
 
 	switch set.Operator.Token {
 	case parser.UNION:
-		view.Union(rview, !set.All.IsEmpty())
+		view.Union(rview, !set.All.IsEmpty(), filter.flags())
 	case parser.EXCEPT:
-		view.Except(rview, !set.All.IsEmpty())
+		view.Except(rview, !set.All.IsEmpty(), filter.flags())
 	case parser.INTERSECT:
-		view.Intersect(rview, !set.All.IsEmpty())
+		view.Intersect(rview, !set.All.IsEmpty(), filter.flags())
 	}
 
 	return selectSetForRecursion(view, set, filter)
@@ -302,10 +354,14 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*FileInfo, int, err
 	view.RestoreHeaderReferences()
 	view.Filter = nil
 
+	if err = view.ValidateConstraints(query.Table.Object, filter); err != nil {
+		return nil, insertRecords, err
+	}
+
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		parentFilter.viewCache().Replace(view)
 	}
 
 	return view.FileInfo, insertRecords, nil
@@ -351,7 +407,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if filter.TempViews.Exists(fpath) {
 			viewsToUpdate[viewKey], _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToUpdate[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
+			viewsToUpdate[viewKey], _ = parentFilter.viewCache().Get(parser.Identifier{Literal: fpath})
 		}
 		viewsToUpdate[viewKey].Header.Update(table.Name().Literal, nil)
 	}
@@ -403,10 +459,27 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId] = append(updatesList[viewref][internalId], fieldIdx)
+
+			if val, err = viewsToUpdate[viewref].convertFieldValue(positionedExpr(uset.Value, uset.Field), fieldIdx, val, parentFilter.flags()); err != nil {
+				return nil, nil, err
+			}
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
 
+	for _, v := range query.Tables {
+		table := v.(parser.Table)
+		viewKey := strings.ToUpper(table.Name().Literal)
+		if _, ok := updatesList[viewKey]; !ok {
+			continue
+		}
+
+		viewsToUpdate[viewKey].RestoreHeaderReferences()
+		if err := viewsToUpdate[viewKey].ValidateConstraints(table.Name(), filter); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	updateRecords := make([]int, 0)
 	for k, v := range viewsToUpdate {
@@ -415,7 +488,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if v.FileInfo.IsTemporary {
 			filter.TempViews.Replace(v)
 		} else {
-			ViewCache.Replace(v)
+			parentFilter.viewCache().Replace(v)
 		}
 
 		fileInfos = append(fileInfos, v.FileInfo)
@@ -475,7 +548,7 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if filter.TempViews.Exists(fpath) {
 			viewsToDelete[viewKey], _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToDelete[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
+			viewsToDelete[viewKey], _ = parentFilter.viewCache().Get(parser.Identifier{Literal: fpath})
 		}
 		viewsToDelete[viewKey].Header.Update(table.Name().Literal, nil)
 		deletedIndices[viewKey] = make(map[int]bool)
@@ -509,7 +582,7 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 		if v.FileInfo.IsTemporary {
 			filter.TempViews.Replace(v)
 		} else {
-			ViewCache.Replace(v)
+			parentFilter.viewCache().Replace(v)
 		}
 
 		fileInfos = append(fileInfos, v.FileInfo)
@@ -519,18 +592,180 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*FileInfo, []int,
 	return fileInfos, deletedCounts, nil
 }
 
+func Merge(query parser.MergeQuery, parentFilter *Filter) (*FileInfo, int, int, int, error) {
+	filter := parentFilter.CreateNode()
+
+	if query.WithClause != nil {
+		if err := filter.LoadInlineTable(query.WithClause.(parser.WithClause)); err != nil {
+			return nil, 0, 0, 0, err
+		}
+	}
+
+	view := NewView()
+	view.UseInternalId = true
+	view.ForUpdate = true
+	err := view.Load(parser.FromClause{Tables: []parser.QueryExpression{query.Table}}, filter)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	sourceView, err := loadView(query.Source, filter, false, false)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	sourceIdIdx := view.FieldLen()
+	sourceRecords := make(RecordSet, sourceView.RecordLen())
+	for i, record := range sourceView.RecordSet {
+		sourceRecords[i] = append(Record{NewCell(value.NewInteger(int64(i)))}, record...)
+	}
+	sourceView.Header = append(Header{{Column: InternalIdColumn}}, sourceView.Header...)
+	sourceView.RecordSet = sourceRecords
+
+	if err = OuterJoin(view, sourceView, query.Condition, parser.FULL, filter); err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	tableName := query.Table.Name()
+	fpath, err := filter.Aliases.Get(tableName)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	var viewToMerge *View
+	if filter.TempViews.Exists(fpath) {
+		viewToMerge, _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
+	} else {
+		viewToMerge, _ = parentFilter.viewCache().Get(parser.Identifier{Literal: fpath})
+	}
+	viewToMerge.Header.Update(tableName.Literal, nil)
+
+	var insertedCount int
+	var updatedCount int
+	matchedIds := make(map[int]bool)
+	deletedIds := make(map[int]bool)
+
+	filterForLoop := NewFilterForSequentialEvaluation(view, filter)
+	for i, record := range view.RecordSet {
+		if _, ok := record[sourceIdIdx].Value().(value.Integer); !ok {
+			continue
+		}
+		targetId, matched := record[0].Value().(value.Integer)
+		filterForLoop.Records[0].RecordIndex = i
+
+		for _, when := range query.WhenList {
+			if when.Matched != matched {
+				continue
+			}
+
+			if when.Condition != nil {
+				p, err := filterForLoop.Evaluate(when.Condition)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+				if p.Ternary() != ternary.TRUE {
+					continue
+				}
+			}
+
+			if !matched {
+				rv := when.Values.(parser.RowValue)
+				values, err := filterForLoop.evalRowValue(rv)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+
+				fields := when.Fields
+				if fields == nil {
+					fields = viewToMerge.Header.TableColumns()
+				}
+				if len(fields) != len(values) {
+					return nil, 0, 0, 0, NewInsertRowValueLengthError(rv, len(fields))
+				}
+
+				if _, err = viewToMerge.insert(fields, [][]value.Primary{values}, [][]parser.QueryExpression{rowValueExprs(rv, len(values))}); err != nil {
+					return nil, 0, 0, 0, err
+				}
+				insertedCount++
+				break
+			}
+
+			internalId := int(targetId.Raw())
+			if matchedIds[internalId] {
+				return nil, 0, 0, 0, NewMergeTargetRecordAmbiguousError(when, tableName)
+			}
+			matchedIds[internalId] = true
+
+			if when.Action.Token == parser.DELETE {
+				deletedIds[internalId] = true
+				break
+			}
+
+			updatedFields := make([]int, 0, len(when.SetList))
+			for _, uset := range when.SetList {
+				val, err := filterForLoop.Evaluate(uset.Value)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+
+				fieldIdx, err := viewToMerge.FieldIndex(uset.Field)
+				if err != nil {
+					return nil, 0, 0, 0, NewUpdateFieldNotExistError(uset.Field)
+				}
+				if InIntSlice(fieldIdx, updatedFields) {
+					return nil, 0, 0, 0, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				}
+				updatedFields = append(updatedFields, fieldIdx)
+
+				if val, err = viewToMerge.convertFieldValue(positionedExpr(uset.Value, uset.Field), fieldIdx, val, parentFilter.flags()); err != nil {
+					return nil, 0, 0, 0, err
+				}
+				viewToMerge.RecordSet[internalId][fieldIdx] = NewCell(val)
+			}
+			updatedCount++
+			break
+		}
+	}
+
+	if 0 < len(deletedIds) {
+		records := make(RecordSet, 0, viewToMerge.RecordLen()-len(deletedIds))
+		for i, record := range viewToMerge.RecordSet {
+			if !deletedIds[i] {
+				records = append(records, record)
+			}
+		}
+		viewToMerge.RecordSet = records
+	}
+
+	viewToMerge.RestoreHeaderReferences()
+
+	if 0 < insertedCount+updatedCount {
+		if err = viewToMerge.ValidateConstraints(tableName, filter); err != nil {
+			return nil, 0, 0, 0, err
+		}
+	}
+
+	if viewToMerge.FileInfo.IsTemporary {
+		filter.TempViews.Replace(viewToMerge)
+	} else {
+		parentFilter.viewCache().Replace(viewToMerge)
+	}
+
+	return viewToMerge.FileInfo, insertedCount, updatedCount, len(deletedIds), nil
+}
+
 func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, error) {
 	filter := parentFilter.CreateNode()
 
 	var view *View
 	var err error
 
-	flags := cmd.GetFlags()
+	flags := parentFilter.flags()
 	fileInfo, err := NewFileInfoForCreate(query.Table, flags.Repository, flags.WriteDelimiter, flags.WriteEncoding)
 	if err != nil {
 		return nil, err
 	}
-	h, err := file.NewHandlerForCreate(fileInfo.Path)
+	h, err := file.NewHandlerForCreate(parentFilter.fileContainer(), fileInfo.Path)
 	if err != nil {
 		return nil, NewFileAlreadyExistError(query.Table)
 	}
@@ -541,6 +776,13 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 	fileInfo.NoHeader = flags.WithoutHeader
 	fileInfo.PrettyPrint = flags.PrettyPrint
 
+	columns, schema, err := parseColumnDefinitions(query.Fields, parser.FormatTableName(fileInfo.Path))
+	if err != nil {
+		fileInfo.Close()
+		return nil, err
+	}
+	fileInfo.Schema = schema
+
 	if query.Query != nil {
 		view, err = Select(query.Query.(parser.SelectQuery), filter)
 		if err != nil {
@@ -548,16 +790,16 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 			return nil, err
 		}
 
-		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), query.Fields); err != nil {
+		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), columns); err != nil {
 			fileInfo.Close()
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
-				return nil, NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
+				return nil, NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(columns))
 			}
 			return nil, err
 		}
 	} else {
-		fields := make([]string, len(query.Fields))
-		for i, v := range query.Fields {
+		fields := make([]string, len(columns))
+		for i, v := range columns {
 			f, _ := v.(parser.Identifier)
 			if InStrSliceWithCaseInsensitive(f.Literal, fields) {
 				fileInfo.Close()
@@ -575,11 +817,119 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*FileInfo, err
 	view.FileInfo = fileInfo
 	view.ForUpdate = true
 
-	ViewCache.Set(view)
+	if schema != nil {
+		if query.Query != nil {
+			exprs := selectFieldExprs(query.Query.(parser.SelectQuery), view.FieldLen())
+			for i := range view.RecordSet {
+				for j := range view.RecordSet[i] {
+					val, err := view.convertFieldValue(exprs[j], j, view.RecordSet[i][j].Value(), parentFilter.flags())
+					if err != nil {
+						fileInfo.Close()
+						return nil, err
+					}
+					view.RecordSet[i][j] = NewCell(val)
+				}
+			}
+		}
+
+		if err = view.ValidateConstraints(query.Table, filter); err != nil {
+			fileInfo.Close()
+			return nil, err
+		}
+	}
+
+	parentFilter.viewCache().Set(view)
 
 	return view.FileInfo, nil
 }
 
+func parseColumnDefinitions(fields []parser.QueryExpression, table string) ([]parser.QueryExpression, *TableSchema, error) {
+	if fields == nil {
+		return nil, nil, nil
+	}
+
+	columns := make([]parser.QueryExpression, 0, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, v := range fields {
+		switch v.(type) {
+		case parser.Identifier:
+			columns = append(columns, v)
+			names = append(names, v.(parser.Identifier).Literal)
+		case parser.ColumnDefinition:
+			columns = append(columns, v.(parser.ColumnDefinition).Column)
+			names = append(names, v.(parser.ColumnDefinition).Column.Literal)
+		}
+	}
+
+	schema := &TableSchema{}
+	for _, v := range fields {
+		switch v.(type) {
+		case parser.ColumnDefinition:
+			def := v.(parser.ColumnDefinition)
+			if 0 < len(def.Type.Literal) {
+				columnType, err := ParseColumnType(def.Type.Literal)
+				if err != nil {
+					return nil, nil, NewInvalidColumnTypeError(def.Type)
+				}
+				schema.Columns = append(schema.Columns, ColumnSchema{Name: def.Column.Literal, Type: columnType})
+			}
+			for _, c := range def.Constraints {
+				if _, err := schema.AddConstraint(c.(parser.TableConstraint), def.Column, table, names); err != nil {
+					return nil, nil, err
+				}
+			}
+		case parser.TableConstraint:
+			if _, err := schema.AddConstraint(v.(parser.TableConstraint), parser.Identifier{}, table, names); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if len(schema.Columns) < 1 && len(schema.Constraints) < 1 {
+		schema = nil
+	}
+	return columns, schema, nil
+}
+
+func CreateIndex(query parser.CreateIndex, filter *Filter) (string, error) {
+	if !IsValidIndexName(query.Name.Literal) {
+		return "", NewInvalidIndexNameError(query.Name)
+	}
+
+	flags := filter.flags()
+	fileInfo, err := NewFileInfo(query.Table, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+	if err != nil {
+		return "", err
+	}
+	fileInfo.NoHeader = flags.NoHeader
+
+	ipath := IndexFilePath(fileInfo.Path, query.Name.Literal)
+	if file.Exists(ipath) {
+		return "", NewIndexAlreadyExistError(query.Name, fileInfo.Path)
+	}
+
+	columns := make([]string, len(query.Columns))
+	for i, v := range query.Columns {
+		f, _ := v.(parser.Identifier)
+		if InStrSliceWithCaseInsensitive(f.Literal, columns) {
+			return "", NewDuplicateFieldNameError(f)
+		}
+		columns[i] = f.Literal
+	}
+
+	idx, err := BuildIndex(fileInfo, query.Name.Literal, columns, flags.WithoutNull, flags)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return "", NewFileLockTimeoutError(query.Table, fileInfo.Path)
+		}
+		return "", NewCreateIndexError(query.Name, err.Error())
+	}
+	if err = idx.Save(ipath); err != nil {
+		return "", NewWriteFileError(query.Name, err.Error())
+	}
+	return fileInfo.Path, nil
+}
+
 func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int, error) {
 	filter := parentFilter.CreateNode()
 
@@ -686,7 +1036,7 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*FileInfo, int,
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		parentFilter.viewCache().Replace(view)
 	}
 
 	return view.FileInfo, len(fields), nil
@@ -711,6 +1061,13 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*FileInfo, int
 		dropIndices[i] = idx
 	}
 
+	if view.FileInfo.Schema != nil {
+		view.FileInfo.Schema = view.FileInfo.Schema.Copy()
+		for _, idx := range dropIndices {
+			view.FileInfo.Schema.DropColumn(view.Header[idx].Column)
+		}
+	}
+
 	view.selectFields = []int{}
 	for i := 0; i < view.FieldLen(); i++ {
 		if view.Header[i].IsFromTable && !InIntSlice(i, dropIndices) {
@@ -718,12 +1075,12 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*FileInfo, int
 		}
 	}
 
-	view.Fix()
+	view.Fix(filter.flags())
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		parentFilter.viewCache().Replace(view)
 	}
 
 	return view.FileInfo, len(dropIndices), nil
@@ -750,18 +1107,191 @@ func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*FileInfo, e
 		return nil, err
 	}
 
+	if view.FileInfo.Schema != nil {
+		for _, c := range view.FileInfo.Schema.Constraints {
+			if c.Type == CheckConstraint && InStrSliceWithCaseInsensitive(view.Header[idx].Column, c.References()) {
+				return nil, NewFieldReferencedByConstraintError(query.Old, c.Name)
+			}
+		}
+
+		view.FileInfo.Schema = view.FileInfo.Schema.Copy()
+		view.FileInfo.Schema.RenameColumn(view.Header[idx].Column, query.New.Literal)
+	}
+
 	view.Header[idx].Column = query.New.Literal
 	view.Filter = nil
 
 	if view.FileInfo.IsTemporary {
 		filter.TempViews.Replace(view)
 	} else {
-		ViewCache.Replace(view)
+		parentFilter.viewCache().Replace(view)
+	}
+
+	return view.FileInfo, nil
+}
+
+func AddConstraint(query parser.AddConstraint, parentFilter *Filter) (*FileInfo, string, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	schema := view.FileInfo.Schema.Copy()
+	if schema == nil || schema.Inferred {
+		schema = &TableSchema{}
+	}
+
+	c, err := schema.AddConstraint(query.Constraint, parser.Identifier{}, parser.FormatTableName(view.FileInfo.Path), view.Header.TableColumnNames())
+	if err != nil {
+		return nil, "", err
+	}
+
+	view.Filter = nil
+	if err = view.validateConstraint(query.Constraint, c, filter); err != nil {
+		return nil, "", err
+	}
+	view.FileInfo.Schema = schema
+
+	if view.FileInfo.IsTemporary {
+		filter.TempViews.Replace(view)
+	} else {
+		parentFilter.viewCache().Replace(view)
+	}
+
+	return view.FileInfo, c.Name, nil
+}
+
+func DropConstraint(query parser.DropConstraint, parentFilter *Filter) (*FileInfo, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := view.FileInfo.Schema.Copy()
+	if schema == nil || !schema.DropConstraint(query.Name.Literal) {
+		return nil, NewConstraintNotExistError(query.Name)
+	}
+	view.FileInfo.Schema = schema
+	view.Filter = nil
+
+	if view.FileInfo.IsTemporary {
+		filter.TempViews.Replace(view)
+	} else {
+		parentFilter.viewCache().Replace(view)
 	}
 
 	return view.FileInfo, nil
 }
 
+func RenameTable(query parser.RenameTable, parentFilter *Filter) (*FileInfo, *FileInfo, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if view.FileInfo.IsTemporary {
+		return nil, nil, NewTableIsTemporaryError(query.Table)
+	}
+
+	flags := parentFilter.flags()
+	newFileInfo, err := NewFileInfoForCreate(query.New, flags.Repository, view.FileInfo.Delimiter, view.FileInfo.Encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+	h, err := file.NewHandlerForCreate(parentFilter.fileContainer(), newFileInfo.Path)
+	if err != nil {
+		return nil, nil, NewFileAlreadyExistError(query.New)
+	}
+
+	fileInfo := *view.FileInfo
+	fileInfo.Path = newFileInfo.Path
+	fileInfo.Compression = newFileInfo.Compression
+	fileInfo.Handler = h
+	if len(fileInfo.RenamedFrom) < 1 {
+		fileInfo.RenamedFrom = view.FileInfo.Path
+	}
+
+	oldFileInfo := view.FileInfo
+	if err = removeFromViewCache(oldFileInfo, parentFilter); err != nil {
+		fileInfo.Close()
+		return nil, nil, err
+	}
+
+	view.FileInfo = &fileInfo
+	view.Header.Update(parser.FormatTableName(fileInfo.Path), nil)
+	view.Filter = nil
+	parentFilter.viewCache().Set(view)
+
+	return oldFileInfo, view.FileInfo, nil
+}
+
+func DropTable(query parser.DropTable, parentFilter *Filter) (*FileInfo, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		if _, ok := err.(*FileNotExistError); ok && query.IfExists {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if view.FileInfo.IsTemporary {
+		return nil, NewTableIsTemporaryError(query.Table)
+	}
+
+	if err = removeFromViewCache(view.FileInfo, parentFilter); err != nil {
+		return nil, err
+	}
+
+	return view.FileInfo, nil
+}
+
+func removeFromViewCache(fileInfo *FileInfo, filter *Filter) error {
+	if filter.uncommittedViews().IsCreated(fileInfo.Path) {
+		return filter.viewCache().Dispose(fileInfo.Path)
+	}
+	delete(filter.viewCache(), strings.ToUpper(fileInfo.Path))
+	return nil
+}
+
+func TruncateTable(query parser.TruncateTable, parentFilter *Filter) (*FileInfo, int, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deletedRecords := view.RecordLen()
+	view.RecordSet = RecordSet{}
+	view.Filter = nil
+
+	if view.FileInfo.IsTemporary {
+		filter.TempViews.Replace(view)
+	} else {
+		parentFilter.viewCache().Replace(view)
+	}
+
+	return view.FileInfo, deletedRecords, nil
+}
+
 func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*FileInfo, string, error) {
 	var log string
 	filter := parentFilter.CreateNode()
@@ -789,7 +1319,7 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
 	switch attr {
-	case TableDelimiter, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
+	case TableDelimiter, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape, TableCompression:
 		s := value.ToString(p)
 		if value.IsNull(s) {
 			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
@@ -805,6 +1335,8 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 			err = fileInfo.SetLineBreak(s.(value.String).Raw())
 		case TableJsonEscape:
 			err = fileInfo.SetJsonEscape(s.(value.String).Raw())
+		case TableCompression:
+			err = fileInfo.SetCompression(s.(value.String).Raw())
 		}
 	case TableHeader, TableEncloseAll, TablePrettyPrint:
 		b := value.ToBoolean(p)
@@ -830,7 +1362,7 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 		return nil, log, NewInvalidTableAttributeValueError(query, err.Error())
 	}
 
-	w := NewObjectWriter()
+	w := NewObjectWriter(filter)
 	w.WriteColorWithoutLineBreak("Path: ", cmd.LableEffect)
 	w.WriteColorWithoutLineBreak(fileInfo.Path, cmd.ObjectEffect)
 	w.NewLine()
@@ -842,26 +1374,27 @@ func SetTableAttribute(query parser.SetTableAttribute, parentFilter *Filter) (*F
 	w.Title2Effect = cmd.IdentifierEffect
 	log = "\n" + w.String() + "\n"
 
-	ViewCache.Replace(view)
+	parentFilter.viewCache().Replace(view)
 
 	return view.FileInfo, log, nil
 }
 
 func Commit(expr parser.Expression, filter *Filter) error {
-	createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+	createdFiles, updatedFiles := filter.uncommittedViews().UncommittedFiles()
+	droppedFiles := filter.uncommittedViews().UncommittedDroppedFiles()
 
 	createFileInfo := make([]*FileInfo, 0, len(createdFiles))
 	updateFileInfo := make([]*FileInfo, 0, len(updatedFiles))
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
-			view, _ := ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
+			view, _ := filter.viewCache().Get(parser.Identifier{Literal: fileinfo.Path})
 
 			fp := view.FileInfo.Handler.FileForUpdate()
 			fp.Truncate(0)
 			fp.Seek(0, io.SeekStart)
 
-			err := EncodeView(fp, view, fileinfo)
+			err := EncodeView(fp, view, fileinfo, filter)
 			if err != nil {
 				return NewCommitError(expr, err.Error())
 			}
@@ -871,13 +1404,13 @@ func Commit(expr parser.Expression, filter *Filter) error {
 
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
-			view, _ := ViewCache.Get(parser.Identifier{Literal: fileinfo.Path})
+			view, _ := filter.viewCache().Get(parser.Identifier{Literal: fileinfo.Path})
 
 			fp := view.FileInfo.Handler.FileForUpdate()
 			fp.Truncate(0)
 			fp.Seek(0, io.SeekStart)
 
-			if err := EncodeView(fp, view, fileinfo); err != nil {
+			if err := EncodeView(fp, view, fileinfo, filter); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
@@ -889,45 +1422,69 @@ func Commit(expr parser.Expression, filter *Filter) error {
 		if err := f.Commit(); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
-		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), cmd.GetFlags().Quiet)
+		filter.uncommittedViews().Unset(f)
+		filter.logNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), filter.flags().Quiet)
+		CommitTableSchema(f, filter)
+		if 0 < len(f.RenamedFrom) {
+			RenameIndexes(f.RenamedFrom, f, filter)
+			f.RenamedFrom = ""
+		}
+		RebuildIndexes(f, filter)
 	}
 	for _, f := range updateFileInfo {
 		if err := f.Commit(); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
-		UncommittedViews.Unset(f)
-		LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), cmd.GetFlags().Quiet)
+		filter.uncommittedViews().Unset(f)
+		filter.logNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), filter.flags().Quiet)
+		CommitTableSchema(f, filter)
+		RebuildIndexes(f, filter)
+	}
+	for _, f := range droppedFiles {
+		if err := f.Remove(); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		filter.uncommittedViews().Unset(f)
+		filter.logNotice(fmt.Sprintf("Commit: file %q is deleted.", f.Path), filter.flags().Quiet)
+		RemoveTableSchema(f, filter)
+		RemoveIndexes(f, filter)
 	}
 
-	filter.TempViews.Store(UncommittedViews.UncommittedTempViews())
-	UncommittedViews.Clean()
-	if err := ReleaseResources(); err != nil {
+	filter.TempViews.Store(filter.uncommittedViews().UncommittedTempViews(), filter)
+	filter.uncommittedViews().Clean()
+	if err := filter.releaseResources(); err != nil {
 		return NewCommitError(expr, err.Error())
 	}
 	return nil
 }
 
 func Rollback(expr parser.Expression, filter *Filter) error {
-	createdFiles, updatedFiles := UncommittedViews.UncommittedFiles()
+	createdFiles, updatedFiles := filter.uncommittedViews().UncommittedFiles()
+	droppedFiles := filter.uncommittedViews().UncommittedDroppedFiles()
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is deleted.", fileinfo.Path), cmd.GetFlags().Quiet)
+			filter.logNotice(fmt.Sprintf("Rollback: file %q is deleted.", fileinfo.Path), filter.flags().Quiet)
 		}
 	}
 
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
-			LogNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), cmd.GetFlags().Quiet)
+			filter.logNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), filter.flags().Quiet)
+		}
+	}
+
+	if 0 < len(droppedFiles) {
+		for _, fileinfo := range droppedFiles {
+			filter.logNotice(fmt.Sprintf("Rollback: file %q is restored.", fileinfo.Path), filter.flags().Quiet)
 		}
 	}
 
 	if filter != nil {
-		filter.TempViews.Restore(UncommittedViews.UncommittedTempViews())
+		filter.TempViews.Restore(filter.uncommittedViews().UncommittedTempViews(), filter)
 	}
-	UncommittedViews.Clean()
-	if err := ReleaseResources(); err != nil {
+	filter.uncommittedViews().Clean()
+	if err := filter.releaseResources(); err != nil {
 		return NewRollbackError(expr, err.Error())
 	}
 	return nil
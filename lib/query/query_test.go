@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -1125,6 +1126,376 @@ func TestSelect(t *testing.T) {
 	}
 }
 
+var selectGroupingSetsTests = []struct {
+	Name   string
+	Query  string
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name:  "Rollup",
+		Query: "select column1, count(column2), grouping(column1) from group_table group by rollup(column1)",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(2), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(1), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewInteger(5), value.NewInteger(1)}),
+		},
+	},
+	{
+		Name:  "Cube",
+		Query: "select column1, column2, grouping(column1, column2) from group_table where column1 = 3 group by cube(column1, column2)",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("3"), value.NewString("str5"), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewNull(), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("str5"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewNull(), value.NewInteger(3)}),
+		},
+	},
+	{
+		Name:  "Grouping Sets",
+		Query: "select column1, column2 from group_table group by grouping sets(column1, (column2), ()) having column1 = 1 or column2 = 'str5' or grouping(column1, column2) = 3 order by column1, column2",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewNull(), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("str5")}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewNull()}),
+		},
+	},
+	{
+		Name:  "Grouping Function Not Group Key Error",
+		Query: "select grouping(column2) from group_table group by column1",
+		Error: "[L:1 C:17] field column2 is not a group key",
+	},
+}
+
+func TestSelect_GroupingSets(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectGroupingSetsTests {
+		ViewCache.Clean()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := Select(statements[0].(parser.SelectQuery), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
+
+var selectPivotTests = []struct {
+	Name   string
+	Query  string
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name:  "Pivot",
+		Query: "select p.column1, p.str1, p.str3 from group_table pivot (count(column2) for column2 in ('str1', 'str3')) p",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(1), value.NewInteger(0)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(0), value.NewInteger(1)}),
+		},
+	},
+	{
+		Name:  "Unpivot",
+		Query: "select * from table1 unpivot (val for col in (column1, column2 as c2)) u where u.col = 'c2'",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("c2"), value.NewString("str1")}),
+			NewRecord([]value.Primary{value.NewString("c2"), value.NewString("str2")}),
+			NewRecord([]value.Primary{value.NewString("c2"), value.NewString("str3")}),
+		},
+	},
+	{
+		Name:  "Pivot Field Does Not Exist Error",
+		Query: "select * from table1 pivot (count(*) for notexist in (1))",
+		Error: "[L:1 C:42] field notexist does not exist",
+	},
+}
+
+func TestSelect_Pivot(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectPivotTests {
+		ViewCache.Clean()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := Select(statements[0].(parser.SelectQuery), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
+
+var selectLateralTests = []struct {
+	Name   string
+	Query  string
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name:  "Generate Series",
+		Query: "select * from generate_series(1, 2.5, 0.5)",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewFloat(1)}),
+			NewRecord([]value.Primary{value.NewFloat(1.5)}),
+			NewRecord([]value.Primary{value.NewFloat(2)}),
+			NewRecord([]value.Primary{value.NewFloat(2.5)}),
+		},
+	},
+	{
+		Name:  "Cross Join Lateral Table Function",
+		Query: "select t.column1, s.value from table1 t cross join lateral generate_series(2, t.column1) s",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(3)}),
+		},
+	},
+	{
+		Name:  "Left Join Lateral Table Function",
+		Query: "select t.column1, s.position, s.value from table1 t left join lateral split_to_table(case t.column1 when 1 then null else t.column2 end, 'r') s on true where t.column1 < 3",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewNull(), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(1), value.NewString("st")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(2), value.NewString("2")}),
+		},
+	},
+	{
+		Name:  "Lateral Subquery",
+		Query: "select t.column1, u.c from table1 t, lateral (select t.column2 || '-' || t.column1 as c) u",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1-1")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2-2")}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3-3")}),
+		},
+	},
+	{
+		Name:  "Chained Lateral Tables",
+		Query: "select s1.value, s2.value from generate_series(1, 2) s1, lateral generate_series(s1.value, 2) s2",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewInteger(2)}),
+		},
+	},
+	{
+		Name:  "Lateral Right Join Error",
+		Query: "select * from table1 t right join lateral generate_series(1, 2) s on true",
+		Error: "[L:1 C:65] lateral table s cannot be joined by right outer join",
+	},
+	{
+		Name:  "Generate Series of Datetimes",
+		Query: "select * from generate_series('2012-01-31', '2012-04-30', interval '1 month')",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewDatetime(time.Date(2012, 1, 31, 0, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewDatetime(time.Date(2012, 2, 29, 0, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewDatetime(time.Date(2012, 3, 31, 0, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewDatetime(time.Date(2012, 4, 30, 0, 0, 0, 0, GetTestLocation()))}),
+		},
+	},
+	{
+		Name:  "Generate Series of Datetimes Argument Error",
+		Query: "select * from generate_series(1, 2, interval '1 day')",
+		Error: "[L:1 C:15] the start and the stop must be datetimes when the step is an interval for function generate_series",
+	},
+	{
+		Name:  "Generate Series Zero Step Error",
+		Query: "select * from generate_series(1, 2, 0)",
+		Error: "[L:1 C:15] the step must not be zero for function generate_series",
+	},
+}
+
+func TestSelect_Lateral(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectLateralTests {
+		ViewCache.Clean()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := Select(statements[0].(parser.SelectQuery), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
+
+var selectWindowTests = []struct {
+	Name   string
+	Query  string
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name:  "Range Frame",
+		Query: "select column1, sum(column1) over (order by column1 range between 1 preceding and current row) as s from group_table",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(6)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(6)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(7)}),
+		},
+	},
+	{
+		Name:  "Range Frame with Interval Offsets",
+		Query: "select column1, sum(column1) over (order by datetime('2012-01-0' || column1) range between interval '1 day' preceding and current row) as s from group_table",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(6)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(6)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(7)}),
+		},
+	},
+	{
+		Name:  "Groups Frame",
+		Query: "select column1, count(*) over (order by column1 desc groups between 1 preceding and current row) as c from group_table",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(3)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(3)}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(4)}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(4)}),
+		},
+	},
+	{
+		Name:  "Window Clause",
+		Query: "select column1, row_number() over w as n, count(*) over w as c from group_table window w as (partition by column1 order by column2)",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(1), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("1"), value.NewInteger(2), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(1), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewInteger(2), value.NewInteger(2)}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewInteger(1), value.NewInteger(1)}),
+		},
+	},
+	{
+		Name:  "Window Does Not Exist Error",
+		Query: "select count(*) over w from group_table",
+		Error: "[L:1 C:22] window w does not exist",
+	},
+	{
+		Name:  "Window Redeclared Error",
+		Query: "select 1 from group_table window w as (), w as ()",
+		Error: "[L:1 C:43] window w is redeclared",
+	},
+	{
+		Name:  "Rows Frame Offset Error",
+		Query: "select sum(column1) over (order by column1 rows 1.5 preceding) from group_table",
+		Error: "[L:1 C:8] invalid offset 1.5 preceding for ROWS frame",
+	},
+	{
+		Name:  "Range Frame Mixed Offsets Error",
+		Query: "select sum(column1) over (order by column1 range between interval '1 second' preceding and 1 following) from group_table",
+		Error: "[L:1 C:8] invalid offset 1 following for RANGE frame",
+	},
+	{
+		Name:  "Range Frame Order By Items Error",
+		Query: "select sum(column1) over (order by column1, column2 range 1 preceding) from group_table",
+		Error: "[L:1 C:8] function sum requires exactly one order by item for range frame offsets",
+	},
+	{
+		Name:  "Range Frame Order By Value Error",
+		Query: "select sum(column1) over (order by column2 range 1 preceding) from group_table",
+		Error: "[L:1 C:8] order by item column2 of function sum is not a number or a datetime for range frame offsets",
+	},
+	{
+		Name:  "Range Frame Interval Offset Order By Value Error",
+		Query: "select sum(column1) over (order by column1 range interval '1 second' preceding) from group_table",
+		Error: "[L:1 C:8] order by item column1 of function sum is not a number or a datetime for range frame offsets",
+	},
+}
+
+func TestSelect_Window(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectWindowTests {
+		ViewCache.Clean()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := Select(statements[0].(parser.SelectQuery), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, result.RecordSet, v.Result)
+		}
+	}
+	ViewCache.Clean()
+}
+
 var insertTests = []struct {
 	Name         string
 	Query        parser.InsertQuery
@@ -1743,6 +2114,49 @@ var updateTests = []struct {
 		},
 		Error: "[L:- C:-] file notexist does not exist",
 	},
+	{
+		Name: "Update Query For Compressed Table",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table9"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:        GetTestFilePath("table9.csv.bz2"),
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: cmd.BZIP2,
+			},
+		},
+		UpdateCounts: []int{1},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("table9.csv.bz2")): &View{
+				FileInfo: &FileInfo{
+					Path:        GetTestFilePath("table9.csv.bz2"),
+					Delimiter:   ',',
+					Encoding:    text.UTF8,
+					LineBreak:   text.LF,
+					Compression: cmd.BZIP2,
+				},
+				Header: NewHeader("table9", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("update"),
+					}),
+				},
+				ForUpdate: true,
+			},
+		},
+	},
 	{
 		Name: "Update Query Filter Error",
 		Query: parser.UpdateQuery{
@@ -2350,6 +2764,109 @@ func TestDelete(t *testing.T) {
 	ReleaseResources()
 }
 
+var mergeTests = []struct {
+	Name          string
+	Query         string
+	InsertedCount int
+	UpdatedCount  int
+	DeletedCount  int
+	Result        RecordSet
+	Error         string
+}{
+	{
+		Name: "Merge Query",
+		Query: "merge into table1 t1 using table2 t2 on t1.column1 = t2.column3" +
+			" when matched and t2.column3 = 3 then delete" +
+			" when matched then update set column2 = t2.column4" +
+			" when not matched then insert (column1, column2) values (t2.column3, t2.column4)",
+		InsertedCount: 1,
+		UpdatedCount:  1,
+		DeletedCount:  1,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("str22")}),
+			NewRecord([]value.Primary{value.NewString("4"), value.NewString("str44")}),
+		},
+	},
+	{
+		Name: "Merge Query Without Applicable Clauses",
+		Query: "merge into table1 using (select 9 as id) s on table1.column1 = s.id" +
+			" when not matched and s.id < 5 then insert values (s.id, 'str9')",
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2")}),
+			NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3")}),
+		},
+	},
+	{
+		Name: "Merge Query Target Record Ambiguous Error",
+		Query: "merge into table1 using (select 1 as id union all select 1) s on table1.column1 = s.id" +
+			" when matched then delete",
+		Error: "[L:1 C:88] a record in table1 is matched by more than one record in the source table",
+	},
+	{
+		Name: "Merge Query Update Field Not Exist Error",
+		Query: "merge into table1 using table2 on table1.column1 = table2.column3" +
+			" when matched then update set column3 = 1",
+		Error: "[L:1 C:96] field column3 does not exist in the tables to update",
+	},
+	{
+		Name: "Merge Query Update Value Ambiguous Error",
+		Query: "merge into table1 using table2 on table1.column1 = table2.column3" +
+			" when matched then update set column2 = 1, table1.column2 = 2",
+		Error: "[L:1 C:109] value 2 to set in the field table1.column2 is ambiguous",
+	},
+	{
+		Name: "Merge Query Insert Row Value Length Error",
+		Query: "merge into table1 using table2 on table1.column1 = table2.column3" +
+			" when not matched then insert values (table2.column3)",
+		Error: "[L:1 C:103] row value should contain exactly 2 values",
+	},
+}
+
+func TestMerge(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	filter := NewEmptyFilter()
+
+	for _, v := range mergeTests {
+		ReleaseResources()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		file, inserted, updated, deleted, err := Merge(statements[0].(parser.MergeQuery), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if file.Path != GetTestFilePath("table1.csv") {
+			t.Errorf("%s: file path = %q, want %q", v.Name, file.Path, GetTestFilePath("table1.csv"))
+		}
+		if inserted != v.InsertedCount || updated != v.UpdatedCount || deleted != v.DeletedCount {
+			t.Errorf("%s: counts = %d, %d, %d, want %d, %d, %d", v.Name, inserted, updated, deleted, v.InsertedCount, v.UpdatedCount, v.DeletedCount)
+		}
+
+		result := ViewCache[strings.ToUpper(GetTestFilePath("table1.csv"))].RecordSet
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+	ReleaseResources()
+}
+
 var createTableTests = []struct {
 	Name       string
 	Query      parser.CreateTable
@@ -2382,26 +2899,72 @@ var createTableTests = []struct {
 					Encoding:  text.UTF8,
 					LineBreak: text.LF,
 				},
-				Header:    NewHeader("create_table_1", []string{"column1", "column2"}),
-				RecordSet: RecordSet{},
+				Header:    NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Create Table From Select Query",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							parser.Field{Object: parser.NewIntegerValueFromString("2")},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("create_table_1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+				Header: NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewInteger(2),
+					}),
+				},
 				ForUpdate: true,
 			},
 		},
 	},
 	{
-		Name: "Create Table From Select Query",
+		Name: "Create Table From Select Query with Column Types",
 		Query: parser.CreateTable{
 			Table: parser.Identifier{Literal: "create_table_1.csv"},
 			Fields: []parser.QueryExpression{
-				parser.Identifier{Literal: "column1"},
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
 				parser.Identifier{Literal: "column2"},
 			},
 			Query: parser.SelectQuery{
 				SelectEntity: parser.SelectEntity{
 					SelectClause: parser.SelectClause{
 						Fields: []parser.QueryExpression{
-							parser.Field{Object: parser.NewIntegerValueFromString("1")},
-							parser.Field{Object: parser.NewIntegerValueFromString("2")},
+							parser.Field{Object: parser.NewStringValue("1")},
+							parser.Field{Object: parser.NewStringValue("2")},
 						},
 					},
 				},
@@ -2413,6 +2976,9 @@ var createTableTests = []struct {
 			NoHeader:  false,
 			Encoding:  text.UTF8,
 			LineBreak: text.LF,
+			Schema: &TableSchema{
+				Columns: []ColumnSchema{{Name: "column1", Type: "INTEGER"}},
+			},
 		},
 		ViewCache: ViewMap{
 			strings.ToUpper(GetTestFilePath("create_table_1.csv")): &View{
@@ -2422,18 +2988,142 @@ var createTableTests = []struct {
 					NoHeader:  false,
 					Encoding:  text.UTF8,
 					LineBreak: text.LF,
+					Schema: &TableSchema{
+						Columns: []ColumnSchema{{Name: "column1", Type: "INTEGER"}},
+					},
 				},
 				Header: NewHeader("create_table_1", []string{"column1", "column2"}),
 				RecordSet: RecordSet{
 					NewRecord([]value.Primary{
 						value.NewInteger(1),
-						value.NewInteger(2),
+						value.NewString("2"),
 					}),
 				},
 				ForUpdate: true,
 			},
 		},
 	},
+	{
+		Name: "Create Table Invalid Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "number"}},
+			},
+		},
+		Error: "[L:- C:-] number is an invalid column type",
+	},
+	{
+		Name: "Create Table From Select Query Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("abc")},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] value \"abc\" cannot be converted to INTEGER for field column1",
+	},
+	{
+		Name: "Create Table With Constraints",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column: parser.Identifier{Literal: "column1"},
+					Constraints: []parser.QueryExpression{
+						parser.TableConstraint{Type: parser.Token{Token: parser.PRIMARY, Literal: "PRIMARY KEY"}},
+					},
+				},
+				parser.Identifier{Literal: "column2"},
+				parser.TableConstraint{
+					Constraint: "CONSTRAINT",
+					Name:       parser.Identifier{Literal: "uq"},
+					Type:       parser.Token{Token: parser.UNIQUE, Literal: "UNIQUE"},
+					Columns:    []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			Schema: &TableSchema{
+				Constraints: []ConstraintSchema{
+					{Name: "create_table_1_pkey", Type: "PRIMARY KEY", Columns: []string{"column1"}},
+					{Name: "uq", Type: "UNIQUE", Columns: []string{"column2"}},
+				},
+			},
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("create_table_1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					Schema: &TableSchema{
+						Constraints: []ConstraintSchema{
+							{Name: "create_table_1_pkey", Type: "PRIMARY KEY", Columns: []string{"column1"}},
+							{Name: "uq", Type: "UNIQUE", Columns: []string{"column2"}},
+						},
+					},
+				},
+				Header:    NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{},
+				ForUpdate: true,
+			},
+		},
+	},
+	{
+		Name: "Create Table From Select Query Constraint Violation Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{
+					Column: parser.Identifier{Literal: "column1"},
+					Constraints: []parser.QueryExpression{
+						parser.TableConstraint{Type: parser.Token{Token: parser.NOT, Literal: "NOT NULL"}},
+					},
+				},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewNullValue()},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] constraint create_table_1_column1_not_null is violated by record 1 in create_table_1.csv: field column1 is null",
+	},
+	{
+		Name: "Create Table Constraint Field Not Exist Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.TableConstraint{
+					Type:    parser.Token{Token: parser.UNIQUE, Literal: "UNIQUE"},
+					Columns: []parser.QueryExpression{parser.Identifier{Literal: "notexist"}},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
 	{
 		Name: "Create Table File Already Exist Error",
 		Query: parser.CreateTable{
@@ -3497,7 +4187,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "[L:- C:-] format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "[L:- C:-] format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XLSX",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -3536,7 +4226,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "encoding"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "[L:- C:-] encoding must be one of UTF8|SJIS",
+		Error: "[L:- C:-] encoding must be one of UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5",
 	},
 	{
 		Name: "Set Encoding Error in JSON Format",
@@ -3692,6 +4382,260 @@ var setTableAttributeTests = []struct {
 	},
 }
 
+var dropTableTests = []struct {
+	Name   string
+	Query  string
+	Result string
+	Error  string
+}{
+	{
+		Name:   "Drop Table",
+		Query:  "drop table drop_table",
+		Result: GetTestFilePath("drop_table.csv"),
+	},
+	{
+		Name:  "Drop Table If Exists",
+		Query: "drop table if exists notexist",
+	},
+	{
+		Name:  "Drop Table File Not Exist Error",
+		Query: "drop table notexist",
+		Error: "[L:1 C:12] file notexist does not exist",
+	},
+	{
+		Name:  "Drop Table Temporary Table Error",
+		Query: "drop table tmpview",
+		Error: "[L:1 C:12] tmpview is a temporary table",
+	},
+}
+
+func TestDropTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = true
+	defer func() {
+		tf.Quiet = false
+	}()
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW": &View{
+				Header:    NewHeader("tmpview", []string{"column1"}),
+				RecordSet: []Record{},
+				FileInfo: &FileInfo{
+					Path:        "tmpview",
+					IsTemporary: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range dropTableTests {
+		ReleaseResources()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		result, err := DropTable(statements[0].(parser.DropTable), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if len(v.Result) < 1 {
+			if result != nil {
+				t.Errorf("%s: result = %v, want nil", v.Name, result)
+			}
+			continue
+		}
+		if result.Path != v.Result {
+			t.Errorf("%s: file path = %q, want %q", v.Name, result.Path, v.Result)
+		}
+		if _, ok := ViewCache[strings.ToUpper(v.Result)]; ok {
+			t.Errorf("%s: view is not removed from the cache", v.Name)
+		}
+	}
+	ReleaseResources()
+
+	statements, _ := parser.Parse("drop table drop_table", "", nil, GetTestLocation())
+	result, _ := DropTable(statements[0].(parser.DropTable), filter)
+	UncommittedViews.SetForDroppedView(result)
+	if _, err := loadView(parser.Table{Object: parser.Identifier{Literal: "drop_table"}}, filter, false, false); err == nil {
+		t.Errorf("no error, want error for loading a dropped table")
+	}
+	if err := Commit(parser.TransactionControl{Token: parser.COMMIT}, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err := os.Stat(GetTestFilePath("drop_table.csv")); !os.IsNotExist(err) {
+		t.Errorf("file %q is not removed", GetTestFilePath("drop_table.csv"))
+	}
+}
+
+var truncateTableTests = []struct {
+	Name        string
+	Query       string
+	Result      int
+	ResultTable string
+	Error       string
+}{
+	{
+		Name:        "Truncate Table",
+		Query:       "truncate table table1",
+		Result:      3,
+		ResultTable: strings.ToUpper(GetTestFilePath("table1.csv")),
+	},
+	{
+		Name:        "Truncate Temporary Table",
+		Query:       "truncate table tmpview",
+		Result:      1,
+		ResultTable: "TMPVIEW",
+	},
+	{
+		Name:  "Truncate Table File Not Exist Error",
+		Query: "truncate table notexist",
+		Error: "[L:1 C:16] file notexist does not exist",
+	},
+}
+
+func TestTruncateTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range truncateTableTests {
+		ReleaseResources()
+		filter := NewEmptyFilter()
+		filter.TempViews = TemporaryViewScopes{
+			ViewMap{
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{value.NewString("1")}),
+					},
+					FileInfo: &FileInfo{
+						Path:        "tmpview",
+						IsTemporary: true,
+					},
+				},
+			},
+		}
+
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		_, cnt, err := TruncateTable(statements[0].(parser.TruncateTable), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if cnt != v.Result {
+			t.Errorf("%s: count = %d, want %d", v.Name, cnt, v.Result)
+		}
+
+		var view *View
+		if v.ResultTable == "TMPVIEW" {
+			view = filter.TempViews[0]["TMPVIEW"]
+		} else {
+			view = ViewCache[v.ResultTable]
+		}
+		if view.RecordLen() != 0 {
+			t.Errorf("%s: record length = %d, want %d", v.Name, view.RecordLen(), 0)
+		}
+	}
+	ReleaseResources()
+}
+
+var renameTableTests = []struct {
+	Name      string
+	Query     string
+	ResultOld string
+	ResultNew string
+	Error     string
+}{
+	{
+		Name:      "Rename Table",
+		Query:     "alter table table1 rename to `renamed_table.csv`",
+		ResultOld: GetTestFilePath("table1.csv"),
+		ResultNew: GetTestFilePath("renamed_table.csv"),
+	},
+	{
+		Name:  "Rename Table File Already Exist Error",
+		Query: "alter table table1 rename to `table2.csv`",
+		Error: "[L:1 C:30] file `table2.csv` already exists",
+	},
+	{
+		Name:  "Rename Table File Not Exist Error",
+		Query: "alter table notexist rename to `renamed_table.csv`",
+		Error: "[L:1 C:13] file notexist does not exist",
+	},
+}
+
+func TestRenameTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range renameTableTests {
+		ReleaseResources()
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		oldFile, newFile, err := RenameTable(statements[0].(parser.RenameTable), filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if oldFile.Path != v.ResultOld {
+			t.Errorf("%s: old file path = %q, want %q", v.Name, oldFile.Path, v.ResultOld)
+		}
+		if newFile.Path != v.ResultNew {
+			t.Errorf("%s: new file path = %q, want %q", v.Name, newFile.Path, v.ResultNew)
+		}
+		if _, ok := ViewCache[strings.ToUpper(v.ResultOld)]; ok {
+			t.Errorf("%s: old view is not removed from the cache", v.Name)
+		}
+		view, ok := ViewCache[strings.ToUpper(v.ResultNew)]
+		if !ok {
+			t.Errorf("%s: new view is not cached", v.Name)
+		} else if view.RecordLen() != 3 {
+			t.Errorf("%s: record length = %d, want %d", v.Name, view.RecordLen(), 3)
+		}
+	}
+	ReleaseResources()
+}
+
 func TestSetTableAttribute(t *testing.T) {
 	tf := cmd.GetFlags()
 	tf.Repository = TestDir
@@ -3768,8 +4712,8 @@ func TestSetTableAttribute(t *testing.T) {
 func TestCommit(t *testing.T) {
 	cmd.GetFlags().SetQuiet(false)
 
-	ch, _ := file.NewHandlerForCreate(GetTestFilePath("create_file.csv"))
-	uh, _ := file.NewHandlerForUpdate(GetTestFilePath("updated_file_1.csv"))
+	ch, _ := file.NewHandlerForCreate(FileContainer, GetTestFilePath("create_file.csv"))
+	uh, _ := file.NewHandlerForUpdate(FileContainer, GetTestFilePath("updated_file_1.csv"), file.DefaultWaitTimeout, file.DefaultRetryInterval)
 
 	ViewCache = ViewMap{
 		strings.ToUpper(GetTestFilePath("created_file.csv")): &View{
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
@@ -54,6 +55,6 @@ func BenchmarkRecord_SerializeComparisonKeys(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		buf.Reset()
-		recordSerializeComparisonKeysBenchmarkRecord.SerializeComparisonKeys(buf)
+		recordSerializeComparisonKeysBenchmarkRecord.SerializeComparisonKeys(buf, cmd.GetFlags())
 	}
 }
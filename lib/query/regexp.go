@@ -0,0 +1,103 @@
+package query
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	RegExpFlagCaseInsensitive = 'i'
+	RegExpFlagMultiLine       = 'm'
+	RegExpFlagDotAll          = 's'
+	RegExpFlagUngreedy        = 'U'
+)
+
+// RegExpCacheSize is the maximum number of compiled expressions held by a RegExpCache.
+const RegExpCacheSize = 256
+
+type regExpCacheEntry struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+// RegExpCache holds recently used compiled expressions and evicts the least recently used one when it is full.
+type RegExpCache struct {
+	size  int
+	exps  map[string]*list.Element
+	order *list.List
+	mtx   *sync.Mutex
+}
+
+func NewRegExpCache() *RegExpCache {
+	return NewRegExpCacheWithSize(RegExpCacheSize)
+}
+
+func NewRegExpCacheWithSize(size int) *RegExpCache {
+	return &RegExpCache{
+		size:  size,
+		exps:  make(map[string]*list.Element, size),
+		order: list.New(),
+		mtx:   &sync.Mutex{},
+	}
+}
+
+func (c *RegExpCache) Get(pattern string, flags string) (*regexp.Regexp, error) {
+	prefix, err := regExpFlagsPrefix(flags)
+	if err != nil {
+		return nil, err
+	}
+	expr := prefix + pattern
+
+	if c == nil {
+		return regexp.Compile(expr)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if elem, ok := c.exps[expr]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*regExpCacheEntry).re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if 0 < c.size && c.size <= c.order.Len() {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.exps, oldest.Value.(*regExpCacheEntry).expr)
+	}
+	c.exps[expr] = c.order.PushFront(&regExpCacheEntry{expr: expr, re: re})
+	return re, nil
+}
+
+func (c *RegExpCache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.order.Len()
+}
+
+func regExpFlagsPrefix(flags string) (string, error) {
+	if len(flags) < 1 {
+		return "", nil
+	}
+
+	used := make([]rune, 0, 4)
+	for _, r := range flags {
+		switch r {
+		case RegExpFlagCaseInsensitive, RegExpFlagMultiLine, RegExpFlagDotAll, RegExpFlagUngreedy:
+			if !strings.ContainsRune(string(used), r) {
+				used = append(used, r)
+			}
+		default:
+			return "", errors.New(fmt.Sprintf("%q is an unknown flag", string(r)))
+		}
+	}
+	return "(?" + string(used) + ")", nil
+}
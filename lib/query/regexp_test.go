@@ -0,0 +1,87 @@
+package query
+
+import (
+	"testing"
+)
+
+var regExpCacheGetTests = []struct {
+	Pattern string
+	Flags   string
+	Expr    string
+	Error   string
+}{
+	{
+		Pattern: "^a.c$",
+		Flags:   "",
+		Expr:    "^a.c$",
+	},
+	{
+		Pattern: "^a.c$",
+		Flags:   "imi",
+		Expr:    "(?im)^a.c$",
+	},
+	{
+		Pattern: "^a.c$",
+		Flags:   "sU",
+		Expr:    "(?sU)^a.c$",
+	},
+	{
+		Pattern: "^a.c$",
+		Flags:   "g",
+		Error:   "\"g\" is an unknown flag",
+	},
+	{
+		Pattern: "a(bc",
+		Flags:   "",
+		Error:   "error parsing regexp: missing closing ): `a(bc`",
+	},
+}
+
+func TestRegExpCache_Get(t *testing.T) {
+	c := NewRegExpCache()
+
+	for _, v := range regExpCacheGetTests {
+		re, err := c.Get(v.Pattern, v.Flags)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q, %q", err, v.Pattern, v.Flags)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q, %q", err.Error(), v.Error, v.Pattern, v.Flags)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q, %q", v.Error, v.Pattern, v.Flags)
+			continue
+		}
+		if re.String() != v.Expr {
+			t.Errorf("expression = %q, want %q for %q, %q", re.String(), v.Expr, v.Pattern, v.Flags)
+		}
+
+		cached, _ := c.Get(v.Pattern, v.Flags)
+		if cached != re {
+			t.Errorf("compiled expression is not cached for %q, %q", v.Pattern, v.Flags)
+		}
+	}
+}
+
+func TestRegExpCache_Eviction(t *testing.T) {
+	c := NewRegExpCacheWithSize(2)
+
+	a, _ := c.Get("a", "")
+	_, _ = c.Get("b", "")
+	if cached, _ := c.Get("a", ""); cached != a {
+		t.Error("compiled expression is not cached for \"a\"")
+	}
+	_, _ = c.Get("c", "")
+
+	if c.Len() != 2 {
+		t.Errorf("cache length = %d, want %d", c.Len(), 2)
+	}
+	if _, ok := c.exps["b"]; ok {
+		t.Error("least recently used expression \"b\" is not evicted")
+	}
+	if cached, _ := c.Get("a", ""); cached != a {
+		t.Error("recently used expression \"a\" is evicted")
+	}
+}
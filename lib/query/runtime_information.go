@@ -16,6 +16,8 @@ const (
 	LoadedTablesInformation = "LOADED_TABLES"
 	WorkingDirectory        = "WORKING_DIRECTORY"
 	VersionInformation      = "VERSION"
+	ErrorCodeInformation    = "ERROR_CODE"
+	ErrorMessageInformation = "ERROR_MESSAGE"
 )
 
 var RuntimeInformatinList = []string{
@@ -26,22 +28,24 @@ var RuntimeInformatinList = []string{
 	LoadedTablesInformation,
 	WorkingDirectory,
 	VersionInformation,
+	ErrorCodeInformation,
+	ErrorMessageInformation,
 }
 
-func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error) {
+func GetRuntimeInformation(expr parser.RuntimeInformation, filter *Filter) (value.Primary, error) {
 	var p value.Primary
 
 	switch strings.ToUpper(expr.Name) {
 	case UncommittedInformation:
-		p = value.NewBoolean(!UncommittedViews.IsEmpty())
+		p = value.NewBoolean(!filter.uncommittedViews().IsEmpty())
 	case CreatedInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountCreatedTables()))
+		p = value.NewInteger(int64(filter.uncommittedViews().CountCreatedTables()))
 	case UpdatedInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountUpdatedTables()))
+		p = value.NewInteger(int64(filter.uncommittedViews().CountUpdatedTables()))
 	case UpdatedViewsInformation:
-		p = value.NewInteger(int64(UncommittedViews.CountUpdatedViews()))
+		p = value.NewInteger(int64(filter.uncommittedViews().CountUpdatedViews()))
 	case LoadedTablesInformation:
-		p = value.NewInteger(int64(len(ViewCache)))
+		p = value.NewInteger(int64(len(filter.viewCache())))
 	case WorkingDirectory:
 		wd, err := os.Getwd()
 		if err != nil {
@@ -50,6 +54,22 @@ func GetRuntimeInformation(expr parser.RuntimeInformation) (value.Primary, error
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case ErrorCodeInformation:
+		if filter.caughtError == nil {
+			p = value.NewNull()
+		} else if appErr, ok := filter.caughtError.(AppError); ok {
+			p = value.NewInteger(int64(appErr.GetCode()))
+		} else {
+			p = value.NewInteger(1)
+		}
+	case ErrorMessageInformation:
+		if filter.caughtError == nil {
+			p = value.NewNull()
+		} else if appErr, ok := filter.caughtError.(AppError); ok {
+			p = value.NewString(appErr.ErrorMessage())
+		} else {
+			p = value.NewString(filter.caughtError.Error())
+		}
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
@@ -9,9 +9,10 @@ import (
 )
 
 var getRuntimeInformationTests = []struct {
-	Input  parser.RuntimeInformation
-	Expect value.Primary
-	Error  string
+	Input       parser.RuntimeInformation
+	CaughtError error
+	Expect      value.Primary
+	Error       string
 }{
 	{
 		Input:  parser.RuntimeInformation{Name: "uncommitted"},
@@ -41,6 +42,24 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "version"},
 		Expect: value.NewString("v1.0.0"),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_code"},
+		Expect: value.NewNull(),
+	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_message"},
+		Expect: value.NewNull(),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_code"},
+		CaughtError: NewUserTriggeredError(parser.Trigger{Event: parser.Identifier{Literal: "error"}, Code: value.NewInteger(200)}, "user error"),
+		Expect:      value.NewInteger(200),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_message"},
+		CaughtError: NewUserTriggeredError(parser.Trigger{Event: parser.Identifier{Literal: "error"}, Code: value.NewInteger(200)}, "user error"),
+		Expect:      value.NewString("user error"),
+	},
 	{
 		Input: parser.RuntimeInformation{Name: "invalid"},
 		Error: "[L:- C:-] @#invalid is an unknown runtime information",
@@ -68,7 +87,9 @@ func TestGetRuntimeInformation(t *testing.T) {
 	}
 
 	for _, v := range getRuntimeInformationTests {
-		result, err := GetRuntimeInformation(v.Input)
+		filter := NewEmptyFilter()
+		filter.caughtError = v.CaughtError
+		result, err := GetRuntimeInformation(v.Input, filter)
 
 		if err != nil {
 			if v.Error == "" {
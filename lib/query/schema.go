@@ -0,0 +1,412 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const SchemaFileExt = ".csvqschema"
+
+const (
+	StringColumnType   = "STRING"
+	IntegerColumnType  = "INTEGER"
+	FloatColumnType    = "FLOAT"
+	DecimalColumnType  = "DECIMAL"
+	BooleanColumnType  = "BOOLEAN"
+	DatetimeColumnType = "DATETIME"
+	IntervalColumnType = "INTERVAL"
+)
+
+var ColumnTypeList = []string{
+	StringColumnType,
+	IntegerColumnType,
+	FloatColumnType,
+	DecimalColumnType,
+	BooleanColumnType,
+	DatetimeColumnType,
+	IntervalColumnType,
+}
+
+var columnTypeConverters = map[string]func(value.Primary) value.Primary{
+	IntegerColumnType:  value.ToInteger,
+	FloatColumnType:    value.ToFloat,
+	DecimalColumnType:  value.ToDecimal,
+	BooleanColumnType:  value.ToBoolean,
+	IntervalColumnType: value.ToInterval,
+}
+
+var inferableColumnTypes = []string{
+	IntegerColumnType,
+	FloatColumnType,
+	DatetimeColumnType,
+	BooleanColumnType,
+}
+
+func ParseColumnType(s string) (string, error) {
+	s = strings.ToUpper(s)
+	if InStrSliceWithCaseInsensitive(s, ColumnTypeList) {
+		return s, nil
+	}
+	return "", errors.New(fmt.Sprintf(ErrorInvalidColumnType, s))
+}
+
+// ConvertColumnValue converts p to the column type.
+// Nulls and empty strings result in nulls, and values of STRING columns are returned as they are.
+func ConvertColumnValue(columnType string, p value.Primary, flags *cmd.Flags) (value.Primary, bool) {
+	return convertColumnValue(columnType, p, false, flags)
+}
+
+func convertColumnValue(columnType string, p value.Primary, strict bool, flags *cmd.Flags) (value.Primary, bool) {
+	if columnType == StringColumnType || value.IsNull(p) {
+		return p, true
+	}
+
+	if s, ok := p.(value.String); ok {
+		str := strings.TrimSpace(s.Raw())
+		if len(str) < 1 {
+			return value.NewNull(), true
+		}
+
+		if strict {
+			switch columnType {
+			case DatetimeColumnType:
+				if t, err := value.StrToTime(str, flags.DatetimeFormat, flags.GetLocation()); err == nil {
+					return value.NewDatetime(t), true
+				}
+				return nil, false
+			case BooleanColumnType:
+				if b, err := strconv.ParseBool(str); err == nil && 1 < len(str) {
+					return value.NewBoolean(b), true
+				}
+				return nil, false
+			}
+		}
+	}
+
+	var converted value.Primary
+	if columnType == DatetimeColumnType {
+		converted = value.ToDatetime(p, flags.DatetimeFormat, flags.GetLocation())
+	} else {
+		converted = columnTypeConverters[columnType](p)
+	}
+	return converted, !value.IsNull(converted)
+}
+
+type ColumnSchema struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type TableSchema struct {
+	Columns     []ColumnSchema     `json:"columns"`
+	Constraints []ConstraintSchema `json:"constraints,omitempty"`
+	Inferred    bool               `json:"-"`
+}
+
+func SchemaFilePath(fpath string) string {
+	return filepath.Join(filepath.Dir(fpath), "."+filepath.Base(fpath)+SchemaFileExt)
+}
+
+// LoadTableSchema reads the schema file of the table.
+// A nil schema is returned if the table has no schema file.
+func LoadTableSchema(fpath string) (*TableSchema, error) {
+	spath := SchemaFilePath(fpath)
+	if !file.Exists(spath) {
+		return nil, nil
+	}
+
+	buf, err := ioutil.ReadFile(spath)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &TableSchema{}
+	if err = json.Unmarshal(buf, schema); err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid schema file %s: %s", spath, err.Error()))
+	}
+	for i := range schema.Columns {
+		if schema.Columns[i].Type, err = ParseColumnType(schema.Columns[i].Type); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid schema file %s: %s", spath, err.Error()))
+		}
+	}
+	for i := range schema.Constraints {
+		if err = schema.Constraints[i].validate(); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid schema file %s: %s", spath, err.Error()))
+		}
+	}
+	return schema, nil
+}
+
+func (s *TableSchema) Save(fpath string) error {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	schema := *s
+	if schema.Columns == nil {
+		schema.Columns = []ColumnSchema{}
+	}
+	if err := enc.Encode(schema); err != nil {
+		return err
+	}
+
+	spath := SchemaFilePath(fpath)
+	tmp := spath + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, spath)
+}
+
+func (s *TableSchema) Copy() *TableSchema {
+	if s == nil {
+		return nil
+	}
+	constraints := make([]ConstraintSchema, len(s.Constraints))
+	for i, c := range s.Constraints {
+		constraints[i] = c
+		constraints[i].Columns = append([]string(nil), c.Columns...)
+	}
+	return &TableSchema{
+		Columns:     append([]ColumnSchema(nil), s.Columns...),
+		Constraints: constraints,
+		Inferred:    s.Inferred,
+	}
+}
+
+func (s *TableSchema) ColumnType(column string) string {
+	if s == nil {
+		return ""
+	}
+	for _, c := range s.Columns {
+		if strings.EqualFold(c.Name, column) {
+			return c.Type
+		}
+	}
+	return ""
+}
+
+func (s *TableSchema) RenameColumn(old string, new string) {
+	for i := range s.Columns {
+		if strings.EqualFold(s.Columns[i].Name, old) {
+			s.Columns[i].Name = new
+		}
+	}
+	for i := range s.Constraints {
+		for j := range s.Constraints[i].Columns {
+			if strings.EqualFold(s.Constraints[i].Columns[j], old) {
+				s.Constraints[i].Columns[j] = new
+			}
+		}
+	}
+}
+
+// DropColumn removes the type of the column and the constraints that refer to the column.
+func (s *TableSchema) DropColumn(column string) {
+	columns := make([]ColumnSchema, 0, len(s.Columns))
+	for _, c := range s.Columns {
+		if !strings.EqualFold(c.Name, column) {
+			columns = append(columns, c)
+		}
+	}
+	s.Columns = columns
+
+	if s.Constraints == nil {
+		return
+	}
+	constraints := make([]ConstraintSchema, 0, len(s.Constraints))
+	for _, c := range s.Constraints {
+		if !InStrSliceWithCaseInsensitive(column, c.References()) {
+			constraints = append(constraints, c)
+		}
+	}
+	s.Constraints = constraints
+}
+
+func (s *TableSchema) Constraint(name string) (int, bool) {
+	if s == nil {
+		return -1, false
+	}
+	for i, c := range s.Constraints {
+		if strings.EqualFold(c.Name, name) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ConvertRecords converts the values in typed columns.
+// lineOffset is the number of lines preceding the first record, or -1 if line numbers are not available.
+// Columns of an inferred schema that contain inconvertible values are left as strings.
+func (s *TableSchema) ConvertRecords(header Header, records RecordSet, lineOffset int, flags *cmd.Flags) error {
+	columns := make([]int, 0, len(header))
+	types := make([]string, 0, len(header))
+	for i := range header {
+		if t := s.ColumnType(header[i].Column); 0 < len(t) && t != StringColumnType {
+			columns = append(columns, i)
+			types = append(types, t)
+		}
+	}
+	if len(columns) < 1 {
+		return nil
+	}
+
+	values := make([][]value.Primary, len(columns))
+	for i := range values {
+		values[i] = make([]value.Primary, len(records))
+	}
+
+	NewGoroutineTaskManager(len(records), -1, flags.CPU).Run(func(index int) {
+		for i, idx := range columns {
+			if idx < len(records[index]) {
+				if p, ok := convertColumnValue(types[i], records[index][idx].Value(), s.Inferred, flags); ok {
+					values[i][index] = p
+				}
+			}
+		}
+	})
+
+	for j := range records {
+		for i, idx := range columns {
+			if values[i] == nil || values[i][j] != nil || len(records[j]) <= idx {
+				continue
+			}
+			if s.Inferred {
+				s.DropColumn(header[idx].Column)
+				values[i] = nil
+				continue
+			}
+
+			message := fmt.Sprintf("column %d: "+ErrorFieldValueType, idx+1, records[j][idx].Value(), types[i], header[idx].Column)
+			if -1 < lineOffset {
+				message = fmt.Sprintf("line %d, ", lineOffset+j+1) + message
+			}
+			return errors.New(message)
+		}
+	}
+
+	NewGoroutineTaskManager(len(records), -1, flags.CPU).Run(func(index int) {
+		for i, idx := range columns {
+			if values[i] != nil && idx < len(records[index]) {
+				records[index][idx] = NewCell(values[i][index])
+			}
+		}
+	})
+	return nil
+}
+
+// InferTableSchema determines the column types from the first sampleSize records.
+// Columns that have no values or whose values match none of the types are not included.
+func InferTableSchema(header Header, records RecordSet, sampleSize int, flags *cmd.Flags) *TableSchema {
+	if sampleSize < len(records) {
+		records = records[:sampleSize]
+	}
+
+	schema := &TableSchema{
+		Columns:  make([]ColumnSchema, 0, len(header)),
+		Inferred: true,
+	}
+	for i := range header {
+		if t := inferColumnType(records, i, flags); 0 < len(t) {
+			schema.Columns = append(schema.Columns, ColumnSchema{Name: header[i].Column, Type: t})
+		}
+	}
+	return schema
+}
+
+func inferColumnType(records RecordSet, idx int, flags *cmd.Flags) string {
+	candidates := inferableColumnTypes
+	hasValue := false
+
+	for _, record := range records {
+		if len(record) <= idx {
+			continue
+		}
+		p := record[idx].Value()
+		if value.IsNull(p) {
+			continue
+		}
+		if s, ok := p.(value.String); ok && len(strings.TrimSpace(s.Raw())) < 1 {
+			continue
+		}
+		hasValue = true
+
+		matched := make([]string, 0, len(candidates))
+		for _, t := range candidates {
+			if _, ok := convertColumnValue(t, p, true, flags); ok {
+				matched = append(matched, t)
+			}
+		}
+		if len(matched) < 1 {
+			return ""
+		}
+		candidates = matched
+	}
+
+	if !hasValue {
+		return ""
+	}
+	return candidates[0]
+}
+
+func applyTableSchema(view *View, lineOffset int, flags *cmd.Flags) error {
+	fileInfo := view.FileInfo
+	if fileInfo.Schema == nil {
+		if !fileInfo.IsTemporary {
+			schema, err := LoadTableSchema(fileInfo.Path)
+			if err != nil {
+				return err
+			}
+			fileInfo.Schema = schema
+		}
+		if fileInfo.Schema == nil && fileInfo.Handler == nil && 0 < flags.InferTypes {
+			fileInfo.Schema = InferTableSchema(view.Header, view.RecordSet, flags.InferTypes, flags)
+		}
+	}
+
+	if fileInfo.Schema == nil {
+		return nil
+	}
+	return fileInfo.Schema.ConvertRecords(view.Header, view.RecordSet, lineOffset, flags)
+}
+
+// CommitTableSchema writes or removes the schema file of a created or updated table.
+func CommitTableSchema(fileInfo *FileInfo, filter *Filter) {
+	flags := filter.flags()
+	spath := SchemaFilePath(fileInfo.Path)
+
+	if fileInfo.Schema == nil || fileInfo.Schema.Inferred || (len(fileInfo.Schema.Columns) < 1 && len(fileInfo.Schema.Constraints) < 1) {
+		if file.Exists(spath) {
+			os.Remove(spath)
+			filter.logNotice(fmt.Sprintf("Commit: schema of %q is removed.", fileInfo.Path), flags.Quiet)
+		}
+		return
+	}
+
+	if err := fileInfo.Schema.Save(fileInfo.Path); err != nil {
+		filter.logWarn(fmt.Sprintf("Commit: failed to write schema of %q: %s", fileInfo.Path, err.Error()), flags.Quiet)
+		return
+	}
+	filter.logNotice(fmt.Sprintf("Commit: schema of %q is written.", fileInfo.Path), flags.Quiet)
+}
+
+func RemoveTableSchema(fileInfo *FileInfo, filter *Filter) {
+	spath := SchemaFilePath(fileInfo.Path)
+	if file.Exists(spath) {
+		os.Remove(spath)
+		filter.logNotice(fmt.Sprintf("Commit: schema of %q is removed.", fileInfo.Path), filter.flags().Quiet)
+	}
+}
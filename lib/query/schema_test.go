@@ -0,0 +1,242 @@
+package query
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var convertColumnValueTests = []struct {
+	Type   string
+	Value  value.Primary
+	Result value.Primary
+	OK     bool
+}{
+	{
+		Type:   "INTEGER",
+		Value:  value.NewString(" 12 "),
+		Result: value.NewInteger(12),
+		OK:     true,
+	},
+	{
+		Type:   "INTEGER",
+		Value:  value.NewString(""),
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Type:   "INTEGER",
+		Value:  value.NewNull(),
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Type:  "INTEGER",
+		Value: value.NewString("1.5"),
+		OK:    false,
+	},
+	{
+		Type:   "DECIMAL",
+		Value:  value.NewString("1.50"),
+		Result: value.NewDecimalFromString("1.50"),
+		OK:     true,
+	},
+	{
+		Type:   "BOOLEAN",
+		Value:  value.NewString("1"),
+		Result: value.NewBoolean(true),
+		OK:     true,
+	},
+	{
+		Type:   "DATETIME",
+		Value:  value.NewString("2012-02-03 09:18:15"),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		OK:     true,
+	},
+	{
+		Type:   "INTERVAL",
+		Value:  value.NewString("1 day"),
+		Result: value.NewInterval(0, 1, 0),
+		OK:     true,
+	},
+	{
+		Type:   "STRING",
+		Value:  value.NewInteger(1),
+		Result: value.NewInteger(1),
+		OK:     true,
+	},
+}
+
+func TestConvertColumnValue(t *testing.T) {
+	for _, v := range convertColumnValueTests {
+		result, ok := ConvertColumnValue(v.Type, v.Value, cmd.GetFlags())
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %s to %s", ok, v.OK, v.Value, v.Type)
+			continue
+		}
+		if ok && !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %s to %s", result, v.Result, v.Value, v.Type)
+		}
+	}
+}
+
+func TestInferTableSchema(t *testing.T) {
+	header := NewHeader("table1", []string{"int", "float", "datetime", "boolean", "string", "empty"})
+	records := RecordSet{
+		NewRecord([]value.Primary{value.NewString("1"), value.NewString("1.5"), value.NewString("2012-02-03"), value.NewString("true"), value.NewString("1"), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewString("007"), value.NewString("2"), value.NewNull(), value.NewString("FALSE"), value.NewString("a"), value.NewString("")}),
+		NewRecord([]value.Primary{value.NewString("abc"), value.NewString("abc"), value.NewString("abc"), value.NewString("abc"), value.NewString("abc"), value.NewString("abc")}),
+	}
+	expect := &TableSchema{
+		Columns: []ColumnSchema{
+			{Name: "int", Type: "INTEGER"},
+			{Name: "float", Type: "FLOAT"},
+			{Name: "datetime", Type: "DATETIME"},
+			{Name: "boolean", Type: "BOOLEAN"},
+		},
+		Inferred: true,
+	}
+
+	result := InferTableSchema(header, records, 2, cmd.GetFlags())
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+var tableSchemaConvertRecordsTests = []struct {
+	Name       string
+	Schema     *TableSchema
+	LineOffset int
+	Result     RecordSet
+	Columns    []ColumnSchema
+	Error      string
+}{
+	{
+		Name: "Declared Types",
+		Schema: &TableSchema{
+			Columns: []ColumnSchema{{Name: "column1", Type: "INTEGER"}},
+		},
+		LineOffset: 1,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("x"), value.NewString("c")}),
+		},
+		Error: "line 4, column 1: value \"x\" cannot be converted to INTEGER for field column1",
+	},
+	{
+		Name: "Declared Types without Line Numbers",
+		Schema: &TableSchema{
+			Columns: []ColumnSchema{{Name: "column1", Type: "INTEGER"}},
+		},
+		LineOffset: -1,
+		Error:      "column 1: value \"x\" cannot be converted to INTEGER for field column1",
+	},
+	{
+		Name: "Inferred Types",
+		Schema: &TableSchema{
+			Columns: []ColumnSchema{
+				{Name: "column1", Type: "INTEGER"},
+				{Name: "column2", Type: "STRING"},
+			},
+			Inferred: true,
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewString(""), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("x"), value.NewString("c")}),
+		},
+		Columns: []ColumnSchema{
+			{Name: "column2", Type: "STRING"},
+		},
+	},
+}
+
+func TestTableSchema_ConvertRecords(t *testing.T) {
+	header := NewHeader("table1", []string{"column1", "column2"})
+
+	for _, v := range tableSchemaConvertRecordsTests {
+		records := RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewString(""), value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("x"), value.NewString("c")}),
+		}
+
+		err := v.Schema.ConvertRecords(header, records, v.LineOffset, cmd.GetFlags())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(records, v.Result) {
+			t.Errorf("%s: records = %v, want %v", v.Name, records, v.Result)
+		}
+		if !reflect.DeepEqual(v.Schema.Columns, v.Columns) {
+			t.Errorf("%s: columns = %v, want %v", v.Name, v.Schema.Columns, v.Columns)
+		}
+	}
+}
+
+func TestTableSchema_Save(t *testing.T) {
+	fpath := GetTestFilePath("schema_test.csv")
+	spath := SchemaFilePath(fpath)
+	defer os.Remove(spath)
+
+	if !strings.HasSuffix(spath, ".schema_test.csv"+SchemaFileExt) {
+		t.Errorf("schema file path = %q", spath)
+	}
+
+	schema, err := LoadTableSchema(fpath)
+	if err != nil || schema != nil {
+		t.Fatalf("schema = %v, err = %v, want no schema", schema, err)
+	}
+
+	expect := &TableSchema{
+		Columns: []ColumnSchema{
+			{Name: "id", Type: "INTEGER"},
+			{Name: "at", Type: "DATETIME"},
+		},
+		Constraints: []ConstraintSchema{
+			{Name: "pk", Type: "PRIMARY KEY", Columns: []string{"id"}},
+			{Name: "ck", Type: "CHECK", Check: "at < '2030-01-01' AND id > 0"},
+		},
+	}
+	if err = expect.Save(fpath); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	schema, err = LoadTableSchema(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(schema, expect) {
+		t.Errorf("schema = %v, want %v", schema, expect)
+	}
+
+	if err = ioutil.WriteFile(spath, []byte(`{"columns":[{"name":"id","type":"NUMBER"}]}`), 0644); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = LoadTableSchema(fpath); err == nil {
+		t.Errorf("no error, want error for an invalid column type")
+	}
+
+	if err = ioutil.WriteFile(spath, []byte(`{"columns":[],"constraints":[{"name":"fk","type":"FOREIGN KEY","columns":["id"]}]}`), 0644); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = LoadTableSchema(fpath); err == nil {
+		t.Errorf("no error, want error for an invalid constraint type")
+	}
+}
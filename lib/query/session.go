@@ -0,0 +1,271 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+
+	"github.com/mithrandie/go-text/color"
+)
+
+var ErrSessionClosed = errors.New("session is closed")
+
+// sessionState is the state that a session keeps in place of the package level variables.
+type sessionState struct {
+	flags            *cmd.Flags
+	viewCache        ViewMap
+	uncommittedViews *UncommittedViewMap
+	fileContainer    file.Container
+	executedJoins    *JoinLog
+
+	stdout  io.Writer
+	stderr  io.Writer
+	outFile io.Writer
+}
+
+// Session keeps the state of a series of queries apart from the process and from other sessions.
+// Statements in a session are executed one at a time, and sessions can be executed concurrently.
+type Session struct {
+	Procedure *Procedure
+
+	state  *sessionState
+	lock   chan struct{}
+	closed bool
+}
+
+func NewSession(flags *cmd.Flags) *Session {
+	flags = flags.Copy()
+	flags.Color = false
+
+	state := &sessionState{
+		flags:            flags,
+		viewCache:        make(ViewMap, 10),
+		uncommittedViews: NewUncommittedViewMap(),
+		fileContainer:    file.NewContainer(),
+		executedJoins:    NewJoinLog(),
+		stdout:           ioutil.Discard,
+		stderr:           ioutil.Discard,
+		outFile:          ioutil.Discard,
+	}
+
+	proc := NewProcedure()
+	proc.Filter.session = state
+
+	return &Session{
+		Procedure: proc,
+		state:     state,
+		lock:      make(chan struct{}, 1),
+	}
+}
+
+// Flags returns the flags of the session.
+// They must be changed only while the session is entered.
+func (s *Session) Flags() *cmd.Flags {
+	return s.state.flags
+}
+
+func (s *Session) IsClosed() bool {
+	return s.closed
+}
+
+// Enter waits until the previous caller leaves the session, and then makes outFile and log the outputs of the session.
+// Statements executed by the session procedure are aborted with the context error when ctx is done.
+func (s *Session) Enter(ctx context.Context, outFile io.Writer, log io.Writer) error {
+	select {
+	case s.lock <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if s.closed {
+		<-s.lock
+		return ErrSessionClosed
+	}
+
+	if outFile == nil {
+		outFile = ioutil.Discard
+	}
+	if log == nil {
+		log = ioutil.Discard
+	}
+
+	s.state.stdout = log
+	s.state.stderr = log
+	s.state.outFile = outFile
+	s.Procedure.Filter.ctx = ctx
+	return nil
+}
+
+func (s *Session) Leave() {
+	s.Procedure.Filter.ctx = nil
+	s.state.stdout = ioutil.Discard
+	s.state.stderr = ioutil.Discard
+	s.state.outFile = ioutil.Discard
+	<-s.lock
+}
+
+// ReleaseResources releases the loaded tables and the locked files of the session even if some of them fail.
+// It must be called while the session is entered.
+func (s *Session) ReleaseResources() error {
+	return releaseResourcesWithErrors(s.state.viewCache, s.state.fileContainer)
+}
+
+func (s *Session) Close(ctx context.Context, log io.Writer) error {
+	if err := s.Enter(ctx, nil, log); err != nil {
+		if err == ErrSessionClosed {
+			return nil
+		}
+		return err
+	}
+	defer s.Leave()
+
+	s.closed = true
+
+	filter := s.Procedure.Filter
+	if err := Rollback(nil, filter); err != nil {
+		filter.logError(err.Error())
+	}
+	return s.ReleaseResources()
+}
+
+func (f *Filter) inSession() bool {
+	return f != nil && f.session != nil
+}
+
+func (f *Filter) flags() *cmd.Flags {
+	if f == nil || f.session == nil {
+		return cmd.GetFlags()
+	}
+	return f.session.flags
+}
+
+func (f *Filter) viewCache() ViewMap {
+	if f == nil || f.session == nil {
+		return ViewCache
+	}
+	return f.session.viewCache
+}
+
+func (f *Filter) uncommittedViews() *UncommittedViewMap {
+	if f == nil || f.session == nil {
+		return UncommittedViews
+	}
+	return f.session.uncommittedViews
+}
+
+func (f *Filter) fileContainer() file.Container {
+	if f == nil || f.session == nil {
+		return FileContainer
+	}
+	return f.session.fileContainer
+}
+
+func (f *Filter) executedJoins() *JoinLog {
+	if f == nil || f.session == nil {
+		return ExecutedJoins
+	}
+	return f.session.executedJoins
+}
+
+// terminal returns nil in a session, so that the session never writes to the terminal of the process.
+func (f *Filter) releaseResources() error {
+	return releaseResources(f.viewCache(), f.fileContainer())
+}
+
+func (f *Filter) terminal() VirtualTerminal {
+	if f == nil || f.session == nil {
+		return Terminal
+	}
+	return nil
+}
+
+func (f *Filter) palette() *color.Palette {
+	if f == nil || f.session == nil || f.session.flags.Color {
+		palette, _ := cmd.GetPalette()
+		return palette
+	}
+	return color.NewPalette()
+}
+
+func (f *Filter) outFile() io.Writer {
+	if f == nil || f.session == nil {
+		return OutFile
+	}
+	return f.session.outFile
+}
+
+func (f *Filter) stdout() io.Writer {
+	if f == nil || f.session == nil {
+		return Stdout
+	}
+	return f.session.stdout
+}
+
+func (f *Filter) stderr() io.Writer {
+	if f == nil || f.session == nil {
+		return Stderr
+	}
+	return f.session.stderr
+}
+
+func (f *Filter) writeToStdout(s string) error {
+	if f == nil || f.session == nil {
+		return WriteToStdout(s)
+	}
+	_, err := f.session.stdout.Write([]byte(s))
+	return err
+}
+
+func (f *Filter) writeToStdoutWithLineBreak(s string) error {
+	if 0 < len(s) && s[len(s)-1] != '\n' {
+		s = s + "\n"
+	}
+	return f.writeToStdout(s)
+}
+
+func (f *Filter) writeToStderr(s string) error {
+	if f == nil || f.session == nil {
+		return WriteToStderr(s)
+	}
+	_, err := f.session.stderr.Write([]byte(s))
+	return err
+}
+
+func (f *Filter) writeToStderrWithLineBreak(s string) error {
+	if 0 < len(s) && s[len(s)-1] != '\n' {
+		s = s + "\n"
+	}
+	return f.writeToStderr(s)
+}
+
+func (f *Filter) log(log string, quiet bool) {
+	if !quiet {
+		f.writeToStdoutWithLineBreak(log)
+	}
+}
+
+func (f *Filter) logNotice(log string, quiet bool) {
+	if !quiet {
+		f.writeToStdoutWithLineBreak(f.render(cmd.NoticeEffect, log))
+	}
+}
+
+func (f *Filter) logWarn(log string, quiet bool) {
+	if !quiet {
+		f.writeToStdoutWithLineBreak(f.render(cmd.WarnEffect, log))
+	}
+}
+
+func (f *Filter) logError(log string) {
+	f.writeToStderrWithLineBreak(f.render(cmd.ErrorEffect, log))
+}
+
+func (f *Filter) render(effect string, s string) string {
+	if p := f.palette(); p != nil {
+		return p.Render(effect, s)
+	}
+	return s
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func TestSession(t *testing.T) {
+	flags := cmd.GetFlags().Copy()
+	initFlag(flags)
+	flags.Repository = TestDir
+	oldFormat := cmd.GetFlags().Format
+	oldViewCacheLen := len(ViewCache)
+
+	session := NewSession(flags)
+
+	out := &bytes.Buffer{}
+	log := &bytes.Buffer{}
+	if err := session.Enter(context.Background(), out, log); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	session.Flags().SetFormat("CSV", "")
+	statements, _ := parser.Parse("print 1; select * from table1 where column1 = 1;", "", nil, GetTestLocation())
+	if _, err := session.Procedure.Execute(statements); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if len(session.state.viewCache) != 1 {
+		t.Errorf("view cache length = %d, want %d", len(session.state.viewCache), 1)
+	}
+	session.Leave()
+
+	if out.String() != "column1,column2\n1,str1\n" {
+		t.Errorf("output = %q, want %q", out.String(), "column1,column2\n1,str1\n")
+	}
+	if log.String() != "1\n" {
+		t.Errorf("log = %q, want %q", log.String(), "1\n")
+	}
+	if cmd.GetFlags().Format != oldFormat {
+		t.Errorf("format = %s, want %s", cmd.GetFlags().Format, oldFormat)
+	}
+	if len(ViewCache) != oldViewCacheLen {
+		t.Errorf("package level view cache is changed")
+	}
+
+	if err := session.Close(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if len(session.state.viewCache) != 0 {
+		t.Errorf("view cache length = %d, want %d", len(session.state.viewCache), 0)
+	}
+	if err := session.Enter(context.Background(), nil, nil); err != ErrSessionClosed {
+		t.Errorf("error = %v, want %v", err, ErrSessionClosed)
+	}
+}
+
+func TestSession_Concurrent(t *testing.T) {
+	formats := []string{"CSV", "TSV", "JSON", "LTSV"}
+	expects := []string{
+		"column1,column2\n1,str1\n",
+		"column1\tcolumn2\n1\tstr1\n",
+		"[{\"column1\":\"1\",\"column2\":\"str1\"}]\n",
+		"column1:1\tcolumn2:str1\n",
+	}
+
+	sessions := make([]*Session, len(formats))
+	for i, format := range formats {
+		flags := cmd.GetFlags().Copy()
+		initFlag(flags)
+		flags.Repository = TestDir
+		if err := flags.SetFormat(format, ""); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		sessions[i] = NewSession(flags)
+	}
+
+	outputs := make([]*bytes.Buffer, len(sessions))
+	errs := make([]error, len(sessions))
+	wg := &sync.WaitGroup{}
+	for i := range sessions {
+		outputs[i] = &bytes.Buffer{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			session := sessions[i]
+			if errs[i] = session.Enter(context.Background(), outputs[i], nil); errs[i] != nil {
+				return
+			}
+			defer session.Leave()
+
+			statements, _ := parser.Parse("select * from table1 where column1 = 1;", "", nil, GetTestLocation())
+			for j := 0; j < 10; j++ {
+				outputs[i].Reset()
+				if _, errs[i] = session.Procedure.Execute(statements); errs[i] != nil {
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range sessions {
+		if errs[i] != nil {
+			t.Errorf("%s: unexpected error %q", formats[i], errs[i])
+		} else if outputs[i].String() != expects[i] {
+			t.Errorf("%s: output = %q, want %q", formats[i], outputs[i].String(), expects[i])
+		}
+		sessions[i].Close(context.Background(), nil)
+	}
+}
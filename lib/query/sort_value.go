@@ -2,8 +2,10 @@ package query
 
 import (
 	"bytes"
+	"math"
 	"strings"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -19,6 +21,7 @@ const (
 	DatetimeType
 	BooleanType
 	StringType
+	IntervalType
 )
 
 type SortValues []*SortValue
@@ -84,6 +87,8 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 			serializeBoolean(buf, val.Boolean)
 		case StringType:
 			serializeString(buf, val.String)
+		case IntervalType:
+			serializeInterval(buf, val.String)
 		}
 	}
 }
@@ -91,18 +96,30 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 type SortValue struct {
 	Type SortValueType
 
-	Integer  int64
-	Float    float64
-	Datetime int64
-	String   string
-	Boolean  bool
+	Integer    int64
+	Float      float64
+	Datetime   int64
+	String     string
+	Boolean    bool
+	IsDatetime bool
 }
 
-func NewSortValue(val value.Primary) *SortValue {
+func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
 	sortValue := &SortValue{}
 
 	if value.IsNull(val) {
 		sortValue.Type = NullType
+	} else if iv, ok := val.(value.Interval); ok {
+		nanos := iv.TotalNanoseconds()
+		sortValue.Type = IntervalType
+		sortValue.String = nanos.String()
+		if nanos.IsInt64() {
+			sortValue.Integer = nanos.Int64()
+		} else if nanos.Sign() < 0 {
+			sortValue.Integer = math.MinInt64
+		} else {
+			sortValue.Integer = math.MaxInt64
+		}
 	} else if i := value.ToInteger(val); !value.IsNull(i) {
 		s := value.ToString(val)
 		sortValue.Type = IntegerType
@@ -116,11 +133,12 @@ func NewSortValue(val value.Primary) *SortValue {
 		sortValue.Float = f.(value.Float).Raw()
 		sortValue.Datetime = int64(sortValue.Float * 1e9)
 		sortValue.String = s.(value.String).Raw()
-	} else if dt := value.ToDatetime(val); !value.IsNull(dt) {
+	} else if dt := value.ToDatetime(val, flags.DatetimeFormat, flags.GetLocation()); !value.IsNull(dt) {
 		t := dt.(value.Datetime).Raw()
+		sortValue.IsDatetime = true
 		if t.Nanosecond() > 0 {
 			f := float64(t.Unix()) + float64(t.Nanosecond())/1e9
-			t2 := value.Float64ToTime(f)
+			t2 := value.Float64ToTime(f, flags.GetLocation())
 			if t.Equal(t2) {
 				sortValue.Type = FloatType
 				sortValue.Float = f
@@ -200,6 +218,14 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			}
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			if v.Integer == compareValue.Integer {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(v.Integer < compareValue.Integer)
+		}
 	}
 
 	return ternary.UNKNOWN
@@ -234,6 +260,11 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 		case StringType:
 			return v.String == compareValue.String
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			return v.String == compareValue.String
+		}
 	case NullType:
 		return compareValue.Type == NullType
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
@@ -12,16 +13,17 @@ import (
 
 func TestSortValues_Serialize(t *testing.T) {
 	values := SortValues{
-		NewSortValue(value.NewNull()),
-		NewSortValue(value.NewInteger(1)),
-		NewSortValue(value.NewFloat(1.234)),
-		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15-08:00")),
-		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123-08:00")),
-		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00")),
-		NewSortValue(value.NewBoolean(false)),
-		NewSortValue(value.NewString("str")),
+		NewSortValue(value.NewNull(), cmd.GetFlags()),
+		NewSortValue(value.NewInteger(1), cmd.GetFlags()),
+		NewSortValue(value.NewFloat(1.234), cmd.GetFlags()),
+		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15-08:00", nil, time.Local), cmd.GetFlags()),
+		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123-08:00", nil, time.Local), cmd.GetFlags()),
+		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00", nil, time.Local), cmd.GetFlags()),
+		NewSortValue(value.NewBoolean(false), cmd.GetFlags()),
+		NewSortValue(value.NewString("str"), cmd.GetFlags()),
+		NewSortValue(value.NewIntervalFromString("1 day 1 ns"), cmd.GetFlags()),
 	}
-	expect := "[N]:[I]1[B]true:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]0[B]false:[S]STR"
+	expect := "[N]:[I]1[B]true:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]0[B]false:[S]STR:[V]86400000000001"
 
 	buf := new(bytes.Buffer)
 	values.Serialize(buf)
@@ -39,92 +41,104 @@ var sortValueLessTests = []struct {
 }{
 	{
 		Name:         "SortValue Less Integer",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewInteger(5)),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewInteger(5), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Integer Equal",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewInteger(3)),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewInteger(3), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 	{
 		Name:         "SortValue Less Integer and Float",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewFloat(5.4)),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewFloat(5.4), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Integer and Datetime",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Integer and String",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewString("4a")),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewString("4a"), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Float",
-		SortValue:    NewSortValue(value.NewFloat(3.4)),
-		CompareValue: NewSortValue(value.NewFloat(5.1)),
+		SortValue:    NewSortValue(value.NewFloat(3.4), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewFloat(5.1), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Float Equal",
-		SortValue:    NewSortValue(value.NewFloat(3.4)),
-		CompareValue: NewSortValue(value.NewFloat(3.4)),
+		SortValue:    NewSortValue(value.NewFloat(3.4), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewFloat(3.4), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 	{
 		Name:         "SortValue Less Float and Datetime",
-		SortValue:    NewSortValue(value.NewFloat(3.4)),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewFloat(3.4), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Float and String",
-		SortValue:    NewSortValue(value.NewFloat(3.4)),
-		CompareValue: NewSortValue(value.NewString("4a")),
+		SortValue:    NewSortValue(value.NewFloat(3.4), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewString("4a"), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Datetime",
-		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 123456789, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less Datetime Equal",
-		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 	{
 		Name:         "SortValue Less String",
-		SortValue:    NewSortValue(value.NewString("aaa")),
-		CompareValue: NewSortValue(value.NewString("abc")),
+		SortValue:    NewSortValue(value.NewString("aaa"), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewString("abc"), cmd.GetFlags()),
 		Result:       ternary.TRUE,
 	},
 	{
 		Name:         "SortValue Less String Equal",
-		SortValue:    NewSortValue(value.NewString(" aaa ")),
-		CompareValue: NewSortValue(value.NewString("AAA")),
+		SortValue:    NewSortValue(value.NewString(" aaa "), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewString("AAA"), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 	{
 		Name:         "SortValue Less Boolean",
-		SortValue:    NewSortValue(value.NewBoolean(true)),
-		CompareValue: NewSortValue(value.NewTernary(ternary.FALSE)),
+		SortValue:    NewSortValue(value.NewBoolean(true), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewTernary(ternary.FALSE), cmd.GetFlags()),
+		Result:       ternary.UNKNOWN,
+	},
+	{
+		Name:         "SortValue Less Interval",
+		SortValue:    NewSortValue(value.NewIntervalFromString("1 month"), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewIntervalFromString("31 days"), cmd.GetFlags()),
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "SortValue Less Interval and Integer",
+		SortValue:    NewSortValue(value.NewIntervalFromString("1 month"), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewInteger(1), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 	{
 		Name:         "SortValue Less Incommensurable Types",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewTernary(ternary.UNKNOWN)),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewTernary(ternary.UNKNOWN), cmd.GetFlags()),
 		Result:       ternary.UNKNOWN,
 	},
 }
@@ -146,68 +160,68 @@ var sortValueEquivalentToTests = []struct {
 }{
 	{
 		Name:         "SortValue EquivalentTo Integer",
-		SortValue:    NewSortValue(value.NewInteger(3)),
-		CompareValue: NewSortValue(value.NewInteger(3)),
+		SortValue:    NewSortValue(value.NewInteger(3), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewInteger(3), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Integer and Boolean",
-		SortValue:    NewSortValue(value.NewInteger(1)),
-		CompareValue: NewSortValue(value.NewBoolean(true)),
+		SortValue:    NewSortValue(value.NewInteger(1), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewBoolean(true), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Integer and DateTime",
-		SortValue:    NewSortValue(value.NewInteger(1328260695)),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewInteger(1328260695), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Float",
-		SortValue:    NewSortValue(value.NewFloat(3.21)),
-		CompareValue: NewSortValue(value.NewFloat(3.21)),
+		SortValue:    NewSortValue(value.NewFloat(3.21), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewFloat(3.21), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Float and DateTime",
-		SortValue:    NewSortValue(value.NewFloat(1328260695.0001)),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 100000, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewFloat(1328260695.0001), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 100000, GetTestLocation())), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Datetime",
-		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
-		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation()))),
+		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Boolean",
-		SortValue:    NewSortValue(value.NewBoolean(true)),
-		CompareValue: NewSortValue(value.NewBoolean(true)),
+		SortValue:    NewSortValue(value.NewBoolean(true), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewBoolean(true), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Boolean and Integer",
-		SortValue:    NewSortValue(value.NewBoolean(true)),
-		CompareValue: NewSortValue(value.NewInteger(1)),
+		SortValue:    NewSortValue(value.NewBoolean(true), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewInteger(1), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo String",
-		SortValue:    NewSortValue(value.NewString("str")),
-		CompareValue: NewSortValue(value.NewString("str")),
+		SortValue:    NewSortValue(value.NewString("str"), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewString("str"), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo Null",
-		SortValue:    NewSortValue(value.NewNull()),
-		CompareValue: NewSortValue(value.NewNull()),
+		SortValue:    NewSortValue(value.NewNull(), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewNull(), cmd.GetFlags()),
 		Result:       true,
 	},
 	{
 		Name:         "SortValue EquivalentTo String and Null",
-		SortValue:    NewSortValue(value.NewString("str")),
-		CompareValue: NewSortValue(value.NewNull()),
+		SortValue:    NewSortValue(value.NewString("str"), cmd.GetFlags()),
+		CompareValue: NewSortValue(value.NewNull(), cmd.GetFlags()),
 		Result:       false,
 	},
 }
@@ -221,8 +235,8 @@ func TestSortValue_EquivalentTo(t *testing.T) {
 	}
 }
 
-var sortValueLessBench1 = NewSortValue(value.NewInteger(12345))
-var sortValueLessBench2 = NewSortValue(value.NewInteger(67890))
+var sortValueLessBench1 = NewSortValue(value.NewInteger(12345), cmd.GetFlags())
+var sortValueLessBench2 = NewSortValue(value.NewInteger(67890), cmd.GetFlags())
 
 func BenchmarkSortValue_Less(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -231,15 +245,15 @@ func BenchmarkSortValue_Less(b *testing.B) {
 }
 
 var sortValuesEquivalentBench1 = SortValues{
-	NewSortValue(value.NewInteger(12345)),
-	NewSortValue(value.NewString("abcdefghijklmnopqrstuvwxymabcdefghijklmnopqrstuvwxyz")),
-	NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation()))),
+	NewSortValue(value.NewInteger(12345), cmd.GetFlags()),
+	NewSortValue(value.NewString("abcdefghijklmnopqrstuvwxymabcdefghijklmnopqrstuvwxyz"), cmd.GetFlags()),
+	NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), cmd.GetFlags()),
 }
 
 var sortValuesEquivalentBench2 = SortValues{
-	NewSortValue(value.NewInteger(12345)),
-	NewSortValue(value.NewString("abcdefghijklmnopqrstuvwxymabcdefghijklmnopqrstuvwxyz")),
-	NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation()))),
+	NewSortValue(value.NewInteger(12345), cmd.GetFlags()),
+	NewSortValue(value.NewString("abcdefghijklmnopqrstuvwxymabcdefghijklmnopqrstuvwxyz"), cmd.GetFlags()),
+	NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), cmd.GetFlags()),
 }
 
 func BenchmarkSortValues_EquivalentTo(b *testing.B) {
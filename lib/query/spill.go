@@ -0,0 +1,398 @@
+package query
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	spillNull byte = iota
+	spillString
+	spillInteger
+	spillFloat
+	spillBoolean
+	spillTernary
+	spillDatetime
+	spillDecimal
+	spillInterval
+)
+
+var errSpillFileBroken = errors.New("spill file is broken")
+
+type SpillFile struct {
+	fp *os.File
+	w  *bufio.Writer
+	r  *bufio.Reader
+
+	buf []byte
+}
+
+func NewSpillFile() (*SpillFile, error) {
+	fp, err := ioutil.TempFile("", "csvq_spill_")
+	if err != nil {
+		return nil, err
+	}
+	return &SpillFile{
+		fp:  fp,
+		w:   bufio.NewWriter(fp),
+		buf: make([]byte, binary.MaxVarintLen64),
+	}, nil
+}
+
+func (f *SpillFile) WriteRecord(record []value.Primary) {
+	f.writeUvarint(uint64(len(record)))
+	for _, p := range record {
+		f.writeValue(p)
+	}
+}
+
+func (f *SpillFile) WriteSortedRecord(sortValues SortValues, record []value.Primary) {
+	f.writeUvarint(uint64(len(sortValues)))
+	for _, sv := range sortValues {
+		f.w.WriteByte(byte(sv.Type))
+		f.writeVarint(sv.Integer)
+		f.writeUvarint(math.Float64bits(sv.Float))
+		f.writeVarint(sv.Datetime)
+		f.writeString(sv.String)
+		f.writeBool(sv.Boolean)
+		f.writeBool(sv.IsDatetime)
+	}
+	f.WriteRecord(record)
+}
+
+func (f *SpillFile) Rewind() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	f.r = bufio.NewReader(f.fp)
+	return nil
+}
+
+func (f *SpillFile) ReadRecord() ([]value.Primary, error) {
+	n, err := binary.ReadUvarint(f.r)
+	if err != nil {
+		return nil, err
+	}
+	record := make([]value.Primary, n)
+	for i := range record {
+		if record[i], err = f.readValue(); err != nil {
+			return nil, spillReadError(err)
+		}
+	}
+	return record, nil
+}
+
+func (f *SpillFile) ReadSortedRecord() (SortValues, []value.Primary, error) {
+	n, err := binary.ReadUvarint(f.r)
+	if err != nil {
+		return nil, nil, err
+	}
+	sortValues := make(SortValues, n)
+	for i := range sortValues {
+		sv := &SortValue{}
+		t, e := f.r.ReadByte()
+		if e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		sv.Type = SortValueType(t)
+		if sv.Integer, e = binary.ReadVarint(f.r); e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		bits, e := binary.ReadUvarint(f.r)
+		if e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		sv.Float = math.Float64frombits(bits)
+		if sv.Datetime, e = binary.ReadVarint(f.r); e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		if sv.String, e = f.readString(); e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		if sv.Boolean, e = f.readBool(); e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		if sv.IsDatetime, e = f.readBool(); e != nil {
+			return nil, nil, spillReadError(e)
+		}
+		sortValues[i] = sv
+	}
+
+	record, err := f.ReadRecord()
+	if err != nil {
+		return nil, nil, spillReadError(err)
+	}
+	return sortValues, record, nil
+}
+
+func (f *SpillFile) Close() error {
+	name := f.fp.Name()
+	err := f.fp.Close()
+	if e := os.Remove(name); err == nil {
+		err = e
+	}
+	return err
+}
+
+func (f *SpillFile) writeUvarint(u uint64) {
+	n := binary.PutUvarint(f.buf, u)
+	f.w.Write(f.buf[:n])
+}
+
+func (f *SpillFile) writeVarint(i int64) {
+	n := binary.PutVarint(f.buf, i)
+	f.w.Write(f.buf[:n])
+}
+
+func (f *SpillFile) writeString(s string) {
+	f.writeUvarint(uint64(len(s)))
+	f.w.WriteString(s)
+}
+
+func (f *SpillFile) writeBool(b bool) {
+	if b {
+		f.w.WriteByte(1)
+	} else {
+		f.w.WriteByte(0)
+	}
+}
+
+func (f *SpillFile) writeValue(p value.Primary) {
+	switch p.(type) {
+	case value.String:
+		f.w.WriteByte(spillString)
+		f.writeString(p.(value.String).Raw())
+	case value.Integer:
+		f.w.WriteByte(spillInteger)
+		f.writeVarint(p.(value.Integer).Raw())
+	case value.Float:
+		f.w.WriteByte(spillFloat)
+		f.writeUvarint(math.Float64bits(p.(value.Float).Raw()))
+	case value.Decimal:
+		f.w.WriteByte(spillDecimal)
+		f.writeString(p.(value.Decimal).String())
+	case value.Interval:
+		iv := p.(value.Interval)
+		f.w.WriteByte(spillInterval)
+		f.writeVarint(iv.Months())
+		f.writeVarint(iv.Days())
+		f.writeVarint(iv.Nanoseconds())
+	case value.Boolean:
+		f.w.WriteByte(spillBoolean)
+		f.writeBool(p.(value.Boolean).Raw())
+	case value.Ternary:
+		f.w.WriteByte(spillTernary)
+		f.w.WriteByte(byte(p.(value.Ternary).Ternary()))
+	case value.Datetime:
+		f.w.WriteByte(spillDatetime)
+		b, _ := p.(value.Datetime).Raw().MarshalBinary()
+		f.writeString(string(b))
+	default:
+		f.w.WriteByte(spillNull)
+	}
+}
+
+func (f *SpillFile) readString() (string, error) {
+	n, err := binary.ReadUvarint(f.r)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	if _, err = io.ReadFull(f.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (f *SpillFile) readBool() (bool, error) {
+	b, err := f.r.ReadByte()
+	return b == 1, err
+}
+
+func (f *SpillFile) readValue() (value.Primary, error) {
+	t, err := f.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case spillNull:
+		return value.NewNull(), nil
+	case spillString:
+		s, err := f.readString()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewString(s), nil
+	case spillInteger:
+		i, err := binary.ReadVarint(f.r)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewInteger(i), nil
+	case spillFloat:
+		bits, err := binary.ReadUvarint(f.r)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewFloat(math.Float64frombits(bits)), nil
+	case spillDecimal:
+		s, err := f.readString()
+		if err != nil {
+			return nil, err
+		}
+		d, err := value.StrToDecimal(s)
+		if err != nil {
+			return nil, errSpillFileBroken
+		}
+		return d, nil
+	case spillInterval:
+		var units [3]int64
+		for i := range units {
+			if units[i], err = binary.ReadVarint(f.r); err != nil {
+				return nil, err
+			}
+		}
+		return value.NewInterval(units[0], units[1], units[2]), nil
+	case spillBoolean:
+		b, err := f.readBool()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewBoolean(b), nil
+	case spillTernary:
+		b, err := f.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewTernary(ternary.Value(b)), nil
+	case spillDatetime:
+		s, err := f.readString()
+		if err != nil {
+			return nil, err
+		}
+		var t time.Time
+		if err = t.UnmarshalBinary([]byte(s)); err != nil {
+			return nil, err
+		}
+		return value.NewDatetime(t), nil
+	}
+	return nil, errSpillFileBroken
+}
+
+func spillReadError(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+type sortedRun struct {
+	file       *SpillFile
+	index      int
+	sortValues SortValues
+	record     []value.Primary
+}
+
+func (run *sortedRun) next() (bool, error) {
+	sortValues, record, err := run.file.ReadSortedRecord()
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	run.sortValues = sortValues
+	run.record = record
+	return true, nil
+}
+
+type runMerger struct {
+	runs          []*sortedRun
+	directions    []int
+	nullPositions []int
+}
+
+func (m *runMerger) Len() int {
+	return len(m.runs)
+}
+
+func (m *runMerger) Less(i, j int) bool {
+	if m.runs[i].sortValues.Less(m.runs[j].sortValues, m.directions, m.nullPositions) {
+		return true
+	}
+	if m.runs[j].sortValues.Less(m.runs[i].sortValues, m.directions, m.nullPositions) {
+		return false
+	}
+	return m.runs[i].index < m.runs[j].index
+}
+
+func (m *runMerger) Swap(i, j int) {
+	m.runs[i], m.runs[j] = m.runs[j], m.runs[i]
+}
+
+func (m *runMerger) Push(x interface{}) {
+	m.runs = append(m.runs, x.(*sortedRun))
+}
+
+func (m *runMerger) Pop() interface{} {
+	n := len(m.runs)
+	run := m.runs[n-1]
+	m.runs = m.runs[:n-1]
+	return run
+}
+
+func MergeSortedRuns(files []*SpillFile, directions []int, nullPositions []int, fn func(SortValues, []value.Primary) (bool, error)) error {
+	merger := &runMerger{
+		runs:          make([]*sortedRun, 0, len(files)),
+		directions:    directions,
+		nullPositions: nullPositions,
+	}
+
+	for i, f := range files {
+		if err := f.Rewind(); err != nil {
+			return err
+		}
+		run := &sortedRun{file: f, index: i}
+		ok, err := run.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			merger.runs = append(merger.runs, run)
+		}
+	}
+	heap.Init(merger)
+
+	for 0 < merger.Len() {
+		run := merger.runs[0]
+		if cont, err := fn(run.sortValues, run.record); err != nil || !cont {
+			return err
+		}
+
+		ok, err := run.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(merger, 0)
+		} else {
+			heap.Pop(merger)
+		}
+	}
+	return nil
+}
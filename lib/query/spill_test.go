@@ -0,0 +1,99 @@
+package query
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func TestSpillFile(t *testing.T) {
+	f, err := NewSpillFile()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer f.Close()
+
+	records := [][]value.Primary{
+		{
+			value.NewString("str"),
+			value.NewInteger(-12),
+			value.NewFloat(1.25),
+			value.NewDecimalFromString("-0.50"),
+			value.NewInterval(-1, 2, -3),
+			value.NewBoolean(true),
+			value.NewTernary(ternary.FALSE),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123, time.UTC)),
+			value.NewNull(),
+		},
+		{},
+	}
+	for _, r := range records {
+		f.WriteRecord(r)
+	}
+
+	if err = f.Rewind(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	for _, expect := range records {
+		r, err := f.ReadRecord()
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		if len(expect) != len(r) {
+			t.Fatalf("record = %v, want %v", r, expect)
+		}
+		for i := range expect {
+			if dt, ok := expect[i].(value.Datetime); ok {
+				if !dt.Raw().Equal(r[i].(value.Datetime).Raw()) {
+					t.Errorf("value = %v, want %v", r[i], expect[i])
+				}
+			} else if !reflect.DeepEqual(r[i], expect[i]) {
+				t.Errorf("value = %#v, want %#v", r[i], expect[i])
+			}
+		}
+	}
+	if _, err = f.ReadRecord(); err != io.EOF {
+		t.Errorf("error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestMergeSortedRuns(t *testing.T) {
+	runs := make([]*SpillFile, 0, 2)
+	defer func() {
+		for _, f := range runs {
+			f.Close()
+		}
+	}()
+
+	for _, list := range [][]int64{{1, 4, 5}, {2, 3, 6}} {
+		f, err := NewSpillFile()
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		runs = append(runs, f)
+		for _, i := range list {
+			f.WriteSortedRecord(SortValues{NewSortValue(value.NewInteger(i), cmd.GetFlags())}, []value.Primary{value.NewInteger(i)})
+		}
+	}
+
+	result := make([]int64, 0, 6)
+	err := MergeSortedRuns(runs, []int{parser.ASC}, []int{parser.FIRST}, func(sortValues SortValues, values []value.Primary) (bool, error) {
+		result = append(result, values[0].(value.Integer).Raw())
+		return len(result) < 5, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := []int64{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
@@ -0,0 +1,789 @@
+package query
+
+import (
+	"bytes"
+	"hash/fnv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+const (
+	StreamChunkSize = 1000
+	SpillPartitions = 32
+)
+
+type StreamEncoder interface {
+	WriteHeader(header Header) error
+	Write(values []value.Primary) error
+	Close() error
+}
+
+func NewStreamEncoder(fp io.Writer, fileInfo *FileInfo, filter *Filter) StreamEncoder {
+	switch fileInfo.Format {
+	case cmd.TSV:
+		fileInfo.Delimiter = '\t'
+		fallthrough
+	case cmd.CSV:
+		ew := cmd.NewEncodingWriter(fp, fileInfo.Encoding)
+		w := csv.NewWriter(ew, fileInfo.LineBreak, cmd.TextEncoding(fileInfo.Encoding))
+		w.Delimiter = fileInfo.Delimiter
+		return &csvStreamEncoder{
+			ew:            ew,
+			w:             w,
+			withoutHeader: fileInfo.NoHeader,
+			encloseAll:    fileInfo.EncloseAll,
+		}
+	}
+	return &viewStreamEncoder{
+		fp:       fp,
+		fileInfo: fileInfo,
+		view:     NewView(),
+		filter:   filter,
+	}
+}
+
+type csvStreamEncoder struct {
+	ew     io.WriteCloser
+	w      *csv.Writer
+	fields []csv.Field
+
+	withoutHeader bool
+	encloseAll    bool
+}
+
+func (e *csvStreamEncoder) WriteHeader(header Header) error {
+	names := header.TableColumnNames()
+	e.fields = make([]csv.Field, len(names))
+	if e.withoutHeader {
+		return nil
+	}
+
+	for i, v := range names {
+		e.fields[i] = csv.NewField(v, e.encloseAll)
+	}
+	return e.w.Write(e.fields)
+}
+
+func (e *csvStreamEncoder) Write(values []value.Primary) error {
+	for i, v := range values {
+		str, effect, _ := ConvertFieldContents(v, false)
+		quote := false
+		if e.encloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
+			quote = true
+		}
+		e.fields[i] = csv.NewField(str, quote)
+	}
+	return e.w.Write(e.fields)
+}
+
+func (e *csvStreamEncoder) Close() error {
+	e.w.Flush()
+	return e.ew.Close()
+}
+
+type viewStreamEncoder struct {
+	fp       io.Writer
+	fileInfo *FileInfo
+	view     *View
+	filter   *Filter
+}
+
+func (e *viewStreamEncoder) WriteHeader(header Header) error {
+	e.view.Header = header
+	e.view.RecordSet = make(RecordSet, 0, StreamChunkSize)
+	return nil
+}
+
+func (e *viewStreamEncoder) Write(values []value.Primary) error {
+	e.view.RecordSet = append(e.view.RecordSet, NewRecord(values))
+	return nil
+}
+
+func (e *viewStreamEncoder) Close() error {
+	return EncodeView(e.fp, e.view, e.fileInfo, e.filter)
+}
+
+type discardStreamEncoder struct{}
+
+func (e discardStreamEncoder) WriteHeader(header Header) error {
+	return nil
+}
+
+func (e discardStreamEncoder) Write(values []value.Primary) error {
+	return nil
+}
+
+func (e discardStreamEncoder) Close() error {
+	return nil
+}
+
+type selectStream struct {
+	query  parser.SelectQuery
+	entity parser.SelectEntity
+	filter *Filter
+
+	table    parser.Table
+	fileInfo *FileInfo
+
+	header     Header
+	reader     *csv.Reader
+	pending    []text.RawText
+	eof        bool
+	lineOffset int
+
+	offset   int
+	limit    int
+	withTies bool
+
+	chunkSize   int
+	memoryLimit int64
+
+	outputHeader Header
+	encoder      StreamEncoder
+	skipped      int
+	emitted      int
+	read         int
+	readTime     time.Duration
+}
+
+func newSelectStream(query parser.SelectQuery, filter *Filter) *selectStream {
+	if filter.flags().MemoryLimit < 1 || 0 < filter.flags().InferTypes || query.WithClause != nil || (query.LimitClause != nil && query.LimitClause.(parser.LimitClause).IsPercentage()) {
+		return nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil || entity.WindowClause != nil {
+		return nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok {
+		return nil
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() || hasAnalyticFunction(selectClause) {
+		return nil
+	}
+	if query.OrderByClause != nil && searchExpressions(query.OrderByClause, func(expr parser.QueryExpression) bool {
+		_, ok := expr.(parser.AnalyticFunction)
+		return ok
+	}) {
+		return nil
+	}
+
+	if entity.GroupByClause == nil {
+		if entity.HavingClause != nil || hasAggregateFunction([]interface{}{selectClause, query.OrderByClause}, filter) {
+			return nil
+		}
+	} else {
+		for _, item := range entity.GroupByClause.(parser.GroupByClause).Items {
+			switch item.(type) {
+			case parser.Rollup, parser.Cube, parser.GroupingSets:
+				return nil
+			}
+		}
+	}
+
+	if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) {
+		return nil
+	}
+	if _, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+		return nil
+	}
+	if filter.TempViews.Exists(tableIdentifier.Literal) {
+		return nil
+	}
+
+	flags := filter.flags()
+	fileInfo, err := NewFileInfo(tableIdentifier, flags.Repository, cmd.AutoSelect, flags.Delimiter, flags.Encoding, flags)
+	if err != nil {
+		return nil
+	}
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return nil
+	}
+	if filter.viewCache().Exists(fileInfo.Path) || filter.uncommittedViews().IsDropped(fileInfo.Path) {
+		return nil
+	}
+	if entity.WhereClause != nil {
+		if _, idx, _, err := searchIndexByCondition(table, entity.WhereClause.(parser.WhereClause).Filter, filter); err != nil || idx != nil {
+			return nil
+		}
+	}
+	fileInfo.NoHeader = flags.NoHeader
+	fileInfo.LineBreak = flags.LineBreak
+
+	return &selectStream{
+		query:       query,
+		entity:      entity,
+		table:       table,
+		fileInfo:    fileInfo,
+		limit:       -1,
+		chunkSize:   StreamChunkSize,
+		memoryLimit: int64(flags.MemoryLimit) * 1024 * 1024,
+	}
+}
+
+func hasAggregateFunction(expr interface{}, filter *Filter) bool {
+	return searchExpressions(expr, func(e parser.QueryExpression) bool {
+		switch e.(type) {
+		case parser.AggregateFunction, parser.ListFunction:
+			return true
+		case parser.Function:
+			if udfn, err := filter.Functions.Get(e, e.(parser.Function).Name); err == nil && udfn.IsAggregate {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *selectStream) run(encoder StreamEncoder) error {
+	tableIdentifier := s.table.Object.(parser.Identifier)
+
+	h, err := file.NewHandlerForRead(s.fileInfo.Path, s.filter.flags().WaitTimeout, s.filter.flags().RetryInterval)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return NewFileLockTimeoutError(tableIdentifier, s.fileInfo.Path)
+		}
+		return NewReadFileError(tableIdentifier, err.Error())
+	}
+	defer h.Close()
+
+	r, err := NewDecompressionReader(h.FileForRead(), s.fileInfo.Compression)
+	if err != nil {
+		return NewDataParsingError(tableIdentifier, s.fileInfo.Path, err.Error())
+	}
+	defer r.Close()
+
+	if err = s.openReader(r); err != nil {
+		return err
+	}
+
+	if err = s.filter.Aliases.Add(s.table.Name(), s.fileInfo.Path); err != nil {
+		return err
+	}
+	if err = s.evaluateOffsetAndLimit(); err != nil {
+		return err
+	}
+
+	s.encoder = encoder
+	if s.query.OrderByClause == nil && s.entity.GroupByClause == nil {
+		err = s.stream()
+	} else {
+		err = s.spill()
+	}
+	if err != nil {
+		return err
+	}
+
+	if s.outputHeader == nil {
+		view, err := s.evaluate(RecordSet{}, true)
+		if err != nil {
+			return err
+		}
+		view.Fix(s.filter.flags())
+		if err = s.encoder.WriteHeader(view.Header); err != nil {
+			return err
+		}
+	}
+	return s.encoder.Close()
+}
+
+func (s *selectStream) openReader(r io.Reader) error {
+	r, err := newDecodingReader(r, s.fileInfo)
+	if err != nil {
+		return NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+	}
+
+	s.reader = csv.NewReader(r, cmd.TextEncoding(s.fileInfo.Encoding))
+	s.reader.Delimiter = s.fileInfo.Delimiter
+	s.reader.WithoutNull = s.filter.flags().WithoutNull
+
+	var header []string
+	if !s.fileInfo.NoHeader {
+		header, err = s.reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+		}
+	}
+
+	if header == nil {
+		s.pending, err = s.reader.Read()
+		if err != nil && err != io.EOF {
+			return NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+		}
+		s.eof = err == io.EOF
+
+		header = make([]string, len(s.pending))
+		for i := range header {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	s.header = NewHeader(s.table.Name().Literal, header)
+
+	if !s.fileInfo.NoHeader {
+		s.lineOffset = 1
+	}
+	if s.fileInfo.Schema, err = LoadTableSchema(s.fileInfo.Path); err != nil {
+		return NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+	}
+	return nil
+}
+
+func (s *selectStream) evaluateOffsetAndLimit() error {
+	if s.query.OffsetClause != nil {
+		clause := s.query.OffsetClause.(parser.OffsetClause)
+		val, err := s.filter.Evaluate(clause.Value)
+		if err != nil {
+			return err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return NewInvalidOffsetNumberError(clause)
+		}
+		if s.offset = int(number.(value.Integer).Raw()); s.offset < 0 {
+			s.offset = 0
+		}
+	}
+
+	if s.query.LimitClause != nil {
+		clause := s.query.LimitClause.(parser.LimitClause)
+		val, err := s.filter.Evaluate(clause.Value)
+		if err != nil {
+			return err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return NewInvalidLimitNumberError(clause)
+		}
+		if s.limit = int(number.(value.Integer).Raw()); s.limit < 0 {
+			s.limit = 0
+		}
+		s.withTies = clause.IsWithTies() && s.query.OrderByClause != nil
+	}
+	return nil
+}
+
+func (s *selectStream) readChunk() (RecordSet, error) {
+	if err := s.filter.Err(); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	defer func() {
+		s.readTime += time.Since(start)
+	}()
+
+	records := make(RecordSet, 0, s.chunkSize)
+
+	if s.pending != nil {
+		records = append(records, NewRecord(rawTextsToPrimaries(s.pending)))
+		s.pending = nil
+	}
+
+	for !s.eof && len(records) < s.chunkSize {
+		row, err := s.reader.Read()
+		if err == io.EOF {
+			s.eof = true
+			break
+		}
+		if err != nil {
+			return nil, NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+		}
+		records = append(records, NewRecord(rawTextsToPrimaries(row)))
+	}
+
+	if s.fileInfo.Schema != nil {
+		if err := s.fileInfo.Schema.ConvertRecords(s.header, records, s.lineOffset, s.filter.flags()); err != nil {
+			return nil, NewDataParsingError(s.table.Object, s.fileInfo.Path, err.Error())
+		}
+	}
+	s.lineOffset = s.lineOffset + len(records)
+	s.read += len(records)
+	return records, nil
+}
+
+func (s *selectStream) readFilteredChunk() (*View, error) {
+	records, err := s.readChunk()
+	if err != nil {
+		return nil, err
+	}
+
+	view := &View{
+		Header:    s.header.Copy(),
+		RecordSet: records,
+		Filter:    s.filter,
+	}
+	if s.entity.WhereClause != nil {
+		if err = view.Where(s.entity.WhereClause.(parser.WhereClause)); err != nil {
+			return nil, err
+		}
+	}
+	return view, nil
+}
+
+func (s *selectStream) evaluate(records RecordSet, sorting bool) (*View, error) {
+	view := &View{
+		Header:    s.header.Copy(),
+		RecordSet: records,
+		Filter:    s.filter,
+	}
+
+	if s.entity.GroupByClause != nil {
+		if err := view.GroupBy(s.entity.GroupByClause.(parser.GroupByClause)); err != nil {
+			return nil, err
+		}
+	}
+	if s.entity.HavingClause != nil {
+		if err := view.Having(s.entity.HavingClause.(parser.HavingClause)); err != nil {
+			return nil, err
+		}
+	}
+	if err := view.Select(s.entity.SelectClause.(parser.SelectClause)); err != nil {
+		return nil, err
+	}
+	if sorting && s.query.OrderByClause != nil {
+		if err := view.OrderBy(s.query.OrderByClause.(parser.OrderByClause)); err != nil {
+			return nil, err
+		}
+	}
+	return view, nil
+}
+
+func (s *selectStream) setOutputHeader(header Header) error {
+	if s.outputHeader != nil {
+		return nil
+	}
+	s.outputHeader = header
+	return s.encoder.WriteHeader(header)
+}
+
+func (s *selectStream) isLimitReached() bool {
+	return -1 < s.limit && s.limit <= s.emitted
+}
+
+func (s *selectStream) emit(values []value.Primary) error {
+	if s.skipped < s.offset {
+		s.skipped++
+		return nil
+	}
+	s.emitted++
+	return s.encoder.Write(values)
+}
+
+func (s *selectStream) emitView(view *View) error {
+	if err := s.setOutputHeader(view.Header); err != nil {
+		return err
+	}
+	for _, record := range view.RecordSet {
+		if s.isLimitReached() {
+			break
+		}
+		if err := s.emit(recordValues(record)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *selectStream) stream() error {
+	for !s.isLimitReached() {
+		view, err := s.readFilteredChunk()
+		if err != nil {
+			return err
+		}
+		if view.RecordLen() < 1 && s.eof {
+			break
+		}
+
+		if err = view.Select(s.entity.SelectClause.(parser.SelectClause)); err != nil {
+			return err
+		}
+		view.Fix(s.filter.flags())
+
+		if err = s.emitView(view); err != nil {
+			return err
+		}
+		if s.eof {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *selectStream) spill() error {
+	var partitions []*SpillFile
+	var runs []*SpillFile
+	defer func() {
+		for _, f := range partitions {
+			f.Close()
+		}
+		for _, f := range runs {
+			f.Close()
+		}
+	}()
+
+	buffer := make(RecordSet, 0, StreamChunkSize)
+	var size int64
+
+	for !s.eof {
+		view, err := s.readFilteredChunk()
+		if err != nil {
+			return err
+		}
+
+		if partitions != nil {
+			if err = s.partition(view, partitions); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, record := range view.RecordSet {
+			size += estimateRecordSize(record)
+		}
+		buffer = append(buffer, view.RecordSet...)
+		if size <= s.memoryLimit {
+			continue
+		}
+
+		view.RecordSet = buffer
+		if s.entity.GroupByClause != nil {
+			partitions = make([]*SpillFile, 0, SpillPartitions)
+			for i := 0; i < SpillPartitions; i++ {
+				f, err := NewSpillFile()
+				if err != nil {
+					return err
+				}
+				partitions = append(partitions, f)
+			}
+			err = s.partition(view, partitions)
+		} else {
+			runs, err = s.writeRun(buffer, runs)
+		}
+		if err != nil {
+			return err
+		}
+
+		buffer = make(RecordSet, 0, StreamChunkSize)
+		size = 0
+	}
+
+	if partitions == nil && runs == nil {
+		view, err := s.evaluate(buffer, true)
+		if err != nil {
+			return err
+		}
+		if s.query.OffsetClause != nil {
+			if err = view.Offset(s.query.OffsetClause.(parser.OffsetClause)); err != nil {
+				return err
+			}
+		}
+		if s.query.LimitClause != nil {
+			if err = view.Limit(s.query.LimitClause.(parser.LimitClause)); err != nil {
+				return err
+			}
+		}
+		view.Fix(s.filter.flags())
+		s.offset, s.limit = 0, -1
+		return s.emitView(view)
+	}
+
+	if partitions != nil {
+		for _, f := range partitions {
+			records, err := readSpilledRecords(f)
+			if err != nil {
+				return err
+			}
+
+			if s.query.OrderByClause != nil {
+				if runs, err = s.writeRun(records, runs); err != nil {
+					return err
+				}
+				continue
+			}
+
+			view, err := s.evaluate(records, false)
+			if err != nil {
+				return err
+			}
+			view.Fix(s.filter.flags())
+			if err = s.emitView(view); err != nil {
+				return err
+			}
+			if s.isLimitReached() {
+				return nil
+			}
+		}
+		if s.query.OrderByClause == nil {
+			return nil
+		}
+	} else if 0 < len(buffer) {
+		var err error
+		if runs, err = s.writeRun(buffer, runs); err != nil {
+			return err
+		}
+	}
+
+	var directions []int
+	var nullPositions []int
+	for _, v := range s.query.OrderByClause.(parser.OrderByClause).Items {
+		item := v.(parser.OrderItem)
+		direction := parser.ASC
+		if !item.Direction.IsEmpty() {
+			direction = item.Direction.Token
+		}
+		nullPosition := parser.FIRST
+		if !item.Position.IsEmpty() {
+			nullPosition = item.Position.Token
+		} else if direction != parser.ASC {
+			nullPosition = parser.LAST
+		}
+		directions = append(directions, direction)
+		nullPositions = append(nullPositions, nullPosition)
+	}
+
+	var bottom SortValues
+	return MergeSortedRuns(runs, directions, nullPositions, func(sortValues SortValues, values []value.Primary) (bool, error) {
+		if s.isLimitReached() && !(s.withTies && sortValues.EquivalentTo(bottom)) {
+			return false, nil
+		}
+		if s.offset <= s.skipped {
+			bottom = sortValues
+		}
+		return true, s.emit(values)
+	})
+}
+
+func (s *selectStream) writeRun(records RecordSet, runs []*SpillFile) ([]*SpillFile, error) {
+	view, err := s.evaluate(records, true)
+	if err != nil {
+		return runs, err
+	}
+
+	sortValues := view.sortValuesInEachRecord
+	view.Fix(s.filter.flags())
+	if err = s.setOutputHeader(view.Header); err != nil {
+		return runs, err
+	}
+
+	n := view.RecordLen()
+	if -1 < s.limit && s.offset+s.limit < n {
+		n = s.offset + s.limit
+		for s.withTies && 0 < n && n < view.RecordLen() && sortValues[n-1].EquivalentTo(sortValues[n]) {
+			n++
+		}
+	}
+
+	f, err := NewSpillFile()
+	if err != nil {
+		return runs, err
+	}
+	runs = append(runs, f)
+	for i := 0; i < n; i++ {
+		f.WriteSortedRecord(sortValues[i], recordValues(view.RecordSet[i]))
+	}
+	return runs, nil
+}
+
+func (s *selectStream) partition(view *View, partitions []*SpillFile) error {
+	items := s.entity.GroupByClause.(parser.GroupByClause).Items
+	indices := make([]int, view.RecordLen())
+
+	err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		values := make([]value.Primary, len(items))
+		for i, item := range items {
+			p, e := f.Evaluate(item)
+			if e != nil {
+				return e
+			}
+			values[i] = p
+		}
+
+		keyBuf := new(bytes.Buffer)
+		SerializeComparisonKeys(keyBuf, values, f.flags())
+		h := fnv.New32a()
+		h.Write(keyBuf.Bytes())
+		indices[rIdx] = int(h.Sum32() % uint32(len(partitions)))
+		return nil
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, record := range view.RecordSet {
+		partitions[indices[i]].WriteRecord(recordValues(record))
+	}
+	return nil
+}
+
+func readSpilledRecords(f *SpillFile) (RecordSet, error) {
+	if err := f.Rewind(); err != nil {
+		return nil, err
+	}
+
+	records := make(RecordSet, 0, StreamChunkSize)
+	for {
+		values, err := f.ReadRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, NewRecord(values))
+	}
+	return records, nil
+}
+
+func estimateRecordSize(record Record) int64 {
+	var size int64
+	for _, cell := range record {
+		size += 48
+		if s, ok := cell.Value().(value.String); ok {
+			size += int64(len(s.Raw()))
+		}
+	}
+	return size
+}
+
+func recordValues(record Record) []value.Primary {
+	values := make([]value.Primary, len(record))
+	for i, cell := range record {
+		values[i] = cell.Value()
+	}
+	return values
+}
+
+func rawTextsToPrimaries(row []text.RawText) []value.Primary {
+	fields := make([]value.Primary, len(row))
+	for i, v := range row {
+		if v == nil {
+			fields[i] = value.NewNull()
+		} else {
+			fields[i] = value.NewString(string(v))
+		}
+	}
+	return fields
+}
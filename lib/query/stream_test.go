@@ -0,0 +1,176 @@
+package query
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+)
+
+var selectStreamTests = []struct {
+	Name          string
+	Query         string
+	MemoryLimit   int
+	ChunkSize     int
+	SpillLimit    int64
+	NotStreamable bool
+	Result        string
+	Error         string
+}{
+	{
+		Name:        "Stream Select",
+		Query:       "select column2, column1 from table1 where column1 > 1",
+		MemoryLimit: 1,
+		ChunkSize:   1,
+		Result: "column2,column1\n" +
+			"str2,2\n" +
+			"str3,3",
+	},
+	{
+		Name:        "Stream Select with Offset and Limit",
+		Query:       "select * from group_table limit 2 offset 1",
+		MemoryLimit: 1,
+		ChunkSize:   2,
+		Result: "column1,column2\n" +
+			"1,str2\n" +
+			"2,str3",
+	},
+	{
+		Name:        "Stream Select Empty Result",
+		Query:       "select column1 from table1 where false",
+		MemoryLimit: 1,
+		Result:      "column1",
+	},
+	{
+		Name:        "Stream Select Order By without Spill",
+		Query:       "select * from group_table order by column2 desc limit 2",
+		MemoryLimit: 1,
+		Result: "column1,column2\n" +
+			"3,str5\n" +
+			"2,str4",
+	},
+	{
+		Name:        "Stream Select Order By with Spill",
+		Query:       "select column2 from group_table order by column1 desc, column2 limit 3 offset 1",
+		MemoryLimit: 1,
+		ChunkSize:   2,
+		SpillLimit:  1,
+		Result: "column2\n" +
+			"str3\n" +
+			"str4\n" +
+			"str1",
+	},
+	{
+		Name:        "Stream Select Order By with Ties and Spill",
+		Query:       "select column2 from group_table order by column1 limit 1 with ties",
+		MemoryLimit: 1,
+		ChunkSize:   1,
+		SpillLimit:  1,
+		Result: "column2\n" +
+			"str1\n" +
+			"str2",
+	},
+	{
+		Name:        "Stream Select Group By with Spill",
+		Query:       "select column1, count(*) as cnt from group_table group by column1 having count(*) > 1 order by column1 desc",
+		MemoryLimit: 1,
+		ChunkSize:   2,
+		SpillLimit:  1,
+		Result: "column1,cnt\n" +
+			"2,2\n" +
+			"1,2",
+	},
+	{
+		Name:        "Stream Select Field Error",
+		Query:       "select notexist from table1",
+		MemoryLimit: 1,
+		Error:       "[L:1 C:8] field notexist does not exist",
+	},
+	{
+		Name:          "Stream Select Disabled",
+		Query:         "select * from table1",
+		NotStreamable: true,
+	},
+	{
+		Name:          "Stream Select Aggregation without Group By",
+		Query:         "select count(*) from table1",
+		MemoryLimit:   1,
+		NotStreamable: true,
+	},
+	{
+		Name:          "Stream Select Distinct",
+		Query:         "select distinct column1 from group_table",
+		MemoryLimit:   1,
+		NotStreamable: true,
+	},
+	{
+		Name:          "Stream Select Not CSV",
+		Query:         "select * from table6",
+		MemoryLimit:   1,
+		NotStreamable: true,
+	},
+}
+
+func TestSelectStream(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range selectStreamTests {
+		ViewCache.Clean()
+		tf.MemoryLimit = v.MemoryLimit
+
+		statements, err := parser.Parse(v.Query, "", nil, GetTestLocation())
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		filter := NewEmptyFilter()
+		stream := newSelectStream(statements[0].(parser.SelectQuery), filter)
+		if stream == nil {
+			if !v.NotStreamable {
+				t.Errorf("%s: query is not streamable", v.Name)
+			}
+			continue
+		}
+		if v.NotStreamable {
+			t.Errorf("%s: query is streamable, want not streamable", v.Name)
+			continue
+		}
+
+		stream.filter = filter.CreateNode()
+		if 0 < v.ChunkSize {
+			stream.chunkSize = v.ChunkSize
+		}
+		if 0 < v.SpillLimit {
+			stream.memoryLimit = v.SpillLimit
+		}
+
+		buf := &bytes.Buffer{}
+		err = stream.run(NewStreamEncoder(buf, &FileInfo{
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+		}, filter))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+	tf.MemoryLimit = 0
+	ViewCache.Clean()
+}
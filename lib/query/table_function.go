@@ -0,0 +1,159 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var TableFunctions = map[string]func(parser.TableFunction, []value.Primary, *cmd.Flags) ([]string, [][]value.Primary, error){
+	"GENERATE_SERIES": GenerateSeries,
+	"SPLIT_TO_TABLE":  SplitToTable,
+}
+
+func loadTableFunctionView(fn parser.TableFunction, name string, filter *Filter) (*View, error) {
+	tableFn, ok := TableFunctions[strings.ToUpper(fn.Name)]
+	if !ok {
+		return nil, NewFunctionNotExistError(fn, fn.Name)
+	}
+
+	args := make([]value.Primary, len(fn.Args))
+	for i, v := range fn.Args {
+		arg, err := filter.Evaluate(v)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+
+	columns, rows, err := tableFn(fn, args, filter.flags())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(RecordSet, len(rows))
+	for i, row := range rows {
+		records[i] = NewRecord(row)
+	}
+
+	return &View{
+		Header:    NewHeader(name, columns),
+		RecordSet: records,
+	}, nil
+}
+
+func GenerateSeries(fn parser.TableFunction, args []value.Primary, flags *cmd.Flags) ([]string, [][]value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	columns := []string{"value"}
+
+	bounds := []value.Primary{args[0], args[1], value.NewInteger(1)}
+	if len(args) == 3 {
+		bounds[2] = args[2]
+	}
+
+	if step, ok := bounds[2].(value.Interval); ok {
+		return generateDatetimeSeries(fn, columns, bounds[0], bounds[1], step, flags)
+	}
+
+	isInteger := true
+	floats := make([]float64, len(bounds))
+	for i, p := range bounds {
+		if value.IsNull(p) {
+			return columns, [][]value.Primary{}, nil
+		}
+
+		f := value.ToFloat(p)
+		if value.IsNull(f) {
+			return nil, nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the arguments must be numbers")
+		}
+		floats[i] = f.(value.Float).Raw()
+
+		if value.IsNull(value.ToInteger(p)) {
+			isInteger = false
+		}
+	}
+
+	start, stop, step := floats[0], floats[1], floats[2]
+	if step == 0 {
+		return nil, nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the step must not be zero")
+	}
+
+	rows := make([][]value.Primary, 0)
+	for i := 0; ; i++ {
+		v := start + float64(i)*step
+		if (0 < step && stop < v) || (step < 0 && v < stop) {
+			break
+		}
+
+		if isInteger {
+			rows = append(rows, []value.Primary{value.NewInteger(int64(v))})
+		} else {
+			rows = append(rows, []value.Primary{value.NewFloat(v)})
+		}
+	}
+	return columns, rows, nil
+}
+
+func generateDatetimeSeries(fn parser.TableFunction, columns []string, start value.Primary, stop value.Primary, step value.Interval, flags *cmd.Flags) ([]string, [][]value.Primary, error) {
+	if value.IsNull(start) || value.IsNull(stop) {
+		return columns, [][]value.Primary{}, nil
+	}
+
+	dt1, ok1 := toDatetimeOperand(start, flags)
+	dt2, ok2 := toDatetimeOperand(stop, flags)
+	if !ok1 || !ok2 {
+		return nil, nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the start and the stop must be datetimes when the step is an interval")
+	}
+	t1, t2 := dt1.Raw(), dt2.Raw()
+
+	direction := step.AddTo(t1).Sub(t1)
+	if direction == 0 {
+		return nil, nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the step must not be zero")
+	}
+
+	rows := make([][]value.Primary, 0)
+	for i := int64(0); ; i++ {
+		t := step.Mul(float64(i)).AddTo(t1)
+		if (0 < direction && t.After(t2)) || (direction < 0 && t.Before(t2)) {
+			break
+		}
+		rows = append(rows, []value.Primary{value.NewDatetime(t)})
+	}
+	return columns, rows, nil
+}
+
+func SplitToTable(fn parser.TableFunction, args []value.Primary, _ *cmd.Flags) ([]string, [][]value.Primary, error) {
+	if len(args) != 2 {
+		return nil, nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	columns := []string{"position", "value"}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return nil, nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a string")
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return columns, [][]value.Primary{}, nil
+	}
+
+	var items []string
+	if len(sep.(value.String).Raw()) < 1 {
+		items = []string{s.(value.String).Raw()}
+	} else {
+		items = strings.Split(s.(value.String).Raw(), sep.(value.String).Raw())
+	}
+
+	rows := make([][]value.Primary, len(items))
+	for i, item := range items {
+		rows[i] = []value.Primary{value.NewInteger(int64(i + 1)), value.NewString(item)}
+	}
+	return columns, rows, nil
+}
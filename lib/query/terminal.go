@@ -155,7 +155,7 @@ func (p *Prompt) Render(sequence []PromptElement) (string, error) {
 		case excmd.CsvqExpression:
 			if 0 < len(element.Text) {
 				command := element.Text
-				statements, err := parser.Parse(command, "")
+				statements, err := parser.Parse(command, "", p.filter.flags().DatetimeFormat, p.filter.flags().GetLocation())
 				if err != nil {
 					syntaxErr := err.(*parser.SyntaxError)
 					return "", NewPromptEvaluationError(syntaxErr.Message)
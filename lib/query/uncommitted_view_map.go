@@ -5,12 +5,14 @@ import "strings"
 type UncommittedViewMap struct {
 	Created map[string]*FileInfo
 	Updated map[string]*FileInfo
+	Dropped map[string]*FileInfo
 }
 
 func NewUncommittedViewMap() *UncommittedViewMap {
 	return &UncommittedViewMap{
 		Created: make(map[string]*FileInfo),
 		Updated: make(map[string]*FileInfo),
+		Dropped: make(map[string]*FileInfo),
 	}
 }
 
@@ -34,6 +36,18 @@ func (m *UncommittedViewMap) SetForUpdatedView(fileInfo *FileInfo) {
 	}
 }
 
+func (m *UncommittedViewMap) SetForDroppedView(fileInfo *FileInfo) {
+	ufpath := strings.ToUpper(fileInfo.Path)
+
+	if _, ok := m.Created[ufpath]; ok {
+		delete(m.Created, ufpath)
+		return
+	}
+
+	delete(m.Updated, ufpath)
+	m.Dropped[ufpath] = fileInfo
+}
+
 func (m *UncommittedViewMap) Unset(fileInfo *FileInfo) {
 	ufpath := strings.ToUpper(fileInfo.Path)
 
@@ -44,6 +58,11 @@ func (m *UncommittedViewMap) Unset(fileInfo *FileInfo) {
 
 	if _, ok := m.Created[ufpath]; ok {
 		delete(m.Created, ufpath)
+		return
+	}
+
+	if _, ok := m.Dropped[ufpath]; ok {
+		delete(m.Dropped, ufpath)
 	}
 }
 
@@ -54,6 +73,19 @@ func (m *UncommittedViewMap) Clean() {
 	for k := range m.Created {
 		delete(m.Created, k)
 	}
+	for k := range m.Dropped {
+		delete(m.Dropped, k)
+	}
+}
+
+func (m *UncommittedViewMap) IsCreated(fpath string) bool {
+	_, ok := m.Created[strings.ToUpper(fpath)]
+	return ok
+}
+
+func (m *UncommittedViewMap) IsDropped(fpath string) bool {
+	_, ok := m.Dropped[strings.ToUpper(fpath)]
+	return ok
 }
 
 func (m *UncommittedViewMap) UncommittedFiles() (map[string]*FileInfo, map[string]*FileInfo) {
@@ -74,6 +106,16 @@ func (m *UncommittedViewMap) UncommittedFiles() (map[string]*FileInfo, map[strin
 	return createdFiles, updatedFiles
 }
 
+func (m *UncommittedViewMap) UncommittedDroppedFiles() map[string]*FileInfo {
+	var droppedFiles = make(map[string]*FileInfo)
+
+	for k, v := range m.Dropped {
+		droppedFiles[k] = v
+	}
+
+	return droppedFiles
+}
+
 func (m *UncommittedViewMap) UncommittedTempViews() map[string]*FileInfo {
 	var updatedViews = map[string]*FileInfo{}
 
@@ -93,6 +135,9 @@ func (m *UncommittedViewMap) IsEmpty() bool {
 	if 0 < len(m.Updated) {
 		return false
 	}
+	if 0 < len(m.Dropped) {
+		return false
+	}
 	return true
 }
 
@@ -84,6 +84,40 @@ func TestUncommittedViewMap_SetForUpdatedView(t *testing.T) {
 	}
 }
 
+func TestUncommittedViewMap_SetForDroppedView(t *testing.T) {
+	m := &UncommittedViewMap{
+		Created: map[string]*FileInfo{
+			"PRE_CREATED.TXT": {Path: "pre_created.txt"},
+		},
+		Updated: map[string]*FileInfo{
+			"PRE_UPDATED.TXT": {Path: "pre_updated.txt"},
+		},
+		Dropped: map[string]*FileInfo{},
+	}
+
+	expect := &UncommittedViewMap{
+		Created: map[string]*FileInfo{},
+		Updated: map[string]*FileInfo{},
+		Dropped: map[string]*FileInfo{
+			"PRE_UPDATED.TXT": {Path: "pre_updated.txt"},
+			"DROP.TXT":        {Path: "drop.txt"},
+		},
+	}
+	m.SetForDroppedView(&FileInfo{Path: "drop.txt"})
+	m.SetForDroppedView(preCreatedFileInfo)
+	m.SetForDroppedView(preUpdatedFileInfo)
+	if !reflect.DeepEqual(m, expect) {
+		t.Errorf("map = %v, want %v", m, expect)
+	}
+
+	if !m.IsDropped("DROP.TXT") {
+		t.Errorf("is dropped = %t, want %t", false, true)
+	}
+	if m.IsDropped("pre_created.txt") {
+		t.Errorf("is dropped = %t, want %t", true, false)
+	}
+}
+
 func TestUncommittedViewMap_Unset(t *testing.T) {
 	m := &UncommittedViewMap{
 		Created: map[string]*FileInfo{
@@ -20,6 +20,10 @@ func (list UserDefinedFunctionScopes) DeclareAggregate(expr parser.AggregateDecl
 	return list[0].DeclareAggregate(expr)
 }
 
+func (list UserDefinedFunctionScopes) DeclareProcedure(expr parser.ProcedureDeclaration) error {
+	return list[0].DeclareProcedure(expr)
+}
+
 func (list UserDefinedFunctionScopes) Get(expr parser.QueryExpression, name string) (*UserDefinedFunction, error) {
 	for _, v := range list {
 		if fn, err := v.Get(expr, name); err == nil {
@@ -29,6 +33,16 @@ func (list UserDefinedFunctionScopes) Get(expr parser.QueryExpression, name stri
 	return nil, NewFunctionNotExistError(expr, name)
 }
 
+func (list UserDefinedFunctionScopes) GetProcedure(name parser.Identifier) (*UserDefinedFunction, error) {
+	uname := strings.ToUpper(name.Literal)
+	for _, m := range list {
+		if fn, ok := m[uname]; ok && fn.IsProcedure {
+			return fn, nil
+		}
+	}
+	return nil, NewProcedureNotExistError(name)
+}
+
 func (list UserDefinedFunctionScopes) Dispose(name parser.Identifier) error {
 	for _, m := range list {
 		err := m.Dispose(name)
@@ -39,12 +53,25 @@ func (list UserDefinedFunctionScopes) Dispose(name parser.Identifier) error {
 	return NewFunctionNotExistError(name, name.Literal)
 }
 
+func (list UserDefinedFunctionScopes) DisposeProcedure(name parser.Identifier) error {
+	for _, m := range list {
+		err := m.DisposeProcedure(name)
+		if err == nil {
+			return nil
+		}
+	}
+	return NewProcedureNotExistError(name)
+}
+
 func (list UserDefinedFunctionScopes) All() (UserDefinedFunctionMap, UserDefinedFunctionMap) {
 	scalaAll := make(UserDefinedFunctionMap, 10)
 	aggregateAll := make(UserDefinedFunctionMap, 10)
 
 	for _, m := range list {
 		for key, fn := range m {
+			if fn.IsProcedure {
+				continue
+			}
 			if fn.IsAggregate {
 				if _, ok := aggregateAll[key]; !ok {
 					aggregateAll[key] = fn
@@ -60,6 +87,23 @@ func (list UserDefinedFunctionScopes) All() (UserDefinedFunctionMap, UserDefined
 	return scalaAll, aggregateAll
 }
 
+func (list UserDefinedFunctionScopes) Procedures() UserDefinedFunctionMap {
+	all := make(UserDefinedFunctionMap, 10)
+
+	for _, m := range list {
+		for key, fn := range m {
+			if !fn.IsProcedure {
+				continue
+			}
+			if _, ok := all[key]; !ok {
+				all[key] = fn
+			}
+		}
+	}
+
+	return all
+}
+
 type UserDefinedFunctionMap map[string]*UserDefinedFunction
 
 func (m UserDefinedFunctionMap) Declare(expr parser.FunctionDeclaration) error {
@@ -108,6 +152,42 @@ func (m UserDefinedFunctionMap) DeclareAggregate(expr parser.AggregateDeclaratio
 	return nil
 }
 
+func (m UserDefinedFunctionMap) DeclareProcedure(expr parser.ProcedureDeclaration) error {
+	if err := m.CheckDuplicate(expr.Name); err != nil {
+		if _, ok := err.(*FunctionRedeclaredError); ok {
+			err = NewProcedureRedeclaredError(expr.Name)
+		}
+		return err
+	}
+
+	assignments := make([]parser.VariableAssignment, len(expr.Parameters))
+	outParameters := make(map[string]bool)
+	for i, p := range expr.Parameters {
+		assignments[i] = parser.VariableAssignment{Variable: p.Variable, Value: p.Value}
+		if p.Out {
+			outParameters[p.Variable.Name] = true
+		}
+	}
+
+	parameters, defaults, required, err := m.parseParameters(assignments)
+	if err != nil {
+		return err
+	}
+
+	uname := strings.ToUpper(expr.Name.Literal)
+
+	m[uname] = &UserDefinedFunction{
+		Name:          expr.Name,
+		Statements:    expr.Statements,
+		Parameters:    parameters,
+		Defaults:      defaults,
+		RequiredArgs:  required,
+		IsProcedure:   true,
+		OutParameters: outParameters,
+	}
+	return nil
+}
+
 func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssignment) ([]parser.Variable, map[string]parser.QueryExpression, int, error) {
 	var isDuplicate = func(variable parser.Variable, variables []parser.Variable) bool {
 		for _, v := range variables {
@@ -140,7 +220,7 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "NOW" || uname == "JSON_OBJECT" {
+	if isBuiltInFunction(uname) {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := AggregateFunctions[uname]; ok {
@@ -179,13 +259,22 @@ func (m UserDefinedFunctionMap) SortedKeys() []string {
 
 func (m UserDefinedFunctionMap) Dispose(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
-	if _, ok := m[uname]; ok {
+	if fn, ok := m[uname]; ok && !fn.IsProcedure {
 		delete(m, uname)
 		return nil
 	}
 	return NewFunctionNotExistError(name, name.Literal)
 }
 
+func (m UserDefinedFunctionMap) DisposeProcedure(name parser.Identifier) error {
+	uname := strings.ToUpper(name.Literal)
+	if fn, ok := m[uname]; ok && fn.IsProcedure {
+		delete(m, uname)
+		return nil
+	}
+	return NewProcedureNotExistError(name)
+}
+
 type UserDefinedFunction struct {
 	Name         parser.Identifier
 	Statements   []parser.Statement
@@ -195,6 +284,9 @@ type UserDefinedFunction struct {
 
 	IsAggregate bool
 	Cursor      parser.Identifier // For Aggregate Functions
+
+	IsProcedure   bool
+	OutParameters map[string]bool // For Procedures
 }
 
 func (fn *UserDefinedFunction) Execute(args []value.Primary, filter *Filter) (value.Primary, error) {
@@ -229,9 +321,36 @@ func (fn *UserDefinedFunction) CheckArgsLen(expr parser.QueryExpression, name st
 	return nil
 }
 
-func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (value.Primary, error) {
+func (fn *UserDefinedFunction) ExecuteProcedure(args []value.Primary, parentProc *Procedure) ([]value.Primary, StatementFlow, error) {
+	proc := parentProc.NewChildProcedure()
+	if err := fn.setArguments(args, proc.Filter); err != nil {
+		return nil, Error, err
+	}
+
+	flow, err := proc.Execute(fn.Statements)
+	if err != nil {
+		return nil, flow, err
+	}
+	if flow != Exit {
+		flow = Terminate
+	}
+
+	outValues := make([]value.Primary, len(fn.Parameters))
+	for i, v := range fn.Parameters {
+		if fn.IsOutParameter(i) {
+			outValues[i], _ = proc.Filter.Variables[0].Get(v)
+		}
+	}
+	return outValues, flow, nil
+}
+
+func (fn *UserDefinedFunction) IsOutParameter(i int) bool {
+	return i < len(fn.Parameters) && fn.OutParameters[fn.Parameters[i].Name]
+}
+
+func (fn *UserDefinedFunction) setArguments(args []value.Primary, filter *Filter) error {
 	if err := fn.CheckArgsLen(fn.Name, fn.Name.Literal, len(args)); err != nil {
-		return nil, err
+		return err
 	}
 
 	for i, v := range fn.Parameters {
@@ -241,11 +360,18 @@ func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (va
 			defaultValue, _ := fn.Defaults[v.Name]
 			val, err := filter.Evaluate(defaultValue)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			filter.Variables[0].Add(v, val)
 		}
 	}
+	return nil
+}
+
+func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (value.Primary, error) {
+	if err := fn.setArguments(args, filter); err != nil {
+		return nil, err
+	}
 
 	proc := NewProcedure()
 	proc.Filter = filter
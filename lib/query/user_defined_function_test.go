@@ -408,6 +408,18 @@ func TestUserDefinedFunctionScopes_All(t *testing.T) {
 					parser.Print{Value: parser.Variable{Name: "arg1"}},
 				},
 			},
+			"USERPROC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userproc"},
+				Parameters: []parser.Variable{
+					{Name: "arg1"},
+				},
+				RequiredArgs:  1,
+				IsProcedure:   true,
+				OutParameters: map[string]bool{"arg1": true},
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "arg1"}},
+				},
+			},
 		},
 	}
 
@@ -456,6 +468,21 @@ func TestUserDefinedFunctionScopes_All(t *testing.T) {
 		},
 	}
 
+	expectProc := UserDefinedFunctionMap{
+		"USERPROC": &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userproc"},
+			Parameters: []parser.Variable{
+				{Name: "arg1"},
+			},
+			RequiredArgs:  1,
+			IsProcedure:   true,
+			OutParameters: map[string]bool{"arg1": true},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "arg1"}},
+			},
+		},
+	}
+
 	scala, agg := list.All()
 	if !reflect.DeepEqual(scala, expectScala) {
 		t.Errorf("scala: result = %v, want %v", scala, expectScala)
@@ -463,6 +490,11 @@ func TestUserDefinedFunctionScopes_All(t *testing.T) {
 	if !reflect.DeepEqual(agg, expectAgg) {
 		t.Errorf("aggregate: result = %v, want %v", agg, expectAgg)
 	}
+
+	procs := list.Procedures()
+	if !reflect.DeepEqual(procs, expectProc) {
+		t.Errorf("procedure: result = %v, want %v", procs, expectProc)
+	}
 }
 
 var userDefinedFunctionMapDeclareTests = []struct {
@@ -658,6 +690,102 @@ func TestUserDefinedFunctionMap_DeclareAggregate(t *testing.T) {
 	}
 }
 
+var userDefinedFunctionMapDeclareProcedureTests = []struct {
+	Name   string
+	Expr   parser.ProcedureDeclaration
+	Result UserDefinedFunctionMap
+	Error  string
+}{
+	{
+		Name: "UserDefinedFunctionMap DeclareProcedure",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc"},
+			Parameters: []parser.ProcedureParameter{
+				{Variable: parser.Variable{Name: "arg1"}},
+				{Variable: parser.Variable{Name: "arg2"}, Out: true},
+				{Variable: parser.Variable{Name: "arg3"}, Value: parser.NewIntegerValue(1)},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "arg1"}},
+			},
+		},
+		Result: UserDefinedFunctionMap{
+			"USERPROC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userproc"},
+				Parameters: []parser.Variable{
+					{Name: "arg1"},
+					{Name: "arg2"},
+					{Name: "arg3"},
+				},
+				Defaults: map[string]parser.QueryExpression{
+					"arg3": parser.NewIntegerValue(1),
+				},
+				RequiredArgs:  2,
+				IsProcedure:   true,
+				OutParameters: map[string]bool{"arg2": true},
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "arg1"}},
+				},
+			},
+		},
+	},
+	{
+		Name: "UserDefinedFunctionMap DeclareProcedure Redeclaration Error",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc"},
+		},
+		Error: "[L:- C:-] procedure userproc is redeclared",
+	},
+	{
+		Name: "UserDefinedFunctionMap DeclareProcedure Built-in Function Name Error",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "call"},
+		},
+		Error: "[L:- C:-] function call is a built-in function",
+	},
+	{
+		Name: "UserDefinedFunctionMap DeclareProcedure Duplicate Parameters Error",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc2"},
+			Parameters: []parser.ProcedureParameter{
+				{Variable: parser.Variable{Name: "arg1"}},
+				{Variable: parser.Variable{Name: "arg1"}, Out: true},
+			},
+		},
+		Error: "[L:- C:-] parameter @arg1 is a duplicate",
+	},
+}
+
+func TestUserDefinedFunctionMap_DeclareProcedure(t *testing.T) {
+	funcs := UserDefinedFunctionMap{}
+
+	for _, v := range userDefinedFunctionMapDeclareProcedureTests {
+		err := funcs.DeclareProcedure(v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(funcs, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, funcs, v.Result)
+		}
+	}
+
+	if err := funcs.Dispose(parser.Identifier{Literal: "userproc"}); err == nil {
+		t.Errorf("procedure is disposed as a function")
+	}
+	if err := funcs.DisposeProcedure(parser.Identifier{Literal: "userproc"}); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
 var userDefinedFunctionMapCheckDuplicateTests = []struct {
 	Name     string
 	FuncName parser.Identifier
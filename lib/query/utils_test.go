@@ -3,7 +3,9 @@ package query
 import (
 	"bytes"
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
@@ -16,9 +18,9 @@ func TestSerializeComparisonKeys(t *testing.T) {
 		value.NewInteger(0),
 		value.NewInteger(3),
 		value.NewFloat(1.234),
-		value.NewDatetimeFromString("2012-02-03T09:18:15-08:00"),
-		value.NewDatetimeFromString("2012-02-03T09:18:15.123-08:00"),
-		value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00"),
+		value.NewDatetimeFromString("2012-02-03T09:18:15-08:00", nil, time.Local),
+		value.NewDatetimeFromString("2012-02-03T09:18:15.123-08:00", nil, time.Local),
+		value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00", nil, time.Local),
 		value.NewBoolean(true),
 		value.NewBoolean(false),
 		value.NewTernary(ternary.UNKNOWN),
@@ -27,7 +29,7 @@ func TestSerializeComparisonKeys(t *testing.T) {
 	expect := "[S]STR:[I]1[B]true:[I]0[B]false:[I]3:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]1[B]true:[I]0[B]false:[N]:[N]"
 
 	buf := new(bytes.Buffer)
-	SerializeComparisonKeys(buf, values)
+	SerializeComparisonKeys(buf, values, cmd.GetFlags())
 	result := buf.String()
 	if result != expect {
 		t.Errorf("result = %q, want %q", result, expect)
@@ -45,7 +47,7 @@ func BenchmarkDistinguish(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		Distinguish(values)
+		Distinguish(values, cmd.GetFlags())
 	}
 }
 
@@ -60,6 +62,6 @@ func BenchmarkSerializeKey(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		buf.Reset()
-		SerializeComparisonKeys(buf, plist)
+		SerializeComparisonKeys(buf, plist, cmd.GetFlags())
 	}
 }
@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	gojson "encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,12 +13,14 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
@@ -42,6 +45,12 @@ type View struct {
 	selectLabels []string
 	isGrouped    bool
 
+	groupingKeys      []parser.QueryExpression
+	groupingFieldBits map[int]int64
+	groupingIdIndex   int
+
+	windows []parser.QueryExpression
+
 	comparisonKeysInEachRecord []string
 	sortValuesInEachCell       [][]*SortValue
 	sortValuesInEachRecord     []SortValues
@@ -61,6 +70,10 @@ func NewView() *View {
 }
 
 func (view *View) Load(clause parser.FromClause, filter *Filter) error {
+	return view.LoadWithCondition(clause, nil, filter)
+}
+
+func (view *View) LoadWithCondition(clause parser.FromClause, condition parser.QueryExpression, filter *Filter) error {
 	if clause.Tables == nil {
 		var obj parser.QueryExpression
 		if cmd.IsReadableFromPipeOrRedirection() {
@@ -72,20 +85,49 @@ func (view *View) Load(clause parser.FromClause, filter *Filter) error {
 	}
 
 	views := make([]*View, len(clause.Tables))
-	for i, v := range clause.Tables {
-		loaded, err := loadView(v, filter, view.UseInternalId, view.ForUpdate)
+	if condition != nil && len(clause.Tables) == 1 && !view.UseInternalId && !view.ForUpdate {
+		if table, ok := clause.Tables[0].(parser.Table); ok {
+			loaded, err := loadViewByIndex(table, condition, filter)
+			if err != nil {
+				return err
+			}
+			views[0] = loaded
+		}
+	}
+
+	if views[0] == nil {
+		loaded, err := loadView(clause.Tables[0], filter, view.UseInternalId, view.ForUpdate)
 		if err != nil {
 			return err
 		}
-		views[i] = loaded
+		views[0] = loaded
 	}
 
 	view.Header = views[0].Header
 	view.RecordSet = views[0].RecordSet
 	view.FileInfo = views[0].FileInfo
 
-	for i := 1; i < len(views); i++ {
-		CrossJoin(view, views[i])
+	for i := 1; i < len(clause.Tables); i++ {
+		if isLateralTable(clause.Tables[i]) {
+			elapsed, err := LateralJoin(view, clause.Tables[i], filter, func(view *View, joinView *View) error {
+				CrossJoin(view, joinView, filter.flags())
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			filter.plan.combine(newCrossJoinPlanNode("LATERAL"), view.RecordLen(), elapsed)
+			continue
+		}
+
+		loaded, err := loadView(clause.Tables[i], filter, view.UseInternalId, view.ForUpdate)
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		CrossJoin(view, loaded, filter.flags())
+		filter.plan.combine(newCrossJoinPlanNode(""), view.RecordLen(), time.Since(start))
 	}
 
 	view.Filter = filter
@@ -112,11 +154,15 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	var view *View
 	var err error
 
+	filter.plan.begin()
+	start := time.Now()
+	node := filter.plan.tableNode(table, filter)
+
 	switch table.Object.(type) {
 	case parser.Dual:
 		view = loadDualView()
 	case parser.Stdin:
-		flags := cmd.GetFlags()
+		flags := filter.flags()
 		fileInfo := &FileInfo{
 			Path:               table.Object.String(),
 			Format:             flags.SelectImportFormat(),
@@ -142,7 +188,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 				fp := os.Stdin
 				defer fp.Close()
 
-				loadView, err = loadViewFromFile(fp, fileInfo, flags.WithoutNull)
+				loadView, err = loadViewFromFile(fp, fileInfo, flags.WithoutNull, flags)
 				if err != nil {
 					return nil, NewDataParsingError(table.Object, fileInfo.Path, err.Error())
 				}
@@ -170,6 +216,10 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 				loadView.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
 				loadView.RecordSet = records
 				loadView.FileInfo = fileInfo
+
+				if err = applyTableSchema(loadView, -1, filter.flags()); err != nil {
+					return nil, NewDataParsingError(table.Object, fileInfo.Path, err.Error())
+				}
 			}
 
 			loadView.FileInfo.InitialHeader = loadView.Header.Copy()
@@ -182,7 +232,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 
 		pathIdent := parser.Identifier{Literal: table.Object.String()}
 		if useInternalId {
-			view, _ = filter.TempViews[len(filter.TempViews)-1].GetWithInternalId(pathIdent)
+			view, _ = filter.TempViews[len(filter.TempViews)-1].GetWithInternalId(pathIdent, filter.flags())
 		} else {
 			view, _ = filter.TempViews[len(filter.TempViews)-1].Get(pathIdent)
 		}
@@ -192,7 +242,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.TableObject:
 		tableObject := table.Object.(parser.TableObject)
 
-		flags := cmd.GetFlags()
+		flags := filter.flags()
 		importFormat := flags.SelectImportFormat()
 		delimiter := flags.Delimiter
 		delimiterPositions := flags.DelimiterPositions
@@ -200,6 +250,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		encoding := flags.Encoding
 		noHeader := flags.NoHeader
 		withoutNull := flags.WithoutNull
+		sheet := ""
+		cellRange := ""
 
 		var felem value.Primary
 		if tableObject.FormatElement != nil {
@@ -213,6 +265,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		sheetIdx := -1
+		cellRangeIdx := -1
 
 		switch strings.ToUpper(tableObject.Type.Literal) {
 		case cmd.CSV.String():
@@ -276,11 +330,22 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 			importFormat = cmd.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case cmd.XLSX.String():
+			if felem != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, "the first argument must be a table name")
+			}
+			if 4 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
+			}
+			importFormat = cmd.XLSX
+			encoding = text.UTF8
+			encodingIdx = -1
+			sheetIdx, cellRangeIdx, noHeaderIdx, withoutNullIdx = 0, 1, 2, 3
 		default:
 			return nil, NewTableObjectInvalidObjectError(tableObject, tableObject.Type.Literal)
 		}
 
-		args := make([]value.Primary, 3)
+		args := make([]value.Primary, 4)
 		for i, a := range tableObject.Args {
 			if pt, ok := a.(parser.PrimitiveType); ok && value.IsNull(pt.Value) {
 				continue
@@ -295,6 +360,20 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 
 			switch i {
+			case sheetIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a sheet name value: %s", tableObject.Args[sheetIdx].String()))
+				}
+			case cellRangeIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a cell range value: %s", tableObject.Args[cellRangeIdx].String()))
+				}
 			case encodingIdx:
 				v := value.ToString(p)
 				if !value.IsNull(v) {
@@ -319,8 +398,17 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 		}
 
-		if args[encodingIdx] != nil {
-			if encoding, err = cmd.ParseEncoding(args[0].(value.String).Raw()); err != nil {
+		if -1 < encodingIdx && args[encodingIdx] != nil {
+			if encoding, err = cmd.ParseEncoding(args[encodingIdx].(value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
+		if -1 < sheetIdx && args[sheetIdx] != nil {
+			sheet = args[sheetIdx].(value.String).Raw()
+		}
+		if -1 < cellRangeIdx && args[cellRangeIdx] != nil {
+			cellRange = args[cellRangeIdx].(value.String).Raw()
+			if _, err = xlsx.ParseCellRange(cellRange); err != nil {
 				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
 			}
 		}
@@ -347,13 +435,15 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			withoutNull,
+			sheet,
+			cellRange,
 		)
 		if err != nil {
 			return nil, err
 		}
 
 	case parser.Identifier:
-		flags := cmd.GetFlags()
+		flags := filter.flags()
 
 		view, err = loadObject(
 			table.Object.(parser.Identifier),
@@ -371,6 +461,8 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			flags.EncloseAll,
 			flags.JsonEscape,
 			flags.WithoutNull,
+			"",
+			"",
 		)
 		if err != nil {
 			return nil, err
@@ -381,34 +473,51 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		if err != nil {
 			return nil, err
 		}
-		view2, err := loadView(join.JoinTable, filter, useInternalId, forUpdate)
-		if err != nil {
-			return nil, err
-		}
 
-		condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, view2)
-		if err != nil {
-			return nil, err
-		}
+		var condition parser.QueryExpression
+		var includeFields []parser.FieldReference
+		var excludeFields []parser.FieldReference
 
-		joinType := join.JoinType.Token
-		if join.JoinType.IsEmpty() {
-			if join.Direction.IsEmpty() {
-				joinType = parser.INNER
-			} else {
-				joinType = parser.OUTER
+		if isLateralTable(join.JoinTable) {
+			if join.Direction.Token == parser.RIGHT || join.Direction.Token == parser.FULL {
+				return nil, NewLateralJoinDirectionError(join)
 			}
-		}
 
-		switch joinType {
-		case parser.CROSS:
-			CrossJoin(view, view2)
-		case parser.INNER:
-			if err = InnerJoin(view, view2, condition, filter); err != nil {
+			node = filter.plan.lateralJoinNode(join)
+
+			_, err = LateralJoin(view, join.JoinTable, filter, func(view *View, joinView *View) error {
+				var e error
+				if condition, includeFields, excludeFields, e = ParseJoinCondition(join, view, joinView); e != nil {
+					return e
+				}
+				return joinViews(join, view, joinView, condition, filter)
+			})
+			if err != nil {
 				return nil, err
 			}
-		case parser.OUTER:
-			if err = OuterJoin(view, view2, condition, join.Direction.Token, filter); err != nil {
+		} else {
+			var view2 *View
+			if !useInternalId && !forUpdate && join.Natural.IsEmpty() &&
+				(join.JoinType.Token == parser.INNER || (join.JoinType.IsEmpty() && join.Direction.IsEmpty()) || join.Direction.Token == parser.LEFT) {
+				if view2, err = loadJoinTableByIndex(join, view, filter); err != nil {
+					return nil, err
+				}
+			}
+			if view2 == nil {
+				view2, err = loadView(join.JoinTable, filter, useInternalId, forUpdate)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			condition, includeFields, excludeFields, err = ParseJoinCondition(join, view, view2)
+			if err != nil {
+				return nil, err
+			}
+
+			node = filter.plan.joinNode(join, condition, view, view2)
+
+			if err = joinViews(join, view, view2, condition, filter); err != nil {
 				return nil, err
 			}
 		}
@@ -442,7 +551,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 			view.Header = header
 
-			NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+			NewGoroutineTaskManager(view.RecordLen(), -1, filter.flags().CPU).Run(func(index int) {
 				record := make(Record, len(fieldIndices))
 				for i, idx := range fieldIndices {
 					record[i] = view.RecordSet[index][idx]
@@ -468,12 +577,12 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		var reader io.Reader
 
 		if jsonPath, ok := jsonQuery.JsonText.(parser.Identifier); ok {
-			fpath, err := SearchJsonFilePath(jsonPath, cmd.GetFlags().Repository)
+			fpath, err := SearchJsonFilePath(jsonPath, filter.flags().Repository)
 			if err != nil {
 				return nil, err
 			}
 
-			h, err := file.NewHandlerForRead(fpath)
+			h, err := file.NewHandlerForRead(fpath, filter.flags().WaitTimeout, filter.flags().RetryInterval)
 			if err != nil {
 				if _, ok := err.(*file.TimeoutError); ok {
 					return nil, NewFileLockTimeoutError(jsonPath, fpath)
@@ -501,7 +610,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			Format:      cmd.JSON,
 			JsonQuery:   queryValue.(value.String).Raw(),
 			Encoding:    text.UTF8,
-			LineBreak:   cmd.GetFlags().LineBreak,
+			LineBreak:   filter.flags().LineBreak,
 			IsTemporary: true,
 		}
 
@@ -514,6 +623,34 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			return nil, err
 		}
 
+	case parser.TableFunction:
+		view, err = loadTableFunctionView(table.Object.(parser.TableFunction), table.Name().Literal, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+			return nil, err
+		}
+
+	case parser.Pivot, parser.Unpivot:
+		if pivot, ok := table.Object.(parser.Pivot); ok {
+			view, err = loadPivotView(pivot, filter)
+		} else {
+			view, err = loadUnpivotView(table.Object.(parser.Unpivot), filter)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if table.Alias != nil {
+			view.Header.Update(table.Name().Literal, nil)
+
+			if err = filter.Aliases.Add(table.Name(), ""); err != nil {
+				return nil, err
+			}
+		}
+
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
 		view, err = Select(subquery.Query, filter)
@@ -528,6 +665,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		}
 	}
 
+	filter.plan.end(node, view.RecordLen(), start)
 	return view, err
 }
 
@@ -547,6 +685,8 @@ func loadObject(
 	encloseAll bool,
 	jsonEscape txjson.EscapeType,
 	withoutNull bool,
+	sheet string,
+	cellRange string,
 ) (*View, error) {
 	var view *View
 
@@ -573,21 +713,24 @@ func loadObject(
 
 			pathIdent := parser.Identifier{Literal: filePath}
 			if useInternalId {
-				view, _ = filter.TempViews.GetWithInternalId(pathIdent)
+				view, _ = filter.TempViews.GetWithInternalId(pathIdent, filter.flags())
 			} else {
 				view, _ = filter.TempViews.Get(pathIdent)
 			}
 		} else {
-			filePath, err = CreateFilePath(tableIdentifier, cmd.GetFlags().Repository)
+			filePath, err = CreateFilePath(tableIdentifier, filter.flags().Repository)
 			if err != nil {
 				return nil, err
 			}
 
-			if !ViewCache.Exists(filePath) {
-				fileInfo, err := NewFileInfo(tableIdentifier, cmd.GetFlags().Repository, importFormat, delimiter, encoding)
+			if !filter.viewCache().Exists(filePath) || isXlsxTableChanged(filter.viewCache(), filePath, sheet, cellRange) {
+				fileInfo, err := NewFileInfo(tableIdentifier, filter.flags().Repository, importFormat, delimiter, encoding, filter.flags())
 				if err != nil {
 					return nil, err
 				}
+				if filter.uncommittedViews().IsDropped(fileInfo.Path) {
+					return nil, NewFileNotExistError(tableIdentifier)
+				}
 				filePath = fileInfo.Path
 
 				fileInfo.DelimiterPositions = delimiterPositions
@@ -596,13 +739,15 @@ func loadObject(
 				fileInfo.NoHeader = noHeader
 				fileInfo.EncloseAll = encloseAll
 				fileInfo.JsonEscape = jsonEscape
+				fileInfo.Sheet = sheet
+				fileInfo.CellRange = strings.ToUpper(strings.TrimSpace(cellRange))
 
-				if !ViewCache.Exists(fileInfo.Path) || (forUpdate && !ViewCache[strings.ToUpper(fileInfo.Path)].ForUpdate) {
-					ViewCache.Dispose(fileInfo.Path)
+				if !filter.viewCache().Exists(fileInfo.Path) || (forUpdate && !filter.viewCache()[strings.ToUpper(fileInfo.Path)].ForUpdate) || isXlsxTableChanged(filter.viewCache(), fileInfo.Path, sheet, cellRange) {
+					filter.viewCache().Dispose(fileInfo.Path)
 
 					var fp *os.File
 					if forUpdate {
-						h, err := file.NewHandlerForUpdate(fileInfo.Path)
+						h, err := file.NewHandlerForUpdate(filter.fileContainer(), fileInfo.Path, filter.flags().WaitTimeout, filter.flags().RetryInterval)
 						if err != nil {
 							if _, ok := err.(*file.TimeoutError); ok {
 								return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
@@ -612,7 +757,7 @@ func loadObject(
 						fileInfo.Handler = h
 						fp = h.FileForRead()
 					} else {
-						h, err := file.NewHandlerForRead(fileInfo.Path)
+						h, err := file.NewHandlerForRead(fileInfo.Path, filter.flags().WaitTimeout, filter.flags().RetryInterval)
 						if err != nil {
 							if _, ok := err.(*file.TimeoutError); ok {
 								return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
@@ -623,22 +768,29 @@ func loadObject(
 						fp = h.FileForRead()
 					}
 
-					loadView, err := loadViewFromFile(fp, fileInfo, withoutNull)
+					r, err := NewDecompressionReader(fp, fileInfo.Compression)
+					if err != nil {
+						fileInfo.Close()
+						return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+					}
+
+					loadView, err := loadViewFromFile(r, fileInfo, withoutNull, filter.flags())
+					r.Close()
 					if err != nil {
 						fileInfo.Close()
 						return nil, NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
 					}
 					loadView.ForUpdate = forUpdate
-					ViewCache.Set(loadView)
+					filter.viewCache().Set(loadView)
 				}
 			}
 			commonTableName = parser.FormatTableName(filePath)
 
 			pathIdent := parser.Identifier{Literal: filePath}
 			if useInternalId {
-				view, _ = ViewCache.GetWithInternalId(pathIdent)
+				view, _ = filter.viewCache().GetWithInternalId(pathIdent, filter.flags())
 			} else {
-				view, _ = ViewCache.Get(pathIdent)
+				view, _ = filter.viewCache().Get(pathIdent)
 			}
 		}
 
@@ -653,19 +805,72 @@ func loadObject(
 	return view, nil
 }
 
-func loadViewFromFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+func isXlsxTableChanged(viewCache ViewMap, fpath string, sheet string, cellRange string) bool {
+	view, ok := viewCache[strings.ToUpper(fpath)]
+	if !ok || view.FileInfo.Format != cmd.XLSX || view.FileInfo.Handler != nil {
+		return false
+	}
+	if 0 < len(sheet) && !strings.EqualFold(view.FileInfo.Sheet, sheet) {
+		return true
+	}
+	return !strings.EqualFold(view.FileInfo.CellRange, strings.TrimSpace(cellRange))
+}
+
+func loadViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, flags *cmd.Flags) (*View, error) {
+	view, err := readViewFromFile(fp, fileInfo, withoutNull, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	lineOffset := 1
+	switch {
+	case fileInfo.Format == cmd.JSON:
+		lineOffset = -1
+	case fileInfo.Format == cmd.LTSV, fileInfo.NoHeader:
+		lineOffset = 0
+	}
+	if err = applyTableSchema(view, lineOffset, flags); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func readViewFromFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, flags *cmd.Flags) (*View, error) {
 	switch fileInfo.Format {
-	case cmd.FIXED:
-		return loadViewFromFixedLengthTextFile(fp, fileInfo, withoutNull)
-	case cmd.LTSV:
-		return loadViewFromLTSVFile(fp, fileInfo, withoutNull)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo)
+	case cmd.XLSX:
+		return loadViewFromXlsxFile(fp, fileInfo, withoutNull, flags)
+	}
+
+	r, err := newDecodingReader(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fileInfo.Format {
+	case cmd.FIXED:
+		return loadViewFromFixedLengthTextFile(r, fileInfo, withoutNull)
+	case cmd.LTSV:
+		return loadViewFromLTSVFile(r, fileInfo, withoutNull, flags)
 	}
-	return loadViewFromCSVFile(fp, fileInfo, withoutNull)
+	return loadViewFromCSVFile(r, fileInfo, withoutNull)
 }
 
-func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+func newDecodingReader(fp io.Reader, fileInfo *FileInfo) (io.Reader, error) {
+	r, encoding, err := cmd.DetectEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Format == cmd.FIXED && !cmd.IsFixedLengthEncoding(encoding) {
+		return nil, errors.New(fmt.Sprintf("%s encoding is not supported in fixed-length format", encoding))
+	}
+
+	fileInfo.Encoding = encoding
+	return cmd.NewDecodingReader(r, encoding), nil
+}
+
+func loadViewFromFixedLengthTextFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
 	var err error
 
 	data, err := ioutil.ReadAll(fp)
@@ -674,10 +879,11 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	}
 	r := bytes.NewReader(data)
 
+	encoding := cmd.TextEncoding(fileInfo.Encoding)
 	if fileInfo.DelimiterPositions == nil {
-		d := fixedlen.NewDelimiter(r, fileInfo.Encoding)
+		d := fixedlen.NewDelimiter(r, encoding)
 		d.NoHeader = fileInfo.NoHeader
-		d.Encoding = fileInfo.Encoding
+		d.Encoding = encoding
 		fileInfo.DelimiterPositions, err = d.Delimit()
 		if err != nil {
 			return nil, err
@@ -685,9 +891,9 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	}
 
 	r.Seek(0, io.SeekStart)
-	reader := fixedlen.NewReader(r, fileInfo.DelimiterPositions, fileInfo.Encoding)
+	reader := fixedlen.NewReader(r, fileInfo.DelimiterPositions, encoding)
 	reader.WithoutNull = withoutNull
-	reader.Encoding = fileInfo.Encoding
+	reader.Encoding = encoding
 
 	var header []string
 	if !fileInfo.NoHeader {
@@ -720,8 +926,8 @@ func loadViewFromFixedLengthTextFile(fp *os.File, fileInfo *FileInfo, withoutNul
 	return view, nil
 }
 
-func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	reader := csv.NewReader(fp, fileInfo.Encoding)
+func loadViewFromCSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	reader := csv.NewReader(fp, cmd.TextEncoding(fileInfo.Encoding))
 	reader.Delimiter = fileInfo.Delimiter
 	reader.WithoutNull = withoutNull
 
@@ -758,8 +964,61 @@ func loadViewFromCSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*Vi
 	return view, nil
 }
 
-func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*View, error) {
-	reader := ltsv.NewReader(fp, fileInfo.Encoding)
+func loadViewFromXlsxFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, flags *cmd.Flags) (*View, error) {
+	data, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	wb, err := xlsx.Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	sheetNames := wb.SheetNames()
+	if fileInfo.Handler != nil && 1 < len(sheetNames) {
+		return nil, errors.New("workbook with multiple sheets cannot be updated")
+	}
+
+	cellRange, err := xlsx.ParseCellRange(fileInfo.CellRange)
+	if err != nil {
+		return nil, err
+	}
+
+	header, rows, err := wb.LoadTable(fileInfo.Sheet, cellRange, fileInfo.NoHeader, withoutNull, flags.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fileInfo.Sheet) < 1 && 0 < len(sheetNames) {
+		fileInfo.Sheet = sheetNames[0]
+	}
+
+	if header == nil && 0 < len(rows) {
+		header = make([]string, len(rows[0]))
+	}
+	for i := range header {
+		if len(header[i]) < 1 {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	records := make(RecordSet, len(rows))
+	for i, row := range rows {
+		records[i] = NewRecord(row)
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromLTSVFile(fp io.Reader, fileInfo *FileInfo, withoutNull bool, flags *cmd.Flags) (*View, error) {
+	reader := ltsv.NewReader(fp, cmd.TextEncoding(fileInfo.Encoding))
 	reader.WithoutNull = withoutNull
 
 	records, err := readRecordSet(reader)
@@ -768,7 +1027,7 @@ func loadViewFromLTSVFile(fp *os.File, fileInfo *FileInfo, withoutNull bool) (*V
 	}
 
 	header := reader.Header.Fields()
-	NewGoroutineTaskManager(len(records), -1).Run(func(index int) {
+	NewGoroutineTaskManager(len(records), -1, flags.CPU).Run(func(index int) {
 		for j := len(records[index]); j < len(header); j++ {
 			if withoutNull {
 				records[index] = append(records[index], NewCell(value.NewString("")))
@@ -816,15 +1075,7 @@ func readRecordSet(reader RecordReader) (RecordSet, error) {
 			if !ok {
 				break
 			}
-			fields := make([]value.Primary, len(row))
-			for i, v := range row {
-				if v == nil {
-					fields[i] = value.NewNull()
-				} else {
-					fields[i] = value.NewString(string(v))
-				}
-			}
-			fieldch <- fields
+			fieldch <- rawTextsToPrimaries(row)
 		}
 		close(fieldch)
 		wg.Done()
@@ -951,66 +1202,243 @@ func (view *View) group(items []parser.QueryExpression) error {
 		return view.groupAll()
 	}
 
-	keys := make([]string, view.RecordLen())
+	keys, sets, withSets := view.expandGroupingSets(items)
 
-	err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
-		values := make([]value.Primary, len(items))
-		keyBuf := new(bytes.Buffer)
+	keyValues := make([][]value.Primary, view.RecordLen())
 
-		for i, item := range items {
-			p, e := f.Evaluate(item)
+	err := NewFilterForSequentialEvaluation(view, view.Filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		values := make([]value.Primary, len(keys))
+		for i, key := range keys {
+			p, e := f.Evaluate(key)
 			if e != nil {
 				return e
 			}
 			values[i] = p
 		}
-		SerializeComparisonKeys(keyBuf, values)
-		keys[rIdx] = keyBuf.String()
+		keyValues[rIdx] = values
 		return nil
 	}, nil)
 	if err != nil {
 		return err
 	}
 
-	groups := make(map[string][]int)
-	groupKeys := make([]string, 0)
-	for i, key := range keys {
-		if _, ok := groups[key]; ok {
-			groups[key] = append(groups[key], i)
-		} else {
-			groups[key] = []int{i}
-			groupKeys = append(groupKeys, key)
-		}
-	}
+	fieldLen := view.FieldLen()
+	records := make(RecordSet, 0, view.RecordLen())
+	keyBuf := new(bytes.Buffer)
 
-	records := make(RecordSet, len(groupKeys))
-	for i, groupKey := range groupKeys {
-		record := make(Record, view.FieldLen())
-		indices := groups[groupKey]
+	for _, set := range sets {
+		groups := make(map[string][]int)
+		groupKeys := make([]string, 0)
+		setValues := make([]value.Primary, len(set))
 
-		for j := 0; j < view.FieldLen(); j++ {
-			primaries := make([]value.Primary, len(indices))
-			for k, idx := range indices {
-				primaries[k] = view.RecordSet[idx][j].Value()
+		for i, values := range keyValues {
+			for j, k := range set {
+				setValues[j] = values[k]
+			}
+			keyBuf.Reset()
+			SerializeComparisonKeys(keyBuf, setValues, view.Filter.flags())
+			key := keyBuf.String()
+
+			if _, ok := groups[key]; ok {
+				groups[key] = append(groups[key], i)
+			} else {
+				groups[key] = []int{i}
+				groupKeys = append(groupKeys, key)
 			}
-			record[j] = NewGroupCell(primaries)
 		}
 
-		records[i] = record
+		groupingId := value.NewInteger(groupingIdOfSet(set, len(keys)))
+
+		for _, groupKey := range groupKeys {
+			record := make(Record, fieldLen, fieldLen+1)
+			indices := groups[groupKey]
+
+			for j := 0; j < fieldLen; j++ {
+				primaries := make([]value.Primary, len(indices))
+				for k, idx := range indices {
+					primaries[k] = view.RecordSet[idx][j].Value()
+				}
+				record[j] = NewGroupCell(primaries)
+			}
+			if withSets {
+				record = append(record, NewCell(groupingId))
+			}
+
+			records = append(records, record)
+		}
 	}
 
 	view.RecordSet = records
 	view.isGrouped = true
-	for _, item := range items {
-		switch item.(type) {
+	view.groupingKeys = keys
+	for _, key := range keys {
+		switch key.(type) {
 		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.FieldIndex(item)
+			idx, _ := view.FieldIndex(key)
 			view.Header[idx].IsGroupKey = true
 		}
 	}
+
+	if withSets {
+		view.groupingFieldBits = make(map[int]int64)
+		for i, key := range keys {
+			switch key.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				idx, _ := view.FieldIndex(key)
+				view.groupingFieldBits[idx] = groupingBit(i, len(keys))
+			}
+		}
+		view.Header, view.groupingIdIndex = AddHeaderField(view.Header, GroupingIdColumn, "")
+	}
 	return nil
 }
 
+func (view *View) expandGroupingSets(items []parser.QueryExpression) ([]parser.QueryExpression, [][]int, bool) {
+	keys := make([]parser.QueryExpression, 0, len(items))
+	withSets := false
+
+	keyIndices := func(exprs []parser.QueryExpression) []int {
+		indices := make([]int, 0, len(exprs))
+		for _, expr := range exprs {
+			for p, ok := expr.(parser.Parentheses); ok; p, ok = expr.(parser.Parentheses) {
+				expr = p.Expr
+			}
+
+			i := view.indexOfGroupingKey(keys, expr)
+			if i < 0 {
+				keys = append(keys, expr)
+				i = len(keys) - 1
+			}
+			indices = append(indices, i)
+		}
+		return indices
+	}
+
+	sets := [][]int{{}}
+	for _, item := range items {
+		var elementSets [][]int
+
+		switch item.(type) {
+		case parser.Rollup:
+			indices := keyIndices(item.(parser.Rollup).Items)
+			elementSets = make([][]int, 0, len(indices)+1)
+			for i := len(indices); 0 <= i; i-- {
+				elementSets = append(elementSets, indices[:i])
+			}
+			withSets = true
+		case parser.Cube:
+			indices := keyIndices(item.(parser.Cube).Items)
+			elementSets = make([][]int, 0, 1<<uint(len(indices)))
+			for mask := int64(1)<<uint(len(indices)) - 1; 0 <= mask; mask-- {
+				set := make([]int, 0, len(indices))
+				for i, idx := range indices {
+					if mask&groupingBit(i, len(indices)) != 0 {
+						set = append(set, idx)
+					}
+				}
+				elementSets = append(elementSets, set)
+			}
+			withSets = true
+		case parser.GroupingSets:
+			for _, set := range item.(parser.GroupingSets).Sets {
+				if list, ok := set.(parser.ValueList); ok {
+					elementSets = append(elementSets, keyIndices(list.Values))
+				} else {
+					elementSets = append(elementSets, keyIndices([]parser.QueryExpression{set}))
+				}
+			}
+			withSets = true
+		default:
+			elementSets = [][]int{keyIndices([]parser.QueryExpression{item})}
+		}
+
+		product := make([][]int, 0, len(sets)*len(elementSets))
+		for _, set := range sets {
+			for _, elementSet := range elementSets {
+				s := make([]int, 0, len(set)+len(elementSet))
+				s = append(s, set...)
+				product = append(product, append(s, elementSet...))
+			}
+		}
+		sets = product
+	}
+
+	return keys, sets, withSets
+}
+
+func (view *View) indexOfGroupingKey(keys []parser.QueryExpression, expr parser.QueryExpression) int {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		idx, err := view.FieldIndex(expr)
+		if err != nil {
+			return -1
+		}
+		for i, key := range keys {
+			switch key.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				if keyIdx, err := view.FieldIndex(key); err == nil && keyIdx == idx {
+					return i
+				}
+			}
+		}
+	default:
+		s := expr.String()
+		for i, key := range keys {
+			if key.String() == s {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func groupingBit(keyIdx int, keyLen int) int64 {
+	return int64(1) << uint(keyLen-1-keyIdx)
+}
+
+func groupingIdOfSet(set []int, keyLen int) int64 {
+	id := int64(1)<<uint(keyLen) - 1
+	for _, k := range set {
+		id &^= groupingBit(k, keyLen)
+	}
+	return id
+}
+
+func (view *View) groupingId(recordIdx int) int64 {
+	if view.groupingFieldBits == nil {
+		return 0
+	}
+	return view.RecordSet[recordIdx][view.groupingIdIndex].Value().(value.Integer).Raw()
+}
+
+func (view *View) isRolledUpField(recordIdx int, fieldIdx int) bool {
+	bit, ok := view.groupingFieldBits[fieldIdx]
+	return ok && view.groupingId(recordIdx)&bit != 0
+}
+
+func (view *View) fieldValue(recordIdx int, fieldIdx int) value.Primary {
+	if view.isRolledUpField(recordIdx, fieldIdx) {
+		return value.NewNull()
+	}
+	return view.RecordSet[recordIdx][fieldIdx].Value()
+}
+
+func (view *View) evalGroupingKeyColumn(fieldIdx int) int {
+	column := GroupingKeyColumnPrefix + strconv.Itoa(fieldIdx)
+	for i, f := range view.Header {
+		if !f.IsFromTable && f.Column == column {
+			return i
+		}
+	}
+
+	for i := range view.RecordSet {
+		view.RecordSet[i] = append(view.RecordSet[i], NewCell(view.fieldValue(i, fieldIdx)))
+	}
+	header, idx := AddHeaderField(view.Header, column, "")
+	view.Header = header
+	return idx
+}
+
 func (view *View) groupAll() error {
 	if 0 < view.RecordLen() {
 		records := make(RecordSet, 1)
@@ -1046,6 +1474,35 @@ func (view *View) Having(clause parser.HavingClause) error {
 	return nil
 }
 
+func (view *View) Window(clause parser.WindowClause) error {
+	for i, v := range clause.Windows {
+		name := v.(parser.WindowDefinition).Name
+		for _, w := range clause.Windows[:i] {
+			if strings.EqualFold(w.(parser.WindowDefinition).Name.Literal, name.Literal) {
+				return NewWindowRedeclaredError(name)
+			}
+		}
+	}
+
+	view.windows = clause.Windows
+	return nil
+}
+
+func (view *View) resolveWindow(fn parser.AnalyticFunction) (parser.AnalyticFunction, error) {
+	name := fn.AnalyticClause.WindowName
+	if len(name.Literal) < 1 {
+		return fn, nil
+	}
+
+	for _, v := range view.windows {
+		if w := v.(parser.WindowDefinition); strings.EqualFold(w.Name.Literal, name.Literal) {
+			fn.AnalyticClause = w.Clause
+			return fn, nil
+		}
+	}
+	return fn, NewWindowNotExistError(name)
+}
+
 func (view *View) Select(clause parser.SelectClause) error {
 	var parseAllColumns = func(view *View, fields []parser.QueryExpression) []parser.QueryExpression {
 		insertIdx := -1
@@ -1139,7 +1596,7 @@ func (view *View) Select(clause parser.SelectClause) error {
 	}
 
 	if clause.IsDistinct() {
-		view.GenerateComparisonKeys()
+		view.GenerateComparisonKeys(view.Filter.flags())
 		records := make(RecordSet, 0, view.RecordLen())
 		values := make(map[string]bool)
 		for i, v := range view.RecordSet {
@@ -1169,19 +1626,19 @@ func (view *View) Select(clause parser.SelectClause) error {
 	return nil
 }
 
-func (view *View) GenerateComparisonKeys() {
+func (view *View) GenerateComparisonKeys(flags *cmd.Flags) {
 	view.comparisonKeysInEachRecord = make([]string, view.RecordLen())
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(func(index int) {
 		buf := new(bytes.Buffer)
 		if view.selectFields != nil {
 			primaries := make([]value.Primary, len(view.selectFields))
 			for j, idx := range view.selectFields {
 				primaries[j] = view.RecordSet[index][idx].Value()
 			}
-			SerializeComparisonKeys(buf, primaries)
+			SerializeComparisonKeys(buf, primaries, flags)
 		} else {
-			view.RecordSet[index].SerializeComparisonKeys(buf)
+			view.RecordSet[index].SerializeComparisonKeys(buf, flags)
 		}
 		view.comparisonKeysInEachRecord[index] = buf.String()
 	})
@@ -1239,7 +1696,8 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 		}
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	flags := view.Filter.flags()
+	NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(func(index int) {
 		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
 			view.sortValuesInEachCell[index] = make([]*SortValue, cap(view.RecordSet[index]))
 		}
@@ -1249,7 +1707,7 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 			if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) && view.sortValuesInEachCell[index][idx] != nil {
 				sortValues[j] = view.sortValuesInEachCell[index][idx]
 			} else {
-				sortValues[j] = NewSortValue(view.RecordSet[index][idx].Value())
+				sortValues[j] = NewSortValue(view.RecordSet[index][idx].Value(), flags)
 				if view.sortValuesInEachCell != nil && idx < len(view.sortValuesInEachCell[index]) {
 					view.sortValuesInEachCell[index][idx] = sortValues[j]
 				}
@@ -1283,7 +1741,10 @@ func (view *View) additionalColumns(expr parser.QueryExpression) ([]string, erro
 			return nil, NewNotGroupingRecordsError(expr, expr.(parser.ListFunction).Name)
 		}
 	case parser.AnalyticFunction:
-		fn := expr.(parser.AnalyticFunction)
+		fn, err := view.resolveWindow(expr.(parser.AnalyticFunction))
+		if err != nil {
+			return nil, err
+		}
 		pvalues := fn.AnalyticClause.PartitionValues()
 		ovalues := []parser.QueryExpression(nil)
 		if fn.AnalyticClause.OrderByClause != nil {
@@ -1350,7 +1811,7 @@ func (view *View) ExtendRecordCapacity(exprs []parser.QueryExpression) error {
 		return nil
 	}
 
-	NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+	NewGoroutineTaskManager(view.RecordLen(), -1, view.Filter.flags().CPU).Run(func(index int) {
 		record := make(Record, currentLen, fieldCap)
 		copy(record, view.RecordSet[index])
 		view.RecordSet[index] = record
@@ -1368,6 +1829,9 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 			err = NewFieldNotGroupKeyError(obj)
 			return
 		}
+		if _, ok := view.groupingFieldBits[idx]; ok {
+			idx = view.evalGroupingKeyColumn(idx)
+		}
 	default:
 		idx, err = view.Header.ContainsObject(obj)
 		if err != nil {
@@ -1406,6 +1870,11 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 }
 
 func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
+	expr, err := view.resolveWindow(expr)
+	if err != nil {
+		return err
+	}
+
 	name := strings.ToUpper(expr.Name)
 	if _, ok := AggregateFunctions[name]; !ok {
 		if _, ok := AnalyticFunctions[name]; !ok {
@@ -1415,6 +1884,8 @@ func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 		}
 	}
 
+	start := time.Now()
+
 	var partitionIndices []int
 	if expr.AnalyticClause.PartitionClause != nil {
 		partitionExprs := expr.AnalyticClause.PartitionValues()
@@ -1440,7 +1911,8 @@ func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 		}
 	}
 
-	err := Analyze(view, expr, partitionIndices)
+	err = Analyze(view, expr, partitionIndices)
+	view.Filter.plan.addAnalyticTime(time.Since(start))
 
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
@@ -1528,6 +2000,7 @@ func (view *View) Limit(clause parser.LimitClause) error {
 
 func (view *View) InsertValues(fields []parser.QueryExpression, list []parser.QueryExpression) (int, error) {
 	valuesList := make([][]value.Primary, len(list))
+	exprsList := make([][]parser.QueryExpression, len(list))
 
 	for i, item := range list {
 		rv := item.(parser.RowValue)
@@ -1540,9 +2013,10 @@ func (view *View) InsertValues(fields []parser.QueryExpression, list []parser.Qu
 		}
 
 		valuesList[i] = values
+		exprsList[i] = rowValueExprs(rv, len(values))
 	}
 
-	return view.insert(fields, valuesList)
+	return view.insert(fields, valuesList, exprsList)
 }
 
 func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.SelectQuery) (int, error) {
@@ -1555,6 +2029,8 @@ func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.
 	}
 
 	valuesList := make([][]value.Primary, insertView.RecordLen())
+	exprsList := make([][]parser.QueryExpression, insertView.RecordLen())
+	exprs := selectFieldExprs(query, insertView.FieldLen())
 
 	for i, record := range insertView.RecordSet {
 		values := make([]value.Primary, insertView.FieldLen())
@@ -1562,12 +2038,13 @@ func (view *View) InsertFromQuery(fields []parser.QueryExpression, query parser.
 			values[j] = cell.Value()
 		}
 		valuesList[i] = values
+		exprsList[i] = exprs
 	}
 
-	return view.insert(fields, valuesList)
+	return view.insert(fields, valuesList, exprsList)
 }
 
-func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.Primary) (int, error) {
+func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.Primary, exprsList [][]parser.QueryExpression) (int, error) {
 	var valueIndex = func(i int, list []int) int {
 		for j, v := range list {
 			if i == v {
@@ -1592,7 +2069,11 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 			if idx < 0 {
 				record[j] = NewCell(value.NewNull())
 			} else {
-				record[j] = NewCell(values[idx])
+				val, err := view.convertFieldValue(exprsList[i][idx], j, values[idx], view.Filter.flags())
+				if err != nil {
+					return insertRecords, err
+				}
+				record[j] = NewCell(val)
 			}
 		}
 		records[i] = record
@@ -1602,7 +2083,65 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 	return len(valuesList), nil
 }
 
-func (view *View) Fix() {
+// convertFieldValue converts a value to be stored in the field of a table that has declared column types.
+// The expr is the expression from which the value is derived, and is used to report the position of an error.
+func (view *View) convertFieldValue(expr parser.QueryExpression, fieldIndex int, val value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if view.FileInfo == nil || view.FileInfo.Schema == nil || view.FileInfo.Schema.Inferred {
+		return val, nil
+	}
+
+	columnType := view.FileInfo.Schema.ColumnType(view.Header[fieldIndex].Column)
+	if len(columnType) < 1 {
+		return val, nil
+	}
+
+	converted, ok := ConvertColumnValue(columnType, val, flags)
+	if !ok {
+		return nil, NewFieldValueTypeError(expr, val, columnType, view.Header[fieldIndex].Column)
+	}
+	return converted, nil
+}
+
+// rowValueExprs returns the expressions from which each value of the row value is derived.
+func rowValueExprs(rv parser.RowValue, valueLen int) []parser.QueryExpression {
+	list, isList := rv.Value.(parser.ValueList)
+
+	exprs := make([]parser.QueryExpression, valueLen)
+	for i := range exprs {
+		if isList && len(list.Values) == valueLen {
+			exprs[i] = positionedExpr(list.Values[i], rv)
+		} else {
+			exprs[i] = rv
+		}
+	}
+	return exprs
+}
+
+// positionedExpr returns expr if it has its position in the source, otherwise alt.
+func positionedExpr(expr parser.QueryExpression, alt parser.QueryExpression) parser.QueryExpression {
+	if expr.HasParseInfo() {
+		return expr
+	}
+	return alt
+}
+
+// selectFieldExprs returns the expressions from which each field of the result of the query is derived.
+func selectFieldExprs(query parser.SelectQuery, fieldLen int) []parser.QueryExpression {
+	selectClause := searchSelectClause(query)
+
+	exprs := make([]parser.QueryExpression, fieldLen)
+	for i := range exprs {
+		exprs[i] = selectClause
+		if len(selectClause.Fields) == fieldLen {
+			if field, ok := selectClause.Fields[i].(parser.Field); ok {
+				exprs[i] = positionedExpr(field.Object, selectClause)
+			}
+		}
+	}
+	return exprs
+}
+
+func (view *View) Fix(flags *cmd.Flags) {
 	resize := false
 	if len(view.selectFields) < view.FieldLen() {
 		resize = true
@@ -1616,7 +2155,7 @@ func (view *View) Fix() {
 	}
 
 	if resize {
-		NewGoroutineTaskManager(view.RecordLen(), -1).Run(func(index int) {
+		NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(func(index int) {
 			record := make(Record, len(view.selectFields))
 			for j, idx := range view.selectFields {
 				if 1 < view.RecordSet[index].GroupLen() {
@@ -1652,6 +2191,10 @@ func (view *View) Fix() {
 	view.selectFields = nil
 	view.selectLabels = nil
 	view.isGrouped = false
+	view.groupingKeys = nil
+	view.groupingFieldBits = nil
+	view.groupingIdIndex = 0
+	view.windows = nil
 	view.comparisonKeysInEachRecord = nil
 	view.sortValuesInEachCell = nil
 	view.sortValuesInEachRecord = nil
@@ -1660,12 +2203,12 @@ func (view *View) Fix() {
 	view.offset = 0
 }
 
-func (view *View) Union(calcView *View, all bool) {
+func (view *View) Union(calcView *View, all bool, flags *cmd.Flags) {
 	view.RecordSet = append(view.RecordSet, calcView.RecordSet...)
 	view.FileInfo = nil
 
 	if !all {
-		view.GenerateComparisonKeys()
+		view.GenerateComparisonKeys(flags)
 
 		records := make(RecordSet, 0, view.RecordLen())
 		values := make(map[string]bool)
@@ -1682,9 +2225,9 @@ func (view *View) Union(calcView *View, all bool) {
 	}
 }
 
-func (view *View) Except(calcView *View, all bool) {
-	view.GenerateComparisonKeys()
-	calcView.GenerateComparisonKeys()
+func (view *View) Except(calcView *View, all bool, flags *cmd.Flags) {
+	view.GenerateComparisonKeys(flags)
+	calcView.GenerateComparisonKeys(flags)
 
 	keys := make(map[string]bool)
 	for _, key := range calcView.comparisonKeysInEachRecord {
@@ -1711,9 +2254,9 @@ func (view *View) Except(calcView *View, all bool) {
 	view.comparisonKeysInEachRecord = nil
 }
 
-func (view *View) Intersect(calcView *View, all bool) {
-	view.GenerateComparisonKeys()
-	calcView.GenerateComparisonKeys()
+func (view *View) Intersect(calcView *View, all bool, flags *cmd.Flags) {
+	view.GenerateComparisonKeys(flags)
+	calcView.GenerateComparisonKeys(flags)
 
 	keys := make(map[string]bool)
 	for _, key := range calcView.comparisonKeysInEachRecord {
@@ -1742,8 +2285,27 @@ func (view *View) Intersect(calcView *View, all bool) {
 
 func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, arg parser.QueryExpression, distinct bool, filter *Filter) ([]value.Primary, error) {
 	list := make([]value.Primary, view.RecordLen())
+	condition := aggregateFilterCondition(expr)
+
+	exprs := []parser.QueryExpression{arg}
+	if condition != nil {
+		exprs = append(exprs, condition)
+	}
 
 	err := NewFilterForSequentialEvaluation(view, filter).EvaluateSequentially(func(f *Filter, rIdx int) error {
+		if condition != nil {
+			p, e := f.Evaluate(condition)
+			if e != nil {
+				if _, ok := e.(*NotGroupingRecordsError); ok {
+					e = NewNestedAggregateFunctionsError(expr)
+				}
+				return e
+			}
+			if p.Ternary() != ternary.TRUE {
+				return nil
+			}
+		}
+
 		p, e := f.Evaluate(arg)
 		if e != nil {
 			if _, ok := e.(*NotGroupingRecordsError); ok {
@@ -1753,18 +2315,44 @@ func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, a
 		}
 		list[rIdx] = p
 		return nil
-	}, arg)
+	}, exprs)
 	if err != nil {
 		return nil, err
 	}
 
+	if condition != nil {
+		filtered := list[:0]
+		for _, p := range list {
+			if p != nil {
+				filtered = append(filtered, p)
+			}
+		}
+		list = filtered
+	}
+
 	if distinct {
-		list = Distinguish(list)
+		list = Distinguish(list, filter.flags())
 	}
 
 	return list, nil
 }
 
+func aggregateFilterCondition(expr parser.QueryExpression) parser.QueryExpression {
+	var clause parser.QueryExpression
+	switch fn := expr.(type) {
+	case parser.AggregateFunction:
+		clause = fn.Filter
+	case parser.ListFunction:
+		clause = fn.Filter
+	case parser.AnalyticFunction:
+		clause = fn.Filter
+	}
+	if clause == nil {
+		return nil
+	}
+	return clause.(parser.FilterClause).Where.(parser.WhereClause).Filter
+}
+
 func (view *View) RestoreHeaderReferences() {
 	view.Header.Update(parser.FormatTableName(view.FileInfo.Path), nil)
 }
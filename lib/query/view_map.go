@@ -32,9 +32,9 @@ func (list TemporaryViewScopes) Get(name parser.Identifier) (*View, error) {
 	return nil, NewTableNotLoadedError(name)
 }
 
-func (list TemporaryViewScopes) GetWithInternalId(name parser.Identifier) (*View, error) {
+func (list TemporaryViewScopes) GetWithInternalId(name parser.Identifier, flags *cmd.Flags) (*View, error) {
 	for _, m := range list {
-		if view, err := m.GetWithInternalId(name); err == nil {
+		if view, err := m.GetWithInternalId(name, flags); err == nil {
 			return view, nil
 		}
 	}
@@ -62,25 +62,25 @@ func (list TemporaryViewScopes) Dispose(name parser.Identifier) error {
 	return NewUndeclaredTemporaryTableError(name)
 }
 
-func (list TemporaryViewScopes) Store(uncomittedViews map[string]*FileInfo) {
+func (list TemporaryViewScopes) Store(uncomittedViews map[string]*FileInfo, filter *Filter) {
 	for _, m := range list {
 		for _, view := range m {
 			if _, ok := uncomittedViews[view.FileInfo.Path]; ok {
 				view.FileInfo.InitialRecordSet = view.RecordSet.Copy()
 				view.FileInfo.InitialHeader = view.Header.Copy()
-				LogNotice(fmt.Sprintf("Commit: restore point of view %q is created.", view.FileInfo.Path), cmd.GetFlags().Quiet)
+				filter.logNotice(fmt.Sprintf("Commit: restore point of view %q is created.", view.FileInfo.Path), filter.flags().Quiet)
 			}
 		}
 	}
 }
 
-func (list TemporaryViewScopes) Restore(uncomittedViews map[string]*FileInfo) {
+func (list TemporaryViewScopes) Restore(uncomittedViews map[string]*FileInfo, filter *Filter) {
 	for _, m := range list {
 		for _, view := range m {
 			if _, ok := uncomittedViews[view.FileInfo.Path]; ok {
 				view.RecordSet = view.FileInfo.InitialRecordSet.Copy()
 				view.Header = view.FileInfo.InitialHeader.Copy()
-				LogNotice(fmt.Sprintf("Rollback: view %q is restored.", view.FileInfo.Path), cmd.GetFlags().Quiet)
+				filter.logNotice(fmt.Sprintf("Rollback: view %q is restored.", view.FileInfo.Path), filter.flags().Quiet)
 			}
 		}
 	}
@@ -120,14 +120,14 @@ func (m ViewMap) Get(fpath parser.Identifier) (*View, error) {
 	return nil, NewTableNotLoadedError(fpath)
 }
 
-func (m ViewMap) GetWithInternalId(fpath parser.Identifier) (*View, error) {
+func (m ViewMap) GetWithInternalId(fpath parser.Identifier, flags *cmd.Flags) (*View, error) {
 	ufpath := strings.ToUpper(fpath.Literal)
 	if view, ok := m[ufpath]; ok {
 		ret := view.Copy()
 
 		ret.Header = MergeHeader(NewHeaderWithId(ret.Header[0].View, []string{}), ret.Header)
 
-		NewGoroutineTaskManager(ret.RecordLen(), -1).Run(func(index int) {
+		NewGoroutineTaskManager(ret.RecordLen(), -1, flags.CPU).Run(func(index int) {
 			ret.RecordSet[index] = append(Record{NewCell(value.NewInteger(int64(index)))}, ret.RecordSet[index]...)
 		})
 
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -232,7 +233,7 @@ func TestTemporaryViewScopes_GetWithInternalId(t *testing.T) {
 	}
 
 	for _, v := range temporaryViewScopesGetWithInternalIdTests {
-		view, err := list.GetWithInternalId(v.Path)
+		view, err := list.GetWithInternalId(v.Path, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -675,7 +676,7 @@ func TestTemporaryViewScopes_Store(t *testing.T) {
 	r, w, _ := os.Pipe()
 	Stdout = w
 
-	list.Store(UncommittedViews)
+	list.Store(UncommittedViews, NewEmptyFilter())
 
 	w.Close()
 	Stdout = oldStdout
@@ -801,7 +802,7 @@ func TestTemporaryViewScopes_Restore(t *testing.T) {
 	r, w, _ := os.Pipe()
 	Stdout = w
 
-	list.Restore(UncommittedViews)
+	list.Restore(UncommittedViews, NewEmptyFilter())
 
 	w.Close()
 	Stdout = oldStdout
@@ -1036,7 +1037,7 @@ func TestViewMap_GetWithInternalId(t *testing.T) {
 	}
 
 	for _, v := range viewMapGetWithInternalIdTests {
-		view, err := viewMap.GetWithInternalId(v.Path)
+		view, err := viewMap.GetWithInternalId(v.Path, cmd.GetFlags())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1391,6 +1392,6 @@ func generateViewMapGetWithInternalIdBenchViewMap() ViewMap {
 
 func BenchmarkViewMap_GetWithInternalId(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		viewMapGetWithInternalIdBench.GetWithInternalId(parser.Identifier{Literal: "BENCH_VIEW"})
+		viewMapGetWithInternalIdBench.GetWithInternalId(parser.Identifier{Literal: "BENCH_VIEW"}, cmd.GetFlags())
 	}
 }
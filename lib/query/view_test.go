@@ -117,6 +117,47 @@ var viewLoadTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Load Compressed File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table8"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table8", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "table8.csv.gz",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: cmd.GZIP,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE8": strings.ToUpper(GetTestFilePath("table8.csv.gz")),
+					},
+				},
+			},
+		},
+	},
 	{
 		Name: "Load with Parentheses",
 		From: parser.FromClause{
@@ -840,7 +881,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "[L:- C:-] invalid argument for csv: encoding must be one of UTF8|SJIS",
+		Error: "[L:- C:-] invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5",
 	},
 	{
 		Name: "Load TableObject From Fixed-Length File",
@@ -1262,6 +1303,164 @@ var viewLoadTests = []struct {
 		},
 		Error: "[L:- C:-] table object ltsv takes exactly 3 arguments",
 	},
+	{
+		Name: "Load TableObject From XLSX File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("data"),
+							parser.NewStringValue("B3:D"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "name", "date"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewDatetime(time.Date(2020, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewBoolean(false),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table7.xlsx")),
+				}},
+			},
+		},
+	},
+	{
+		Name: "Load TableObject From XLSX File Without Header",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "Data"}},
+							parser.NewStringValue("b4:c5"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("false"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				NoHeader:  true,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{{
+					"T": strings.ToUpper(GetTestFilePath("table7.xlsx")),
+				}},
+			},
+		},
+	},
+	{
+		Name: "Load TableObject From XLSX File Sheet Not Exist Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("notexist"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] data parse error in file %s: sheet \"notexist\" does not exist", GetTestFilePath("table7.xlsx")),
+	},
+	{
+		Name: "Load TableObject From XLSX File Invalid Cell Range Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("Data"),
+							parser.NewStringValue("D3:B4"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] invalid argument for xlsx: cell range \"D3:B4\" is invalid",
+	},
+	{
+		Name: "Load TableObject From XLSX File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Identifier{Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("Data"),
+							parser.NewStringValue("A1"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("extra"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] table object xlsx takes at most 5 arguments",
+	},
 	{
 		Name: "Load TableObject Invalid Object Type",
 		From: parser.FromClause{
@@ -1487,6 +1686,48 @@ var viewLoadTests = []struct {
 			},
 		},
 	},
+	{
+		Name:     "Load File with Encoding Detection",
+		Encoding: cmd.AUTO,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_utf16"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_utf16", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("日本語"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_utf16.csv",
+				Delimiter: ',',
+				Format:    cmd.CSV,
+				Encoding:  cmd.UTF16LEM,
+				LineBreak: text.CRLF,
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_UTF16": strings.ToUpper(GetTestFilePath("table_utf16.csv")),
+					},
+				},
+			},
+		},
+	},
 	{
 		Name:     "Load No Header File",
 		NoHeader: true,
@@ -2394,6 +2635,9 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.Format != v.Result.FileInfo.Format {
 				t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, view.FileInfo.Format, v.Result.FileInfo.Format)
 			}
+			if view.FileInfo.Compression != v.Result.FileInfo.Compression {
+				t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, view.FileInfo.Compression, v.Result.FileInfo.Compression)
+			}
 			if view.FileInfo.Delimiter != v.Result.FileInfo.Delimiter {
 				t.Errorf("%s: FileInfo.Delimiter = %q, want %q", v.Name, view.FileInfo.Delimiter, v.Result.FileInfo.Delimiter)
 			}
@@ -2700,6 +2944,9 @@ var viewGroupByTests = []struct {
 			},
 			Filter:    NewEmptyFilter(),
 			isGrouped: true,
+			groupingKeys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			},
 		},
 	},
 	{
@@ -2777,6 +3024,9 @@ var viewGroupByTests = []struct {
 			},
 			Filter:    NewEmptyFilter(),
 			isGrouped: true,
+			groupingKeys: []parser.QueryExpression{
+				parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(3)},
+			},
 		},
 	},
 	{
@@ -2814,6 +3064,69 @@ var viewGroupByTests = []struct {
 		},
 		Error: "[L:- C:-] field table1.0 does not exist",
 	},
+	{
+		Name: "Group By Rollup",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("b")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("a")}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.Rollup{Items: []parser.QueryExpression{
+					parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					Column: GroupingIdColumn,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("a")}),
+					NewCell(value.NewInteger(0)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("b")}),
+					NewCell(value.NewInteger(0)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("2"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("b"), value.NewString("a")}),
+					NewCell(value.NewInteger(1)),
+				},
+			},
+			Filter:    NewEmptyFilter(),
+			isGrouped: true,
+			groupingKeys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			groupingFieldBits: map[int]int64{1: 1},
+			groupingIdIndex:   2,
+		},
+	},
 	{
 		Name: "Group By Empty Record",
 		View: &View{
@@ -2855,6 +3168,9 @@ var viewGroupByTests = []struct {
 			RecordSet: []Record{},
 			Filter:    NewEmptyFilter(),
 			isGrouped: true,
+			groupingKeys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			},
 		},
 	},
 	{
@@ -4063,11 +4379,11 @@ var viewOrderByTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{nil, nil, NewSortValue(value.NewString("3")), nil},
-				{nil, nil, NewSortValue(value.NewString("4")), nil},
-				{nil, nil, NewSortValue(value.NewString("4")), nil},
-				{nil, nil, NewSortValue(value.NewString("3")), nil},
-				{nil, nil, NewSortValue(value.NewString("2")), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("2"), cmd.GetFlags()), nil},
 			},
 		},
 		OrderBy: parser.OrderByClause{
@@ -4108,11 +4424,11 @@ var viewOrderByTests = []struct {
 			},
 			Filter: NewEmptyFilter(),
 			sortValuesInEachCell: [][]*SortValue{
-				{nil, nil, NewSortValue(value.NewString("2")), nil},
-				{nil, nil, NewSortValue(value.NewString("3")), nil},
-				{nil, nil, NewSortValue(value.NewString("3")), nil},
-				{nil, nil, NewSortValue(value.NewString("4")), nil},
-				{nil, nil, NewSortValue(value.NewString("4")), nil},
+				{nil, nil, NewSortValue(value.NewString("2"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), cmd.GetFlags()), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), cmd.GetFlags()), nil},
 			},
 		},
 	},
@@ -5395,6 +5711,76 @@ func TestView_InsertValues(t *testing.T) {
 	}
 }
 
+func TestView_InsertValuesWithColumnTypes(t *testing.T) {
+	view := &View{
+		Header:    NewHeaderWithId("table1", []string{"column1", "column2"}),
+		RecordSet: []Record{},
+		FileInfo: &FileInfo{
+			Schema: &TableSchema{
+				Columns: []ColumnSchema{{Name: "column1", Type: "INTEGER"}},
+			},
+		},
+		Filter: NewEmptyFilter(),
+	}
+	fields := []parser.QueryExpression{
+		parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+	}
+
+	_, err := view.InsertValues(fields, []parser.QueryExpression{
+		parser.RowValue{
+			Value: parser.ValueList{
+				Values: []parser.QueryExpression{
+					parser.NewStringValue("2"),
+					parser.NewStringValue("2"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := Record{NewCell(value.NewNull()), NewCell(value.NewInteger(2)), NewCell(value.NewString("2"))}
+	if !reflect.DeepEqual(view.RecordSet[0], expect) {
+		t.Errorf("record = %v, want %v", view.RecordSet[0], expect)
+	}
+
+	_, err = view.InsertValues(fields, []parser.QueryExpression{
+		parser.RowValue{
+			Value: parser.ValueList{
+				Values: []parser.QueryExpression{
+					parser.NewStringValue("abc"),
+					parser.NewStringValue("3"),
+				},
+			},
+		},
+	})
+	expectErr := "[L:- C:-] value \"abc\" cannot be converted to INTEGER for field column1"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+
+	_, err = view.InsertValues(fields, []parser.QueryExpression{
+		parser.RowValue{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 20}),
+			Value: parser.ValueList{
+				Values: []parser.QueryExpression{
+					parser.NewStringValue("abc"),
+					parser.NewStringValue("3"),
+				},
+			},
+		},
+	})
+	expectErr = "[L:1 C:20] value \"abc\" cannot be converted to INTEGER for field column1"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
 var viewInsertFromQueryTests = []struct {
 	Name        string
 	Fields      []parser.QueryExpression
@@ -5546,7 +5932,7 @@ func TestView_Fix(t *testing.T) {
 		selectFields: []int(nil),
 	}
 
-	view.Fix()
+	view.Fix(cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("fix: view = %v, want %v", view, expect)
 	}
@@ -5616,7 +6002,7 @@ func TestView_Union(t *testing.T) {
 		},
 	}
 
-	view.Union(calcView, false)
+	view.Union(calcView, false, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("union: view = %v, want %v", view, expect)
 	}
@@ -5679,7 +6065,7 @@ func TestView_Union(t *testing.T) {
 		},
 	}
 
-	view.Union(calcView, true)
+	view.Union(calcView, true, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("union all: view = %v, want %v", view, expect)
 	}
@@ -5741,7 +6127,7 @@ func TestView_Except(t *testing.T) {
 		},
 	}
 
-	view.Except(calcView, false)
+	view.Except(calcView, false, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("except: view = %v, want %v", view, expect)
 	}
@@ -5788,7 +6174,7 @@ func TestView_Except(t *testing.T) {
 		},
 	}
 
-	view.Except(calcView, true)
+	view.Except(calcView, true, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("except all: view = %v, want %v", view, expect)
 	}
@@ -5850,7 +6236,7 @@ func TestView_Intersect(t *testing.T) {
 		},
 	}
 
-	view.Intersect(calcView, false)
+	view.Intersect(calcView, false, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("intersect: view = %v, want %v", view, expect)
 	}
@@ -5897,7 +6283,7 @@ func TestView_Intersect(t *testing.T) {
 		},
 	}
 
-	view.Intersect(calcView, true)
+	view.Intersect(calcView, true, cmd.GetFlags())
 	if !reflect.DeepEqual(view, expect) {
 		t.Errorf("intersect all: view = %v, want %v", view, expect)
 	}
@@ -0,0 +1,282 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/csvq"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const DriverName = "csvq"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+type Driver struct{}
+
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	options, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := csvq.Open(options)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{db: db}, nil
+}
+
+func ParseDSN(dsn string) (csvq.Options, error) {
+	options := csvq.Options{}
+
+	repository := dsn
+	rawQuery := ""
+	if i := strings.IndexByte(dsn, '?'); -1 < i {
+		repository = dsn[:i]
+		rawQuery = dsn[i+1:]
+	}
+	options.Repository = repository
+
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return options, fmt.Errorf("invalid dsn %q: %s", dsn, err)
+	}
+
+	for key, values := range params {
+		v := values[len(values)-1]
+
+		switch strings.ToLower(key) {
+		case "timezone":
+			options.Timezone = v
+		case "datetime_format":
+			options.DatetimeFormat = values
+		case "wait_timeout":
+			options.WaitTimeout, err = strconv.ParseFloat(v, 64)
+		case "delimiter":
+			options.Delimiter = v
+		case "json_query":
+			options.JsonQuery = v
+		case "encoding":
+			options.Encoding = v
+		case "no_header":
+			options.NoHeader, err = strconv.ParseBool(v)
+		case "without_null":
+			options.WithoutNull, err = strconv.ParseBool(v)
+		case "cpu":
+			options.CPU, err = strconv.Atoi(v)
+		default:
+			return options, fmt.Errorf("invalid dsn %q: parameter %s is not supported", dsn, key)
+		}
+
+		if err != nil {
+			return options, fmt.Errorf("invalid dsn %q: parameter %s has an invalid value %q", dsn, key, v)
+		}
+	}
+	return options, nil
+}
+
+type Conn struct {
+	db            *csvq.DB
+	inTransaction bool
+}
+
+func (c *Conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &Stmt{conn: c, stmt: stmt}, nil
+}
+
+func (c *Conn) Close() error {
+	return c.db.Close()
+}
+
+func (c *Conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.inTransaction {
+		return nil, errors.New("transaction has already been started")
+	}
+	if opts.ReadOnly {
+		return nil, errors.New("read-only transactions are not supported")
+	}
+	c.inTransaction = true
+	return &Tx{conn: c}, nil
+}
+
+func (c *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	stmt, err := c.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.(*Stmt).ExecContext(ctx, args)
+}
+
+func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	stmt, err := c.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.(*Stmt).QueryContext(ctx, args)
+}
+
+func (c *Conn) autoCommit(ctx context.Context, err error) error {
+	if c.inTransaction {
+		return err
+	}
+
+	if err != nil {
+		c.db.Rollback(ctx)
+		return err
+	}
+	return c.db.Commit(ctx)
+}
+
+type Tx struct {
+	conn *Conn
+}
+
+func (tx *Tx) Commit() error {
+	tx.conn.inTransaction = false
+	return tx.conn.db.Commit(context.Background())
+}
+
+func (tx *Tx) Rollback() error {
+	tx.conn.inTransaction = false
+	return tx.conn.db.Rollback(context.Background())
+}
+
+type Stmt struct {
+	conn *Conn
+	stmt *csvq.Stmt
+}
+
+func (s *Stmt) Close() error {
+	return nil
+}
+
+func (s *Stmt) NumInput() int {
+	return -1
+}
+
+func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	result, err := s.stmt.Exec(ctx, bindValues(args)...)
+	if err = s.conn.autoCommit(ctx, err); err != nil {
+		return nil, err
+	}
+	return Result{affectedRows: int64(result.AffectedRows)}, nil
+}
+
+func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.stmt.Query(ctx, bindValues(args)...)
+	if err = s.conn.autoCommit(ctx, err); err != nil {
+		return nil, err
+	}
+	return &Rows{rows: rows}, nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	values := make([]driver.NamedValue, 0, len(args))
+	for i, v := range args {
+		values = append(values, driver.NamedValue{Ordinal: i + 1, Value: v})
+	}
+	return values
+}
+
+func bindValues(args []driver.NamedValue) []interface{} {
+	values := make([]interface{}, 0, len(args))
+	for _, v := range args {
+		if 0 < len(v.Name) {
+			values = append(values, csvq.Named(v.Name, v.Value))
+		} else {
+			values = append(values, v.Value)
+		}
+	}
+	return values
+}
+
+type Result struct {
+	affectedRows int64
+}
+
+func (r Result) LastInsertId() (int64, error) {
+	return 0, errors.New("LastInsertId is not supported")
+}
+
+func (r Result) RowsAffected() (int64, error) {
+	return r.affectedRows, nil
+}
+
+type Rows struct {
+	rows *csvq.Rows
+}
+
+func (r *Rows) Columns() []string {
+	return r.rows.Columns()
+}
+
+func (r *Rows) Close() error {
+	return r.rows.Close()
+}
+
+func (r *Rows) Next(dest []driver.Value) error {
+	if !r.rows.Next() {
+		return io.EOF
+	}
+
+	for i, v := range r.rows.Values() {
+		dest[i] = DriverValue(v)
+	}
+	return nil
+}
+
+func DriverValue(p value.Primary) driver.Value {
+	switch p.(type) {
+	case value.String:
+		return p.(value.String).Raw()
+	case value.Integer:
+		return p.(value.Integer).Raw()
+	case value.Float:
+		return p.(value.Float).Raw()
+	case value.Decimal:
+		return p.(value.Decimal).String()
+	case value.Interval:
+		return p.(value.Interval).ISO8601()
+	case value.Boolean:
+		return p.(value.Boolean).Raw()
+	case value.Ternary:
+		if t := p.Ternary(); t != ternary.UNKNOWN {
+			return t.ParseBool()
+		}
+	case value.Datetime:
+		return p.(value.Datetime).Raw()
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/csvq"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var parseDSNTests = []struct {
+	DSN     string
+	Options csvq.Options
+	Error   string
+}{
+	{
+		DSN:     "/path/to/dir",
+		Options: csvq.Options{Repository: "/path/to/dir"},
+	},
+	{
+		DSN: "/path/to/dir?timezone=UTC&datetime_format=%25Y%25m%25d&datetime_format=%25H%25i&wait_timeout=2.5&delimiter=%3B&json_query=items&encoding=SJIS&no_header=true&without_null=1&cpu=2",
+		Options: csvq.Options{
+			Repository:     "/path/to/dir",
+			Timezone:       "UTC",
+			DatetimeFormat: []string{"%Y%m%d", "%H%i"},
+			WaitTimeout:    2.5,
+			Delimiter:      ";",
+			JsonQuery:      "items",
+			Encoding:       "SJIS",
+			NoHeader:       true,
+			WithoutNull:    true,
+			CPU:            2,
+		},
+	},
+	{
+		DSN:   "/path/to/dir?format=json",
+		Error: "invalid dsn \"/path/to/dir?format=json\": parameter format is not supported",
+	},
+	{
+		DSN:   "/path/to/dir?no_header=maybe",
+		Error: "invalid dsn \"/path/to/dir?no_header=maybe\": parameter no_header has an invalid value \"maybe\"",
+	},
+}
+
+func TestParseDSN(t *testing.T) {
+	for _, v := range parseDSNTests {
+		options, err := ParseDSN(v.DSN)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.DSN)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.DSN)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.DSN)
+			continue
+		}
+		if !reflect.DeepEqual(options, v.Options) {
+			t.Errorf("options = %#v, want %#v for %q", options, v.Options, v.DSN)
+		}
+	}
+}
+
+func TestDriver(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir+"?timezone=UTC")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer db.Close()
+
+	var id int
+	var name string
+	var created time.Time
+	var flag sql.NullBool
+	err = db.QueryRow("select id, name, datetime(created), id = 2 and null from table1 where id = ?", 2).Scan(&id, &name, &created, &flag)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if id != 2 || name != "str2" || !created.Equal(time.Date(2012, 2, 4, 9, 18, 15, 0, time.UTC)) || flag.Valid {
+		t.Errorf("result = %d, %q, %s, %v", id, name, created, flag)
+	}
+
+	stmt, err := db.Prepare("select name from table1 where id = :id")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = stmt.QueryRow(sql.Named("id", 1)).Scan(&name); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if name != "str1" {
+		t.Errorf("name = %q, want %q", name, "str1")
+	}
+	stmt.Close()
+
+	result, err := db.Exec("insert into table1 (id, name) values (?, ?)", 3, "str3")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if n, _ := result.RowsAffected(); n != 1 {
+		t.Errorf("rows affected = %d, want %d", n, 1)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("delete from table1 where id = ?", 3); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	tx, _ = db.Begin()
+	if _, err = tx.Exec("update table1 set name = :name where id = 1", sql.Named("name", "upd")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	rows, err := db.Query("select id, name from table1")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	names := make([]string, 0)
+	for rows.Next() {
+		rows.Scan(&id, &name)
+		names = append(names, name)
+	}
+	rows.Close()
+	if expect := []string{"upd", "str2", "str3"}; !reflect.DeepEqual(names, expect) {
+		t.Errorf("names = %q, want %q", names, expect)
+	}
+
+	if _, err = db.Exec("select from"); err == nil {
+		t.Error("no error, want syntax error")
+	}
+
+	err = db.QueryRow("var @name := 'var'; select @name || :name", sql.Named("name", "arg")).Scan(&name)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if name != "vararg" {
+		t.Errorf("name = %q, want %q", name, "vararg")
+	}
+}
+
+func TestConn_PrepareContext(t *testing.T) {
+	conn, err := (&Driver{}).Open(TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = conn.(*Conn).PrepareContext(ctx, "select 1"); err != context.Canceled {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+var driverValueTests = []struct {
+	Value  value.Primary
+	Result driver.Value
+}{
+	{Value: value.NewString("str"), Result: "str"},
+	{Value: value.NewInteger(1), Result: int64(1)},
+	{Value: value.NewFloat(1.5), Result: 1.5},
+	{Value: value.NewBoolean(true), Result: true},
+	{Value: value.NewTernary(ternary.FALSE), Result: false},
+	{Value: value.NewTernary(ternary.UNKNOWN), Result: nil},
+	{Value: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)), Result: time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+	{Value: value.NewNull(), Result: nil},
+}
+
+func TestDriverValue(t *testing.T) {
+	for _, v := range driverValueTests {
+		result := DriverValue(v.Value)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %s", result, v.Result, v.Value)
+		}
+	}
+}
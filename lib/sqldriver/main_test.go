@@ -0,0 +1,40 @@
+package sqldriver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var TestDir = filepath.Join(os.TempDir(), "csvq_sqldriver_test")
+
+func GetTestFilePath(filename string) string {
+	return filepath.Join(TestDir, filename)
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	defer teardown()
+
+	setup()
+	return m.Run()
+}
+
+func setup() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+	os.Mkdir(TestDir, 0755)
+
+	ioutil.WriteFile(GetTestFilePath("table1.csv"), []byte("id,name,created\n1,str1,2012-02-03 09:18:15\n2,str2,2012-02-04 09:18:15\n"), 0644)
+}
+
+func teardown() {
+	if _, err := os.Stat(TestDir); err == nil {
+		os.RemoveAll(TestDir)
+	}
+}
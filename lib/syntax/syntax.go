@@ -28,7 +28,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "select_entity",
 				Group: []Grammar{
-					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}},
+					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}, Option{Link("window_clause")}},
 					{Link("select_set_entity"), Link("Set Operators"), Option{Keyword("ALL")}, Link("select_set_entity")},
 				},
 			},
@@ -92,6 +92,12 @@ var CsvqSyntax = []Expression{
 							{Link("table_entity"), Identifier("alias")},
 							{Link("table_entity"), Keyword("AS"), Identifier("alias")},
 							{Link("join")},
+							{Link("pivot")},
+							{Link("pivot"), Identifier("alias")},
+							{Link("pivot"), Keyword("AS"), Identifier("alias")},
+							{Keyword("LATERAL"), Link("lateral_entity")},
+							{Keyword("LATERAL"), Link("lateral_entity"), Identifier("alias")},
+							{Keyword("LATERAL"), Link("lateral_entity"), Keyword("AS"), Identifier("alias")},
 							{Keyword("DUAL")},
 							{Parentheses{Link("table")}},
 						},
@@ -102,10 +108,20 @@ var CsvqSyntax = []Expression{
 							{Identifier("table_name")},
 							{Link("table_object")},
 							{Link("json_inline_table")},
+							{Link("table_function")},
 							{Parentheses{Link("select_query")}},
 							{Keyword("STDIN")},
 						},
 					},
+					{
+						Name: "lateral_entity",
+						Group: []Grammar{
+							{Link("json_inline_table")},
+							{Link("table_function")},
+							{Parentheses{Link("select_query")}},
+						},
+						Description: Description{Template: "A lateral table can refer to the columns of the tables listed before it, and is evaluated for each record of them."},
+					},
 					{
 						Name: "join",
 						Group: []Grammar{
@@ -124,6 +140,27 @@ var CsvqSyntax = []Expression{
 							{Keyword("USING"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 						},
 					},
+					{
+						Name: "pivot",
+						Group: []Grammar{
+							{Link("table"), Keyword("PIVOT"), Parentheses{Link("aggregate_function"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{ContinuousOption{Link("pivot_value")}}}},
+							{Link("table"), Keyword("UNPIVOT"), Parentheses{Identifier("value_column"), Keyword("FOR"), Identifier("name_column"), Keyword("IN"), Parentheses{ContinuousOption{Link("unpivot_column")}}}},
+						},
+					},
+					{
+						Name: "pivot_value",
+						Group: []Grammar{
+							{Link("value")},
+							{Link("value"), Keyword("AS"), Identifier("column_name")},
+						},
+					},
+					{
+						Name: "unpivot_column",
+						Group: []Grammar{
+							{Identifier("column_name")},
+							{Identifier("column_name"), Keyword("AS"), Identifier("label")},
+						},
+					},
 					{
 						Name: "table_object",
 						Group: []Grammar{
@@ -131,6 +168,7 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Identifier("table_name"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Identifier("table_name")}}},
 							{Function{Name: "LTSV", Args: []Element{Identifier("table_name"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "XLSX", Args: []Element{Identifier("table_name"), Option{String("sheet"), String("cell_range"), Boolean("no_header"), Boolean("without_null")}}}},
 						},
 					},
 					{
@@ -140,6 +178,13 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "JSON_TABLE", Args: []Element{String("json_query"), String("json_data")}}},
 						},
 					},
+					{
+						Name: "table_function",
+						Group: []Grammar{
+							{Function{Name: "GENERATE_SERIES", Args: []Element{Float("start"), Float("stop"), Option{Float("step")}}}},
+							{Function{Name: "SPLIT_TO_TABLE", Args: []Element{String("str"), String("separator")}}},
+						},
+					},
 				},
 			},
 			{
@@ -165,7 +210,27 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "group_by_clause",
 						Group: []Grammar{
-							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("field")}},
+							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("grouping_element")}},
+						},
+					},
+					{
+						Name: "grouping_element",
+						Group: []Grammar{
+							{Link("field")},
+							{Keyword("ROLLUP"), Parentheses{ContinuousOption{Link("field")}}},
+							{Keyword("CUBE"), Parentheses{ContinuousOption{Link("field")}}},
+							{Keyword("GROUPING"), Keyword("SETS"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+						},
+						Description: Description{
+							Template: "Fields that are not included in the grouping set of a record are %s.",
+							Values:   []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "grouping_set",
+						Group: []Grammar{
+							{Link("field")},
+							{Parentheses{Option{ContinuousOption{Link("field")}}}},
 						},
 					},
 				},
@@ -181,6 +246,27 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "WINDOW Clause",
+				Grammar: []Definition{
+					{
+						Name: "window_clause",
+						Group: []Grammar{
+							{Keyword("WINDOW"), ContinuousOption{Link("window_definition")}},
+						},
+					},
+					{
+						Name: "window_definition",
+						Group: []Grammar{
+							{Identifier("window_name"), Keyword("AS"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+						},
+						Description: Description{
+							Template: "Analytic functions can refer to a defined window by %s.",
+							Values:   []Element{Token("OVER window_name")},
+						},
+					},
+				},
+			},
 			{
 				Label: "ORDER BY Clause",
 				Grammar: []Definition{
@@ -307,14 +393,118 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "MERGE Statement",
+		Grammar: []Definition{
+			{
+				Name: "merge_statement",
+				Group: []Grammar{
+					{Option{Link("with_clause")}, Link("merge_query")},
+				},
+			},
+			{
+				Name: "merge_query",
+				Group: []Grammar{
+					{Keyword("MERGE"), Keyword("INTO"), Identifier("table_name"), Keyword("USING"), Link("table"), Keyword("ON"), Link("condition"), Link("merge_when_clause"), Token("...")},
+				},
+			},
+			{
+				Name: "merge_when_clause",
+				Group: []Grammar{
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("INSERT"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), Link("row_value")},
+				},
+				Description: Description{
+					Template: "For each record, the first clause whose condition is satisfied is applied.",
+				},
+			},
+		},
+	},
 	{
 		Label: "CREATE TABLE Statement",
 		Grammar: []Definition{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("table_element")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("table_element")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "table_element",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Link("column_constraint"), Token("...")}},
+					{Link("table_constraint")},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("STRING"), Keyword("INTEGER"), Keyword("FLOAT"), Keyword("DECIMAL"), Keyword("BOOLEAN"), Keyword("DATETIME"), Keyword("INTERVAL")}},
+				},
+				Description: Description{
+					Template: "Column types are saved to a hidden file in the same directory as the table file when the table is committed by %s.",
+					Values:   []Element{Keyword("COMMIT")},
+				},
+			},
+			{
+				Name: "column_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, AnyOne{PlainGroup{Keyword("PRIMARY"), Keyword("KEY")}, Keyword("UNIQUE"), PlainGroup{Keyword("NOT"), Null("NULL")}, PlainGroup{Keyword("CHECK"), Parentheses{Link("condition")}}}},
+				},
+			},
+			{
+				Name: "table_constraint",
+				Group: []Grammar{
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("PRIMARY"), Keyword("KEY"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("UNIQUE"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("NOT"), Null("NULL"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+					{Option{Keyword("CONSTRAINT"), Identifier("constraint_name")}, Keyword("CHECK"), Parentheses{Link("condition")}},
+				},
+				Description: Description{
+					Template: "Constraints are saved with column types, and all the records in the table are validated when the table is modified by %s, %s or %s. If a constraint is violated, the transaction is rolled back.",
+					Values:   []Element{Keyword("INSERT"), Keyword("UPDATE"), Keyword("MERGE")},
+				},
+			},
+		},
+	},
+	{
+		Label: "CREATE INDEX Statement",
+		Grammar: []Definition{
+			{
+				Name: "create_index_statement",
+				Group: []Grammar{
+					{Keyword("CREATE"), Keyword("INDEX"), Identifier("index_name"), Keyword("ON"), Identifier("table_name"), Parentheses{ContinuousOption{Identifier("column_name")}}},
+				},
+				Description: Description{
+					Template: "Index is saved to a hidden file in the same directory as the table file, and is rebuilt when the table is updated by %s.",
+					Values:   []Element{Keyword("COMMIT")},
+				},
+			},
+		},
+	},
+	{
+		Label: "DROP TABLE Statement",
+		Grammar: []Definition{
+			{
+				Name: "drop_table_statement",
+				Group: []Grammar{
+					{Keyword("DROP"), Keyword("TABLE"), Option{Keyword("IF"), Keyword("EXISTS")}, Identifier("table_name")},
+				},
+				Description: Description{
+					Template: "The file is deleted when the transaction is committed.",
+				},
+			},
+		},
+	},
+	{
+		Label: "TRUNCATE TABLE Statement",
+		Grammar: []Definition{
+			{
+				Name: "truncate_table_statement",
+				Group: []Grammar{
+					{Keyword("TRUNCATE"), Keyword("TABLE"), Identifier("table_name")},
 				},
 			},
 		},
@@ -362,16 +552,52 @@ var CsvqSyntax = []Expression{
 					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("RENAME"), Identifier("old_column_name"), Keyword("TO"), Identifier("new_column_name")},
 				},
 			},
+			{
+				Name: "alter_table_rename_table_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("RENAME"), Keyword("TO"), Identifier("new_file_path")},
+				},
+				Description: Description{
+					Template: "The file is renamed when the transaction is committed.",
+				},
+			},
 			{
 				Name: "alter_table_set_attribute_statement",
 				Group: []Grammar{
 					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("SET"), Link("table_attribute"), Keyword("TO"), Link("value")},
 				},
 			},
+			{
+				Name: "alter_table_add_constraint_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("ADD"), Link("table_constraint")},
+				},
+			},
+			{
+				Name: "alter_table_drop_constraint_statement",
+				Group: []Grammar{
+					{Keyword("ALTER"), Keyword("TABLE"), Identifier("table_name"), Keyword("DROP"), Keyword("CONSTRAINT"), Identifier("constraint_name")},
+				},
+			},
 			{
 				Name: "table_attribute",
 				Group: []Grammar{
-					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT")}},
+					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT"), Keyword("COMPRESSION")}},
+				},
+			},
+		},
+	},
+	{
+		Label: "EXPLAIN Statement",
+		Grammar: []Definition{
+			{
+				Name: "explain_statement",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_query")},
+				},
+				Description: Description{
+					Template: "Shows the operators used to execute the query with estimated numbers of records. With %s, the query is executed and actual numbers of records and times are shown too.",
+					Values:   []Element{Keyword("ANALYZE")},
 				},
 			},
 		},
@@ -510,6 +736,39 @@ var CsvqSyntax = []Expression{
 					{Keyword("DISPOSE"), Keyword("FUNCTION"), Identifier("function_name")},
 				},
 			},
+			{
+				Name: "declare_procedure_statement",
+				Group: []Grammar{
+					{Keyword("DECLARE"), Identifier("procedure_name"), Keyword("PROCEDURE"), Parentheses{Link("procedure_parameters")}, Keyword("AS"), Keyword("BEGIN"), Token("statements"), Keyword("END")},
+				},
+			},
+			{
+				Name: "procedure_parameters",
+				Group: []Grammar{
+					{Option{ContinuousOption{AnyOne{Variable("@parameter"), Link("optional_parameter"), Link("out_parameter")}}}},
+				},
+			},
+			{
+				Name: "out_parameter",
+				Group: []Grammar{
+					{Keyword("OUT"), Variable("@parameter")},
+				},
+				Description: Description{
+					Template: "When the procedure terminates, the value of the parameter is set to the variable passed as the argument.",
+				},
+			},
+			{
+				Name: "call_statement",
+				Group: []Grammar{
+					{Keyword("CALL"), Identifier("procedure_name"), Parentheses{ContinuousOption{Link("argument")}}},
+				},
+			},
+			{
+				Name: "dispose_procedure_statement",
+				Group: []Grammar{
+					{Keyword("DISPOSE"), Keyword("PROCEDURE"), Identifier("procedure_name")},
+				},
+			},
 			{
 				Name: "return_statement",
 				Group: []Grammar{
@@ -529,13 +788,13 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "aggregate_function_call",
 				Group: []Grammar{
-					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Option{Keyword("FILTER"), Parentheses{Keyword("WHERE"), Link("condition")}}},
 				},
 			},
 			{
 				Name: "analytic_function_call",
 				Group: []Grammar{
-					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Option{Keyword("FILTER"), Parentheses{Keyword("WHERE"), Link("condition")}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
 				},
 			},
 		},
@@ -568,6 +827,17 @@ var CsvqSyntax = []Expression{
 					{Keyword("WHILE"), Option{AnyOne{Keyword("DECLARE"), Keyword("VAR")}}, ContinuousOption{Variable("@variable")}, Keyword("IN"), Identifier("cursor_name"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
 				},
 			},
+			{
+				Name: "try_statement",
+				Group: []Grammar{
+					{Keyword("TRY"), Token("statements"), Keyword("CATCH"), Token("statements"), Keyword("END"), Keyword("TRY")},
+				},
+				Description: Description{
+					Template: "If an error occurs in the statements after %s, the statements after %s are executed. " +
+						"The caught error can be referred by %s and %s.",
+					Values: []Element{Keyword("TRY"), Keyword("CATCH"), Variable("@#ERROR_CODE"), Variable("@#ERROR_MESSAGE")},
+				},
+			},
 			{
 				Name: "continue_statement",
 				Group: []Grammar{
@@ -596,8 +866,8 @@ var CsvqSyntax = []Expression{
 					{Keyword("TRIGGER"), Keyword("ERROR"), Option{Integer("exit_code")}, Option{String("error_message")}},
 				},
 				Description: Description{
-					Template: "%s is the default %s.",
-					Values:   []Element{Token("1"), Integer("exit_code")},
+					Template: "%s is the default %s. In a %s block, the statement without any arguments raises the caught error again.",
+					Values:   []Element{Token("1"), Integer("exit_code"), Keyword("CATCH")},
 				},
 			},
 		},
@@ -935,6 +1205,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
+				Flag("@@COMPRESSION"), String("string"), Link("Compression"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
 				Flag("@@COUNT_DIACRITICAL_SIGN"), Boolean("boolean"),
 				Flag("@@COUNT_FORMAT_CODE"), Boolean("boolean"),
@@ -1009,6 +1280,10 @@ var CsvqSyntax = []Expression{
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
+				"%s  <type::%s>\n" +
+				"  > Exit code of the error caught in a CATCH block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Message of the error caught in a CATCH block.\n" +
 				"",
 			Values: []Element{
 				Variable("@#UNCOMMITTED"), Boolean("boolean"),
@@ -1018,6 +1293,8 @@ var CsvqSyntax = []Expression{
 				Variable("@#LOADED_TABLES"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#ERROR_CODE"), Integer("integer"),
+				Variable("@#ERROR_MESSAGE"), String("string"),
 			},
 		},
 	},
@@ -1122,6 +1399,7 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
+						"  |            | REGEXP              | n/a           |\n" +
 						"  |          6 | NOT                 | Right-to-Left |\n" +
 						"  |          7 | AND                 | Left-to-Right |\n" +
 						"  |          8 | OR                  | Left-to-Right |\n" +
@@ -1244,6 +1522,16 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("str"), String("pattern"), String("str"), Ternary("UNKNOWN"), String("pattern"), Token("%")},
 						},
 					},
+					{
+						Name: "regexp",
+						Group: []Grammar{
+							{String("str"), Option{Keyword("NOT")}, Keyword("REGEXP"), String("pattern")},
+						},
+						Description: Description{
+							Template: "Check if %s matches the regular expression %s. If %s or %s is null, then returns %s.",
+							Values:   []Element{String("str"), String("pattern"), String("str"), String("pattern"), Ternary("UNKNOWN")},
+						},
+					},
 					{
 						Name: "in",
 						Group: []Grammar{
@@ -2026,6 +2314,62 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "regexp_match",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_MATCH", Args: []Element{String("str"), String("regexp"), Option{String("flags")}}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns TRUE if %s matches the regular expression %s. In %s, following characters can be specified.\n" +
+								"\n" +
+								"```\n" +
+								"  +------+---------------------------------------------+\n" +
+								"  | flag |                 Description                 |\n" +
+								"  +------+---------------------------------------------+\n" +
+								"  | i    | Case-insensitive                            |\n" +
+								"  | m    | Multi-line mode                             |\n" +
+								"  | s    | Let . match \\n                              |\n" +
+								"  | U    | Ungreedy                                    |\n" +
+								"  +------+---------------------------------------------+\n" +
+								"```",
+							Values: []Element{String("str"), String("regexp"), String("flags")},
+						},
+					},
+					{
+						Name: "regexp_find",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND", Args: []Element{String("str"), String("regexp"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the first substring of %s that matches the regular expression %s.", Values: []Element{String("str"), String("regexp")}},
+					},
+					{
+						Name: "regexp_find_submatches",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_SUBMATCHES", Args: []Element{String("str"), String("regexp"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of the first match of the regular expression %s in %s and its submatches.", Values: []Element{String("regexp"), String("str")}},
+					},
+					{
+						Name: "regexp_find_all",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("regexp"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of all matches of the regular expression %s in %s and their submatches.", Values: []Element{String("regexp"), String("str")}},
+					},
+					{
+						Name: "regexp_replace",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_REPLACE", Args: []Element{String("str"), String("regexp"), String("replacement"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that is replaced all matches of the regular expression %s with %s in %s.", Values: []Element{String("regexp"), String("replacement"), String("str")}},
+					},
+					{
+						Name: "regexp_split",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_SPLIT", Args: []Element{String("str"), String("regexp"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of the substrings of %s separated by the matches of the regular expression %s.", Values: []Element{String("str"), String("regexp")}},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2134,6 +2478,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a float.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "decimal",
+						Group: []Grammar{
+							{Function{Name: "DECIMAL", Args: []Element{Link("value"), Option{Integer("scale")}}, Return: Return("decimal")}},
+						},
+						Description: Description{Template: "Converts %s to a decimal. If %s is specified, then the result is rounded or padded to %s decimal places.", Values: []Element{Link("value"), Integer("scale"), Integer("scale")}},
+					},
 					{
 						Name: "datetime",
 						Group: []Grammar{
@@ -2141,6 +2492,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a datetime.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "interval",
+						Group: []Grammar{
+							{Function{Name: "INTERVAL", Args: []Element{Link("value")}, Return: Return("interval")}},
+						},
+						Description: Description{Template: "Converts %s to an interval. A number is regarded as a number of seconds.", Values: []Element{Link("value")}},
+					},
 					{
 						Name: "boolean",
 						Group: []Grammar{
@@ -2255,6 +2613,85 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "stdev",
+						Group: []Grammar{
+							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. " +
+								"If the number of non-null values is less than 2, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stdevp",
+						Group: []Grammar{
+							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var",
+						Group: []Grammar{
+							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. " +
+								"If the number of non-null values is less than 2, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "varp",
+						Group: []Grammar{
+							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If there are several most frequent values, then returns the value that appears first. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s of float values of %s sorted in the specified order. " +
+								"If there is no value at exactly the position, then the value is calculated by linear interpolation between the adjacent values. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value of %s sorted in the specified order whose cumulative distribution is greater than or equal to %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2280,6 +2717,16 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "grouping",
+						Group: []Grammar{
+							{Function{Name: "GROUPING", Args: []Element{ContinuousOption{Link("field")}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns a bit vector of the %s. Each bit is 1 if the field is not included in the grouping set of the record, otherwise 0.",
+							Values:   []Element{Link("field")},
+						},
+					},
 				},
 			},
 			{
@@ -2463,6 +2910,85 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "stdev",
+						Group: []Grammar{
+							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample standard deviation of float values of %s. " +
+								"If the number of non-null values is less than 2, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "stdevp",
+						Group: []Grammar{
+							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population standard deviation of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "var",
+						Group: []Grammar{
+							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample variance of float values of %s. " +
+								"If the number of non-null values is less than 2, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "varp",
+						Group: []Grammar{
+							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population variance of float values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If there are several most frequent values, then returns the value that appears first. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s of float values of %s sorted in the specified order. " +
+								"If there is no value at exactly the position, then the value is calculated by linear interpolation between the adjacent values. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value of %s sorted in the specified order whose cumulative distribution is greater than or equal to %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2504,15 +3030,21 @@ var CsvqSyntax = []Expression{
 							{
 								Name: "windowing_clause",
 								Group: []Grammar{
-									{Keyword("ROWS"), Link("window_position")},
-									{Keyword("ROWS"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high")},
+									{AnyOne{Keyword("ROWS"), Keyword("RANGE"), Keyword("GROUPS")}, Link("window_position")},
+									{AnyOne{Keyword("ROWS"), Keyword("RANGE"), Keyword("GROUPS")}, Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high")},
+								},
+								Description: Description{
+									Template: "" +
+										"%s counts offsets in records, %s counts offsets in peer groups, " +
+										"and %s compares offsets with the value of the only %s.",
+									Values: []Element{Keyword("ROWS"), Keyword("GROUPS"), Keyword("RANGE"), Link("order_item")},
 								},
 							},
 							{
 								Name: "window_position",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("PRECEDING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2520,8 +3052,8 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_low",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Float("offset"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2529,8 +3061,8 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_high",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("FOLLOWING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Float("offset"), Keyword("PRECEDING")},
+									{Float("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2660,19 +3192,22 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
-						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
+						"BETWEEN BREAK BY CASE CATCH CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"EXIT EXPLAIN FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
 						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LEAD " +
 						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
 						"PERCENT_RANK PRECEDING PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
 						"RELATIVE RELOAD REMOVE RENAME RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
-						"SELECT SEPARATOR SET SHOW SOURCE STDIN SUM SYNTAX TABLE THEN TO TRIGGER TRUE " +
+						"SELECT SEPARATOR SET SHOW SOURCE STDIN SUM SYNTAX TABLE THEN TO TRIGGER TRUE TRY " +
 						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VIEW WHEN WHERE " +
-						"WHILE WITH WITHIN",
+						"WHILE WITH WITHIN\n" +
+						"\n" +
+						"Names of aggregate functions and analytic functions in the list, except COUNT, " +
+						"can be used as identifiers unless they are followed by a left parenthesis.",
 				},
 			},
 		},
@@ -2825,6 +3360,23 @@ var CsvqSyntax = []Expression{
 						"```",
 				},
 			},
+			{
+				Name: "Compression",
+				Description: Description{
+					Template: "" +
+						"```\n" +
+						"+-------+-----------+----------------+\n" +
+						"| Value | Extension |  Compression   |\n" +
+						"+-------+-----------+----------------+\n" +
+						"| NONE  |           | No compression |\n" +
+						"| GZIP  | .gz       | gzip           |\n" +
+						"| BZIP2 | .bz2      | bzip2          |\n" +
+						"| ZSTD  | .zst      | Zstandard      |\n" +
+						"| XZ    | .xz       | xz             |\n" +
+						"+-------+-----------+----------------+\n" +
+						"```",
+				},
+			},
 			{
 				Name: "Line Break",
 				Description: Description{
@@ -2856,6 +3408,7 @@ var CsvqSyntax = []Expression{
 						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
 						"| ORG   | Text Table for Emacs Org-Mode            |\n" +
 						"| TEXT  | Text Table for console                   |\n" +
+						"| XLSX  | Office Open XML Workbook                 |\n" +
 						"+-------+------------------------------------------+\n" +
 						"```",
 				},
@@ -3,6 +3,7 @@ package value
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/ternary"
 )
@@ -31,7 +32,7 @@ func (cr ComparisonResult) String() string {
 	return comparisonResultLiterals[cr]
 }
 
-func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
+func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ComparisonResult {
 	if IsNull(p1) || IsNull(p2) {
 		return IsIncommensurable
 	}
@@ -50,6 +51,37 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 		}
 	}
 
+	if isDecimal(p1) || isDecimal(p2) {
+		if d1 := ToDecimal(p1); !IsNull(d1) {
+			if d2 := ToDecimal(p2); !IsNull(d2) {
+				switch d1.(Decimal).Cmp(d2.(Decimal)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				default:
+					return IsGreater
+				}
+			}
+		}
+	}
+
+	if isInterval(p1) || isInterval(p2) {
+		if iv1 := ToInterval(p1); !IsNull(iv1) {
+			if iv2 := ToInterval(p2); !IsNull(iv2) {
+				switch iv1.(Interval).Cmp(iv2.(Interval)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				default:
+					return IsGreater
+				}
+			}
+		}
+		return IsIncommensurable
+	}
+
 	if f1 := ToFloat(p1); !IsNull(f1) {
 		if f2 := ToFloat(p2); !IsNull(f2) {
 			v1 := f1.(Float).Raw()
@@ -64,8 +96,8 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 		}
 	}
 
-	if d1 := ToDatetime(p1); !IsNull(d1) {
-		if d2 := ToDatetime(p2); !IsNull(d2) {
+	if d1 := ToDatetime(p1, datetimeFormat, location); !IsNull(d1) {
+		if d2 := ToDatetime(p2, datetimeFormat, location); !IsNull(d2) {
 			v1 := d1.(Datetime).Raw()
 			v2 := d2.(Datetime).Raw()
 			if v1.Equal(v2) {
@@ -108,6 +140,16 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 	return IsIncommensurable
 }
 
+func isDecimal(p Primary) bool {
+	_, ok := p.(Decimal)
+	return ok
+}
+
+func isInterval(p Primary) bool {
+	_, ok := p.(Interval)
+	return ok
+}
+
 func Identical(p1 Primary, p2 Primary) ternary.Value {
 	if t, ok := p1.(Ternary); (ok && t.value == ternary.UNKNOWN) || IsNull(p1) {
 		return ternary.UNKNOWN
@@ -128,6 +170,18 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 		}
 	}
 
+	if v1, ok := p1.(Decimal); ok {
+		if v2, ok := p2.(Decimal); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
+	if v1, ok := p1.(Interval); ok {
+		if v2, ok := p2.(Interval); ok {
+			return ternary.ConvertFromBool(v1 == v2)
+		}
+	}
+
 	if v1, ok := p1.(Datetime); ok {
 		if v2, ok := p2.(Datetime); ok {
 			return ternary.ConvertFromBool(v1.value.Equal(v2.value))
@@ -155,68 +209,68 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 	return ternary.FALSE
 }
 
-func Equal(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable {
+func Equal(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable {
 		return ternary.ConvertFromBool(r == IsEqual || r == IsBoolEqual)
 	}
 	return ternary.UNKNOWN
 }
 
-func NotEqual(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable {
+func NotEqual(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable {
 		return ternary.ConvertFromBool(r != IsEqual && r != IsBoolEqual)
 	}
 	return ternary.UNKNOWN
 }
 
-func Less(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
+func Less(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
 		return ternary.ConvertFromBool(r == IsLess)
 	}
 	return ternary.UNKNOWN
 }
 
-func Greater(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
+func Greater(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
 		return ternary.ConvertFromBool(r == IsGreater)
 	}
 	return ternary.UNKNOWN
 }
 
-func LessOrEqual(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
+func LessOrEqual(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
 		return ternary.ConvertFromBool(r != IsGreater)
 	}
 	return ternary.UNKNOWN
 }
 
-func GreaterOrEqual(p1 Primary, p2 Primary) ternary.Value {
-	if r := CompareCombinedly(p1, p2); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
+func GreaterOrEqual(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
+	if r := CompareCombinedly(p1, p2, datetimeFormat, location); r != IsIncommensurable && r != IsNotEqual && r != IsBoolEqual {
 		return ternary.ConvertFromBool(r != IsLess)
 	}
 	return ternary.UNKNOWN
 }
 
-func Compare(p1 Primary, p2 Primary, operator string) ternary.Value {
+func Compare(p1 Primary, p2 Primary, operator string, datetimeFormat []string, location *time.Location) ternary.Value {
 	switch operator {
 	case "=":
-		return Equal(p1, p2)
+		return Equal(p1, p2, datetimeFormat, location)
 	case "==":
 		return Identical(p1, p2)
 	case ">":
-		return Greater(p1, p2)
+		return Greater(p1, p2, datetimeFormat, location)
 	case "<":
-		return Less(p1, p2)
+		return Less(p1, p2, datetimeFormat, location)
 	case ">=":
-		return GreaterOrEqual(p1, p2)
+		return GreaterOrEqual(p1, p2, datetimeFormat, location)
 	case "<=":
-		return LessOrEqual(p1, p2)
+		return LessOrEqual(p1, p2, datetimeFormat, location)
 	default: //case "<>", "!=":
-		return NotEqual(p1, p2)
+		return NotEqual(p1, p2, datetimeFormat, location)
 	}
 }
 
-func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string) (ternary.Value, error) {
+func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string, datetimeFormat []string, location *time.Location) (ternary.Value, error) {
 	if rowValue1 == nil || rowValue2 == nil {
 		return ternary.UNKNOWN, nil
 	}
@@ -238,7 +292,7 @@ func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string) (
 			continue
 		}
 
-		r := CompareCombinedly(rowValue1[i], rowValue2[i])
+		r := CompareCombinedly(rowValue1[i], rowValue2[i], datetimeFormat, location)
 
 		if r == IsIncommensurable {
 			switch operator {
@@ -296,9 +350,9 @@ func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string) (
 	return ternary.TRUE, nil
 }
 
-func Equivalent(p1 Primary, p2 Primary) ternary.Value {
+func Equivalent(p1 Primary, p2 Primary, datetimeFormat []string, location *time.Location) ternary.Value {
 	if IsNull(p1) && IsNull(p2) {
 		return ternary.TRUE
 	}
-	return Equal(p1, p2)
+	return Equal(p1, p2, datetimeFormat, location)
 }
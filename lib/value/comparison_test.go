@@ -2,6 +2,7 @@ package value
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mithrandie/ternary"
 )
@@ -53,18 +54,18 @@ var compareCombinedlyTests = []struct {
 		Result: IsGreater,
 	},
 	{
-		LHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00"),
-		RHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00"),
+		LHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00", nil, time.Local),
+		RHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00", nil, time.Local),
 		Result: IsEqual,
 	},
 	{
-		LHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00"),
-		RHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00"),
+		LHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00", nil, time.Local),
+		RHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00", nil, time.Local),
 		Result: IsLess,
 	},
 	{
-		LHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00"),
-		RHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00"),
+		LHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00", nil, time.Local),
+		RHS:    NewDatetimeFromString("2006-01-02T15:04:05-07:00", nil, time.Local),
 		Result: IsGreater,
 	},
 	{
@@ -97,11 +98,41 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewTernaryFromString("true"),
 		Result: IsIncommensurable,
 	},
+	{
+		LHS:    NewDecimalFromString("0.30"),
+		RHS:    NewFloat(0.3),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewString("12345678901234567.89"),
+		RHS:    NewDecimalFromString("12345678901234567.9"),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewDecimalFromString("2.5"),
+		RHS:    NewInteger(2),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewIntervalFromString("1 day"),
+		RHS:    NewString("24 hours"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewIntervalFromString("1 month"),
+		RHS:    NewIntervalFromString("31 days"),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewIntervalFromString("1 second"),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
 }
 
 func TestCompareCombinedly(t *testing.T) {
 	for _, v := range compareCombinedlyTests {
-		r := CompareCombinedly(v.LHS, v.RHS)
+		r := CompareCombinedly(v.LHS, v.RHS, nil, time.Local)
 		if r != v.Result {
 			t.Errorf("result = %s, want %s for comparison with %s and %s", r, v.Result, v.LHS, v.RHS)
 		}
@@ -144,8 +175,8 @@ var identicalTests = []struct {
 		Result: ternary.TRUE,
 	},
 	{
-		LHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00"),
-		RHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00"),
+		LHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00", nil, time.Local),
+		RHS:    NewDatetimeFromString("2006-02-02T15:04:05-07:00", nil, time.Local),
 		Result: ternary.TRUE,
 	},
 	{
@@ -173,6 +204,21 @@ var identicalTests = []struct {
 		RHS:    NewFloat(1),
 		Result: ternary.FALSE,
 	},
+	{
+		LHS:    NewDecimalFromString("1.50"),
+		RHS:    NewDecimalFromString("1.5"),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewDecimalFromString("1.5"),
+		RHS:    NewFloat(1.5),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewIntervalFromString("1 day"),
+		RHS:    NewIntervalFromString("24 hours"),
+		Result: ternary.FALSE,
+	},
 }
 
 func TestIdentical(t *testing.T) {
@@ -314,7 +360,7 @@ var compareTests = []struct {
 
 func TestCompare(t *testing.T) {
 	for _, v := range compareTests {
-		r := Compare(v.LHS, v.RHS, v.Op)
+		r := Compare(v.LHS, v.RHS, v.Op, nil, time.Local)
 		if r != v.Result {
 			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, v.Op, v.RHS)
 		}
@@ -649,7 +695,7 @@ var compareRowValuesTests = []struct {
 
 func TestCompareRowValues(t *testing.T) {
 	for _, v := range compareRowValuesTests {
-		r, err := CompareRowValues(v.LHS, v.RHS, v.Op)
+		r, err := CompareRowValues(v.LHS, v.RHS, v.Op, nil, time.Local)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("unexpected error %q for (%s %s %s)", err, v.LHS, v.Op, v.RHS)
@@ -687,7 +733,7 @@ var equivalentToTests = []struct {
 
 func TestEquivalentTo(t *testing.T) {
 	for _, v := range equivalentToTests {
-		r := Equivalent(v.LHS, v.RHS)
+		r := Equivalent(v.LHS, v.RHS, nil, time.Local)
 		if r != v.Result {
 			t.Errorf("result = %s, want %s for (%s is equivalent to %s)", r, v.Result, v.LHS, v.RHS)
 		}
@@ -4,34 +4,48 @@ import (
 	"bytes"
 	"errors"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/mithrandie/csvq/lib/cmd"
-
 	"github.com/mithrandie/ternary"
 )
 
-type DatetimeFormatMap map[string]string
+type DatetimeFormatMap struct {
+	m   map[string]string
+	mtx sync.RWMutex
+}
 
-func (m DatetimeFormatMap) Get(s string) string {
-	if f, ok := m[s]; ok {
+func NewDatetimeFormatMap() *DatetimeFormatMap {
+	return &DatetimeFormatMap{
+		m: make(map[string]string),
+	}
+}
+
+func (m *DatetimeFormatMap) Get(s string) string {
+	m.mtx.RLock()
+	f, ok := m.m[s]
+	m.mtx.RUnlock()
+	if ok {
 		return f
 	}
-	f := ConvertDatetimeFormat(s)
-	m[s] = f
+
+	f = ConvertDatetimeFormat(s)
+	m.mtx.Lock()
+	m.m[s] = f
+	m.mtx.Unlock()
 	return f
 }
 
-var DatetimeFormats = DatetimeFormatMap{}
+var DatetimeFormats = NewDatetimeFormatMap()
 
-func StrToTime(s string) (time.Time, error) {
+func StrToTime(s string, datetimeFormat []string, location *time.Location) (time.Time, error) {
 	s = strings.TrimSpace(s)
 
-	flags := cmd.GetFlags()
-	for _, format := range flags.DatetimeFormat {
-		if t, e := time.ParseInLocation(DatetimeFormats.Get(format), s, cmd.GetLocation()); e == nil {
+	for _, format := range datetimeFormat {
+		if t, e := time.ParseInLocation(DatetimeFormats.Get(format), s, location); e == nil {
 			return t, nil
 		}
 	}
@@ -40,11 +54,11 @@ func StrToTime(s string) (time.Time, error) {
 		switch {
 		case s[4] == '-':
 			if len(s) < 10 {
-				if t, e := time.ParseInLocation("2006-1-2", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006-1-2", s, location); e == nil {
 					return t, nil
 				}
 			} else if len(s) == 10 {
-				if t, e := time.ParseInLocation("2006-01-02", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006-01-02", s, location); e == nil {
 					return t, nil
 				}
 			} else if s[10] == 'T' {
@@ -53,12 +67,12 @@ func StrToTime(s string) (time.Time, error) {
 						return t, nil
 					}
 				} else {
-					if t, e := time.ParseInLocation("2006-01-02T15:04:05.999999999", s, cmd.GetLocation()); e == nil {
+					if t, e := time.ParseInLocation("2006-01-02T15:04:05.999999999", s, location); e == nil {
 						return t, nil
 					}
 				}
 			} else if s[10] == ' ' {
-				if t, e := time.ParseInLocation("2006-01-02 15:04:05.999999999", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006-01-02 15:04:05.999999999", s, location); e == nil {
 					return t, nil
 				} else if t, e := time.Parse("2006-01-02 15:04:05.999999999 -07:00", s); e == nil {
 					return t, nil
@@ -68,7 +82,7 @@ func StrToTime(s string) (time.Time, error) {
 					return t, nil
 				}
 			} else {
-				if t, e := time.ParseInLocation("2006-1-2 15:04:05.999999999", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006-1-2 15:04:05.999999999", s, location); e == nil {
 					return t, nil
 				} else if t, e := time.Parse("2006-1-2 15:04:05.999999999 -07:00", s); e == nil {
 					return t, nil
@@ -80,15 +94,15 @@ func StrToTime(s string) (time.Time, error) {
 			}
 		case s[4] == '/':
 			if len(s) < 10 {
-				if t, e := time.ParseInLocation("2006/1/2", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006/1/2", s, location); e == nil {
 					return t, nil
 				}
 			} else if len(s) == 10 {
-				if t, e := time.ParseInLocation("2006/01/02", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006/01/02", s, location); e == nil {
 					return t, nil
 				}
 			} else if s[10] == ' ' {
-				if t, e := time.ParseInLocation("2006/01/02 15:04:05.999999999", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006/01/02 15:04:05.999999999", s, location); e == nil {
 					return t, nil
 				} else if t, e := time.Parse("2006/01/02 15:04:05.999999999 Z07:00", s); e == nil {
 					return t, nil
@@ -98,7 +112,7 @@ func StrToTime(s string) (time.Time, error) {
 					return t, nil
 				}
 			} else {
-				if t, e := time.ParseInLocation("2006/1/2 15:04:05.999999999", s, cmd.GetLocation()); e == nil {
+				if t, e := time.ParseInLocation("2006/1/2 15:04:05.999999999", s, location); e == nil {
 					return t, nil
 				} else if t, e := time.Parse("2006/1/2 15:04:05.999999999 Z07:00", s); e == nil {
 					return t, nil
@@ -195,7 +209,7 @@ func ConvertDatetimeFormat(format string) string {
 	return buf.String()
 }
 
-func Float64ToTime(f float64) time.Time {
+func Float64ToTime(f float64, location *time.Location) time.Time {
 	s := Float64ToStr(f)
 	ns := strings.Split(s, ".")
 	sec, _ := strconv.ParseInt(ns[0], 10, 64)
@@ -206,7 +220,7 @@ func Float64ToTime(f float64) time.Time {
 		}
 		nsec, _ = strconv.ParseInt(ns[1]+strings.Repeat("0", 9-len(ns[1])), 10, 64)
 	}
-	return time.Unix(sec, nsec)
+	return time.Unix(sec, nsec).In(location)
 }
 
 func Int64ToStr(i int64) string {
@@ -217,6 +231,49 @@ func Float64ToStr(f float64) string {
 	return strconv.FormatFloat(f, 'f', -1, 64)
 }
 
+const maxDecimalExponent = 1024
+
+func StrToDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); -1 < i {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -maxDecimalExponent || maxDecimalExponent < e {
+			return Decimal{}, errors.New("conversion failed")
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	neg := false
+	if 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	digits := s
+	scale := 0
+	if i := strings.IndexByte(s, '.'); -1 < i {
+		digits = s[:i] + s[i+1:]
+		scale = len(s) - i - 1
+	}
+	if len(digits) < 1 {
+		return Decimal{}, errors.New("conversion failed")
+	}
+	for _, c := range digits {
+		if c < '0' || '9' < c {
+			return Decimal{}, errors.New("conversion failed")
+		}
+	}
+
+	i, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		i.Neg(i)
+	}
+	return NewDecimal(i, scale-exp), nil
+}
+
 func ParseFloat64(f float64) Primary {
 	if math.Remainder(f, 1) == 0 {
 		return NewInteger(int64(f))
@@ -233,6 +290,13 @@ func ToInteger(p Primary) Primary {
 		if math.Remainder(f, 1) == 0 {
 			return NewInteger(int64(f))
 		}
+	case Decimal:
+		d := p.(Decimal)
+		if d.IsInteger() {
+			if i := d.Truncate(0).Unscaled(); i.IsInt64() {
+				return NewInteger(i.Int64())
+			}
+		}
 	case String:
 		s := strings.TrimSpace(p.(String).Raw())
 		if maybeNumber(s) {
@@ -256,6 +320,8 @@ func ToFloat(p Primary) Primary {
 		return NewFloat(float64(p.(Integer).Raw()))
 	case Float:
 		return p
+	case Decimal:
+		return NewFloat(p.(Decimal).Float64())
 	case String:
 		s := strings.TrimSpace(p.(String).Raw())
 		if maybeNumber(s) {
@@ -268,6 +334,42 @@ func ToFloat(p Primary) Primary {
 	return NewNull()
 }
 
+func ToDecimal(p Primary) Primary {
+	switch p.(type) {
+	case Integer:
+		return NewDecimal(big.NewInt(p.(Integer).Raw()), 0)
+	case Float:
+		f := p.(Float).Raw()
+		if !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return NewDecimalFromString(Float64ToStr(f))
+		}
+	case Decimal:
+		return p
+	case String:
+		s := strings.TrimSpace(p.(String).Raw())
+		if maybeNumber(s) {
+			if d, e := StrToDecimal(s); e == nil {
+				return d
+			}
+		}
+	}
+
+	return NewNull()
+}
+
+func ToInterval(p Primary) Primary {
+	switch p.(type) {
+	case Interval:
+		return p
+	case String:
+		if iv, e := StrToInterval(p.(String).Raw()); e == nil {
+			return iv
+		}
+	}
+
+	return NewNull()
+}
+
 func maybeNumber(s string) bool {
 	slen := len(s)
 	if 1 < slen && (s[0] == '-' || s[0] == '+') && '0' <= s[1] && s[1] <= '9' {
@@ -290,28 +392,33 @@ func maybeNumber(s string) bool {
 	return false
 }
 
-func ToDatetime(p Primary) Primary {
+func ToDatetime(p Primary, datetimeFormat []string, location *time.Location) Primary {
 	switch p.(type) {
 	case Integer:
-		dt := time.Unix(p.(Integer).Raw(), 0)
+		dt := time.Unix(p.(Integer).Raw(), 0).In(location)
 		return NewDatetime(dt)
 	case Float:
-		dt := Float64ToTime(p.(Float).Raw())
+		dt := Float64ToTime(p.(Float).Raw(), location)
 		return NewDatetime(dt)
+	case Decimal:
+		d := p.(Decimal)
+		sec := d.Floor(0).Unscaled()
+		nsec := d.Sub(NewDecimal(sec, 0)).Truncate(9).Rescale(9).Unscaled()
+		return NewDatetime(time.Unix(sec.Int64(), nsec.Int64()).In(location))
 	case Datetime:
 		return p
 	case String:
 		s := strings.TrimSpace(p.(String).Raw())
-		if dt, e := StrToTime(s); e == nil {
+		if dt, e := StrToTime(s, datetimeFormat, location); e == nil {
 			return NewDatetime(dt)
 		}
 		if maybeNumber(s) {
 			if i, e := strconv.ParseInt(s, 10, 64); e == nil {
-				dt := time.Unix(i, 0)
+				dt := time.Unix(i, 0).In(location)
 				return NewDatetime(dt)
 			}
 			if f, e := strconv.ParseFloat(s, 64); e == nil {
-				dt := Float64ToTime(f)
+				dt := Float64ToTime(f, location)
 				return NewDatetime(dt)
 			}
 		}
@@ -324,7 +431,7 @@ func ToBoolean(p Primary) Primary {
 	switch p.(type) {
 	case Boolean:
 		return p
-	case String, Integer, Float, Ternary:
+	case String, Integer, Float, Decimal, Ternary:
 		if p.Ternary() != ternary.UNKNOWN {
 			return NewBoolean(p.Ternary().ParseBool())
 		}
@@ -340,6 +447,10 @@ func ToString(p Primary) Primary {
 		return NewString(Int64ToStr(p.(Integer).Raw()))
 	case Float:
 		return NewString(Float64ToStr(p.(Float).Raw()))
+	case Decimal:
+		return NewString(p.(Decimal).String())
+	case Interval:
+		return NewString(p.(Interval).ISO8601())
 	}
 	return NewNull()
 }
@@ -4,157 +4,154 @@ import (
 	"testing"
 	"time"
 
-	"github.com/mithrandie/csvq/lib/cmd"
-
 	"github.com/mithrandie/ternary"
 )
 
 func TestStrToTime(t *testing.T) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{"01/02/2006"}
+	format := []string{"01/02/2006"}
 
 	s := "01/02/2006"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02 15:04:05"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02 15:04:05 -08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02 15:04:05 PST"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02 15:04:05"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02 15:04:05 -08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/11/2 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/01/02 15:04:05 PST"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-1-2 15:04:05"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-1-2"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-1-2 15:04:05 -08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-1-2 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-1-2 15:04:05 PST"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/1/2 15:04:05"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/1/2"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/1/2 15:04:05 -08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/1/2 15:04:05 -0800"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006/1/2 15:04:05 PST"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "02 Jan 06 15:04 PDT"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "02 Jan 06 15:04 -0700"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02T15:04:05-08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02T15:04:05+08:00"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "2006-01-02T15:04:05"
-	if _, err := StrToTime(s); err != nil {
+	if _, err := StrToTime(s, format, time.Local); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
 	s = "e"
-	if _, err := StrToTime(s); err == nil {
+	if _, err := StrToTime(s, format, time.Local); err == nil {
 		t.Errorf("no errors, want error for %q", s)
 	}
 }
@@ -294,6 +291,18 @@ func TestToInteger(t *testing.T) {
 		t.Errorf("primary type = %T, want Null for %#v", i, p)
 	}
 
+	p = NewDecimalFromString("2.00")
+	i = ToInteger(p)
+	if _, ok := i.(Integer); !ok {
+		t.Errorf("primary type = %T, want Integer for %#v", i, p)
+	}
+
+	p = NewDecimalFromString("2.01")
+	i = ToInteger(p)
+	if _, ok := i.(Null); !ok {
+		t.Errorf("primary type = %T, want Null for %#v", i, p)
+	}
+
 	p = NewString("1")
 	i = ToInteger(p)
 	if _, ok := i.(Integer); !ok {
@@ -371,6 +380,12 @@ func TestToFloat(t *testing.T) {
 		t.Errorf("primary type = %T, want Float for %#v", f, p)
 	}
 
+	p = NewDecimalFromString("1.25")
+	f = ToFloat(p)
+	if _, ok := f.(Float); !ok {
+		t.Errorf("primary type = %T, want Float for %#v", f, p)
+	}
+
 	p = NewString("error")
 	f = ToFloat(p)
 	if _, ok := f.(Null); !ok {
@@ -378,68 +393,156 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
-func TestToDatetime(t *testing.T) {
-	flags := cmd.GetFlags()
+var toDecimalTests = []struct {
+	Value  Primary
+	Result string
+}{
+	{
+		Value:  NewInteger(-12),
+		Result: "-12",
+	},
+	{
+		Value:  NewFloat(0.1),
+		Result: "0.1",
+	},
+	{
+		Value:  NewDecimalFromString("1.50"),
+		Result: "1.50",
+	},
+	{
+		Value:  NewString(" 12.340 "),
+		Result: "12.340",
+	},
+	{
+		Value:  NewString("-1.5e-3"),
+		Result: "-0.0015",
+	},
+	{
+		Value:  NewString("2.5E2"),
+		Result: "250",
+	},
+	{
+		Value:  NewString("1.2.3"),
+		Result: "NULL",
+	},
+	{
+		Value:  NewString("2012-01-01"),
+		Result: "NULL",
+	},
+	{
+		Value:  NewBoolean(true),
+		Result: "NULL",
+	},
+}
 
+func TestToDecimal(t *testing.T) {
+	for _, v := range toDecimalTests {
+		result := ToDecimal(v.Value)
+		if result.String() != v.Result {
+			t.Errorf("result = %s, want %s for %#v", result, v.Result, v.Value)
+		}
+	}
+}
+
+var toIntervalTests = []struct {
+	Value  Primary
+	Result string
+}{
+	{
+		Value:  NewInterval(1, 2, 3),
+		Result: "\"P1M2DT0.000000003S\"",
+	},
+	{
+		Value:  NewString("2 weeks"),
+		Result: "\"P14D\"",
+	},
+	{
+		Value:  NewString("1"),
+		Result: "NULL",
+	},
+	{
+		Value:  NewDatetimeFromString("2012-01-01", nil, time.Local),
+		Result: "NULL",
+	},
+}
+
+func TestToInterval(t *testing.T) {
+	for _, v := range toIntervalTests {
+		result := ToInterval(v.Value)
+		if result.String() != v.Result {
+			t.Errorf("result = %s, want %s for %#v", result, v.Result, v.Value)
+		}
+	}
+}
+
+func TestToDatetime(t *testing.T) {
 	var p Primary
 	var dt Primary
 
-	flags.DatetimeFormat = []string{"01022006"}
+	format := []string{"01022006"}
 	p = NewString("02012012")
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	} else {
-		expect := time.Date(2012, 2, 1, 0, 0, 0, 0, cmd.GetLocation())
+		expect := time.Date(2012, 2, 1, 0, 0, 0, 0, time.Local)
 		if !dt.(Datetime).Raw().Equal(expect) {
 			t.Errorf("datetime = %s, want %s for %#v", dt, expect, p)
 		}
 	}
 
 	p = NewInteger(1136181845)
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewFloat(1136181845)
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewFloat(1.123456789123)
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
+	if _, ok := dt.(Datetime); !ok {
+		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
+	}
+
+	p = NewDecimalFromString("-1.5")
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
+	} else if !dt.(Datetime).Raw().Equal(time.Unix(-2, 500000000)) {
+		t.Errorf("datetime = %s, want %s for %#v", dt, time.Unix(-2, 500000000), p)
 	}
 
-	p = NewDatetimeFromString("2006-01-02 15:04:05")
-	dt = ToDatetime(p)
+	p = NewDatetimeFromString("2006-01-02 15:04:05", nil, time.Local)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewString("1136181845")
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewString("1136181845.12345")
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewString("2006-01-02 15:04:05")
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Datetime); !ok {
 		t.Errorf("primary type = %T, want Datetime for %#v", dt, p)
 	}
 
 	p = NewString("error")
-	dt = ToDatetime(p)
+	dt = ToDatetime(p, format, time.Local)
 	if _, ok := dt.(Null); !ok {
 		t.Errorf("primary type = %T, want Null for %#v", dt, p)
 	}
@@ -514,7 +617,15 @@ func TestToString(t *testing.T) {
 		t.Errorf("primary type = %T, want String for %#v", s, p)
 	}
 
-	p = NewDatetimeFromString("2006-01-02 15:04:05")
+	p = NewIntervalFromString("90 minutes")
+	s = ToString(p)
+	if _, ok := s.(String); !ok {
+		t.Errorf("primary type = %T, want String for %#v", s, p)
+	} else if s.(String).Raw() != "PT1H30M" {
+		t.Errorf("string = %q, want %q for %#v", s.(String).Raw(), "PT1H30M", p)
+	}
+
+	p = NewDatetimeFromString("2006-01-02 15:04:05", nil, time.Local)
 	s = ToString(p)
 	if _, ok := s.(Null); !ok {
 		t.Errorf("primary type = %T, want Null for %#v", s, p)
@@ -522,72 +633,65 @@ func TestToString(t *testing.T) {
 }
 
 func BenchmarkStrToTime1(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{"01/02/2006"}
+	format := []string{"01/02/2006"}
 
 	for i := 0; i < b.N; i++ {
 		s := "01/02/2006"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime2(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "2006-01-02T15:04:05-07:00"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime3(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "2006-01-02"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime4(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "2006-01-02 15:04:05"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime5(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "2006-01-02 15:04:05 -0700"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime6(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "02 Jan 06 15:04 PDT"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
 func BenchmarkStrToTime7(b *testing.B) {
-	flags := cmd.GetFlags()
-	flags.DatetimeFormat = []string{}
+	format := []string{}
 
 	for i := 0; i < b.N; i++ {
 		s := "abcdefghijklmnopq"
-		StrToTime(s)
+		StrToTime(s, format, time.Local)
 	}
 }
 
@@ -0,0 +1,191 @@
+package value
+
+import (
+	"math/big"
+)
+
+const DecimalDivisionScale = 16
+
+type roundingMode int
+
+const (
+	roundHalfUp roundingMode = iota
+	roundDown
+	roundFloor
+	roundCeiling
+)
+
+var bigTen = big.NewInt(10)
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) unscaled() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+func (d Decimal) Sign() int {
+	return d.unscaled().Sign()
+}
+
+func (d Decimal) IsInteger() bool {
+	if d.scale < 1 || d.Sign() == 0 {
+		return true
+	}
+	return new(big.Int).Rem(d.unscaled(), pow10(d.scale)).Sign() == 0
+}
+
+func (d Decimal) rescaledValue(scale int) *big.Int {
+	if scale <= d.scale {
+		return new(big.Int).Set(d.unscaled())
+	}
+	return new(big.Int).Mul(d.unscaled(), pow10(scale-d.scale))
+}
+
+func alignScale(d1 Decimal, d2 Decimal) (*big.Int, *big.Int, int) {
+	scale := d1.scale
+	if scale < d2.scale {
+		scale = d2.scale
+	}
+	return d1.rescaledValue(scale), d2.rescaledValue(scale), scale
+}
+
+func (d Decimal) Cmp(d2 Decimal) int {
+	v1, v2, _ := alignScale(d, d2)
+	return v1.Cmp(v2)
+}
+
+func (d Decimal) Add(d2 Decimal) Decimal {
+	v1, v2, scale := alignScale(d, d2)
+	return NewDecimal(v1.Add(v1, v2), scale)
+}
+
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	v1, v2, scale := alignScale(d, d2)
+	return NewDecimal(v1.Sub(v1, v2), scale)
+}
+
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return NewDecimal(new(big.Int).Mul(d.unscaled(), d2.unscaled()), d.scale+d2.scale)
+}
+
+// Div returns the quotient rounded half away from zero at DecimalDivisionScale places.
+// Trailing zeros are removed as long as the scale of the operands is kept.
+// The second return value is false if d2 is zero.
+func (d Decimal) Div(d2 Decimal) (Decimal, bool) {
+	if d2.Sign() == 0 {
+		return Decimal{}, false
+	}
+
+	minScale := d.scale
+	if minScale < d2.scale {
+		minScale = d2.scale
+	}
+	scale := DecimalDivisionScale
+	if scale < minScale {
+		scale = minScale
+	}
+
+	dividend := new(big.Int).Mul(d.unscaled(), pow10(scale+d2.scale))
+	divisor := new(big.Int).Mul(d2.unscaled(), pow10(d.scale))
+	q, r := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	if 0 <= new(big.Int).Abs(new(big.Int).Mul(r, big.NewInt(2))).Cmp(new(big.Int).Abs(divisor)) {
+		if dividend.Sign() == divisor.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return NewDecimal(q, scale).Trim(minScale), true
+}
+
+// Mod returns the remainder truncated toward zero.
+// The second return value is false if d2 is zero.
+func (d Decimal) Mod(d2 Decimal) (Decimal, bool) {
+	if d2.Sign() == 0 {
+		return Decimal{}, false
+	}
+	v1, v2, scale := alignScale(d, d2)
+	return NewDecimal(v1.Rem(v1, v2), scale), true
+}
+
+func (d Decimal) Neg() Decimal {
+	return NewDecimal(new(big.Int).Neg(d.unscaled()), d.scale)
+}
+
+func (d Decimal) Abs() Decimal {
+	return NewDecimal(new(big.Int).Abs(d.unscaled()), d.scale)
+}
+
+// Trim removes trailing zeros in the fractional part, keeping at least minScale places.
+func (d Decimal) Trim(minScale int) Decimal {
+	v := new(big.Int).Set(d.unscaled())
+	scale := d.scale
+	r := new(big.Int)
+	for minScale < scale {
+		q, m := new(big.Int).QuoRem(v, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		v = q
+		scale--
+	}
+	return NewDecimal(v, scale)
+}
+
+func (d Decimal) quantize(places int, mode roundingMode) Decimal {
+	if d.scale <= places {
+		return d
+	}
+
+	divisor := pow10(d.scale - places)
+	q, r := new(big.Int).QuoRem(d.unscaled(), divisor, new(big.Int))
+	if r.Sign() != 0 {
+		switch mode {
+		case roundHalfUp:
+			if 0 <= new(big.Int).Abs(new(big.Int).Mul(r, big.NewInt(2))).Cmp(divisor) {
+				q.Add(q, big.NewInt(int64(r.Sign())))
+			}
+		case roundFloor:
+			if r.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			}
+		case roundCeiling:
+			if 0 < r.Sign() {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	return NewDecimal(q, places)
+}
+
+func (d Decimal) Round(places int) Decimal {
+	return d.quantize(places, roundHalfUp)
+}
+
+func (d Decimal) Truncate(places int) Decimal {
+	return d.quantize(places, roundDown)
+}
+
+func (d Decimal) Floor(places int) Decimal {
+	return d.quantize(places, roundFloor)
+}
+
+func (d Decimal) Ceil(places int) Decimal {
+	return d.quantize(places, roundCeiling)
+}
+
+// Rescale returns the decimal rounded or padded to exactly scale places.
+func (d Decimal) Rescale(scale int) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if scale < d.scale {
+		return d.Round(scale)
+	}
+	return NewDecimal(d.rescaledValue(scale), scale)
+}
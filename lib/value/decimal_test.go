@@ -0,0 +1,160 @@
+package value
+
+import (
+	"testing"
+)
+
+var strToDecimalTests = []struct {
+	String string
+	Result string
+	Error  bool
+}{
+	{
+		String: "12.30",
+		Result: "12.30",
+	},
+	{
+		String: " -0.05 ",
+		Result: "-0.05",
+	},
+	{
+		String: "+.5",
+		Result: "0.5",
+	},
+	{
+		String: "5.",
+		Result: "5",
+	},
+	{
+		String: "1.25e-4",
+		Result: "0.000125",
+	},
+	{
+		String: "123456789012345678901234567890.123",
+		Result: "123456789012345678901234567890.123",
+	},
+	{
+		String: ".",
+		Error:  true,
+	},
+	{
+		String: "1e",
+		Error:  true,
+	},
+	{
+		String: "1e9999",
+		Error:  true,
+	},
+	{
+		String: "abc",
+		Error:  true,
+	},
+}
+
+func TestStrToDecimal(t *testing.T) {
+	for _, v := range strToDecimalTests {
+		d, err := StrToDecimal(v.String)
+		if err != nil {
+			if !v.Error {
+				t.Errorf("unexpected error %q for %q", err, v.String)
+			}
+			continue
+		}
+		if v.Error {
+			t.Errorf("no error, want error for %q", v.String)
+			continue
+		}
+		if d.String() != v.Result {
+			t.Errorf("result = %s, want %s for %q", d.String(), v.Result, v.String)
+		}
+	}
+}
+
+var decimalOperationTests = []struct {
+	Name   string
+	Result Decimal
+	Expect string
+}{
+	{
+		Name:   "Add",
+		Result: NewDecimalFromString("0.1").Add(NewDecimalFromString("0.20")),
+		Expect: "0.30",
+	},
+	{
+		Name:   "Sub",
+		Result: NewDecimalFromString("1").Sub(NewDecimalFromString("1.01")),
+		Expect: "-0.01",
+	},
+	{
+		Name:   "Mul",
+		Result: NewDecimalFromString("1.10").Mul(NewDecimalFromString("-3")),
+		Expect: "-3.30",
+	},
+	{
+		Name:   "Neg",
+		Result: NewDecimalFromString("1.10").Neg(),
+		Expect: "-1.10",
+	},
+	{
+		Name:   "Round Half Away from Zero",
+		Result: NewDecimalFromString("-2.5").Round(0),
+		Expect: "-3",
+	},
+	{
+		Name:   "Round Negative Places",
+		Result: NewDecimalFromString("1250.5").Round(-2),
+		Expect: "1300",
+	},
+	{
+		Name:   "Truncate",
+		Result: NewDecimalFromString("-2.59").Truncate(1),
+		Expect: "-2.5",
+	},
+	{
+		Name:   "Floor",
+		Result: NewDecimalFromString("-2.51").Floor(1),
+		Expect: "-2.6",
+	},
+	{
+		Name:   "Ceil",
+		Result: NewDecimalFromString("2.51").Ceil(1),
+		Expect: "2.6",
+	},
+	{
+		Name:   "Rescale",
+		Result: NewDecimalFromString("2.5").Rescale(3),
+		Expect: "2.500",
+	},
+	{
+		Name:   "Trim",
+		Result: NewDecimalFromString("2.5000").Trim(2),
+		Expect: "2.50",
+	},
+}
+
+func TestDecimal_Operations(t *testing.T) {
+	for _, v := range decimalOperationTests {
+		if v.Result.String() != v.Expect {
+			t.Errorf("%s: result = %s, want %s", v.Name, v.Result.String(), v.Expect)
+		}
+	}
+}
+
+func TestDecimal_Div(t *testing.T) {
+	d, ok := NewDecimalFromString("2.00").Div(NewDecimalFromString("3"))
+	if !ok {
+		t.Fatalf("division failed")
+	}
+	if d.String() != "0.6666666666666667" {
+		t.Errorf("result = %s, want %s", d.String(), "0.6666666666666667")
+	}
+
+	d, _ = NewDecimalFromString("7.50").Div(NewDecimalFromString("2.5"))
+	if d.String() != "3.00" {
+		t.Errorf("result = %s, want %s", d.String(), "3.00")
+	}
+
+	if _, ok = NewDecimalFromString("1").Div(NewDecimalFromString("0.00")); ok {
+		t.Errorf("division by zero succeeded")
+	}
+}
@@ -0,0 +1,302 @@
+package value
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DaysPerMonth = 30
+	NanosPerDay  = int64(24 * time.Hour)
+)
+
+var iso8601DurationExp = regexp.MustCompile(`^([+-])?P(?:([+-]?\d+)Y)?(?:([+-]?\d+)M)?(?:([+-]?\d+)W)?(?:([+-]?\d+)D)?(?:T(?:([+-]?\d+)H)?(?:([+-]?\d+)M)?(?:([+-]?\d+(?:[.,]\d+)?)S)?)?$`)
+var intervalItemExp = regexp.MustCompile(`^([+-]?\d+(?:\.\d+)?)\s*([a-zA-Z]+)`)
+var intervalClockExp = regexp.MustCompile(`^([+-])?(\d+):(\d{1,2})(?::(\d{1,2}(?:\.\d+)?))?`)
+
+type intervalUnit struct {
+	months int64
+	days   int64
+	nanos  int64
+}
+
+var intervalUnits = map[string]intervalUnit{
+	"Y":            {months: 12},
+	"YR":           {months: 12},
+	"YRS":          {months: 12},
+	"YEAR":         {months: 12},
+	"YEARS":        {months: 12},
+	"MON":          {months: 1},
+	"MONS":         {months: 1},
+	"MONTH":        {months: 1},
+	"MONTHS":       {months: 1},
+	"W":            {days: 7},
+	"WEEK":         {days: 7},
+	"WEEKS":        {days: 7},
+	"D":            {days: 1},
+	"DAY":          {days: 1},
+	"DAYS":         {days: 1},
+	"H":            {nanos: int64(time.Hour)},
+	"HR":           {nanos: int64(time.Hour)},
+	"HRS":          {nanos: int64(time.Hour)},
+	"HOUR":         {nanos: int64(time.Hour)},
+	"HOURS":        {nanos: int64(time.Hour)},
+	"MIN":          {nanos: int64(time.Minute)},
+	"MINS":         {nanos: int64(time.Minute)},
+	"MINUTE":       {nanos: int64(time.Minute)},
+	"MINUTES":      {nanos: int64(time.Minute)},
+	"S":            {nanos: int64(time.Second)},
+	"SEC":          {nanos: int64(time.Second)},
+	"SECS":         {nanos: int64(time.Second)},
+	"SECOND":       {nanos: int64(time.Second)},
+	"SECONDS":      {nanos: int64(time.Second)},
+	"MS":           {nanos: int64(time.Millisecond)},
+	"MILLISECOND":  {nanos: int64(time.Millisecond)},
+	"MILLISECONDS": {nanos: int64(time.Millisecond)},
+	"US":           {nanos: int64(time.Microsecond)},
+	"MICROSECOND":  {nanos: int64(time.Microsecond)},
+	"MICROSECONDS": {nanos: int64(time.Microsecond)},
+	"NS":           {nanos: 1},
+	"NANOSECOND":   {nanos: 1},
+	"NANOSECONDS":  {nanos: 1},
+}
+
+// StrToInterval parses an ISO 8601 duration such as "P1DT2H",
+// or a list of quantities such as "1 day 2 hours" and "1 day 02:00:00".
+func StrToInterval(s string) (Interval, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return Interval{}, errors.New("conversion failed")
+	}
+
+	if iv, ok := parseISO8601Duration(s); ok {
+		return iv, nil
+	}
+	return parseIntervalItems(s)
+}
+
+func parseISO8601Duration(s string) (Interval, bool) {
+	m := iso8601DurationExp.FindStringSubmatch(strings.ToUpper(s))
+	if m == nil || strings.HasSuffix(m[0], "P") || strings.HasSuffix(m[0], "T") {
+		return Interval{}, false
+	}
+
+	atoi := func(s string) int64 {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		return i
+	}
+
+	iv := Interval{
+		months: atoi(m[2])*12 + atoi(m[3]),
+		days:   atoi(m[4])*7 + atoi(m[5]),
+		nanos:  atoi(m[6])*int64(time.Hour) + atoi(m[7])*int64(time.Minute),
+	}
+	if 0 < len(m[8]) {
+		sec, _ := strconv.ParseFloat(strings.Replace(m[8], ",", ".", 1), 64)
+		iv.nanos = iv.nanos + int64(math.Round(sec*float64(time.Second)))
+	}
+	if m[1] == "-" {
+		iv = iv.Neg()
+	}
+	return iv, true
+}
+
+func parseIntervalItems(s string) (Interval, error) {
+	var iv Interval
+
+	for 0 < len(s) {
+		if m := intervalClockExp.FindStringSubmatch(s); m != nil {
+			h, _ := strconv.ParseInt(m[2], 10, 64)
+			mi, _ := strconv.ParseInt(m[3], 10, 64)
+			var sec float64
+			if 0 < len(m[4]) {
+				sec, _ = strconv.ParseFloat(m[4], 64)
+			}
+			nanos := h*int64(time.Hour) + mi*int64(time.Minute) + int64(math.Round(sec*float64(time.Second)))
+			if m[1] == "-" {
+				nanos = -nanos
+			}
+			iv.nanos = iv.nanos + nanos
+			s = s[len(m[0]):]
+		} else if m := intervalItemExp.FindStringSubmatch(s); m != nil {
+			unit, ok := intervalUnits[strings.ToUpper(m[2])]
+			if !ok {
+				return Interval{}, errors.New("conversion failed")
+			}
+			f, err := strconv.ParseFloat(m[1], 64)
+			if err != nil {
+				return Interval{}, errors.New("conversion failed")
+			}
+			iv = iv.Add(intervalUnitMul(unit, f))
+			s = s[len(m[0]):]
+		} else {
+			return Interval{}, errors.New("conversion failed")
+		}
+
+		s = strings.TrimLeft(s, " \t,")
+	}
+	return iv, nil
+}
+
+func intervalUnitMul(unit intervalUnit, f float64) Interval {
+	if f == math.Trunc(f) {
+		i := int64(f)
+		return Interval{months: unit.months * i, days: unit.days * i, nanos: unit.nanos * i}
+	}
+	return Interval{months: unit.months, days: unit.days, nanos: unit.nanos}.Mul(f)
+}
+
+// IntervalBetween returns the interval from t2 to t1.
+// The difference is expressed in days and the remaining time.
+func IntervalBetween(t1 time.Time, t2 time.Time) Interval {
+	d := int64(t1.Sub(t2))
+	return Interval{
+		days:  d / NanosPerDay,
+		nanos: d % NanosPerDay,
+	}
+}
+
+func (iv Interval) IsZero() bool {
+	return iv.months == 0 && iv.days == 0 && iv.nanos == 0
+}
+
+func (iv Interval) Add(iv2 Interval) Interval {
+	return Interval{
+		months: iv.months + iv2.months,
+		days:   iv.days + iv2.days,
+		nanos:  iv.nanos + iv2.nanos,
+	}
+}
+
+func (iv Interval) Sub(iv2 Interval) Interval {
+	return iv.Add(iv2.Neg())
+}
+
+func (iv Interval) Neg() Interval {
+	return Interval{
+		months: -iv.months,
+		days:   -iv.days,
+		nanos:  -iv.nanos,
+	}
+}
+
+// Mul multiplies each unit of the interval by f.
+// Fractions of months are carried into days as 30 days per month, and fractions of days into time.
+func (iv Interval) Mul(f float64) Interval {
+	if f == math.Trunc(f) && math.Abs(f) < math.MaxInt32 {
+		i := int64(f)
+		return Interval{months: iv.months * i, days: iv.days * i, nanos: iv.nanos * i}
+	}
+
+	months := float64(iv.months) * f
+	wholeMonths := math.Trunc(months)
+	days := float64(iv.days)*f + (months-wholeMonths)*DaysPerMonth
+	wholeDays := math.Trunc(days)
+	nanos := float64(iv.nanos)*f + (days-wholeDays)*float64(NanosPerDay)
+
+	return Interval{
+		months: int64(wholeMonths),
+		days:   int64(wholeDays),
+		nanos:  int64(math.Round(nanos)),
+	}
+}
+
+// Div divides the interval by f. The second return value is false if f is zero.
+func (iv Interval) Div(f float64) (Interval, bool) {
+	if f == 0 {
+		return Interval{}, false
+	}
+
+	months := float64(iv.months) / f
+	wholeMonths := math.Trunc(months)
+	days := float64(iv.days)/f + (months-wholeMonths)*DaysPerMonth
+	wholeDays := math.Trunc(days)
+
+	nanos := new(big.Float).SetInt64(iv.nanos)
+	nanos.Quo(nanos, big.NewFloat(f))
+	nanos.Add(nanos, big.NewFloat((days-wholeDays)*float64(NanosPerDay)))
+	n, _ := nanos.Float64()
+
+	return Interval{
+		months: int64(wholeMonths),
+		days:   int64(wholeDays),
+		nanos:  int64(math.Round(n)),
+	}, true
+}
+
+// AddTo returns the time t added by the interval.
+// If the day of t does not exist in the resulting month, then the last day of the month is used.
+func (iv Interval) AddTo(t time.Time) time.Time {
+	if iv.months != 0 {
+		year, month, day := t.Date()
+		first := time.Date(year, month+time.Month(iv.months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if lastDay := first.AddDate(0, 1, -1).Day(); lastDay < day {
+			day = lastDay
+		}
+		t = first.AddDate(0, 0, day-1)
+	}
+	return t.AddDate(0, 0, int(iv.days)).Add(time.Duration(iv.nanos))
+}
+
+// TotalNanoseconds returns the length of the interval assuming that a month has 30 days and a day has 24 hours.
+func (iv Interval) TotalNanoseconds() *big.Int {
+	total := big.NewInt(iv.months*DaysPerMonth + iv.days)
+	total.Mul(total, big.NewInt(NanosPerDay))
+	return total.Add(total, big.NewInt(iv.nanos))
+}
+
+func (iv Interval) Cmp(iv2 Interval) int {
+	return iv.TotalNanoseconds().Cmp(iv2.TotalNanoseconds())
+}
+
+// ISO8601 returns the interval formatted as an ISO 8601 duration.
+func (iv Interval) ISO8601() string {
+	if iv.IsZero() {
+		return "PT0S"
+	}
+
+	var buf bytes.Buffer
+	if iv.months <= 0 && iv.days <= 0 && iv.nanos <= 0 {
+		buf.WriteByte('-')
+		iv = iv.Neg()
+	}
+	buf.WriteByte('P')
+
+	writeItem := func(i int64, designator byte) {
+		if i != 0 {
+			buf.WriteString(strconv.FormatInt(i, 10))
+			buf.WriteByte(designator)
+		}
+	}
+
+	writeItem(iv.months/12, 'Y')
+	writeItem(iv.months%12, 'M')
+	writeItem(iv.days, 'D')
+
+	if iv.nanos != 0 {
+		buf.WriteByte('T')
+		writeItem(iv.nanos/int64(time.Hour), 'H')
+		writeItem(iv.nanos%int64(time.Hour)/int64(time.Minute), 'M')
+
+		nanos := iv.nanos % int64(time.Minute)
+		if nanos != 0 {
+			if nanos < 0 {
+				buf.WriteByte('-')
+				nanos = -nanos
+			}
+			buf.WriteString(strconv.FormatInt(nanos/int64(time.Second), 10))
+			if frac := nanos % int64(time.Second); frac != 0 {
+				buf.WriteByte('.')
+				buf.WriteString(strings.TrimRight(strconv.FormatInt(frac+int64(time.Second), 10)[1:], "0"))
+			}
+			buf.WriteByte('S')
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,208 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+var strToIntervalTests = []struct {
+	String string
+	Result Interval
+	Error  bool
+}{
+	{
+		String: "P1Y2M3W4DT5H6M7.5S",
+		Result: NewInterval(14, 25, int64(5*time.Hour+6*time.Minute+7500*time.Millisecond)),
+	},
+	{
+		String: "-p1dt1h",
+		Result: NewInterval(0, -1, -int64(time.Hour)),
+	},
+	{
+		String: "P1M-3D",
+		Result: NewInterval(1, -3, 0),
+	},
+	{
+		String: "3 days 2 hours",
+		Result: NewInterval(0, 3, int64(2*time.Hour)),
+	},
+	{
+		String: "1 year, -1 mon 30min 15s 250ms",
+		Result: NewInterval(11, 0, int64(30*time.Minute+15*time.Second+250*time.Millisecond)),
+	},
+	{
+		String: "1.5 months",
+		Result: NewInterval(1, 15, 0),
+	},
+	{
+		String: "0.5 day",
+		Result: NewInterval(0, 0, int64(12*time.Hour)),
+	},
+	{
+		String: "1 day 02:30:00.5",
+		Result: NewInterval(0, 1, int64(2*time.Hour+30*time.Minute+500*time.Millisecond)),
+	},
+	{
+		String: "-1:30",
+		Result: NewInterval(0, 0, -int64(90*time.Minute)),
+	},
+	{
+		String: "P",
+		Error:  true,
+	},
+	{
+		String: "PT",
+		Error:  true,
+	},
+	{
+		String: "3 fortnights",
+		Error:  true,
+	},
+	{
+		String: "day",
+		Error:  true,
+	},
+	{
+		String: "",
+		Error:  true,
+	},
+}
+
+func TestStrToInterval(t *testing.T) {
+	for _, v := range strToIntervalTests {
+		iv, err := StrToInterval(v.String)
+		if err != nil {
+			if !v.Error {
+				t.Errorf("unexpected error %q for %q", err, v.String)
+			}
+			continue
+		}
+		if v.Error {
+			t.Errorf("no error, want error for %q", v.String)
+			continue
+		}
+		if iv != v.Result {
+			t.Errorf("result = %#v, want %#v for %q", iv, v.Result, v.String)
+		}
+	}
+}
+
+var intervalISO8601Tests = []struct {
+	Interval Interval
+	Expect   string
+}{
+	{
+		Interval: NewInterval(0, 0, 0),
+		Expect:   "PT0S",
+	},
+	{
+		Interval: NewInterval(26, 3, int64(25*time.Hour+1*time.Second+10*time.Millisecond)),
+		Expect:   "P2Y2M3DT25H1.01S",
+	},
+	{
+		Interval: NewInterval(0, -1, -int64(90*time.Second)),
+		Expect:   "-P1DT1M30S",
+	},
+	{
+		Interval: NewInterval(1, -3, 0),
+		Expect:   "P1M-3D",
+	},
+}
+
+func TestInterval_ISO8601(t *testing.T) {
+	for _, v := range intervalISO8601Tests {
+		s := v.Interval.ISO8601()
+		if s != v.Expect {
+			t.Errorf("result = %s, want %s for %#v", s, v.Expect, v.Interval)
+			continue
+		}
+		iv, err := StrToInterval(s)
+		if err != nil || iv != v.Interval {
+			t.Errorf("round trip = %#v, want %#v for %s", iv, v.Interval, s)
+		}
+	}
+}
+
+var intervalOperationTests = []struct {
+	Name   string
+	Result Interval
+	Expect Interval
+}{
+	{
+		Name:   "Add",
+		Result: NewInterval(1, 2, 3).Add(NewInterval(1, -1, 1)),
+		Expect: NewInterval(2, 1, 4),
+	},
+	{
+		Name:   "Sub",
+		Result: NewInterval(1, 2, 3).Sub(NewInterval(1, -1, 1)),
+		Expect: NewInterval(0, 3, 2),
+	},
+	{
+		Name:   "Mul",
+		Result: NewInterval(1, 2, 3).Mul(3),
+		Expect: NewInterval(3, 6, 9),
+	},
+	{
+		Name:   "Mul Fraction",
+		Result: NewInterval(1, 1, 0).Mul(1.5),
+		Expect: NewInterval(1, 16, int64(12*time.Hour)),
+	},
+}
+
+func TestInterval_Operations(t *testing.T) {
+	for _, v := range intervalOperationTests {
+		if v.Result != v.Expect {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, v.Result, v.Expect)
+		}
+	}
+}
+
+func TestInterval_Div(t *testing.T) {
+	iv, ok := NewInterval(1, 1, 0).Div(2)
+	if !ok {
+		t.Fatalf("division failed")
+	}
+	if expect := NewInterval(0, 15, int64(12*time.Hour)); iv != expect {
+		t.Errorf("result = %#v, want %#v", iv, expect)
+	}
+
+	if _, ok = NewInterval(1, 1, 0).Div(0); ok {
+		t.Errorf("division by zero succeeded")
+	}
+}
+
+func TestInterval_AddTo(t *testing.T) {
+	base := time.Date(2012, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	result := NewInterval(1, 1, int64(time.Hour)).AddTo(base)
+	if expect := time.Date(2012, 3, 1, 11, 0, 0, 0, time.UTC); !result.Equal(expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+
+	result = NewInterval(-2, 0, 0).AddTo(base)
+	if expect := time.Date(2011, 11, 30, 10, 0, 0, 0, time.UTC); !result.Equal(expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+}
+
+func TestIntervalBetween(t *testing.T) {
+	t1 := time.Date(2012, 1, 3, 12, 30, 0, 0, time.UTC)
+	t2 := time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if iv := IntervalBetween(t1, t2); iv != NewInterval(0, 2, int64(12*time.Hour+30*time.Minute)) {
+		t.Errorf("result = %#v, want %s", iv, "P2DT12H30M")
+	}
+	if iv := IntervalBetween(t2, t1); iv != NewInterval(0, -2, -int64(12*time.Hour+30*time.Minute)) {
+		t.Errorf("result = %#v, want %s", iv, "-P2DT12H30M")
+	}
+}
+
+func TestInterval_Cmp(t *testing.T) {
+	if r := NewInterval(1, 0, 0).Cmp(NewInterval(0, 30, 0)); r != 0 {
+		t.Errorf("result = %d, want %d", r, 0)
+	}
+	if r := NewInterval(0, 1, 1).Cmp(NewInterval(0, 0, NanosPerDay)); r != 1 {
+		t.Errorf("result = %d, want %d", r, 1)
+	}
+}
@@ -2,6 +2,7 @@ package value
 
 import (
 	"github.com/mithrandie/csvq/lib/cmd"
+	"math/big"
 	"strconv"
 	"strings"
 	"time"
@@ -119,6 +120,76 @@ func (f Float) Ternary() ternary.Value {
 	}
 }
 
+type Decimal struct {
+	value *big.Int
+	scale int
+}
+
+func NewDecimalFromString(s string) Decimal {
+	d, _ := StrToDecimal(s)
+	return d
+}
+
+func NewDecimal(unscaled *big.Int, scale int) Decimal {
+	i := new(big.Int).SetBytes(unscaled.Bytes())
+	if unscaled.Sign() < 0 {
+		i.Neg(i)
+	}
+	if scale < 0 {
+		i.Mul(i, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{
+		value: i,
+		scale: scale,
+	}
+}
+
+func (d Decimal) String() string {
+	if d.value == nil {
+		return "0"
+	}
+
+	s := new(big.Int).Abs(d.value).String()
+	if 0 < d.scale {
+		if len(s) <= d.scale {
+			s = strings.Repeat("0", d.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if d.value.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+func (d Decimal) Unscaled() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.value)
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) Ternary() ternary.Value {
+	switch {
+	case d.Sign() == 0:
+		return ternary.FALSE
+	case d.Cmp(NewDecimal(big.NewInt(1), 0)) == 0:
+		return ternary.TRUE
+	default:
+		return ternary.UNKNOWN
+	}
+}
+
 type Boolean struct {
 	value bool
 }
@@ -170,8 +241,8 @@ type Datetime struct {
 	value time.Time
 }
 
-func NewDatetimeFromString(s string) Datetime {
-	t, _ := StrToTime(s)
+func NewDatetimeFromString(s string, datetimeFormat []string, location *time.Location) Datetime {
+	t, _ := StrToTime(s, datetimeFormat, location)
 	return Datetime{
 		value: t,
 	}
@@ -199,6 +270,45 @@ func (dt Datetime) Format(s string) string {
 	return dt.value.Format(s)
 }
 
+type Interval struct {
+	months int64
+	days   int64
+	nanos  int64
+}
+
+func NewIntervalFromString(s string) Interval {
+	iv, _ := StrToInterval(s)
+	return iv
+}
+
+func NewInterval(months int64, days int64, nanos int64) Interval {
+	return Interval{
+		months: months,
+		days:   days,
+		nanos:  nanos,
+	}
+}
+
+func (iv Interval) String() string {
+	return cmd.QuoteString(iv.ISO8601())
+}
+
+func (iv Interval) Months() int64 {
+	return iv.months
+}
+
+func (iv Interval) Days() int64 {
+	return iv.days
+}
+
+func (iv Interval) Nanoseconds() int64 {
+	return iv.nanos
+}
+
+func (iv Interval) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
 type Null struct{}
 
 func NewNull() Null {
@@ -121,6 +121,44 @@ func TestFloat_Ternary(t *testing.T) {
 	}
 }
 
+func TestDecimal_String(t *testing.T) {
+	s := "-0.050"
+	p := NewDecimalFromString("-.050")
+	if p.String() != s {
+		t.Errorf("string = %q, want %q for %#v", p.String(), s, p)
+	}
+}
+
+func TestDecimal_Ternary(t *testing.T) {
+	p := NewDecimalFromString("1.00")
+	if p.Ternary() != ternary.TRUE {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.TRUE, p)
+	}
+	p = NewDecimalFromString("0.0")
+	if p.Ternary() != ternary.FALSE {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.FALSE, p)
+	}
+	p = NewDecimalFromString("1.5")
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
+func TestInterval_String(t *testing.T) {
+	s := "\"P1DT2H\""
+	p := NewIntervalFromString("1 day 2 hours")
+	if p.String() != s {
+		t.Errorf("string = %q, want %q for %#v", p.String(), s, p)
+	}
+}
+
+func TestInterval_Ternary(t *testing.T) {
+	p := NewIntervalFromString("1 day")
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
 func TestBoolean_String(t *testing.T) {
 	s := "true"
 	p := NewBoolean(true)
@@ -160,7 +198,7 @@ func TestTernary_Ternary(t *testing.T) {
 
 func TestDatetime_String(t *testing.T) {
 	s := "2012-01-01T12:34:56Z"
-	p := NewDatetimeFromString(s)
+	p := NewDatetimeFromString(s, nil, time.Local)
 
 	expect := "\"" + s + "\""
 	if p.String() != expect {
@@ -169,14 +207,14 @@ func TestDatetime_String(t *testing.T) {
 }
 
 func TestDatetime_Value(t *testing.T) {
-	d := NewDatetimeFromString("2012-01-01 12:34:56")
+	d := NewDatetimeFromString("2012-01-01 12:34:56", nil, time.Local)
 	expect := time.Date(2012, time.January, 1, 12, 34, 56, 0, time.Local)
 
 	if d.Raw() != expect {
 		t.Errorf("value = %v, want %v for %#v", d.Raw(), expect, d)
 	}
 
-	d = NewDatetimeFromString("2012-01-01T12:34:56-08:00")
+	d = NewDatetimeFromString("2012-01-01T12:34:56-08:00", nil, time.Local)
 	l, _ := time.LoadLocation("America/Los_Angeles")
 	expect = time.Date(2012, time.January, 1, 12, 34, 56, 0, l)
 
@@ -186,7 +224,7 @@ func TestDatetime_Value(t *testing.T) {
 }
 
 func TestDatetime_Ternary(t *testing.T) {
-	p := NewDatetimeFromString("2012-01-01T12:34:56-08:00")
+	p := NewDatetimeFromString("2012-01-01T12:34:56-08:00", nil, time.Local)
 	if p.Ternary() != ternary.UNKNOWN {
 		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
 	}
@@ -194,7 +232,7 @@ func TestDatetime_Ternary(t *testing.T) {
 
 func TestDatetime_Format(t *testing.T) {
 	dtstring := "2012-08-01T04:03:05.123-08:00"
-	dt := NewDatetimeFromString(dtstring)
+	dt := NewDatetimeFromString(dtstring, nil, time.Local)
 	expect := "2012-08-01T04:03:05-08:00"
 	if dt.Format(time.RFC3339) != expect {
 		t.Errorf("result = %q, want %q for %q ", dt.Format(time.RFC3339), expect, dtstring)
@@ -0,0 +1,186 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxColumns = 16384
+const maxRows = 1048576
+
+type CellRange struct {
+	FirstColumn int
+	FirstRow    int
+	LastColumn  int
+	LastRow     int
+}
+
+func (r CellRange) ContainsColumn(col int) bool {
+	return r.FirstColumn <= col && (r.LastColumn < 0 || col <= r.LastColumn)
+}
+
+func (r CellRange) ContainsRow(row int) bool {
+	return r.FirstRow <= row && (r.LastRow < 0 || row <= r.LastRow)
+}
+
+func ParseCellRange(s string) (CellRange, error) {
+	r := CellRange{
+		FirstColumn: 0,
+		FirstRow:    0,
+		LastColumn:  -1,
+		LastRow:     -1,
+	}
+
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return r, nil
+	}
+
+	cells := strings.Split(s, ":")
+	if 2 < len(cells) {
+		return r, errors.New(fmt.Sprintf("cell range %q is invalid", s))
+	}
+
+	col, row, err := ParseCellReference(cells[0])
+	if err != nil || col < 0 || row < 0 {
+		return r, errors.New(fmt.Sprintf("cell range %q is invalid", s))
+	}
+	r.FirstColumn, r.FirstRow = col, row
+
+	if len(cells) == 2 {
+		col, row, err = ParseCellReference(cells[1])
+		if err != nil || col < 0 {
+			return r, errors.New(fmt.Sprintf("cell range %q is invalid", s))
+		}
+		if col < r.FirstColumn || (-1 < row && row < r.FirstRow) {
+			return r, errors.New(fmt.Sprintf("cell range %q is invalid", s))
+		}
+		r.LastColumn, r.LastRow = col, row
+	}
+
+	return r, nil
+}
+
+func ParseCellReference(s string) (int, int, error) {
+	s = strings.ToUpper(strings.TrimSpace(strings.Replace(s, "$", "", -1)))
+
+	pos := 0
+	col := 0
+	for pos < len(s) && 'A' <= s[pos] && s[pos] <= 'Z' {
+		col = col*26 + int(s[pos]-'A') + 1
+		if maxColumns < col {
+			return -1, -1, errors.New(fmt.Sprintf("cell reference %q is invalid", s))
+		}
+		pos++
+	}
+	col = col - 1
+
+	row := -1
+	if pos < len(s) {
+		i, err := strconv.Atoi(s[pos:])
+		if err != nil || i < 1 || maxRows < i {
+			return -1, -1, errors.New(fmt.Sprintf("cell reference %q is invalid", s))
+		}
+		row = i - 1
+	}
+
+	if col < 0 && row < 0 {
+		return -1, -1, errors.New(fmt.Sprintf("cell reference %q is invalid", s))
+	}
+	return col, row, nil
+}
+
+func ColumnName(col int) string {
+	buf := make([]byte, 0, 3)
+	for col = col + 1; 0 < col; col = (col - 1) / 26 {
+		buf = append(buf, byte('A'+(col-1)%26))
+	}
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return string(buf)
+}
+
+func CellReference(col int, row int) string {
+	return ColumnName(col) + strconv.Itoa(row+1)
+}
+
+func baseDate(date1904 bool, loc *time.Location) time.Time {
+	if date1904 {
+		return time.Date(1904, 1, 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(1899, 12, 30, 0, 0, 0, 0, loc)
+}
+
+func SerialToTime(serial float64, date1904 bool, loc *time.Location) time.Time {
+	if !date1904 && serial < 60 {
+		serial = serial + 1
+	}
+
+	days := math.Floor(serial)
+	msec := math.Round((serial - days) * 86400000)
+	return baseDate(date1904, loc).AddDate(0, 0, int(days)).Add(time.Duration(msec) * time.Millisecond)
+}
+
+func TimeToSerial(t time.Time, date1904 bool) (float64, bool) {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	base := baseDate(date1904, time.UTC)
+
+	days := math.Floor(wall.Sub(base).Hours() / 24)
+	date := base.AddDate(0, 0, int(days))
+	serial := days + float64(wall.Sub(date))/float64(24*time.Hour)
+
+	if !date1904 && serial < 61 {
+		serial = serial - 1
+	}
+	if serial < 0 {
+		return 0, false
+	}
+	return serial, true
+}
+
+func isDateFormatCode(code string) bool {
+	var buf strings.Builder
+
+	inQuote := false
+	inBracket := false
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case inQuote:
+			if c == '"' {
+				inQuote = false
+			}
+		case inBracket:
+			if c == ']' {
+				inBracket = false
+			} else if c == 'h' || c == 'H' || c == 'm' || c == 'M' || c == 's' || c == 'S' {
+				buf.WriteByte(c)
+			}
+		case c == '"':
+			inQuote = true
+		case c == '[':
+			inBracket = true
+		case c == '\\' || c == '_' || c == '*':
+			i++
+		case c == ';':
+			i = len(code)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	return strings.ContainsAny(strings.ToLower(buf.String()), "ymdhs")
+}
+
+func isDateFormatId(id int) bool {
+	switch {
+	case 14 <= id && id <= 22, 27 <= id && id <= 36, 45 <= id && id <= 47, 50 <= id && id <= 58:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package xlsx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var parseCellRangeTests = []struct {
+	Input  string
+	Expect CellRange
+	Error  string
+}{
+	{
+		Input:  "",
+		Expect: CellRange{FirstColumn: 0, FirstRow: 0, LastColumn: -1, LastRow: -1},
+	},
+	{
+		Input:  "B3",
+		Expect: CellRange{FirstColumn: 1, FirstRow: 2, LastColumn: -1, LastRow: -1},
+	},
+	{
+		Input:  "b3:$AA$10",
+		Expect: CellRange{FirstColumn: 1, FirstRow: 2, LastColumn: 26, LastRow: 9},
+	},
+	{
+		Input:  "A2:D",
+		Expect: CellRange{FirstColumn: 0, FirstRow: 1, LastColumn: 3, LastRow: -1},
+	},
+	{
+		Input: "A",
+		Error: "cell range \"A\" is invalid",
+	},
+	{
+		Input: "C3:B4",
+		Error: "cell range \"C3:B4\" is invalid",
+	},
+	{
+		Input: "A1:B2:C3",
+		Error: "cell range \"A1:B2:C3\" is invalid",
+	},
+	{
+		Input: "A0",
+		Error: "cell range \"A0\" is invalid",
+	},
+}
+
+func TestParseCellRange(t *testing.T) {
+	for _, v := range parseCellRangeTests {
+		result, err := ParseCellRange(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %q", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var columnNameTests = []struct {
+	Input  int
+	Expect string
+}{
+	{Input: 0, Expect: "A"},
+	{Input: 25, Expect: "Z"},
+	{Input: 26, Expect: "AA"},
+	{Input: 701, Expect: "ZZ"},
+	{Input: 702, Expect: "AAA"},
+}
+
+func TestColumnName(t *testing.T) {
+	for _, v := range columnNameTests {
+		result := ColumnName(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %d", result, v.Expect, v.Input)
+		}
+
+		col, _, _ := ParseCellReference(result)
+		if col != v.Input {
+			t.Errorf("parsed column = %d, want %d for %q", col, v.Input, result)
+		}
+	}
+}
+
+var serialTests = []struct {
+	Serial   float64
+	Date1904 bool
+	Time     time.Time
+}{
+	{
+		Serial: 43831.5,
+		Time:   time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial: 59,
+		Time:   time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial: 61,
+		Time:   time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial:   42369.25,
+		Date1904: true,
+		Time:     time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestSerialToTime(t *testing.T) {
+	for _, v := range serialTests {
+		result := SerialToTime(v.Serial, v.Date1904, time.UTC)
+		if !result.Equal(v.Time) {
+			t.Errorf("result = %s, want %s for %f", result, v.Time, v.Serial)
+		}
+	}
+}
+
+func TestTimeToSerial(t *testing.T) {
+	for _, v := range serialTests {
+		result, _ := TimeToSerial(v.Time, v.Date1904)
+		if result != v.Serial {
+			t.Errorf("result = %f, want %f for %s", result, v.Serial, v.Time)
+		}
+	}
+
+	if _, ok := TimeToSerial(time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC), false); ok {
+		t.Error("result is ok, want not ok for a datetime before 1900")
+	}
+}
+
+var isDateFormatCodeTests = []struct {
+	Input  string
+	Expect bool
+}{
+	{Input: "yyyy-mm-dd", Expect: true},
+	{Input: "[h]:mm:ss", Expect: true},
+	{Input: "[$-409]d-mmm-yy;@", Expect: true},
+	{Input: "0.00", Expect: false},
+	{Input: "#,##0 \"days\"", Expect: false},
+	{Input: "[Red]0.00", Expect: false},
+	{Input: "General", Expect: false},
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	for _, v := range isDateFormatCodeTests {
+		result := isDateFormatCode(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %t, want %t for %q", result, v.Expect, v.Input)
+		}
+	}
+}
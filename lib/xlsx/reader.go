@@ -0,0 +1,493 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	relTypeOfficeDocument = "/officeDocument"
+	relTypeSharedStrings  = "/sharedStrings"
+	relTypeStyles         = "/styles"
+)
+
+type relationships struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type workbookXML struct {
+	WorkbookPr struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		Id   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type stylesXML struct {
+	NumFmts []struct {
+		Id   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtId int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type sheetEntry struct {
+	Name string
+	Path string
+}
+
+type Workbook struct {
+	files      map[string]*zip.File
+	sheets     []sheetEntry
+	strings    []string
+	dateStyles []bool
+	date1904   bool
+}
+
+func Open(r io.ReaderAt, size int64) (*Workbook, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("data is not a valid xlsx file")
+	}
+
+	wb := &Workbook{
+		files: make(map[string]*zip.File, len(zr.File)),
+	}
+	for _, f := range zr.File {
+		wb.files[strings.TrimPrefix(f.Name, "/")] = f
+	}
+
+	workbookPath := "xl/workbook.xml"
+	rels := &relationships{}
+	if ok, err := wb.decode("_rels/.rels", rels); err != nil {
+		return nil, err
+	} else if ok {
+		for _, rel := range rels.Relationships {
+			if strings.HasSuffix(rel.Type, relTypeOfficeDocument) {
+				workbookPath = resolvePath("", rel.Target)
+				break
+			}
+		}
+	}
+
+	workbook := &workbookXML{}
+	if ok, err := wb.decode(workbookPath, workbook); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errors.New("data is not a valid xlsx file")
+	}
+	wb.date1904 = workbook.WorkbookPr.Date1904 == "1" || strings.EqualFold(workbook.WorkbookPr.Date1904, "true")
+
+	baseDir := path.Dir(workbookPath)
+	relPath := path.Join(baseDir, "_rels", path.Base(workbookPath)+".rels")
+	targets := make(map[string]string)
+	sharedStringsPath := path.Join(baseDir, "sharedStrings.xml")
+	stylesPath := path.Join(baseDir, "styles.xml")
+
+	rels = &relationships{}
+	if _, err := wb.decode(relPath, rels); err != nil {
+		return nil, err
+	}
+	for _, rel := range rels.Relationships {
+		target := resolvePath(baseDir, rel.Target)
+		targets[rel.Id] = target
+		switch {
+		case strings.HasSuffix(rel.Type, relTypeSharedStrings):
+			sharedStringsPath = target
+		case strings.HasSuffix(rel.Type, relTypeStyles):
+			stylesPath = target
+		}
+	}
+
+	wb.sheets = make([]sheetEntry, 0, len(workbook.Sheets))
+	for _, s := range workbook.Sheets {
+		target, ok := targets[s.Id]
+		if !ok {
+			continue
+		}
+		wb.sheets = append(wb.sheets, sheetEntry{Name: s.Name, Path: target})
+	}
+
+	if err := wb.loadSharedStrings(sharedStringsPath); err != nil {
+		return nil, err
+	}
+	if err := wb.loadStyles(stylesPath); err != nil {
+		return nil, err
+	}
+
+	return wb, nil
+}
+
+func resolvePath(baseDir string, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(baseDir, target)
+}
+
+func (wb *Workbook) open(name string) (io.ReadCloser, bool, error) {
+	f, ok := wb.files[name]
+	if !ok {
+		return nil, false, nil
+	}
+	r, err := f.Open()
+	if err != nil {
+		return nil, true, err
+	}
+	return r, true, nil
+}
+
+func (wb *Workbook) decode(name string, v interface{}) (bool, error) {
+	r, ok, err := wb.open(name)
+	if !ok || err != nil {
+		return ok, err
+	}
+	defer r.Close()
+
+	if err = xml.NewDecoder(r).Decode(v); err != nil {
+		return true, errors.New(fmt.Sprintf("%s: %s", name, err.Error()))
+	}
+	return true, nil
+}
+
+func (wb *Workbook) loadSharedStrings(name string) error {
+	r, ok, err := wb.open(name)
+	if !ok || err != nil {
+		return err
+	}
+	defer r.Close()
+
+	wb.strings = make([]string, 0, 100)
+
+	decoder := xml.NewDecoder(r)
+	var buf strings.Builder
+	inText := false
+	inPhonetic := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.New(fmt.Sprintf("%s: %s", name, err.Error()))
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				buf.Reset()
+			case "t":
+				inText = !inPhonetic
+			case "rPh":
+				inPhonetic = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				wb.strings = append(wb.strings, buf.String())
+			case "t":
+				inText = false
+			case "rPh":
+				inPhonetic = false
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+	return nil
+}
+
+func (wb *Workbook) loadStyles(name string) error {
+	styles := &stylesXML{}
+	if ok, err := wb.decode(name, styles); !ok || err != nil {
+		return err
+	}
+
+	dateFormats := make(map[int]bool, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		dateFormats[f.Id] = isDateFormatCode(f.Code)
+	}
+
+	wb.dateStyles = make([]bool, len(styles.CellXfs))
+	for i, xf := range styles.CellXfs {
+		if isDate, ok := dateFormats[xf.NumFmtId]; ok {
+			wb.dateStyles[i] = isDate
+		} else {
+			wb.dateStyles[i] = isDateFormatId(xf.NumFmtId)
+		}
+	}
+	return nil
+}
+
+func (wb *Workbook) SheetNames() []string {
+	names := make([]string, len(wb.sheets))
+	for i, s := range wb.sheets {
+		names[i] = s.Name
+	}
+	return names
+}
+
+func (wb *Workbook) isDateStyle(s string) bool {
+	if len(s) < 1 {
+		return false
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 || len(wb.dateStyles) <= i {
+		return false
+	}
+	return wb.dateStyles[i]
+}
+
+func (wb *Workbook) sheetPath(sheetName string) (string, error) {
+	if len(wb.sheets) < 1 {
+		return "", errors.New("workbook has no sheets")
+	}
+	if len(sheetName) < 1 {
+		return wb.sheets[0].Path, nil
+	}
+	for _, s := range wb.sheets {
+		if strings.EqualFold(s.Name, sheetName) {
+			return s.Path, nil
+		}
+	}
+	return "", errors.New(fmt.Sprintf("sheet %q does not exist", sheetName))
+}
+
+func (wb *Workbook) LoadTable(sheetName string, cellRange CellRange, noHeader bool, withoutNull bool, loc *time.Location) ([]string, [][]value.Primary, error) {
+	spath, err := wb.sheetPath(sheetName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, ok, err := wb.open(spath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errors.New(fmt.Sprintf("sheet %q does not exist", sheetName))
+	}
+	defer r.Close()
+
+	rows, err := wb.readRows(r, cellRange, loc)
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("%s: %s", spath, err.Error()))
+	}
+
+	width := 0
+	if -1 < cellRange.LastColumn {
+		width = cellRange.LastColumn - cellRange.FirstColumn + 1
+	} else {
+		for _, row := range rows {
+			if width < len(row) {
+				width = len(row)
+			}
+		}
+	}
+
+	var header []string
+	if !noHeader && 0 < len(rows) {
+		header = make([]string, width)
+		for i, v := range rows[0] {
+			if i < width && v != nil {
+				header[i] = toString(v)
+			}
+		}
+		rows = rows[1:]
+	}
+
+	records := make([][]value.Primary, len(rows))
+	for i, row := range rows {
+		record := make([]value.Primary, width)
+		for j := 0; j < width; j++ {
+			switch {
+			case j < len(row) && row[j] != nil:
+				record[j] = row[j]
+			case withoutNull:
+				record[j] = value.NewString("")
+			default:
+				record[j] = value.NewNull()
+			}
+		}
+		records[i] = record
+	}
+
+	return header, records, nil
+}
+
+func (wb *Workbook) readRows(r io.Reader, cellRange CellRange, loc *time.Location) ([][]value.Primary, error) {
+	rows := make([][]value.Primary, 0, 1000)
+
+	decoder := xml.NewDecoder(r)
+
+	var row []value.Primary
+	rowIdx := -1
+	colIdx := -1
+
+	var cellType string
+	var cellStyle string
+	var buf strings.Builder
+	inValue := false
+	inPhonetic := false
+	hasValue := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				rowIdx++
+				colIdx = -1
+				if s := attr(t, "r"); 0 < len(s) {
+					if i, err := strconv.Atoi(s); err == nil && 0 < i {
+						rowIdx = i - 1
+					}
+				}
+				row = nil
+			case "c":
+				colIdx++
+				if s := attr(t, "r"); 0 < len(s) {
+					if col, _, err := ParseCellReference(s); err == nil && -1 < col {
+						colIdx = col
+					}
+				}
+				cellType = attr(t, "t")
+				cellStyle = attr(t, "s")
+				buf.Reset()
+				hasValue = false
+			case "v":
+				inValue = true
+				hasValue = true
+			case "is":
+				hasValue = true
+			case "t":
+				inValue = !inPhonetic
+			case "rPh":
+				inPhonetic = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "row":
+				if 0 < len(row) && cellRange.ContainsRow(rowIdx) {
+					rows = append(rows, row)
+				}
+				row = nil
+			case "c":
+				if !hasValue || !cellRange.ContainsRow(rowIdx) || !cellRange.ContainsColumn(colIdx) {
+					break
+				}
+				p, err := wb.cellValue(cellType, cellStyle, buf.String(), loc)
+				if err != nil {
+					return nil, errors.New(fmt.Sprintf("cell %s: %s", CellReference(colIdx, rowIdx), err.Error()))
+				}
+				if p == nil {
+					break
+				}
+				idx := colIdx - cellRange.FirstColumn
+				for len(row) <= idx {
+					row = append(row, nil)
+				}
+				row[idx] = p
+			case "v", "t":
+				inValue = false
+			case "rPh":
+				inPhonetic = false
+			case "sheetData":
+				return rows, nil
+			}
+		case xml.CharData:
+			if inValue {
+				buf.Write(t)
+			}
+		}
+	}
+	return rows, nil
+}
+
+func (wb *Workbook) cellValue(cellType string, cellStyle string, s string, loc *time.Location) (value.Primary, error) {
+	switch cellType {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || i < 0 || len(wb.strings) <= i {
+			return nil, errors.New("invalid shared string index")
+		}
+		return value.NewString(wb.strings[i]), nil
+	case "inlineStr", "str":
+		return value.NewString(s), nil
+	case "b":
+		return value.NewBoolean(strings.TrimSpace(s) == "1"), nil
+	case "e":
+		return nil, nil
+	case "d":
+		t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", strings.TrimSpace(strings.TrimSuffix(s, "Z")), loc)
+		if err != nil {
+			return value.NewString(s), nil
+		}
+		return value.NewDatetime(t), nil
+	}
+
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return value.NewString(s), nil
+	}
+	if wb.isDateStyle(cellStyle) {
+		return value.NewDatetime(SerialToTime(f, wb.date1904, loc)), nil
+	}
+	return value.ParseFloat64(f), nil
+}
+
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func toString(p value.Primary) string {
+	switch p.(type) {
+	case value.String:
+		return p.(value.String).Raw()
+	case value.Boolean:
+		return strconv.FormatBool(p.(value.Boolean).Raw())
+	case value.Datetime:
+		return p.(value.Datetime).Format(time.RFC3339Nano)
+	}
+	if s := value.ToString(p); !value.IsNull(s) {
+		return s.(value.String).Raw()
+	}
+	return ""
+}
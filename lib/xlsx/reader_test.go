@@ -0,0 +1,153 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func createTestWorkbook(files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, _ := zw.Create(name)
+		w.Write([]byte(content))
+	}
+	zw.Close()
+	return buf.Bytes()
+}
+
+var testWorkbook = createTestWorkbook(map[string]string{
+	"_rels/.rels": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`,
+	"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Summary" sheetId="1" r:id="rId1"/><sheet name="Data" sheetId="2" r:id="rId2"/></sheets>` +
+		`</workbook>`,
+	"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet2.xml"/>` +
+		`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>` +
+		`<Relationship Id="rId4" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`,
+	"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<si><t>id</t></si>` +
+		`<si><t>name</t></si>` +
+		`<si><r><t>rich </t></r><r><t>text</t></r><rPh><t>phonetic</t></rPh></si>` +
+		`</sst>`,
+	"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<numFmts><numFmt numFmtId="164" formatCode="yyyy/mm/dd"/></numFmts>` +
+		`<cellXfs><xf numFmtId="0"/><xf numFmtId="164"/><xf numFmtId="22"/><xf numFmtId="2"/></cellXfs>` +
+		`</styleSheet>`,
+	"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1" t="inlineStr"><is><t>summary</t></is></c></row>` +
+		`</sheetData></worksheet>`,
+	"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="1"><c r="A1" t="inlineStr"><is><t>title</t></is></c></row>` +
+		`<row r="3"><c r="B3" t="s"><v>0</v></c><c r="C3" t="s"><v>1</v></c><c r="D3" t="inlineStr"><is><t>date</t></is></c><c r="E3" t="inlineStr"><is><t>flag</t></is></c></row>` +
+		`<row r="4"><c r="B4"><v>1</v></c><c r="C4" t="s"><v>2</v></c><c r="D4" s="1"><v>43831</v></c><c r="E4" t="b"><v>1</v></c></row>` +
+		`<row r="5" spans="2:5"><c r="B5" s="3"><v>2.5</v></c><c r="D5" s="2"><v>43831.75</v></c><c r="E5" t="e"><v>#N/A</v></c></row>` +
+		`<row r="6"><c r="B6" s="3"/></row>` +
+		`<row r="7"><c r="B7"><v>3</v></c><c r="C7" t="str"><f>"a"&amp;"b"</f><v>ab</v></c></row>` +
+		`</sheetData></worksheet>`,
+})
+
+var workbookLoadTableTests = []struct {
+	Name         string
+	Sheet        string
+	Range        string
+	NoHeader     bool
+	WithoutNull  bool
+	ExpectHeader []string
+	ExpectRows   [][]value.Primary
+	Error        string
+}{
+	{
+		Name:         "First Sheet",
+		ExpectHeader: []string{"summary"},
+		ExpectRows:   [][]value.Primary{},
+	},
+	{
+		Name:         "Sheet with Range",
+		Sheet:        "data",
+		Range:        "B3:E7",
+		ExpectHeader: []string{"id", "name", "date", "flag"},
+		ExpectRows: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("rich text"), value.NewDatetime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), value.NewBoolean(true)},
+			{value.NewFloat(2.5), value.NewNull(), value.NewDatetime(time.Date(2020, 1, 1, 18, 0, 0, 0, time.UTC)), value.NewNull()},
+			{value.NewInteger(3), value.NewString("ab"), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:        "Sheet without Header",
+		Sheet:       "Data",
+		Range:       "B4:C",
+		NoHeader:    true,
+		WithoutNull: true,
+		ExpectRows: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("rich text")},
+			{value.NewFloat(2.5), value.NewString("")},
+			{value.NewInteger(3), value.NewString("ab")},
+		},
+	},
+	{
+		Name:  "Sheet Not Exist",
+		Sheet: "notexist",
+		Error: "sheet \"notexist\" does not exist",
+	},
+}
+
+func TestWorkbook_LoadTable(t *testing.T) {
+	wb, err := Open(bytes.NewReader(testWorkbook), int64(len(testWorkbook)))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if !reflect.DeepEqual(wb.SheetNames(), []string{"Summary", "Data"}) {
+		t.Errorf("sheet names = %q, want %q", wb.SheetNames(), []string{"Summary", "Data"})
+	}
+
+	for _, v := range workbookLoadTableTests {
+		cellRange, _ := ParseCellRange(v.Range)
+		header, rows, err := wb.LoadTable(v.Sheet, cellRange, v.NoHeader, v.WithoutNull, time.UTC)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.ExpectHeader)
+		}
+		if !reflect.DeepEqual(rows, v.ExpectRows) {
+			t.Errorf("%s: rows = %v, want %v", v.Name, rows, v.ExpectRows)
+		}
+	}
+}
+
+func TestOpen(t *testing.T) {
+	data := []byte("a,b,c\n1,2,3\n")
+	_, err := Open(bytes.NewReader(data), int64(len(data)))
+	if err == nil {
+		t.Error("no error, want error for invalid data")
+	} else if err.Error() != "data is not a valid xlsx file" {
+		t.Errorf("error %q, want error %q", err.Error(), "data is not a valid xlsx file")
+	}
+}
@@ -0,0 +1,227 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const DefaultSheetName = "Sheet1"
+
+const maxSheetNameLength = 31
+
+const (
+	styleDefault  = 0
+	styleDatetime = 1
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const contentTypesXML = xmlHeader +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRelsXML = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const workbookXMLTemplate = xmlHeader +
+	`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const stylesXMLContent = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy\-mm\-dd\ hh:mm:ss"/></numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+func ValidateSheetName(name string) error {
+	if len(name) < 1 {
+		return errors.New("sheet name is empty")
+	}
+	if maxSheetNameLength < utf8.RuneCountInString(name) {
+		return errors.New(fmt.Sprintf("sheet name %q must be at most %d characters", name, maxSheetNameLength))
+	}
+	if strings.ContainsAny(name, "[]:*?/\\") || strings.HasPrefix(name, "'") || strings.HasSuffix(name, "'") {
+		return errors.New(fmt.Sprintf("sheet name %q contains invalid characters", name))
+	}
+	return nil
+}
+
+func Write(w io.Writer, sheetName string, header []string, records [][]value.Primary) error {
+	if len(sheetName) < 1 {
+		sheetName = DefaultSheetName
+	}
+	if err := ValidateSheetName(sheetName); err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	modified := time.Now()
+
+	var sheetNameBuf strings.Builder
+	if err := xml.EscapeText(&sheetNameBuf, []byte(sheetName)); err != nil {
+		return err
+	}
+
+	parts := []struct {
+		Name    string
+		Content string
+	}{
+		{Name: "[Content_Types].xml", Content: contentTypesXML},
+		{Name: "_rels/.rels", Content: rootRelsXML},
+		{Name: "xl/workbook.xml", Content: fmt.Sprintf(workbookXMLTemplate, sheetNameBuf.String())},
+		{Name: "xl/_rels/workbook.xml.rels", Content: workbookRelsXML},
+		{Name: "xl/styles.xml", Content: stylesXMLContent},
+	}
+	for _, part := range parts {
+		fw, err := createPart(zw, part.Name, modified)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(fw, part.Content); err != nil {
+			return err
+		}
+	}
+
+	fw, err := createPart(zw, "xl/worksheets/sheet1.xml", modified)
+	if err != nil {
+		return err
+	}
+	if err = writeSheet(fw, header, records); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func createPart(zw *zip.Writer, name string, modified time.Time) (io.Writer, error) {
+	return zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+}
+
+func writeSheet(w io.Writer, header []string, records [][]value.Primary) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(xmlHeader)
+	bw.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	bw.WriteString(`<sheetData>`)
+
+	rowIdx := 0
+	if header != nil {
+		bw.WriteString(`<row r="` + strconv.Itoa(rowIdx+1) + `">`)
+		for i, v := range header {
+			writeStringCell(bw, CellReference(i, rowIdx), v)
+		}
+		bw.WriteString(`</row>`)
+		rowIdx++
+	}
+
+	for _, record := range records {
+		bw.WriteString(`<row r="` + strconv.Itoa(rowIdx+1) + `">`)
+		for i, v := range record {
+			writeCell(bw, CellReference(i, rowIdx), v)
+		}
+		bw.WriteString(`</row>`)
+		rowIdx++
+	}
+
+	bw.WriteString(`</sheetData>`)
+	bw.WriteString(`</worksheet>`)
+	return bw.Flush()
+}
+
+func writeCell(w *bufio.Writer, ref string, p value.Primary) {
+	switch p.(type) {
+	case value.String:
+		writeStringCell(w, ref, p.(value.String).Raw())
+	case value.Integer:
+		writeNumberCell(w, ref, styleDefault, strconv.FormatInt(p.(value.Integer).Raw(), 10))
+	case value.Float:
+		f := p.(value.Float).Raw()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			writeStringCell(w, ref, p.(value.Float).String())
+		} else {
+			writeNumberCell(w, ref, styleDefault, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case value.Decimal:
+		writeNumberCell(w, ref, styleDefault, p.(value.Decimal).String())
+	case value.Interval:
+		writeStringCell(w, ref, p.(value.Interval).ISO8601())
+	case value.Boolean:
+		writeBooleanCell(w, ref, p.(value.Boolean).Raw())
+	case value.Ternary:
+		if t := p.(value.Ternary).Ternary(); t != ternary.UNKNOWN {
+			writeBooleanCell(w, ref, t.ParseBool())
+		}
+	case value.Datetime:
+		t := p.(value.Datetime).Raw()
+		if serial, ok := TimeToSerial(t, false); ok {
+			writeNumberCell(w, ref, styleDatetime, strconv.FormatFloat(serial, 'f', -1, 64))
+		} else {
+			writeStringCell(w, ref, t.Format(time.RFC3339Nano))
+		}
+	}
+}
+
+func writeNumberCell(w *bufio.Writer, ref string, style int, s string) {
+	w.WriteString(`<c r="` + ref + `"`)
+	if style != styleDefault {
+		w.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+	w.WriteString(`><v>` + s + `</v></c>`)
+}
+
+func writeBooleanCell(w *bufio.Writer, ref string, b bool) {
+	v := "0"
+	if b {
+		v = "1"
+	}
+	w.WriteString(`<c r="` + ref + `" t="b"><v>` + v + `</v></c>`)
+}
+
+func writeStringCell(w *bufio.Writer, ref string, s string) {
+	w.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t`)
+	if 0 < len(s) && (strings.TrimSpace(s) != s) {
+		w.WriteString(` xml:space="preserve"`)
+	}
+	w.WriteString(`>`)
+	xml.EscapeText(w, []byte(s))
+	w.WriteString(`</t></is></c>`)
+}
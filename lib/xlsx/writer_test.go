@@ -0,0 +1,120 @@
+package xlsx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var writeTests = []struct {
+	Name         string
+	Sheet        string
+	Header       []string
+	Records      [][]value.Primary
+	ExpectSheet  string
+	ExpectHeader []string
+	ExpectRows   [][]value.Primary
+	Error        string
+}{
+	{
+		Name:   "Write Typed Values",
+		Header: []string{"str", "int", "float", "bool", "ternary", "datetime", "null"},
+		Records: [][]value.Primary{
+			{
+				value.NewString(" a & <b> "),
+				value.NewInteger(-12),
+				value.NewFloat(1.25),
+				value.NewBoolean(true),
+				value.NewTernary(ternary.FALSE),
+				value.NewDatetime(time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)),
+				value.NewNull(),
+			},
+			{
+				value.NewString("str"),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewTernary(ternary.UNKNOWN),
+				value.NewDatetime(time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)),
+				value.NewString("last"),
+			},
+		},
+		ExpectSheet:  "Sheet1",
+		ExpectHeader: []string{"str", "int", "float", "bool", "ternary", "datetime", "null"},
+		ExpectRows: [][]value.Primary{
+			{
+				value.NewString(" a & <b> "),
+				value.NewInteger(-12),
+				value.NewFloat(1.25),
+				value.NewBoolean(true),
+				value.NewBoolean(false),
+				value.NewDatetime(time.Date(2020, 2, 3, 4, 5, 6, 0, time.UTC)),
+				value.NewNull(),
+			},
+			{
+				value.NewString("str"),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewString("1800-01-01T00:00:00Z"),
+				value.NewString("last"),
+			},
+		},
+	},
+	{
+		Name:         "Write without Header",
+		Sheet:        "Result",
+		Records:      [][]value.Primary{{value.NewInteger(1)}},
+		ExpectSheet:  "Result",
+		ExpectHeader: []string{"1"},
+		ExpectRows:   [][]value.Primary{},
+	},
+	{
+		Name:  "Invalid Sheet Name",
+		Sheet: "a/b",
+		Error: "sheet name \"a/b\" contains invalid characters",
+	},
+}
+
+func TestWrite(t *testing.T) {
+	for _, v := range writeTests {
+		buf := new(bytes.Buffer)
+		err := Write(buf, v.Sheet, v.Header, v.Records)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		wb, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(wb.SheetNames(), []string{v.ExpectSheet}) {
+			t.Errorf("%s: sheet names = %q, want %q", v.Name, wb.SheetNames(), []string{v.ExpectSheet})
+		}
+
+		cellRange, _ := ParseCellRange("")
+		header, rows, _ := wb.LoadTable("", cellRange, false, false, time.UTC)
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.ExpectHeader)
+		}
+		if !reflect.DeepEqual(rows, v.ExpectRows) {
+			t.Errorf("%s: rows = %v, want %v", v.Name, rows, v.ExpectRows)
+		}
+	}
+}
@@ -76,7 +76,7 @@ func main() {
 		cli.StringFlag{
 			Name:  "encoding, e",
 			Value: "UTF8",
-			Usage: "file encoding. one of: UTF8|SJIS",
+			Usage: "file encoding. one of: AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5",
 		},
 		cli.BoolFlag{
 			Name:  "no-header, n",
@@ -86,6 +86,14 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.BoolFlag{
+			Name:  "number-as-decimal, g",
+			Usage: "calculate numeric strings as decimal numbers",
+		},
+		cli.IntFlag{
+			Name:  "infer-types",
+			Usage: "infer column types of loaded tables from the first `NUMBER` of records. 0 means no inference",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Usage: "export result sets of select queries to `FILE`",
@@ -93,12 +101,12 @@ func main() {
 		cli.StringFlag{
 			Name:  "format, f",
 			Value: "TEXT",
-			Usage: "format of query results. one of: CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+			Usage: "format of query results. one of: CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT|XLSX",
 		},
 		cli.StringFlag{
 			Name:  "write-encoding, E",
 			Value: "UTF8",
-			Usage: "character encoding of query results. one of: UTF8|SJIS",
+			Usage: "character encoding of query results. one of: UTF8|UTF8M|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP|LATIN1|CP1252|GBK|BIG5",
 		},
 		cli.StringFlag{
 			Name:  "write-delimiter, D",
@@ -127,6 +135,10 @@ func main() {
 			Name:  "pretty-print, P",
 			Usage: "make JSON output easier to read in query results",
 		},
+		cli.StringFlag{
+			Name:  "compression, C",
+			Usage: "compression of query results. one of: NONE|GZIP|BZIP2|ZSTD|XZ",
+		},
 		cli.BoolFlag{
 			Name:  "east-asian-encoding, W",
 			Usage: "count ambiguous characters as fullwidth",
@@ -152,6 +164,10 @@ func main() {
 			Value: cmd.GetDefaultNumberOfCPU(),
 			Usage: "hint for the number of cpu cores to be used",
 		},
+		cli.IntFlag{
+			Name:  "memory-limit, m",
+			Usage: "memory limit in megabytes for sorting and grouping. 0 means no limit",
+		},
 		cli.BoolFlag{
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
@@ -202,6 +218,49 @@ func main() {
 				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
 			},
 		},
+		{
+			Name:  "serve",
+			Usage: "Serve queries over HTTP",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "listen, L",
+					Value: "localhost:8080",
+					Usage: "TCP address to listen on",
+				},
+				cli.Float64Flag{
+					Name:  "session-timeout, T",
+					Value: 600,
+					Usage: "idle seconds before a session is rolled back and closed",
+				},
+				cli.Int64Flag{
+					Name:  "max-request-size, M",
+					Value: action.DefaultMaxRequestSize,
+					Usage: "maximum size of a request body in bytes",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				err := action.Serve(c.String("listen"), c.Float64("session-timeout"), c.Int64("max-request-size"))
+				if err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+			OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+				return NewExitError(fmt.Sprintf("Incorrect Usage: %s", err.Error()), 1)
+			},
+		},
+		{
+			Name:   action.WorkerCommandName,
+			Hidden: true,
+			Action: func(c *cli.Context) error {
+				if err := action.ServeWorker(); err != nil {
+					return NewExitError(err.Error(), 1)
+				}
+
+				return nil
+			},
+		},
 		{
 			Name:      "syntax",
 			Usage:     "Print syntax",
@@ -288,7 +347,7 @@ func readQuery(c *cli.Context) (string, string, error) {
 		if !file.Exists(path) {
 			return queryString, path, errors.New(fmt.Sprintf("file %q does not exist", path))
 		}
-		h, err := file.NewHandlerForRead(path)
+		h, err := file.NewHandlerForRead(path, cmd.GetFlags().WaitTimeout, cmd.GetFlags().RetryInterval)
 		if err != nil {
 			return queryString, path, errors.New(fmt.Sprintf("failed to read file: %s", err.Error()))
 		}
@@ -352,6 +411,12 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("without-null") {
 		flags.SetWithoutNull(c.GlobalBool("without-null"))
 	}
+	if c.IsSet("number-as-decimal") {
+		flags.SetNumberAsDecimal(c.GlobalBool("number-as-decimal"))
+	}
+	if c.IsSet("infer-types") {
+		flags.SetInferTypes(c.GlobalInt("infer-types"))
+	}
 
 	if c.IsSet("format") {
 		if err := flags.SetFormat(c.GlobalString("format"), c.GlobalString("out")); err != nil {
@@ -387,6 +452,9 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("pretty-print") {
 		flags.SetPrettyPrint(c.GlobalBool("pretty-print"))
 	}
+	if err := flags.SetCompression(c.GlobalString("compression"), c.GlobalString("out")); err != nil {
+		return err
+	}
 
 	if c.IsSet("east-asian-encoding") {
 		flags.SetEastAsianEncoding(c.GlobalBool("east-asian-encoding"))
@@ -404,6 +472,9 @@ func overwriteFlags(c *cli.Context) error {
 	if c.IsSet("cpu") {
 		flags.SetCPU(c.GlobalInt("cpu"))
 	}
+	if c.IsSet("memory-limit") {
+		flags.SetMemoryLimit(c.GlobalInt("memory-limit"))
+	}
 	if c.IsSet("stats") {
 		flags.SetStats(c.GlobalBool("stats"))
 	}
@@ -425,7 +496,7 @@ func runPreloadCommands(proc *query.Procedure) error {
 			continue
 		}
 
-		statements, err := query.LoadStatementsFromFile(parser.Source{}, fpath)
+		statements, err := query.LoadStatementsFromFile(parser.Source{}, fpath, cmd.GetFlags())
 		if err != nil {
 			if e, ok := err.(*query.ReadFileError); ok {
 				err = errors.New(e.ErrorMessage())